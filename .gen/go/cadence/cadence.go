@@ -132,6 +132,16 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - HeartbeatRequest
   RecordActivityTaskHeartbeat(heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error)
+  // RecordActivityTaskHeartbeatByID is called by application worker while it is processing an ActivityTask.  If worker fails
+  // to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
+  // 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeatByID' will
+  // fail with 'EntityNotExistsError' in such situations.  Instead of using 'taskToken' like in RecordActivityTaskHeartbeat,
+  // use Domain, WorkflowID, RunID and ActivityID
+  // 
+  // 
+  // Parameters:
+  //  - HeartbeatRequest
+  RecordActivityTaskHeartbeatByID(heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error)
   // RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
   // result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
   // created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
@@ -142,6 +152,16 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - CompleteRequest
   RespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest) (err error)
+  // RespondActivityTaskCompletedByID is called by application worker when it is done processing an ActivityTask.
+  // It will result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
+  // created for the workflow so new decisions could be made.  Similar to RespondActivityTaskCompleted but use Domain,
+  // WorkflowID, RunID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'
+  // if these IDs are not valid anymore due to activity timeout.
+  // 
+  // 
+  // Parameters:
+  //  - CompleteRequest
+  RespondActivityTaskCompletedByID(completeRequest *shared.RespondActivityTaskCompletedByIDRequest) (err error)
   // RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
   // result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
   // created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
@@ -152,6 +172,16 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - FailRequest
   RespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest) (err error)
+  // RespondActivityTaskFailedByID is called by application worker when it is done processing an ActivityTask.
+  // It will result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
+  // created for the workflow instance so new decisions could be made.  Similar to RespondActivityTaskFailed but use
+  // Domain, WorkflowID, RunID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'
+  // if these IDs are not valid anymore due to activity timeout.
+  // 
+  // 
+  // Parameters:
+  //  - FailRequest
+  RespondActivityTaskFailedByID(failRequest *shared.RespondActivityTaskFailedByIDRequest) (err error)
   // RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
   // result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
   // created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
@@ -162,6 +192,16 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - CanceledRequest
   RespondActivityTaskCanceled(canceledRequest *shared.RespondActivityTaskCanceledRequest) (err error)
+  // RespondActivityTaskCanceledByID is called by application worker when it is successfully canceled an ActivityTask.
+  // It will result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
+  // created for the workflow instance so new decisions could be made.  Similar to RespondActivityTaskCanceled but use
+  // Domain, WorkflowID, RunID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'
+  // if these IDs are not valid anymore due to activity timeout.
+  // 
+  // 
+  // Parameters:
+  //  - CanceledRequest
+  RespondActivityTaskCanceledByID(canceledRequest *shared.RespondActivityTaskCanceledByIDRequest) (err error)
   // RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
   // It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
   // created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
@@ -178,6 +218,16 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - SignalRequest
   SignalWorkflowExecution(signalRequest *shared.SignalWorkflowExecutionRequest) (err error)
+  // SignalWithStartWorkflowExecution is used to ensure sending signal to a workflow.
+  // If the workflow is running, this results in WorkflowExecutionSignaled event being recorded in the history
+  // and a decision task being created for the execution.
+  // If the workflow is not running or not found, this results in WorkflowExecutionStarted and WorkflowExecutionSignaled
+  // events being recorded in history, and a decision task being created for the execution
+  // 
+  // 
+  // Parameters:
+  //  - SignalWithStartRequest
+  SignalWithStartWorkflowExecution(signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error)
   // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
   // in the history and immediately terminating the execution instance.
   // 
@@ -1166,32 +1216,32 @@ func (p *WorkflowServiceClient) recvRecordActivityTaskHeartbeat() (value *shared
   return
 }
 
-// RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
-// result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
-// created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
-// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
-// anymore due to activity timeout.
+// RecordActivityTaskHeartbeatByID is called by application worker while it is processing an ActivityTask.  If worker fails
+// to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
+// 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeatByID' will
+// fail with 'EntityNotExistsError' in such situations.  Instead of using 'taskToken' like in RecordActivityTaskHeartbeat,
+// use Domain, WorkflowID, RunID and ActivityID
 // 
 // 
 // Parameters:
-//  - CompleteRequest
-func (p *WorkflowServiceClient) RespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest) (err error) {
-  if err = p.sendRespondActivityTaskCompleted(completeRequest); err != nil { return }
-  return p.recvRespondActivityTaskCompleted()
+//  - HeartbeatRequest
+func (p *WorkflowServiceClient) RecordActivityTaskHeartbeatByID(heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error) {
+  if err = p.sendRecordActivityTaskHeartbeatByID(heartbeatRequest); err != nil { return }
+  return p.recvRecordActivityTaskHeartbeatByID()
 }
 
-func (p *WorkflowServiceClient) sendRespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest)(err error) {
+func (p *WorkflowServiceClient) sendRecordActivityTaskHeartbeatByID(heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RecordActivityTaskHeartbeatByID", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceRespondActivityTaskCompletedArgs{
-  CompleteRequest : completeRequest,
+  args := WorkflowServiceRecordActivityTaskHeartbeatByIDArgs{
+  HeartbeatRequest : heartbeatRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1203,7 +1253,7 @@ func (p *WorkflowServiceClient) sendRespondActivityTaskCompleted(completeRequest
 }
 
 
-func (p *WorkflowServiceClient) recvRespondActivityTaskCompleted() (err error) {
+func (p *WorkflowServiceClient) recvRecordActivityTaskHeartbeatByID() (value *shared.RecordActivityTaskHeartbeatResponse, err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1213,32 +1263,32 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskCompleted() (err error) {
   if err != nil {
     return
   }
-  if method != "RespondActivityTaskCompleted" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCompleted failed: wrong method name")
+  if method != "RecordActivityTaskHeartbeatByID" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordActivityTaskHeartbeatByID failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCompleted failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordActivityTaskHeartbeatByID failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error20 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error21 error
-    error21, err = error20.Read(iprot)
+    error18 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error19 error
+    error19, err = error18.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error21
+    err = error19
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCompleted failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordActivityTaskHeartbeatByID failed: invalid message type")
     return
   }
-  result := WorkflowServiceRespondActivityTaskCompletedResult{}
+  result := WorkflowServiceRecordActivityTaskHeartbeatByIDResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1255,35 +1305,36 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskCompleted() (err error) {
     err = result.EntityNotExistError
     return 
   }
+  value = result.GetSuccess()
   return
 }
 
-// RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
-// result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
-// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
+// RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
+// result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
+// created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
 // PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
 // anymore due to activity timeout.
 // 
 // 
 // Parameters:
-//  - FailRequest
-func (p *WorkflowServiceClient) RespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest) (err error) {
-  if err = p.sendRespondActivityTaskFailed(failRequest); err != nil { return }
-  return p.recvRespondActivityTaskFailed()
+//  - CompleteRequest
+func (p *WorkflowServiceClient) RespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest) (err error) {
+  if err = p.sendRespondActivityTaskCompleted(completeRequest); err != nil { return }
+  return p.recvRespondActivityTaskCompleted()
 }
 
-func (p *WorkflowServiceClient) sendRespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceRespondActivityTaskFailedArgs{
-  FailRequest : failRequest,
+  args := WorkflowServiceRespondActivityTaskCompletedArgs{
+  CompleteRequest : completeRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1295,7 +1346,7 @@ func (p *WorkflowServiceClient) sendRespondActivityTaskFailed(failRequest *share
 }
 
 
-func (p *WorkflowServiceClient) recvRespondActivityTaskFailed() (err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskCompleted() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1305,32 +1356,32 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskFailed() (err error) {
   if err != nil {
     return
   }
-  if method != "RespondActivityTaskFailed" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskFailed failed: wrong method name")
+  if method != "RespondActivityTaskCompleted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCompleted failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskFailed failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCompleted failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error22 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error23 error
-    error23, err = error22.Read(iprot)
+    error20 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error21 error
+    error21, err = error20.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error23
+    err = error21
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskFailed failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCompleted failed: invalid message type")
     return
   }
-  result := WorkflowServiceRespondActivityTaskFailedResult{}
+  result := WorkflowServiceRespondActivityTaskCompletedResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1350,32 +1401,32 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskFailed() (err error) {
   return
 }
 
-// RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
-// result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
-// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
-// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
-// anymore due to activity timeout.
+// RespondActivityTaskCompletedByID is called by application worker when it is done processing an ActivityTask.
+// It will result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
+// created for the workflow so new decisions could be made.  Similar to RespondActivityTaskCompleted but use Domain,
+// WorkflowID, RunID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'
+// if these IDs are not valid anymore due to activity timeout.
 // 
 // 
 // Parameters:
-//  - CanceledRequest
-func (p *WorkflowServiceClient) RespondActivityTaskCanceled(canceledRequest *shared.RespondActivityTaskCanceledRequest) (err error) {
-  if err = p.sendRespondActivityTaskCanceled(canceledRequest); err != nil { return }
-  return p.recvRespondActivityTaskCanceled()
+//  - CompleteRequest
+func (p *WorkflowServiceClient) RespondActivityTaskCompletedByID(completeRequest *shared.RespondActivityTaskCompletedByIDRequest) (err error) {
+  if err = p.sendRespondActivityTaskCompletedByID(completeRequest); err != nil { return }
+  return p.recvRespondActivityTaskCompletedByID()
 }
 
-func (p *WorkflowServiceClient) sendRespondActivityTaskCanceled(canceledRequest *shared.RespondActivityTaskCanceledRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskCompletedByID(completeRequest *shared.RespondActivityTaskCompletedByIDRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskCompletedByID", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceRespondActivityTaskCanceledArgs{
-  CanceledRequest : canceledRequest,
+  args := WorkflowServiceRespondActivityTaskCompletedByIDArgs{
+  CompleteRequest : completeRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1387,7 +1438,7 @@ func (p *WorkflowServiceClient) sendRespondActivityTaskCanceled(canceledRequest
 }
 
 
-func (p *WorkflowServiceClient) recvRespondActivityTaskCanceled() (err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskCompletedByID() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1397,32 +1448,32 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskCanceled() (err error) {
   if err != nil {
     return
   }
-  if method != "RespondActivityTaskCanceled" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCanceled failed: wrong method name")
+  if method != "RespondActivityTaskCompletedByID" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCompletedByID failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCanceled failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCompletedByID failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error24 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error25 error
-    error25, err = error24.Read(iprot)
+    error20 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error21 error
+    error21, err = error20.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error25
+    err = error21
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCanceled failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCompletedByID failed: invalid message type")
     return
   }
-  result := WorkflowServiceRespondActivityTaskCanceledResult{}
+  result := WorkflowServiceRespondActivityTaskCompletedByIDResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1442,31 +1493,32 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskCanceled() (err error) {
   return
 }
 
-// RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
-// It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
-// created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
-// anymore due to completion or doesn't exist.
+// RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
+// result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
+// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
+// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
+// anymore due to activity timeout.
 // 
 // 
 // Parameters:
-//  - CancelRequest
-func (p *WorkflowServiceClient) RequestCancelWorkflowExecution(cancelRequest *shared.RequestCancelWorkflowExecutionRequest) (err error) {
-  if err = p.sendRequestCancelWorkflowExecution(cancelRequest); err != nil { return }
-  return p.recvRequestCancelWorkflowExecution()
+//  - FailRequest
+func (p *WorkflowServiceClient) RespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest) (err error) {
+  if err = p.sendRespondActivityTaskFailed(failRequest); err != nil { return }
+  return p.recvRespondActivityTaskFailed()
 }
 
-func (p *WorkflowServiceClient) sendRequestCancelWorkflowExecution(cancelRequest *shared.RequestCancelWorkflowExecutionRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceRequestCancelWorkflowExecutionArgs{
-  CancelRequest : cancelRequest,
+  args := WorkflowServiceRespondActivityTaskFailedArgs{
+  FailRequest : failRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1478,7 +1530,7 @@ func (p *WorkflowServiceClient) sendRequestCancelWorkflowExecution(cancelRequest
 }
 
 
-func (p *WorkflowServiceClient) recvRequestCancelWorkflowExecution() (err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskFailed() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1488,32 +1540,32 @@ func (p *WorkflowServiceClient) recvRequestCancelWorkflowExecution() (err error)
   if err != nil {
     return
   }
-  if method != "RequestCancelWorkflowExecution" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RequestCancelWorkflowExecution failed: wrong method name")
+  if method != "RespondActivityTaskFailed" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskFailed failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RequestCancelWorkflowExecution failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskFailed failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error26 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error27 error
-    error27, err = error26.Read(iprot)
+    error22 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error23 error
+    error23, err = error22.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error27
+    err = error23
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RequestCancelWorkflowExecution failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskFailed failed: invalid message type")
     return
   }
-  result := WorkflowServiceRequestCancelWorkflowExecutionResult{}
+  result := WorkflowServiceRespondActivityTaskFailedResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1529,39 +1581,36 @@ func (p *WorkflowServiceClient) recvRequestCancelWorkflowExecution() (err error)
   } else   if result.EntityNotExistError != nil {
     err = result.EntityNotExistError
     return 
-  } else   if result.CancellationAlreadyRequestedError != nil {
-    err = result.CancellationAlreadyRequestedError
-    return 
-  } else   if result.ServiceBusyError != nil {
-    err = result.ServiceBusyError
-    return 
   }
   return
 }
 
-// SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
-// WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
+// RespondActivityTaskFailedByID is called by application worker when it is done processing an ActivityTask.
+// It will result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
+// created for the workflow instance so new decisions could be made.  Similar to RespondActivityTaskFailed but use
+// Domain, WorkflowID, RunID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'
+// if these IDs are not valid anymore due to activity timeout.
 // 
 // 
 // Parameters:
-//  - SignalRequest
-func (p *WorkflowServiceClient) SignalWorkflowExecution(signalRequest *shared.SignalWorkflowExecutionRequest) (err error) {
-  if err = p.sendSignalWorkflowExecution(signalRequest); err != nil { return }
-  return p.recvSignalWorkflowExecution()
+//  - FailRequest
+func (p *WorkflowServiceClient) RespondActivityTaskFailedByID(failRequest *shared.RespondActivityTaskFailedByIDRequest) (err error) {
+  if err = p.sendRespondActivityTaskFailedByID(failRequest); err != nil { return }
+  return p.recvRespondActivityTaskFailedByID()
 }
 
-func (p *WorkflowServiceClient) sendSignalWorkflowExecution(signalRequest *shared.SignalWorkflowExecutionRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskFailedByID(failRequest *shared.RespondActivityTaskFailedByIDRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskFailedByID", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceSignalWorkflowExecutionArgs{
-  SignalRequest : signalRequest,
+  args := WorkflowServiceRespondActivityTaskFailedByIDArgs{
+  FailRequest : failRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1573,7 +1622,7 @@ func (p *WorkflowServiceClient) sendSignalWorkflowExecution(signalRequest *share
 }
 
 
-func (p *WorkflowServiceClient) recvSignalWorkflowExecution() (err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskFailedByID() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1583,32 +1632,32 @@ func (p *WorkflowServiceClient) recvSignalWorkflowExecution() (err error) {
   if err != nil {
     return
   }
-  if method != "SignalWorkflowExecution" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SignalWorkflowExecution failed: wrong method name")
+  if method != "RespondActivityTaskFailedByID" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskFailedByID failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SignalWorkflowExecution failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskFailedByID failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error28 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error29 error
-    error29, err = error28.Read(iprot)
+    error22 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error23 error
+    error23, err = error22.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error29
+    err = error23
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SignalWorkflowExecution failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskFailedByID failed: invalid message type")
     return
   }
-  result := WorkflowServiceSignalWorkflowExecutionResult{}
+  result := WorkflowServiceRespondActivityTaskFailedByIDResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1624,36 +1673,36 @@ func (p *WorkflowServiceClient) recvSignalWorkflowExecution() (err error) {
   } else   if result.EntityNotExistError != nil {
     err = result.EntityNotExistError
     return 
-  } else   if result.ServiceBusyError != nil {
-    err = result.ServiceBusyError
-    return 
   }
   return
 }
 
-// TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
-// in the history and immediately terminating the execution instance.
+// RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
+// result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
+// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
+// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
+// anymore due to activity timeout.
 // 
 // 
 // Parameters:
-//  - TerminateRequest
-func (p *WorkflowServiceClient) TerminateWorkflowExecution(terminateRequest *shared.TerminateWorkflowExecutionRequest) (err error) {
-  if err = p.sendTerminateWorkflowExecution(terminateRequest); err != nil { return }
-  return p.recvTerminateWorkflowExecution()
+//  - CanceledRequest
+func (p *WorkflowServiceClient) RespondActivityTaskCanceled(canceledRequest *shared.RespondActivityTaskCanceledRequest) (err error) {
+  if err = p.sendRespondActivityTaskCanceled(canceledRequest); err != nil { return }
+  return p.recvRespondActivityTaskCanceled()
 }
 
-func (p *WorkflowServiceClient) sendTerminateWorkflowExecution(terminateRequest *shared.TerminateWorkflowExecutionRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskCanceled(canceledRequest *shared.RespondActivityTaskCanceledRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceTerminateWorkflowExecutionArgs{
-  TerminateRequest : terminateRequest,
+  args := WorkflowServiceRespondActivityTaskCanceledArgs{
+  CanceledRequest : canceledRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1665,7 +1714,7 @@ func (p *WorkflowServiceClient) sendTerminateWorkflowExecution(terminateRequest
 }
 
 
-func (p *WorkflowServiceClient) recvTerminateWorkflowExecution() (err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskCanceled() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1675,32 +1724,32 @@ func (p *WorkflowServiceClient) recvTerminateWorkflowExecution() (err error) {
   if err != nil {
     return
   }
-  if method != "TerminateWorkflowExecution" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "TerminateWorkflowExecution failed: wrong method name")
+  if method != "RespondActivityTaskCanceled" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCanceled failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "TerminateWorkflowExecution failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCanceled failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error30 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error31 error
-    error31, err = error30.Read(iprot)
+    error24 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error25 error
+    error25, err = error24.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error31
+    err = error25
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "TerminateWorkflowExecution failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCanceled failed: invalid message type")
     return
   }
-  result := WorkflowServiceTerminateWorkflowExecutionResult{}
+  result := WorkflowServiceRespondActivityTaskCanceledResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1716,35 +1765,36 @@ func (p *WorkflowServiceClient) recvTerminateWorkflowExecution() (err error) {
   } else   if result.EntityNotExistError != nil {
     err = result.EntityNotExistError
     return 
-  } else   if result.ServiceBusyError != nil {
-    err = result.ServiceBusyError
-    return 
   }
   return
 }
 
-// ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.
+// RespondActivityTaskCanceledByID is called by application worker when it is successfully canceled an ActivityTask.
+// It will result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
+// created for the workflow instance so new decisions could be made.  Similar to RespondActivityTaskCanceled but use
+// Domain, WorkflowID, RunID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'
+// if these IDs are not valid anymore due to activity timeout.
 // 
 // 
 // Parameters:
-//  - ListRequest
-func (p *WorkflowServiceClient) ListOpenWorkflowExecutions(listRequest *shared.ListOpenWorkflowExecutionsRequest) (r *shared.ListOpenWorkflowExecutionsResponse, err error) {
-  if err = p.sendListOpenWorkflowExecutions(listRequest); err != nil { return }
-  return p.recvListOpenWorkflowExecutions()
+//  - CanceledRequest
+func (p *WorkflowServiceClient) RespondActivityTaskCanceledByID(canceledRequest *shared.RespondActivityTaskCanceledByIDRequest) (err error) {
+  if err = p.sendRespondActivityTaskCanceledByID(canceledRequest); err != nil { return }
+  return p.recvRespondActivityTaskCanceledByID()
 }
 
-func (p *WorkflowServiceClient) sendListOpenWorkflowExecutions(listRequest *shared.ListOpenWorkflowExecutionsRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskCanceledByID(canceledRequest *shared.RespondActivityTaskCanceledByIDRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskCanceledByID", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceListOpenWorkflowExecutionsArgs{
-  ListRequest : listRequest,
+  args := WorkflowServiceRespondActivityTaskCanceledByIDArgs{
+  CanceledRequest : canceledRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1756,7 +1806,7 @@ func (p *WorkflowServiceClient) sendListOpenWorkflowExecutions(listRequest *shar
 }
 
 
-func (p *WorkflowServiceClient) recvListOpenWorkflowExecutions() (value *shared.ListOpenWorkflowExecutionsResponse, err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskCanceledByID() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1766,32 +1816,32 @@ func (p *WorkflowServiceClient) recvListOpenWorkflowExecutions() (value *shared.
   if err != nil {
     return
   }
-  if method != "ListOpenWorkflowExecutions" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListOpenWorkflowExecutions failed: wrong method name")
+  if method != "RespondActivityTaskCanceledByID" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCanceledByID failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListOpenWorkflowExecutions failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCanceledByID failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error32 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error33 error
-    error33, err = error32.Read(iprot)
+    error24 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error25 error
+    error25, err = error24.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error33
+    err = error25
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListOpenWorkflowExecutions failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCanceledByID failed: invalid message type")
     return
   }
-  result := WorkflowServiceListOpenWorkflowExecutionsResult{}
+  result := WorkflowServiceRespondActivityTaskCanceledByIDResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1807,36 +1857,35 @@ func (p *WorkflowServiceClient) recvListOpenWorkflowExecutions() (value *shared.
   } else   if result.EntityNotExistError != nil {
     err = result.EntityNotExistError
     return 
-  } else   if result.ServiceBusyError != nil {
-    err = result.ServiceBusyError
-    return 
   }
-  value = result.GetSuccess()
   return
 }
 
-// ListClosedWorkflowExecutions is a visibility API to list the closed executions in a specific domain.
+// RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
+// It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
+// created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
+// anymore due to completion or doesn't exist.
 // 
 // 
 // Parameters:
-//  - ListRequest
-func (p *WorkflowServiceClient) ListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest) (r *shared.ListClosedWorkflowExecutionsResponse, err error) {
-  if err = p.sendListClosedWorkflowExecutions(listRequest); err != nil { return }
-  return p.recvListClosedWorkflowExecutions()
+//  - CancelRequest
+func (p *WorkflowServiceClient) RequestCancelWorkflowExecution(cancelRequest *shared.RequestCancelWorkflowExecutionRequest) (err error) {
+  if err = p.sendRequestCancelWorkflowExecution(cancelRequest); err != nil { return }
+  return p.recvRequestCancelWorkflowExecution()
 }
 
-func (p *WorkflowServiceClient) sendListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest)(err error) {
+func (p *WorkflowServiceClient) sendRequestCancelWorkflowExecution(cancelRequest *shared.RequestCancelWorkflowExecutionRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceListClosedWorkflowExecutionsArgs{
-  ListRequest : listRequest,
+  args := WorkflowServiceRequestCancelWorkflowExecutionArgs{
+  CancelRequest : cancelRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1848,7 +1897,7 @@ func (p *WorkflowServiceClient) sendListClosedWorkflowExecutions(listRequest *sh
 }
 
 
-func (p *WorkflowServiceClient) recvListClosedWorkflowExecutions() (value *shared.ListClosedWorkflowExecutionsResponse, err error) {
+func (p *WorkflowServiceClient) recvRequestCancelWorkflowExecution() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1858,32 +1907,32 @@ func (p *WorkflowServiceClient) recvListClosedWorkflowExecutions() (value *share
   if err != nil {
     return
   }
-  if method != "ListClosedWorkflowExecutions" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListClosedWorkflowExecutions failed: wrong method name")
+  if method != "RequestCancelWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RequestCancelWorkflowExecution failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListClosedWorkflowExecutions failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RequestCancelWorkflowExecution failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error34 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error35 error
-    error35, err = error34.Read(iprot)
+    error26 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error27 error
+    error27, err = error26.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error35
+    err = error27
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListClosedWorkflowExecutions failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RequestCancelWorkflowExecution failed: invalid message type")
     return
   }
-  result := WorkflowServiceListClosedWorkflowExecutionsResult{}
+  result := WorkflowServiceRequestCancelWorkflowExecutionResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1899,38 +1948,39 @@ func (p *WorkflowServiceClient) recvListClosedWorkflowExecutions() (value *share
   } else   if result.EntityNotExistError != nil {
     err = result.EntityNotExistError
     return 
+  } else   if result.CancellationAlreadyRequestedError != nil {
+    err = result.CancellationAlreadyRequestedError
+    return 
   } else   if result.ServiceBusyError != nil {
     err = result.ServiceBusyError
     return 
   }
-  value = result.GetSuccess()
   return
 }
 
-// RespondQueryTaskCompleted is called by application worker to complete a QueryTask (which is a DecisionTask for query)
-// as a result of 'PollForDecisionTask' API call. Completing a QueryTask will unblock the client call to 'QueryWorkflow'
-// API and return the query result to client as a response to 'QueryWorkflow' API call.
+// SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
+// WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
 // 
 // 
 // Parameters:
-//  - CompleteRequest
-func (p *WorkflowServiceClient) RespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest) (err error) {
-  if err = p.sendRespondQueryTaskCompleted(completeRequest); err != nil { return }
-  return p.recvRespondQueryTaskCompleted()
+//  - SignalRequest
+func (p *WorkflowServiceClient) SignalWorkflowExecution(signalRequest *shared.SignalWorkflowExecutionRequest) (err error) {
+  if err = p.sendSignalWorkflowExecution(signalRequest); err != nil { return }
+  return p.recvSignalWorkflowExecution()
 }
 
-func (p *WorkflowServiceClient) sendRespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest)(err error) {
+func (p *WorkflowServiceClient) sendSignalWorkflowExecution(signalRequest *shared.SignalWorkflowExecutionRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceRespondQueryTaskCompletedArgs{
-  CompleteRequest : completeRequest,
+  args := WorkflowServiceSignalWorkflowExecutionArgs{
+  SignalRequest : signalRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1942,7 +1992,7 @@ func (p *WorkflowServiceClient) sendRespondQueryTaskCompleted(completeRequest *s
 }
 
 
-func (p *WorkflowServiceClient) recvRespondQueryTaskCompleted() (err error) {
+func (p *WorkflowServiceClient) recvSignalWorkflowExecution() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1952,32 +2002,32 @@ func (p *WorkflowServiceClient) recvRespondQueryTaskCompleted() (err error) {
   if err != nil {
     return
   }
-  if method != "RespondQueryTaskCompleted" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondQueryTaskCompleted failed: wrong method name")
+  if method != "SignalWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SignalWorkflowExecution failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondQueryTaskCompleted failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SignalWorkflowExecution failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error36 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error37 error
-    error37, err = error36.Read(iprot)
+    error28 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error29 error
+    error29, err = error28.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error37
+    err = error29
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondQueryTaskCompleted failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SignalWorkflowExecution failed: invalid message type")
     return
   }
-  result := WorkflowServiceRespondQueryTaskCompletedResult{}
+  result := WorkflowServiceSignalWorkflowExecutionResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1993,32 +2043,39 @@ func (p *WorkflowServiceClient) recvRespondQueryTaskCompleted() (err error) {
   } else   if result.EntityNotExistError != nil {
     err = result.EntityNotExistError
     return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
   }
   return
 }
 
-// QueryWorkflow returns query result for a specified workflow execution
+// SignalWithStartWorkflowExecution is used to ensure sending signal to a workflow.
+// If the workflow is running, this results in WorkflowExecutionSignaled event being recorded in the history
+// and a decision task being created for the execution.
+// If the workflow is not running or not found, this results in WorkflowExecutionStarted and WorkflowExecutionSignaled
+// events being recorded in history, and a decision task being created for the execution
 // 
 // 
 // Parameters:
-//  - QueryRequest
-func (p *WorkflowServiceClient) QueryWorkflow(queryRequest *shared.QueryWorkflowRequest) (r *shared.QueryWorkflowResponse, err error) {
-  if err = p.sendQueryWorkflow(queryRequest); err != nil { return }
-  return p.recvQueryWorkflow()
+//  - SignalWithStartRequest
+func (p *WorkflowServiceClient) SignalWithStartWorkflowExecution(signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error) {
+  if err = p.sendSignalWithStartWorkflowExecution(signalWithStartRequest); err != nil { return }
+  return p.recvSignalWithStartWorkflowExecution()
 }
 
-func (p *WorkflowServiceClient) sendQueryWorkflow(queryRequest *shared.QueryWorkflowRequest)(err error) {
+func (p *WorkflowServiceClient) sendSignalWithStartWorkflowExecution(signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("QueryWorkflow", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceQueryWorkflowArgs{
-  QueryRequest : queryRequest,
+  args := WorkflowServiceSignalWithStartWorkflowExecutionArgs{
+  SignalWithStartRequest : signalWithStartRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -2030,7 +2087,7 @@ func (p *WorkflowServiceClient) sendQueryWorkflow(queryRequest *shared.QueryWork
 }
 
 
-func (p *WorkflowServiceClient) recvQueryWorkflow() (value *shared.QueryWorkflowResponse, err error) {
+func (p *WorkflowServiceClient) recvSignalWithStartWorkflowExecution() (value *shared.StartWorkflowExecutionResponse, err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -2040,32 +2097,32 @@ func (p *WorkflowServiceClient) recvQueryWorkflow() (value *shared.QueryWorkflow
   if err != nil {
     return
   }
-  if method != "QueryWorkflow" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "QueryWorkflow failed: wrong method name")
+  if method != "SignalWithStartWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SignalWithStartWorkflowExecution failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "QueryWorkflow failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SignalWithStartWorkflowExecution failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error38 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error39 error
-    error39, err = error38.Read(iprot)
+    error8 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error9 error
+    error9, err = error8.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error39
+    err = error9
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "QueryWorkflow failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SignalWithStartWorkflowExecution failed: invalid message type")
     return
   }
-  result := WorkflowServiceQueryWorkflowResult{}
+  result := WorkflowServiceSignalWithStartWorkflowExecutionResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -2078,402 +2135,579 @@ func (p *WorkflowServiceClient) recvQueryWorkflow() (value *shared.QueryWorkflow
   } else   if result.InternalServiceError != nil {
     err = result.InternalServiceError
     return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
+  } else   if result.SessionAlreadyExistError != nil {
+    err = result.SessionAlreadyExistError
     return 
-  } else   if result.QueryFailedError != nil {
-    err = result.QueryFailedError
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
     return 
   }
   value = result.GetSuccess()
   return
 }
 
-
-type WorkflowServiceProcessor struct {
-  processorMap map[string]thrift.TProcessorFunction
-  handler WorkflowService
+// TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
+// in the history and immediately terminating the execution instance.
+// 
+// 
+// Parameters:
+//  - TerminateRequest
+func (p *WorkflowServiceClient) TerminateWorkflowExecution(terminateRequest *shared.TerminateWorkflowExecutionRequest) (err error) {
+  if err = p.sendTerminateWorkflowExecution(terminateRequest); err != nil { return }
+  return p.recvTerminateWorkflowExecution()
 }
 
-func (p *WorkflowServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-  p.processorMap[key] = processor
+func (p *WorkflowServiceClient) sendTerminateWorkflowExecution(terminateRequest *shared.TerminateWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceTerminateWorkflowExecutionArgs{
+  TerminateRequest : terminateRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
 }
 
-func (p *WorkflowServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-  processor, ok = p.processorMap[key]
-  return processor, ok
-}
 
-func (p *WorkflowServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-  return p.processorMap
+func (p *WorkflowServiceClient) recvTerminateWorkflowExecution() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "TerminateWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "TerminateWorkflowExecution failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "TerminateWorkflowExecution failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error30 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error31 error
+    error31, err = error30.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error31
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "TerminateWorkflowExecution failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceTerminateWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  return
 }
 
-func NewWorkflowServiceProcessor(handler WorkflowService) *WorkflowServiceProcessor {
+// ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.
+// 
+// 
+// Parameters:
+//  - ListRequest
+func (p *WorkflowServiceClient) ListOpenWorkflowExecutions(listRequest *shared.ListOpenWorkflowExecutionsRequest) (r *shared.ListOpenWorkflowExecutionsResponse, err error) {
+  if err = p.sendListOpenWorkflowExecutions(listRequest); err != nil { return }
+  return p.recvListOpenWorkflowExecutions()
+}
 
-  self40 := &WorkflowServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self40.processorMap["RegisterDomain"] = &workflowServiceProcessorRegisterDomain{handler:handler}
-  self40.processorMap["DescribeDomain"] = &workflowServiceProcessorDescribeDomain{handler:handler}
-  self40.processorMap["UpdateDomain"] = &workflowServiceProcessorUpdateDomain{handler:handler}
-  self40.processorMap["DeprecateDomain"] = &workflowServiceProcessorDeprecateDomain{handler:handler}
-  self40.processorMap["StartWorkflowExecution"] = &workflowServiceProcessorStartWorkflowExecution{handler:handler}
-  self40.processorMap["GetWorkflowExecutionHistory"] = &workflowServiceProcessorGetWorkflowExecutionHistory{handler:handler}
-  self40.processorMap["PollForDecisionTask"] = &workflowServiceProcessorPollForDecisionTask{handler:handler}
-  self40.processorMap["RespondDecisionTaskCompleted"] = &workflowServiceProcessorRespondDecisionTaskCompleted{handler:handler}
-  self40.processorMap["PollForActivityTask"] = &workflowServiceProcessorPollForActivityTask{handler:handler}
-  self40.processorMap["RecordActivityTaskHeartbeat"] = &workflowServiceProcessorRecordActivityTaskHeartbeat{handler:handler}
-  self40.processorMap["RespondActivityTaskCompleted"] = &workflowServiceProcessorRespondActivityTaskCompleted{handler:handler}
-  self40.processorMap["RespondActivityTaskFailed"] = &workflowServiceProcessorRespondActivityTaskFailed{handler:handler}
-  self40.processorMap["RespondActivityTaskCanceled"] = &workflowServiceProcessorRespondActivityTaskCanceled{handler:handler}
-  self40.processorMap["RequestCancelWorkflowExecution"] = &workflowServiceProcessorRequestCancelWorkflowExecution{handler:handler}
-  self40.processorMap["SignalWorkflowExecution"] = &workflowServiceProcessorSignalWorkflowExecution{handler:handler}
-  self40.processorMap["TerminateWorkflowExecution"] = &workflowServiceProcessorTerminateWorkflowExecution{handler:handler}
-  self40.processorMap["ListOpenWorkflowExecutions"] = &workflowServiceProcessorListOpenWorkflowExecutions{handler:handler}
-  self40.processorMap["ListClosedWorkflowExecutions"] = &workflowServiceProcessorListClosedWorkflowExecutions{handler:handler}
-  self40.processorMap["RespondQueryTaskCompleted"] = &workflowServiceProcessorRespondQueryTaskCompleted{handler:handler}
-  self40.processorMap["QueryWorkflow"] = &workflowServiceProcessorQueryWorkflow{handler:handler}
-return self40
-}
-
-func (p *WorkflowServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  name, _, seqId, err := iprot.ReadMessageBegin()
-  if err != nil { return false, err }
-  if processor, ok := p.GetProcessorFunction(name); ok {
-    return processor.Process(seqId, iprot, oprot)
+func (p *WorkflowServiceClient) sendListOpenWorkflowExecutions(listRequest *shared.ListOpenWorkflowExecutionsRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  iprot.Skip(thrift.STRUCT)
-  iprot.ReadMessageEnd()
-  x41 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
-  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-  x41.Write(oprot)
-  oprot.WriteMessageEnd()
-  oprot.Flush()
-  return false, x41
-
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceListOpenWorkflowExecutionsArgs{
+  ListRequest : listRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorRegisterDomain struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorRegisterDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRegisterDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvListOpenWorkflowExecutions() (value *shared.ListOpenWorkflowExecutionsResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRegisterDomainResult{}
-  var err2 error
-  if err2 = p.handler.RegisterDomain(args.RegisterRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.DomainAlreadyExistsError:
-  result.DomainExistsError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RegisterDomain: " + err2.Error())
-    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
+  if method != "ListOpenWorkflowExecutions" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListOpenWorkflowExecutions failed: wrong method name")
+    return
   }
-  if err2 = oprot.WriteMessageBegin("RegisterDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListOpenWorkflowExecutions failed: out of sequence response")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error32 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error33 error
+    error33, err = error32.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error33
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListOpenWorkflowExecutions failed: invalid message type")
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  result := WorkflowServiceListOpenWorkflowExecutionsResult{}
+  if err = result.Read(iprot); err != nil {
+    return
   }
-  if err != nil {
+  if err = iprot.ReadMessageEnd(); err != nil {
     return
   }
-  return true, err
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorDescribeDomain struct {
-  handler WorkflowService
+// ListClosedWorkflowExecutions is a visibility API to list the closed executions in a specific domain.
+// 
+// 
+// Parameters:
+//  - ListRequest
+func (p *WorkflowServiceClient) ListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest) (r *shared.ListClosedWorkflowExecutionsResponse, err error) {
+  if err = p.sendListClosedWorkflowExecutions(listRequest); err != nil { return }
+  return p.recvListClosedWorkflowExecutions()
 }
 
-func (p *workflowServiceProcessorDescribeDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceDescribeDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceDescribeDomainResult{}
-var retval *shared.DescribeDomainResponse
-  var err2 error
-  if retval, err2 = p.handler.DescribeDomain(args.DescribeRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeDomain: " + err2.Error())
-    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("DescribeDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceListClosedWorkflowExecutionsArgs{
+  ListRequest : listRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorUpdateDomain struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorUpdateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceUpdateDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvListClosedWorkflowExecutions() (value *shared.ListClosedWorkflowExecutionsResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceUpdateDomainResult{}
-var retval *shared.UpdateDomainResponse
-  var err2 error
-  if retval, err2 = p.handler.UpdateDomain(args.UpdateRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateDomain: " + err2.Error())
-    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("UpdateDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "ListClosedWorkflowExecutions" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListClosedWorkflowExecutions failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListClosedWorkflowExecutions failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error34 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error35 error
+    error35, err = error34.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error35
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListClosedWorkflowExecutions failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := WorkflowServiceListClosedWorkflowExecutionsResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorDeprecateDomain struct {
-  handler WorkflowService
+// RespondQueryTaskCompleted is called by application worker to complete a QueryTask (which is a DecisionTask for query)
+// as a result of 'PollForDecisionTask' API call. Completing a QueryTask will unblock the client call to 'QueryWorkflow'
+// API and return the query result to client as a response to 'QueryWorkflow' API call.
+// 
+// 
+// Parameters:
+//  - CompleteRequest
+func (p *WorkflowServiceClient) RespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest) (err error) {
+  if err = p.sendRespondQueryTaskCompleted(completeRequest); err != nil { return }
+  return p.recvRespondQueryTaskCompleted()
 }
 
-func (p *workflowServiceProcessorDeprecateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceDeprecateDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) sendRespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.CALL, p.SeqId); err != nil {
+      return
   }
+  args := WorkflowServiceRespondQueryTaskCompletedArgs{
+  CompleteRequest : completeRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
 
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceDeprecateDomainResult{}
-  var err2 error
-  if err2 = p.handler.DeprecateDomain(args.DeprecateRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeprecateDomain: " + err2.Error())
-    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+
+func (p *WorkflowServiceClient) recvRespondQueryTaskCompleted() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  if err2 = oprot.WriteMessageBegin("DeprecateDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "RespondQueryTaskCompleted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondQueryTaskCompleted failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondQueryTaskCompleted failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error36 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error37 error
+    error37, err = error36.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error37
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondQueryTaskCompleted failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := WorkflowServiceRespondQueryTaskCompletedResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  return
 }
 
-type workflowServiceProcessorStartWorkflowExecution struct {
-  handler WorkflowService
+// QueryWorkflow returns query result for a specified workflow execution
+// 
+// 
+// Parameters:
+//  - QueryRequest
+func (p *WorkflowServiceClient) QueryWorkflow(queryRequest *shared.QueryWorkflowRequest) (r *shared.QueryWorkflowResponse, err error) {
+  if err = p.sendQueryWorkflow(queryRequest); err != nil { return }
+  return p.recvQueryWorkflow()
 }
 
-func (p *workflowServiceProcessorStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceStartWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceStartWorkflowExecutionResult{}
-var retval *shared.StartWorkflowExecutionResponse
-  var err2 error
-  if retval, err2 = p.handler.StartWorkflowExecution(args.StartRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.WorkflowExecutionAlreadyStartedError:
-  result.SessionAlreadyExistError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing StartWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("StartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendQueryWorkflow(queryRequest *shared.QueryWorkflowRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("QueryWorkflow", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceQueryWorkflowArgs{
+  QueryRequest : queryRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorGetWorkflowExecutionHistory struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorGetWorkflowExecutionHistory) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceGetWorkflowExecutionHistoryArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvQueryWorkflow() (value *shared.QueryWorkflowResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "QueryWorkflow" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "QueryWorkflow failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "QueryWorkflow failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error38 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error39 error
+    error39, err = error38.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error39
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "QueryWorkflow failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceQueryWorkflowResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.QueryFailedError != nil {
+    err = result.QueryFailedError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+
+type WorkflowServiceProcessor struct {
+  processorMap map[string]thrift.TProcessorFunction
+  handler WorkflowService
+}
+
+func (p *WorkflowServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+  p.processorMap[key] = processor
+}
+
+func (p *WorkflowServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+  processor, ok = p.processorMap[key]
+  return processor, ok
+}
+
+func (p *WorkflowServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+  return p.processorMap
+}
+
+func NewWorkflowServiceProcessor(handler WorkflowService) *WorkflowServiceProcessor {
+
+  self40 := &WorkflowServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self40.processorMap["RegisterDomain"] = &workflowServiceProcessorRegisterDomain{handler:handler}
+  self40.processorMap["DescribeDomain"] = &workflowServiceProcessorDescribeDomain{handler:handler}
+  self40.processorMap["UpdateDomain"] = &workflowServiceProcessorUpdateDomain{handler:handler}
+  self40.processorMap["DeprecateDomain"] = &workflowServiceProcessorDeprecateDomain{handler:handler}
+  self40.processorMap["StartWorkflowExecution"] = &workflowServiceProcessorStartWorkflowExecution{handler:handler}
+  self40.processorMap["GetWorkflowExecutionHistory"] = &workflowServiceProcessorGetWorkflowExecutionHistory{handler:handler}
+  self40.processorMap["PollForDecisionTask"] = &workflowServiceProcessorPollForDecisionTask{handler:handler}
+  self40.processorMap["RespondDecisionTaskCompleted"] = &workflowServiceProcessorRespondDecisionTaskCompleted{handler:handler}
+  self40.processorMap["PollForActivityTask"] = &workflowServiceProcessorPollForActivityTask{handler:handler}
+  self40.processorMap["RecordActivityTaskHeartbeat"] = &workflowServiceProcessorRecordActivityTaskHeartbeat{handler:handler}
+  self40.processorMap["RecordActivityTaskHeartbeatByID"] = &workflowServiceProcessorRecordActivityTaskHeartbeatByID{handler:handler}
+  self40.processorMap["RespondActivityTaskCompleted"] = &workflowServiceProcessorRespondActivityTaskCompleted{handler:handler}
+  self40.processorMap["RespondActivityTaskCompletedByID"] = &workflowServiceProcessorRespondActivityTaskCompletedByID{handler:handler}
+  self40.processorMap["RespondActivityTaskFailed"] = &workflowServiceProcessorRespondActivityTaskFailed{handler:handler}
+  self40.processorMap["RespondActivityTaskFailedByID"] = &workflowServiceProcessorRespondActivityTaskFailedByID{handler:handler}
+  self40.processorMap["RespondActivityTaskCanceled"] = &workflowServiceProcessorRespondActivityTaskCanceled{handler:handler}
+  self40.processorMap["RespondActivityTaskCanceledByID"] = &workflowServiceProcessorRespondActivityTaskCanceledByID{handler:handler}
+  self40.processorMap["RequestCancelWorkflowExecution"] = &workflowServiceProcessorRequestCancelWorkflowExecution{handler:handler}
+  self40.processorMap["SignalWorkflowExecution"] = &workflowServiceProcessorSignalWorkflowExecution{handler:handler}
+  self40.processorMap["SignalWithStartWorkflowExecution"] = &workflowServiceProcessorSignalWithStartWorkflowExecution{handler:handler}
+  self40.processorMap["TerminateWorkflowExecution"] = &workflowServiceProcessorTerminateWorkflowExecution{handler:handler}
+  self40.processorMap["ListOpenWorkflowExecutions"] = &workflowServiceProcessorListOpenWorkflowExecutions{handler:handler}
+  self40.processorMap["ListClosedWorkflowExecutions"] = &workflowServiceProcessorListClosedWorkflowExecutions{handler:handler}
+  self40.processorMap["RespondQueryTaskCompleted"] = &workflowServiceProcessorRespondQueryTaskCompleted{handler:handler}
+  self40.processorMap["QueryWorkflow"] = &workflowServiceProcessorQueryWorkflow{handler:handler}
+return self40
+}
 
+func (p *WorkflowServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  name, _, seqId, err := iprot.ReadMessageBegin()
+  if err != nil { return false, err }
+  if processor, ok := p.GetProcessorFunction(name); ok {
+    return processor.Process(seqId, iprot, oprot)
+  }
+  iprot.Skip(thrift.STRUCT)
   iprot.ReadMessageEnd()
-  result := WorkflowServiceGetWorkflowExecutionHistoryResult{}
-var retval *shared.GetWorkflowExecutionHistoryResponse
+  x41 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+  x41.Write(oprot)
+  oprot.WriteMessageEnd()
+  oprot.Flush()
+  return false, x41
+
+}
+
+type workflowServiceProcessorRegisterDomain struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRegisterDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRegisterDomainArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRegisterDomainResult{}
   var err2 error
-  if retval, err2 = p.handler.GetWorkflowExecutionHistory(args.GetRequest); err2 != nil {
+  if err2 = p.handler.RegisterDomain(args.RegisterRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
     case *shared.InternalServiceError:
   result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
+    case *shared.DomainAlreadyExistsError:
+  result.DomainExistsError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetWorkflowExecutionHistory: " + err2.Error())
-    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RegisterDomain: " + err2.Error())
+    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
     return true, err2
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.REPLY, seqId); err2 != nil {
+  }
+  if err2 = oprot.WriteMessageBegin("RegisterDomain", thrift.REPLY, seqId); err2 != nil {
     err = err2
   }
   if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2491,16 +2725,16 @@ var retval *shared.GetWorkflowExecutionHistoryResponse
   return true, err
 }
 
-type workflowServiceProcessorPollForDecisionTask struct {
+type workflowServiceProcessorDescribeDomain struct {
   handler WorkflowService
 }
 
-func (p *workflowServiceProcessorPollForDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServicePollForDecisionTaskArgs{}
+func (p *workflowServiceProcessorDescribeDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceDescribeDomainArgs{}
   if err = args.Read(iprot); err != nil {
     iprot.ReadMessageEnd()
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -2508,20 +2742,20 @@ func (p *workflowServiceProcessorPollForDecisionTask) Process(seqId int32, iprot
   }
 
   iprot.ReadMessageEnd()
-  result := WorkflowServicePollForDecisionTaskResult{}
-var retval *shared.PollForDecisionTaskResponse
+  result := WorkflowServiceDescribeDomainResult{}
+var retval *shared.DescribeDomainResponse
   var err2 error
-  if retval, err2 = p.handler.PollForDecisionTask(args.PollRequest); err2 != nil {
+  if retval, err2 = p.handler.DescribeDomain(args.DescribeRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
     case *shared.InternalServiceError:
   result.InternalServiceError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForDecisionTask: " + err2.Error())
-    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeDomain: " + err2.Error())
+    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -2530,7 +2764,7 @@ var retval *shared.PollForDecisionTaskResponse
   } else {
     result.Success = retval
 }
-  if err2 = oprot.WriteMessageBegin("PollForDecisionTask", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin("DescribeDomain", thrift.REPLY, seqId); err2 != nil {
     err = err2
   }
   if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2548,16 +2782,16 @@ var retval *shared.PollForDecisionTaskResponse
   return true, err
 }
 
-type workflowServiceProcessorRespondDecisionTaskCompleted struct {
+type workflowServiceProcessorUpdateDomain struct {
   handler WorkflowService
 }
 
-func (p *workflowServiceProcessorRespondDecisionTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondDecisionTaskCompletedArgs{}
+func (p *workflowServiceProcessorUpdateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceUpdateDomainArgs{}
   if err = args.Read(iprot); err != nil {
     iprot.ReadMessageEnd()
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -2565,9 +2799,10 @@ func (p *workflowServiceProcessorRespondDecisionTaskCompleted) Process(seqId int
   }
 
   iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondDecisionTaskCompletedResult{}
+  result := WorkflowServiceUpdateDomainResult{}
+var retval *shared.UpdateDomainResponse
   var err2 error
-  if err2 = p.handler.RespondDecisionTaskCompleted(args.CompleteRequest); err2 != nil {
+  if retval, err2 = p.handler.UpdateDomain(args.UpdateRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
@@ -2576,15 +2811,17 @@ func (p *workflowServiceProcessorRespondDecisionTaskCompleted) Process(seqId int
     case *shared.EntityNotExistsError:
   result.EntityNotExistError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondDecisionTaskCompleted: " + err2.Error())
-    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateDomain: " + err2.Error())
+    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
     return true, err2
   }
-  }
-  if err2 = oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("UpdateDomain", thrift.REPLY, seqId); err2 != nil {
     err = err2
   }
   if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2602,16 +2839,16 @@ func (p *workflowServiceProcessorRespondDecisionTaskCompleted) Process(seqId int
   return true, err
 }
 
-type workflowServiceProcessorPollForActivityTask struct {
+type workflowServiceProcessorDeprecateDomain struct {
   handler WorkflowService
 }
 
-func (p *workflowServiceProcessorPollForActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServicePollForActivityTaskArgs{}
+func (p *workflowServiceProcessorDeprecateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceDeprecateDomainArgs{}
   if err = args.Read(iprot); err != nil {
     iprot.ReadMessageEnd()
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -2619,29 +2856,26 @@ func (p *workflowServiceProcessorPollForActivityTask) Process(seqId int32, iprot
   }
 
   iprot.ReadMessageEnd()
-  result := WorkflowServicePollForActivityTaskResult{}
-var retval *shared.PollForActivityTaskResponse
+  result := WorkflowServiceDeprecateDomainResult{}
   var err2 error
-  if retval, err2 = p.handler.PollForActivityTask(args.PollRequest); err2 != nil {
+  if err2 = p.handler.DeprecateDomain(args.DeprecateRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
     case *shared.InternalServiceError:
   result.InternalServiceError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForActivityTask: " + err2.Error())
-    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeprecateDomain: " + err2.Error())
+    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
     return true, err2
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("PollForActivityTask", thrift.REPLY, seqId); err2 != nil {
+  }
+  if err2 = oprot.WriteMessageBegin("DeprecateDomain", thrift.REPLY, seqId); err2 != nil {
     err = err2
   }
   if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2659,16 +2893,16 @@ var retval *shared.PollForActivityTaskResponse
   return true, err
 }
 
-type workflowServiceProcessorRecordActivityTaskHeartbeat struct {
+type workflowServiceProcessorStartWorkflowExecution struct {
   handler WorkflowService
 }
 
-func (p *workflowServiceProcessorRecordActivityTaskHeartbeat) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRecordActivityTaskHeartbeatArgs{}
+func (p *workflowServiceProcessorStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceStartWorkflowExecutionArgs{}
   if err = args.Read(iprot); err != nil {
     iprot.ReadMessageEnd()
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -2676,20 +2910,22 @@ func (p *workflowServiceProcessorRecordActivityTaskHeartbeat) Process(seqId int3
   }
 
   iprot.ReadMessageEnd()
-  result := WorkflowServiceRecordActivityTaskHeartbeatResult{}
-var retval *shared.RecordActivityTaskHeartbeatResponse
+  result := WorkflowServiceStartWorkflowExecutionResult{}
+var retval *shared.StartWorkflowExecutionResponse
   var err2 error
-  if retval, err2 = p.handler.RecordActivityTaskHeartbeat(args.HeartbeatRequest); err2 != nil {
+  if retval, err2 = p.handler.StartWorkflowExecution(args.StartRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
     case *shared.InternalServiceError:
   result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
+    case *shared.WorkflowExecutionAlreadyStartedError:
+  result.SessionAlreadyExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskHeartbeat: " + err2.Error())
-    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing StartWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -2698,7 +2934,7 @@ var retval *shared.RecordActivityTaskHeartbeatResponse
   } else {
     result.Success = retval
 }
-  if err2 = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin("StartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
     err = err2
   }
   if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2716,16 +2952,16 @@ var retval *shared.RecordActivityTaskHeartbeatResponse
   return true, err
 }
 
-type workflowServiceProcessorRespondActivityTaskCompleted struct {
+type workflowServiceProcessorGetWorkflowExecutionHistory struct {
   handler WorkflowService
 }
 
-func (p *workflowServiceProcessorRespondActivityTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondActivityTaskCompletedArgs{}
-  if err = args.Read(iprot); err != nil {
+func (p *workflowServiceProcessorGetWorkflowExecutionHistory) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceGetWorkflowExecutionHistoryArgs{}
+  if err = args.Read(iprot); err != nil {
     iprot.ReadMessageEnd()
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -2733,9 +2969,10 @@ func (p *workflowServiceProcessorRespondActivityTaskCompleted) Process(seqId int
   }
 
   iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondActivityTaskCompletedResult{}
+  result := WorkflowServiceGetWorkflowExecutionHistoryResult{}
+var retval *shared.GetWorkflowExecutionHistoryResponse
   var err2 error
-  if err2 = p.handler.RespondActivityTaskCompleted(args.CompleteRequest); err2 != nil {
+  if retval, err2 = p.handler.GetWorkflowExecutionHistory(args.GetRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
@@ -2743,16 +2980,77 @@ func (p *workflowServiceProcessorRespondActivityTaskCompleted) Process(seqId int
   result.InternalServiceError = v
     case *shared.EntityNotExistsError:
   result.EntityNotExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCompleted: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetWorkflowExecutionHistory: " + err2.Error())
+    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
     return true, err2
   }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.REPLY, seqId); err2 != nil {
+    err = err2
   }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorPollForDecisionTask struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorPollForDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServicePollForDecisionTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServicePollForDecisionTaskResult{}
+var retval *shared.PollForDecisionTaskResponse
+  var err2 error
+  if retval, err2 = p.handler.PollForDecisionTask(args.PollRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForDecisionTask: " + err2.Error())
+    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("PollForDecisionTask", thrift.REPLY, seqId); err2 != nil {
     err = err2
   }
   if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2770,16 +3068,16 @@ func (p *workflowServiceProcessorRespondActivityTaskCompleted) Process(seqId int
   return true, err
 }
 
-type workflowServiceProcessorRespondActivityTaskFailed struct {
+type workflowServiceProcessorRespondDecisionTaskCompleted struct {
   handler WorkflowService
 }
 
-func (p *workflowServiceProcessorRespondActivityTaskFailed) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondActivityTaskFailedArgs{}
+func (p *workflowServiceProcessorRespondDecisionTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondDecisionTaskCompletedArgs{}
   if err = args.Read(iprot); err != nil {
     iprot.ReadMessageEnd()
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -2787,9 +3085,9 @@ func (p *workflowServiceProcessorRespondActivityTaskFailed) Process(seqId int32,
   }
 
   iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondActivityTaskFailedResult{}
+  result := WorkflowServiceRespondDecisionTaskCompletedResult{}
   var err2 error
-  if err2 = p.handler.RespondActivityTaskFailed(args.FailRequest); err2 != nil {
+  if err2 = p.handler.RespondDecisionTaskCompleted(args.CompleteRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
@@ -2798,15 +3096,15 @@ func (p *workflowServiceProcessorRespondActivityTaskFailed) Process(seqId int32,
     case *shared.EntityNotExistsError:
   result.EntityNotExistError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskFailed: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondDecisionTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
     return true, err2
   }
   }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.REPLY, seqId); err2 != nil {
     err = err2
   }
   if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2824,16 +3122,16 @@ func (p *workflowServiceProcessorRespondActivityTaskFailed) Process(seqId int32,
   return true, err
 }
 
-type workflowServiceProcessorRespondActivityTaskCanceled struct {
+type workflowServiceProcessorPollForActivityTask struct {
   handler WorkflowService
 }
 
-func (p *workflowServiceProcessorRespondActivityTaskCanceled) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondActivityTaskCanceledArgs{}
+func (p *workflowServiceProcessorPollForActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServicePollForActivityTaskArgs{}
   if err = args.Read(iprot); err != nil {
     iprot.ReadMessageEnd()
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -2841,9 +3139,67 @@ func (p *workflowServiceProcessorRespondActivityTaskCanceled) Process(seqId int3
   }
 
   iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondActivityTaskCanceledResult{}
+  result := WorkflowServicePollForActivityTaskResult{}
+var retval *shared.PollForActivityTaskResponse
   var err2 error
-  if err2 = p.handler.RespondActivityTaskCanceled(args.CanceledRequest); err2 != nil {
+  if retval, err2 = p.handler.PollForActivityTask(args.PollRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForActivityTask: " + err2.Error())
+    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("PollForActivityTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRecordActivityTaskHeartbeat struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRecordActivityTaskHeartbeat) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRecordActivityTaskHeartbeatArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRecordActivityTaskHeartbeatResult{}
+var retval *shared.RecordActivityTaskHeartbeatResponse
+  var err2 error
+  if retval, err2 = p.handler.RecordActivityTaskHeartbeat(args.HeartbeatRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
@@ -2852,15 +3208,17 @@ func (p *workflowServiceProcessorRespondActivityTaskCanceled) Process(seqId int3
     case *shared.EntityNotExistsError:
   result.EntityNotExistError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCanceled: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskHeartbeat: " + err2.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
     return true, err2
   }
-  }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.REPLY, seqId); err2 != nil {
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.REPLY, seqId); err2 != nil {
     err = err2
   }
   if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2878,16 +3236,16 @@ func (p *workflowServiceProcessorRespondActivityTaskCanceled) Process(seqId int3
   return true, err
 }
 
-type workflowServiceProcessorRequestCancelWorkflowExecution struct {
+type workflowServiceProcessorRecordActivityTaskHeartbeatByID struct {
   handler WorkflowService
 }
 
-func (p *workflowServiceProcessorRequestCancelWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRequestCancelWorkflowExecutionArgs{}
+func (p *workflowServiceProcessorRecordActivityTaskHeartbeatByID) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRecordActivityTaskHeartbeatByIDArgs{}
   if err = args.Read(iprot); err != nil {
     iprot.ReadMessageEnd()
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeatByID", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -2895,9 +3253,10 @@ func (p *workflowServiceProcessorRequestCancelWorkflowExecution) Process(seqId i
   }
 
   iprot.ReadMessageEnd()
-  result := WorkflowServiceRequestCancelWorkflowExecutionResult{}
+  result := WorkflowServiceRecordActivityTaskHeartbeatByIDResult{}
+var retval *shared.RecordActivityTaskHeartbeatResponse
   var err2 error
-  if err2 = p.handler.RequestCancelWorkflowExecution(args.CancelRequest); err2 != nil {
+  if retval, err2 = p.handler.RecordActivityTaskHeartbeatByID(args.HeartbeatRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
@@ -2905,20 +3264,18 @@ func (p *workflowServiceProcessorRequestCancelWorkflowExecution) Process(seqId i
   result.InternalServiceError = v
     case *shared.EntityNotExistsError:
   result.EntityNotExistError = v
-    case *shared.CancellationAlreadyRequestedError:
-  result.CancellationAlreadyRequestedError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RequestCancelWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskHeartbeatByID: " + err2.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeatByID", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
     return true, err2
   }
-  }
-  if err2 = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("RecordActivityTaskHeartbeatByID", thrift.REPLY, seqId); err2 != nil {
     err = err2
   }
   if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2936,16 +3293,16 @@ func (p *workflowServiceProcessorRequestCancelWorkflowExecution) Process(seqId i
   return true, err
 }
 
-type workflowServiceProcessorSignalWorkflowExecution struct {
+type workflowServiceProcessorRespondActivityTaskCompleted struct {
   handler WorkflowService
 }
 
-func (p *workflowServiceProcessorSignalWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceSignalWorkflowExecutionArgs{}
+func (p *workflowServiceProcessorRespondActivityTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskCompletedArgs{}
   if err = args.Read(iprot); err != nil {
     iprot.ReadMessageEnd()
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -2953,9 +3310,9 @@ func (p *workflowServiceProcessorSignalWorkflowExecution) Process(seqId int32, i
   }
 
   iprot.ReadMessageEnd()
-  result := WorkflowServiceSignalWorkflowExecutionResult{}
+  result := WorkflowServiceRespondActivityTaskCompletedResult{}
   var err2 error
-  if err2 = p.handler.SignalWorkflowExecution(args.SignalRequest); err2 != nil {
+  if err2 = p.handler.RespondActivityTaskCompleted(args.CompleteRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
@@ -2963,18 +3320,16 @@ func (p *workflowServiceProcessorSignalWorkflowExecution) Process(seqId int32, i
   result.InternalServiceError = v
     case *shared.EntityNotExistsError:
   result.EntityNotExistError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
     return true, err2
   }
   }
-  if err2 = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.REPLY, seqId); err2 != nil {
     err = err2
   }
   if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2992,16 +3347,16 @@ func (p *workflowServiceProcessorSignalWorkflowExecution) Process(seqId int32, i
   return true, err
 }
 
-type workflowServiceProcessorTerminateWorkflowExecution struct {
+type workflowServiceProcessorRespondActivityTaskCompletedByID struct {
   handler WorkflowService
 }
 
-func (p *workflowServiceProcessorTerminateWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceTerminateWorkflowExecutionArgs{}
+func (p *workflowServiceProcessorRespondActivityTaskCompletedByID) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskCompletedByIDArgs{}
   if err = args.Read(iprot); err != nil {
     iprot.ReadMessageEnd()
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin("RespondActivityTaskCompletedByID", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
@@ -3009,9 +3364,9 @@ func (p *workflowServiceProcessorTerminateWorkflowExecution) Process(seqId int32
   }
 
   iprot.ReadMessageEnd()
-  result := WorkflowServiceTerminateWorkflowExecutionResult{}
+  result := WorkflowServiceRespondActivityTaskCompletedByIDResult{}
   var err2 error
-  if err2 = p.handler.TerminateWorkflowExecution(args.TerminateRequest); err2 != nil {
+  if err2 = p.handler.RespondActivityTaskCompletedByID(args.CompleteRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
@@ -3019,18 +3374,16 @@ func (p *workflowServiceProcessorTerminateWorkflowExecution) Process(seqId int32
   result.InternalServiceError = v
     case *shared.EntityNotExistsError:
   result.EntityNotExistError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TerminateWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCompletedByID: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCompletedByID", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
     return true, err2
   }
   }
-  if err2 = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCompletedByID", thrift.REPLY, seqId); err2 != nil {
     err = err2
   }
   if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -3042,268 +3395,2189 @@ func (p *workflowServiceProcessorTerminateWorkflowExecution) Process(seqId int32
   if err2 = oprot.Flush(); err == nil && err2 != nil {
     err = err2
   }
-  if err != nil {
-    return
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskFailed struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskFailed) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskFailedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskFailedResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskFailed(args.FailRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskFailed: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskFailedByID struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskFailedByID) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskFailedByIDArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailedByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskFailedByIDResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskFailedByID(args.FailRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskFailedByID: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailedByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskFailedByID", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskCanceled struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskCanceled) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskCanceledArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskCanceledResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskCanceled(args.CanceledRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCanceled: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskCanceledByID struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskCanceledByID) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskCanceledByIDArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceledByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskCanceledByIDResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskCanceledByID(args.CanceledRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCanceledByID: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceledByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCanceledByID", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRequestCancelWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRequestCancelWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRequestCancelWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRequestCancelWorkflowExecutionResult{}
+  var err2 error
+  if err2 = p.handler.RequestCancelWorkflowExecution(args.CancelRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.CancellationAlreadyRequestedError:
+  result.CancellationAlreadyRequestedError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RequestCancelWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorSignalWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorSignalWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceSignalWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceSignalWorkflowExecutionResult{}
+  var err2 error
+  if err2 = p.handler.SignalWorkflowExecution(args.SignalRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorSignalWithStartWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorSignalWithStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceSignalWithStartWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceSignalWithStartWorkflowExecutionResult{}
+var retval *shared.StartWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.SignalWithStartWorkflowExecution(args.SignalWithStartRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.WorkflowExecutionAlreadyStartedError:
+  result.SessionAlreadyExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWithStartWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorTerminateWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorTerminateWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceTerminateWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceTerminateWorkflowExecutionResult{}
+  var err2 error
+  if err2 = p.handler.TerminateWorkflowExecution(args.TerminateRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TerminateWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorListOpenWorkflowExecutions struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorListOpenWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceListOpenWorkflowExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceListOpenWorkflowExecutionsResult{}
+var retval *shared.ListOpenWorkflowExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.ListOpenWorkflowExecutions(args.ListRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListOpenWorkflowExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorListClosedWorkflowExecutions struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorListClosedWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceListClosedWorkflowExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceListClosedWorkflowExecutionsResult{}
+var retval *shared.ListClosedWorkflowExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.ListClosedWorkflowExecutions(args.ListRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListClosedWorkflowExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondQueryTaskCompleted struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondQueryTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondQueryTaskCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondQueryTaskCompletedResult{}
+  var err2 error
+  if err2 = p.handler.RespondQueryTaskCompleted(args.CompleteRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondQueryTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorQueryWorkflow struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorQueryWorkflow) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceQueryWorkflowArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceQueryWorkflowResult{}
+var retval *shared.QueryWorkflowResponse
+  var err2 error
+  if retval, err2 = p.handler.QueryWorkflow(args.QueryRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.QueryFailedError:
+  result.QueryFailedError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryWorkflow: " + err2.Error())
+    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("QueryWorkflow", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - RegisterRequest
+type WorkflowServiceRegisterDomainArgs struct {
+  RegisterRequest *shared.RegisterDomainRequest `thrift:"registerRequest,1" db:"registerRequest" json:"registerRequest"`
+}
+
+func NewWorkflowServiceRegisterDomainArgs() *WorkflowServiceRegisterDomainArgs {
+  return &WorkflowServiceRegisterDomainArgs{}
+}
+
+var WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT *shared.RegisterDomainRequest
+func (p *WorkflowServiceRegisterDomainArgs) GetRegisterRequest() *shared.RegisterDomainRequest {
+  if !p.IsSetRegisterRequest() {
+    return WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT
+  }
+return p.RegisterRequest
+}
+func (p *WorkflowServiceRegisterDomainArgs) IsSetRegisterRequest() bool {
+  return p.RegisterRequest != nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.RegisterRequest = &shared.RegisterDomainRequest{}
+  if err := p.RegisterRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RegisterRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RegisterDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("registerRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:registerRequest: ", p), err) }
+  if err := p.RegisterRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RegisterRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:registerRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRegisterDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - DomainExistsError
+type WorkflowServiceRegisterDomainResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  DomainExistsError *shared.DomainAlreadyExistsError `thrift:"domainExistsError,3" db:"domainExistsError" json:"domainExistsError,omitempty"`
+}
+
+func NewWorkflowServiceRegisterDomainResult() *WorkflowServiceRegisterDomainResult {
+  return &WorkflowServiceRegisterDomainResult{}
+}
+
+var WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRegisterDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRegisterDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT *shared.DomainAlreadyExistsError
+func (p *WorkflowServiceRegisterDomainResult) GetDomainExistsError() *shared.DomainAlreadyExistsError {
+  if !p.IsSetDomainExistsError() {
+    return WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT
+  }
+return p.DomainExistsError
+}
+func (p *WorkflowServiceRegisterDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) IsSetDomainExistsError() bool {
+  return p.DomainExistsError != nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.DomainExistsError = &shared.DomainAlreadyExistsError{}
+  if err := p.DomainExistsError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DomainExistsError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RegisterDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainExistsError() {
+    if err := oprot.WriteFieldBegin("domainExistsError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:domainExistsError: ", p), err) }
+    if err := p.DomainExistsError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DomainExistsError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:domainExistsError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRegisterDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - DescribeRequest
+type WorkflowServiceDescribeDomainArgs struct {
+  DescribeRequest *shared.DescribeDomainRequest `thrift:"describeRequest,1" db:"describeRequest" json:"describeRequest"`
+}
+
+func NewWorkflowServiceDescribeDomainArgs() *WorkflowServiceDescribeDomainArgs {
+  return &WorkflowServiceDescribeDomainArgs{}
+}
+
+var WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT *shared.DescribeDomainRequest
+func (p *WorkflowServiceDescribeDomainArgs) GetDescribeRequest() *shared.DescribeDomainRequest {
+  if !p.IsSetDescribeRequest() {
+    return WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT
+  }
+return p.DescribeRequest
+}
+func (p *WorkflowServiceDescribeDomainArgs) IsSetDescribeRequest() bool {
+  return p.DescribeRequest != nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.DescribeRequest = &shared.DescribeDomainRequest{}
+  if err := p.DescribeRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DescribeRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("describeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:describeRequest: ", p), err) }
+  if err := p.DescribeRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DescribeRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:describeRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDescribeDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceDescribeDomainResult struct {
+  Success *shared.DescribeDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceDescribeDomainResult() *WorkflowServiceDescribeDomainResult {
+  return &WorkflowServiceDescribeDomainResult{}
+}
+
+var WorkflowServiceDescribeDomainResult_Success_DEFAULT *shared.DescribeDomainResponse
+func (p *WorkflowServiceDescribeDomainResult) GetSuccess() *shared.DescribeDomainResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceDescribeDomainResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceDescribeDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceDescribeDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceDescribeDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceDescribeDomainResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.DescribeDomainResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDescribeDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - UpdateRequest
+type WorkflowServiceUpdateDomainArgs struct {
+  UpdateRequest *shared.UpdateDomainRequest `thrift:"updateRequest,1" db:"updateRequest" json:"updateRequest"`
+}
+
+func NewWorkflowServiceUpdateDomainArgs() *WorkflowServiceUpdateDomainArgs {
+  return &WorkflowServiceUpdateDomainArgs{}
+}
+
+var WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT *shared.UpdateDomainRequest
+func (p *WorkflowServiceUpdateDomainArgs) GetUpdateRequest() *shared.UpdateDomainRequest {
+  if !p.IsSetUpdateRequest() {
+    return WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT
+  }
+return p.UpdateRequest
+}
+func (p *WorkflowServiceUpdateDomainArgs) IsSetUpdateRequest() bool {
+  return p.UpdateRequest != nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.UpdateRequest = &shared.UpdateDomainRequest{}
+  if err := p.UpdateRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UpdateRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("UpdateDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("updateRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:updateRequest: ", p), err) }
+  if err := p.UpdateRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UpdateRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:updateRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceUpdateDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceUpdateDomainResult struct {
+  Success *shared.UpdateDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceUpdateDomainResult() *WorkflowServiceUpdateDomainResult {
+  return &WorkflowServiceUpdateDomainResult{}
+}
+
+var WorkflowServiceUpdateDomainResult_Success_DEFAULT *shared.UpdateDomainResponse
+func (p *WorkflowServiceUpdateDomainResult) GetSuccess() *shared.UpdateDomainResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceUpdateDomainResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceUpdateDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceUpdateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceUpdateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceUpdateDomainResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.UpdateDomainResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("UpdateDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceUpdateDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - DeprecateRequest
+type WorkflowServiceDeprecateDomainArgs struct {
+  DeprecateRequest *shared.DeprecateDomainRequest `thrift:"deprecateRequest,1" db:"deprecateRequest" json:"deprecateRequest"`
+}
+
+func NewWorkflowServiceDeprecateDomainArgs() *WorkflowServiceDeprecateDomainArgs {
+  return &WorkflowServiceDeprecateDomainArgs{}
+}
+
+var WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT *shared.DeprecateDomainRequest
+func (p *WorkflowServiceDeprecateDomainArgs) GetDeprecateRequest() *shared.DeprecateDomainRequest {
+  if !p.IsSetDeprecateRequest() {
+    return WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT
+  }
+return p.DeprecateRequest
+}
+func (p *WorkflowServiceDeprecateDomainArgs) IsSetDeprecateRequest() bool {
+  return p.DeprecateRequest != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.DeprecateRequest = &shared.DeprecateDomainRequest{}
+  if err := p.DeprecateRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DeprecateRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DeprecateDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("deprecateRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:deprecateRequest: ", p), err) }
+  if err := p.DeprecateRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DeprecateRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:deprecateRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDeprecateDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceDeprecateDomainResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceDeprecateDomainResult() *WorkflowServiceDeprecateDomainResult {
+  return &WorkflowServiceDeprecateDomainResult{}
+}
+
+var WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceDeprecateDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceDeprecateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceDeprecateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceDeprecateDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DeprecateDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDeprecateDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - StartRequest
+type WorkflowServiceStartWorkflowExecutionArgs struct {
+  StartRequest *shared.StartWorkflowExecutionRequest `thrift:"startRequest,1" db:"startRequest" json:"startRequest"`
+}
+
+func NewWorkflowServiceStartWorkflowExecutionArgs() *WorkflowServiceStartWorkflowExecutionArgs {
+  return &WorkflowServiceStartWorkflowExecutionArgs{}
+}
+
+var WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT *shared.StartWorkflowExecutionRequest
+func (p *WorkflowServiceStartWorkflowExecutionArgs) GetStartRequest() *shared.StartWorkflowExecutionRequest {
+  if !p.IsSetStartRequest() {
+    return WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT
+  }
+return p.StartRequest
+}
+func (p *WorkflowServiceStartWorkflowExecutionArgs) IsSetStartRequest() bool {
+  return p.StartRequest != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.StartRequest = &shared.StartWorkflowExecutionRequest{}
+  if err := p.StartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecution_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("startRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:startRequest: ", p), err) }
+  if err := p.StartRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:startRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return true, err
+  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionArgs(%+v)", *p)
 }
 
-type workflowServiceProcessorListOpenWorkflowExecutions struct {
-  handler WorkflowService
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - SessionAlreadyExistError
+//  - ServiceBusyError
+type WorkflowServiceStartWorkflowExecutionResult struct {
+  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func (p *workflowServiceProcessorListOpenWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceListOpenWorkflowExecutionsArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
+func NewWorkflowServiceStartWorkflowExecutionResult() *WorkflowServiceStartWorkflowExecutionResult {
+  return &WorkflowServiceStartWorkflowExecutionResult{}
+}
 
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceListOpenWorkflowExecutionsResult{}
-var retval *shared.ListOpenWorkflowExecutionsResponse
-  var err2 error
-  if retval, err2 = p.handler.ListOpenWorkflowExecutions(args.ListRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListOpenWorkflowExecutions: " + err2.Error())
-    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+var WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT
   }
-  } else {
-    result.Success = retval
+return p.Success
 }
-  if err2 = oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+var WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+return p.BadRequestError
+}
+var WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+return p.InternalServiceError
+}
+var WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
+  if !p.IsSetSessionAlreadyExistError() {
+    return WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
   }
-  if err != nil {
-    return
+return p.SessionAlreadyExistError
+}
+var WorkflowServiceStartWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServiceStartWorkflowExecutionResult_ServiceBusyError_DEFAULT
   }
-  return true, err
+return p.ServiceBusyError
 }
-
-type workflowServiceProcessorListClosedWorkflowExecutions struct {
-  handler WorkflowService
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
 }
 
-func (p *workflowServiceProcessorListClosedWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceListClosedWorkflowExecutionsArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
 
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceListClosedWorkflowExecutionsResult{}
-var retval *shared.ListClosedWorkflowExecutionsResponse
-  var err2 error
-  if retval, err2 = p.handler.ListClosedWorkflowExecutions(args.ListRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListClosedWorkflowExecutions: " + err2.Error())
-    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  } else {
-    result.Success = retval
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
 }
-  if err2 = oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err != nil {
-    return
-  }
-  return true, err
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
+  return p.SessionAlreadyExistError != nil
 }
 
-type workflowServiceProcessorRespondQueryTaskCompleted struct {
-  handler WorkflowService
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
 }
 
-func (p *workflowServiceProcessorRespondQueryTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondQueryTaskCompletedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
 
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondQueryTaskCompletedResult{}
-  var err2 error
-  if err2 = p.handler.RespondQueryTaskCompleted(args.CompleteRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondQueryTaskCompleted: " + err2.Error())
-    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.StartWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
-  if err != nil {
-    return
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
   }
-  return true, err
+  return nil
 }
 
-type workflowServiceProcessorQueryWorkflow struct {
-  handler WorkflowService
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
 }
 
-func (p *workflowServiceProcessorQueryWorkflow) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceQueryWorkflowArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
+  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
   }
+  return nil
+}
 
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceQueryWorkflowResult{}
-var retval *shared.QueryWorkflowResponse
-  var err2 error
-  if retval, err2 = p.handler.QueryWorkflow(args.QueryRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *shared.QueryFailedError:
-  result.QueryFailedError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryWorkflow: " + err2.Error())
-    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
   }
-  } else {
-    result.Success = retval
+  return nil
 }
-  if err2 = oprot.WriteMessageBegin("QueryWorkflow", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecution_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
   }
-  if err != nil {
-    return
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSessionAlreadyExistError() {
+    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
+    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
   }
-  return true, err
+  return err
 }
 
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
+  }
+  return err
+}
 
-// HELPER FUNCTIONS AND STRUCTURES
+func (p *WorkflowServiceStartWorkflowExecutionResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionResult(%+v)", *p)
+}
 
 // Attributes:
-//  - RegisterRequest
-type WorkflowServiceRegisterDomainArgs struct {
-  RegisterRequest *shared.RegisterDomainRequest `thrift:"registerRequest,1" db:"registerRequest" json:"registerRequest"`
+//  - GetRequest
+type WorkflowServiceGetWorkflowExecutionHistoryArgs struct {
+  GetRequest *shared.GetWorkflowExecutionHistoryRequest `thrift:"getRequest,1" db:"getRequest" json:"getRequest"`
 }
 
-func NewWorkflowServiceRegisterDomainArgs() *WorkflowServiceRegisterDomainArgs {
-  return &WorkflowServiceRegisterDomainArgs{}
+func NewWorkflowServiceGetWorkflowExecutionHistoryArgs() *WorkflowServiceGetWorkflowExecutionHistoryArgs {
+  return &WorkflowServiceGetWorkflowExecutionHistoryArgs{}
 }
 
-var WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT *shared.RegisterDomainRequest
-func (p *WorkflowServiceRegisterDomainArgs) GetRegisterRequest() *shared.RegisterDomainRequest {
-  if !p.IsSetRegisterRequest() {
-    return WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT
+var WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT *shared.GetWorkflowExecutionHistoryRequest
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) GetGetRequest() *shared.GetWorkflowExecutionHistoryRequest {
+  if !p.IsSetGetRequest() {
+    return WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT
   }
-return p.RegisterRequest
+return p.GetRequest
 }
-func (p *WorkflowServiceRegisterDomainArgs) IsSetRegisterRequest() bool {
-  return p.RegisterRequest != nil
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) IsSetGetRequest() bool {
+  return p.GetRequest != nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3335,16 +5609,16 @@ func (p *WorkflowServiceRegisterDomainArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.RegisterRequest = &shared.RegisterDomainRequest{}
-  if err := p.RegisterRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RegisterRequest), err)
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.GetRequest = &shared.GetWorkflowExecutionHistoryRequest{}
+  if err := p.GetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GetRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RegisterDomain_args"); err != nil {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3356,72 +5630,98 @@ func (p *WorkflowServiceRegisterDomainArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("registerRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:registerRequest: ", p), err) }
-  if err := p.RegisterRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RegisterRequest), err)
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("getRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:getRequest: ", p), err) }
+  if err := p.GetRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GetRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:registerRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:getRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) String() string {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRegisterDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - DomainExistsError
-type WorkflowServiceRegisterDomainResult struct {
+//  - EntityNotExistError
+//  - ServiceBusyError
+type WorkflowServiceGetWorkflowExecutionHistoryResult struct {
+  Success *shared.GetWorkflowExecutionHistoryResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  DomainExistsError *shared.DomainAlreadyExistsError `thrift:"domainExistsError,3" db:"domainExistsError" json:"domainExistsError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServiceRegisterDomainResult() *WorkflowServiceRegisterDomainResult {
-  return &WorkflowServiceRegisterDomainResult{}
+func NewWorkflowServiceGetWorkflowExecutionHistoryResult() *WorkflowServiceGetWorkflowExecutionHistoryResult {
+  return &WorkflowServiceGetWorkflowExecutionHistoryResult{}
 }
 
-var WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRegisterDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT *shared.GetWorkflowExecutionHistoryResponse
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetSuccess() *shared.GetWorkflowExecutionHistoryResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRegisterDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT *shared.DomainAlreadyExistsError
-func (p *WorkflowServiceRegisterDomainResult) GetDomainExistsError() *shared.DomainAlreadyExistsError {
-  if !p.IsSetDomainExistsError() {
-    return WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT
+var WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var WorkflowServiceGetWorkflowExecutionHistoryResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_ServiceBusyError_DEFAULT
   }
-return p.DomainExistsError
+return p.ServiceBusyError
 }
-func (p *WorkflowServiceRegisterDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) IsSetDomainExistsError() bool {
-  return p.DomainExistsError != nil
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3434,6 +5734,10 @@ func (p *WorkflowServiceRegisterDomainResult) Read(iprot thrift.TProtocol) error
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -3446,6 +5750,10 @@ func (p *WorkflowServiceRegisterDomainResult) Read(iprot thrift.TProtocol) error
       if err := p.ReadField3(iprot); err != nil {
         return err
       }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -3461,7 +5769,15 @@ func (p *WorkflowServiceRegisterDomainResult) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.GetWorkflowExecutionHistoryResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -3469,7 +5785,7 @@ func (p *WorkflowServiceRegisterDomainResult)  ReadField1(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -3477,21 +5793,31 @@ func (p *WorkflowServiceRegisterDomainResult)  ReadField2(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.DomainExistsError = &shared.DomainAlreadyExistsError{}
-  if err := p.DomainExistsError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DomainExistsError), err)
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RegisterDomain_result"); err != nil {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -3500,7 +5826,20 @@ func (p *WorkflowServiceRegisterDomainResult) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -3513,7 +5852,7 @@ func (p *WorkflowServiceRegisterDomainResult) writeField1(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -3526,48 +5865,61 @@ func (p *WorkflowServiceRegisterDomainResult) writeField2(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomainExistsError() {
-    if err := oprot.WriteFieldBegin("domainExistsError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:domainExistsError: ", p), err) }
-    if err := p.DomainExistsError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DomainExistsError), err)
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:domainExistsError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainResult) String() string {
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRegisterDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryResult(%+v)", *p)
 }
 
 // Attributes:
-//  - DescribeRequest
-type WorkflowServiceDescribeDomainArgs struct {
-  DescribeRequest *shared.DescribeDomainRequest `thrift:"describeRequest,1" db:"describeRequest" json:"describeRequest"`
+//  - PollRequest
+type WorkflowServicePollForDecisionTaskArgs struct {
+  PollRequest *shared.PollForDecisionTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
 }
 
-func NewWorkflowServiceDescribeDomainArgs() *WorkflowServiceDescribeDomainArgs {
-  return &WorkflowServiceDescribeDomainArgs{}
+func NewWorkflowServicePollForDecisionTaskArgs() *WorkflowServicePollForDecisionTaskArgs {
+  return &WorkflowServicePollForDecisionTaskArgs{}
 }
 
-var WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT *shared.DescribeDomainRequest
-func (p *WorkflowServiceDescribeDomainArgs) GetDescribeRequest() *shared.DescribeDomainRequest {
-  if !p.IsSetDescribeRequest() {
-    return WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT
+var WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT *shared.PollForDecisionTaskRequest
+func (p *WorkflowServicePollForDecisionTaskArgs) GetPollRequest() *shared.PollForDecisionTaskRequest {
+  if !p.IsSetPollRequest() {
+    return WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT
   }
-return p.DescribeRequest
+return p.PollRequest
 }
-func (p *WorkflowServiceDescribeDomainArgs) IsSetDescribeRequest() bool {
-  return p.DescribeRequest != nil
+func (p *WorkflowServicePollForDecisionTaskArgs) IsSetPollRequest() bool {
+  return p.PollRequest != nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3599,16 +5951,16 @@ func (p *WorkflowServiceDescribeDomainArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.DescribeRequest = &shared.DescribeDomainRequest{}
-  if err := p.DescribeRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DescribeRequest), err)
+func (p *WorkflowServicePollForDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.PollRequest = &shared.PollForDecisionTaskRequest{}
+  if err := p.PollRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DescribeDomain_args"); err != nil {
+func (p *WorkflowServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTask_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3620,85 +5972,85 @@ func (p *WorkflowServiceDescribeDomainArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("describeRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:describeRequest: ", p), err) }
-  if err := p.DescribeRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DescribeRequest), err)
+func (p *WorkflowServicePollForDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
+  if err := p.PollRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:describeRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) String() string {
+func (p *WorkflowServicePollForDecisionTaskArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDescribeDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServicePollForDecisionTaskArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - EntityNotExistError
-type WorkflowServiceDescribeDomainResult struct {
-  Success *shared.DescribeDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - ServiceBusyError
+type WorkflowServicePollForDecisionTaskResult struct {
+  Success *shared.PollForDecisionTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServiceDescribeDomainResult() *WorkflowServiceDescribeDomainResult {
-  return &WorkflowServiceDescribeDomainResult{}
+func NewWorkflowServicePollForDecisionTaskResult() *WorkflowServicePollForDecisionTaskResult {
+  return &WorkflowServicePollForDecisionTaskResult{}
 }
 
-var WorkflowServiceDescribeDomainResult_Success_DEFAULT *shared.DescribeDomainResponse
-func (p *WorkflowServiceDescribeDomainResult) GetSuccess() *shared.DescribeDomainResponse {
+var WorkflowServicePollForDecisionTaskResult_Success_DEFAULT *shared.PollForDecisionTaskResponse
+func (p *WorkflowServicePollForDecisionTaskResult) GetSuccess() *shared.PollForDecisionTaskResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServiceDescribeDomainResult_Success_DEFAULT
+    return WorkflowServicePollForDecisionTaskResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceDescribeDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServicePollForDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT
+    return WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceDescribeDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServicePollForDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceDescribeDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
-  if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT
+var WorkflowServicePollForDecisionTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServicePollForDecisionTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServicePollForDecisionTaskResult_ServiceBusyError_DEFAULT
   }
-return p.EntityNotExistError
+return p.ServiceBusyError
 }
-func (p *WorkflowServiceDescribeDomainResult) IsSetSuccess() bool {
+func (p *WorkflowServicePollForDecisionTaskResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServicePollForDecisionTaskResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServicePollForDecisionTaskResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) IsSetEntityNotExistError() bool {
-  return p.EntityNotExistError != nil
+func (p *WorkflowServicePollForDecisionTaskResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3742,15 +6094,15 @@ func (p *WorkflowServiceDescribeDomainResult) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.DescribeDomainResponse{}
+func (p *WorkflowServicePollForDecisionTaskResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.PollForDecisionTaskResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -3758,7 +6110,7 @@ func (p *WorkflowServiceDescribeDomainResult)  ReadField1(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -3766,16 +6118,16 @@ func (p *WorkflowServiceDescribeDomainResult)  ReadField2(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.EntityNotExistError = &shared.EntityNotExistsError{}
-  if err := p.EntityNotExistError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+func (p *WorkflowServicePollForDecisionTaskResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DescribeDomain_result"); err != nil {
+func (p *WorkflowServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTask_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
@@ -3790,7 +6142,7 @@ func (p *WorkflowServiceDescribeDomainResult) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServicePollForDecisionTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -3803,7 +6155,7 @@ func (p *WorkflowServiceDescribeDomainResult) writeField0(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServicePollForDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -3816,7 +6168,7 @@ func (p *WorkflowServiceDescribeDomainResult) writeField1(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServicePollForDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -3825,52 +6177,52 @@ func (p *WorkflowServiceDescribeDomainResult) writeField2(oprot thrift.TProtocol
     }
     if err := oprot.WriteFieldEnd(); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceDescribeDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEntityNotExistError() {
-    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
-    if err := p.EntityNotExistError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainResult) String() string {
+func (p *WorkflowServicePollForDecisionTaskResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDescribeDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServicePollForDecisionTaskResult(%+v)", *p)
 }
 
 // Attributes:
-//  - UpdateRequest
-type WorkflowServiceUpdateDomainArgs struct {
-  UpdateRequest *shared.UpdateDomainRequest `thrift:"updateRequest,1" db:"updateRequest" json:"updateRequest"`
+//  - CompleteRequest
+type WorkflowServiceRespondDecisionTaskCompletedArgs struct {
+  CompleteRequest *shared.RespondDecisionTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
 }
 
-func NewWorkflowServiceUpdateDomainArgs() *WorkflowServiceUpdateDomainArgs {
-  return &WorkflowServiceUpdateDomainArgs{}
+func NewWorkflowServiceRespondDecisionTaskCompletedArgs() *WorkflowServiceRespondDecisionTaskCompletedArgs {
+  return &WorkflowServiceRespondDecisionTaskCompletedArgs{}
 }
 
-var WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT *shared.UpdateDomainRequest
-func (p *WorkflowServiceUpdateDomainArgs) GetUpdateRequest() *shared.UpdateDomainRequest {
-  if !p.IsSetUpdateRequest() {
-    return WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT
+var WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondDecisionTaskCompletedRequest
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) GetCompleteRequest() *shared.RespondDecisionTaskCompletedRequest {
+  if !p.IsSetCompleteRequest() {
+    return WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT
   }
-return p.UpdateRequest
+return p.CompleteRequest
 }
-func (p *WorkflowServiceUpdateDomainArgs) IsSetUpdateRequest() bool {
-  return p.UpdateRequest != nil
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3902,16 +6254,16 @@ func (p *WorkflowServiceUpdateDomainArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.UpdateRequest = &shared.UpdateDomainRequest{}
-  if err := p.UpdateRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UpdateRequest), err)
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &shared.RespondDecisionTaskCompletedRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("UpdateDomain_args"); err != nil {
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3923,85 +6275,72 @@ func (p *WorkflowServiceUpdateDomainArgs) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("updateRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:updateRequest: ", p), err) }
-  if err := p.UpdateRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UpdateRequest), err)
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:updateRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) String() string {
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceUpdateDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceUpdateDomainResult struct {
-  Success *shared.UpdateDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceRespondDecisionTaskCompletedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceUpdateDomainResult() *WorkflowServiceUpdateDomainResult {
-  return &WorkflowServiceUpdateDomainResult{}
+func NewWorkflowServiceRespondDecisionTaskCompletedResult() *WorkflowServiceRespondDecisionTaskCompletedResult {
+  return &WorkflowServiceRespondDecisionTaskCompletedResult{}
 }
 
-var WorkflowServiceUpdateDomainResult_Success_DEFAULT *shared.UpdateDomainResponse
-func (p *WorkflowServiceUpdateDomainResult) GetSuccess() *shared.UpdateDomainResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServiceUpdateDomainResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceUpdateDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceUpdateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceUpdateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceUpdateDomainResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *WorkflowServiceUpdateDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4014,10 +6353,6 @@ func (p *WorkflowServiceUpdateDomainResult) Read(iprot thrift.TProtocol) error {
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -4045,15 +6380,7 @@ func (p *WorkflowServiceUpdateDomainResult) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.UpdateDomainResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServiceUpdateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -4061,7 +6388,7 @@ func (p *WorkflowServiceUpdateDomainResult)  ReadField1(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -4069,7 +6396,7 @@ func (p *WorkflowServiceUpdateDomainResult)  ReadField2(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -4077,11 +6404,10 @@ func (p *WorkflowServiceUpdateDomainResult)  ReadField3(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("UpdateDomain_result"); err != nil {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -4093,20 +6419,7 @@ func (p *WorkflowServiceUpdateDomainResult) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceUpdateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -4119,7 +6432,7 @@ func (p *WorkflowServiceUpdateDomainResult) writeField1(oprot thrift.TProtocol)
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -4132,7 +6445,7 @@ func (p *WorkflowServiceUpdateDomainResult) writeField2(oprot thrift.TProtocol)
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -4145,35 +6458,35 @@ func (p *WorkflowServiceUpdateDomainResult) writeField3(oprot thrift.TProtocol)
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainResult) String() string {
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceUpdateDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - DeprecateRequest
-type WorkflowServiceDeprecateDomainArgs struct {
-  DeprecateRequest *shared.DeprecateDomainRequest `thrift:"deprecateRequest,1" db:"deprecateRequest" json:"deprecateRequest"`
+//  - PollRequest
+type WorkflowServicePollForActivityTaskArgs struct {
+  PollRequest *shared.PollForActivityTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
 }
 
-func NewWorkflowServiceDeprecateDomainArgs() *WorkflowServiceDeprecateDomainArgs {
-  return &WorkflowServiceDeprecateDomainArgs{}
+func NewWorkflowServicePollForActivityTaskArgs() *WorkflowServicePollForActivityTaskArgs {
+  return &WorkflowServicePollForActivityTaskArgs{}
 }
 
-var WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT *shared.DeprecateDomainRequest
-func (p *WorkflowServiceDeprecateDomainArgs) GetDeprecateRequest() *shared.DeprecateDomainRequest {
-  if !p.IsSetDeprecateRequest() {
-    return WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT
+var WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT *shared.PollForActivityTaskRequest
+func (p *WorkflowServicePollForActivityTaskArgs) GetPollRequest() *shared.PollForActivityTaskRequest {
+  if !p.IsSetPollRequest() {
+    return WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT
   }
-return p.DeprecateRequest
+return p.PollRequest
 }
-func (p *WorkflowServiceDeprecateDomainArgs) IsSetDeprecateRequest() bool {
-  return p.DeprecateRequest != nil
+func (p *WorkflowServicePollForActivityTaskArgs) IsSetPollRequest() bool {
+  return p.PollRequest != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4205,16 +6518,16 @@ func (p *WorkflowServiceDeprecateDomainArgs) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.DeprecateRequest = &shared.DeprecateDomainRequest{}
-  if err := p.DeprecateRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DeprecateRequest), err)
+func (p *WorkflowServicePollForActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.PollRequest = &shared.PollForActivityTaskRequest{}
+  if err := p.PollRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DeprecateDomain_args"); err != nil {
+func (p *WorkflowServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTask_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4226,72 +6539,85 @@ func (p *WorkflowServiceDeprecateDomainArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("deprecateRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:deprecateRequest: ", p), err) }
-  if err := p.DeprecateRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DeprecateRequest), err)
+func (p *WorkflowServicePollForActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
+  if err := p.PollRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:deprecateRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) String() string {
+func (p *WorkflowServicePollForActivityTaskArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDeprecateDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServicePollForActivityTaskArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - EntityNotExistError
-type WorkflowServiceDeprecateDomainResult struct {
+//  - ServiceBusyError
+type WorkflowServicePollForActivityTaskResult struct {
+  Success *shared.PollForActivityTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServiceDeprecateDomainResult() *WorkflowServiceDeprecateDomainResult {
-  return &WorkflowServiceDeprecateDomainResult{}
+func NewWorkflowServicePollForActivityTaskResult() *WorkflowServicePollForActivityTaskResult {
+  return &WorkflowServicePollForActivityTaskResult{}
 }
 
-var WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceDeprecateDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServicePollForActivityTaskResult_Success_DEFAULT *shared.PollForActivityTaskResponse
+func (p *WorkflowServicePollForActivityTaskResult) GetSuccess() *shared.PollForActivityTaskResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServicePollForActivityTaskResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServicePollForActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT
+    return WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceDeprecateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServicePollForActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceDeprecateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
-  if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT
+var WorkflowServicePollForActivityTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServicePollForActivityTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServicePollForActivityTaskResult_ServiceBusyError_DEFAULT
   }
-return p.EntityNotExistError
+return p.ServiceBusyError
 }
-func (p *WorkflowServiceDeprecateDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServicePollForActivityTaskResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServicePollForActivityTaskResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) IsSetEntityNotExistError() bool {
-  return p.EntityNotExistError != nil
+func (p *WorkflowServicePollForActivityTaskResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4304,6 +6630,10 @@ func (p *WorkflowServiceDeprecateDomainResult) Read(iprot thrift.TProtocol) erro
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -4331,7 +6661,15 @@ func (p *WorkflowServiceDeprecateDomainResult) Read(iprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServicePollForActivityTaskResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.PollForActivityTaskResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -4339,7 +6677,7 @@ func (p *WorkflowServiceDeprecateDomainResult)  ReadField1(iprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServicePollForActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -4347,18 +6685,19 @@ func (p *WorkflowServiceDeprecateDomainResult)  ReadField2(iprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.EntityNotExistError = &shared.EntityNotExistsError{}
-  if err := p.EntityNotExistError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+func (p *WorkflowServicePollForActivityTaskResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DeprecateDomain_result"); err != nil {
+func (p *WorkflowServicePollForActivityTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTask_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -4370,7 +6709,20 @@ func (p *WorkflowServiceDeprecateDomainResult) Write(oprot thrift.TProtocol) err
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServicePollForActivityTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -4383,7 +6735,7 @@ func (p *WorkflowServiceDeprecateDomainResult) writeField1(oprot thrift.TProtoco
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServicePollForActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -4396,48 +6748,48 @@ func (p *WorkflowServiceDeprecateDomainResult) writeField2(oprot thrift.TProtoco
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEntityNotExistError() {
-    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
-    if err := p.EntityNotExistError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+func (p *WorkflowServicePollForActivityTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) String() string {
+func (p *WorkflowServicePollForActivityTaskResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDeprecateDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServicePollForActivityTaskResult(%+v)", *p)
 }
 
 // Attributes:
-//  - StartRequest
-type WorkflowServiceStartWorkflowExecutionArgs struct {
-  StartRequest *shared.StartWorkflowExecutionRequest `thrift:"startRequest,1" db:"startRequest" json:"startRequest"`
+//  - HeartbeatRequest
+type WorkflowServiceRecordActivityTaskHeartbeatArgs struct {
+  HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
 }
 
-func NewWorkflowServiceStartWorkflowExecutionArgs() *WorkflowServiceStartWorkflowExecutionArgs {
-  return &WorkflowServiceStartWorkflowExecutionArgs{}
+func NewWorkflowServiceRecordActivityTaskHeartbeatArgs() *WorkflowServiceRecordActivityTaskHeartbeatArgs {
+  return &WorkflowServiceRecordActivityTaskHeartbeatArgs{}
 }
 
-var WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT *shared.StartWorkflowExecutionRequest
-func (p *WorkflowServiceStartWorkflowExecutionArgs) GetStartRequest() *shared.StartWorkflowExecutionRequest {
-  if !p.IsSetStartRequest() {
-    return WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT
+var WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT *shared.RecordActivityTaskHeartbeatRequest
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) GetHeartbeatRequest() *shared.RecordActivityTaskHeartbeatRequest {
+  if !p.IsSetHeartbeatRequest() {
+    return WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT
   }
-return p.StartRequest
+return p.HeartbeatRequest
 }
-func (p *WorkflowServiceStartWorkflowExecutionArgs) IsSetStartRequest() bool {
-  return p.StartRequest != nil
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) IsSetHeartbeatRequest() bool {
+  return p.HeartbeatRequest != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4469,16 +6821,16 @@ func (p *WorkflowServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.StartRequest = &shared.StartWorkflowExecutionRequest{}
-  if err := p.StartRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartRequest), err)
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.HeartbeatRequest = &shared.RecordActivityTaskHeartbeatRequest{}
+  if err := p.HeartbeatRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecution_args"); err != nil {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4490,98 +6842,85 @@ func (p *WorkflowServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("startRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:startRequest: ", p), err) }
-  if err := p.StartRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartRequest), err)
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
+  if err := p.HeartbeatRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:startRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) String() string {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - SessionAlreadyExistError
-//  - ServiceBusyError
-type WorkflowServiceStartWorkflowExecutionResult struct {
-  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - EntityNotExistError
+type WorkflowServiceRecordActivityTaskHeartbeatResult struct {
+  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
-  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceStartWorkflowExecutionResult() *WorkflowServiceStartWorkflowExecutionResult {
-  return &WorkflowServiceStartWorkflowExecutionResult{}
+func NewWorkflowServiceRecordActivityTaskHeartbeatResult() *WorkflowServiceRecordActivityTaskHeartbeatResult {
+  return &WorkflowServiceRecordActivityTaskHeartbeatResult{}
 }
 
-var WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
+var WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
-  if !p.IsSetSessionAlreadyExistError() {
-    return WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
-  }
-return p.SessionAlreadyExistError
-}
-var WorkflowServiceStartWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
-  if !p.IsSetServiceBusyError() {
-    return WorkflowServiceStartWorkflowExecutionResult_ServiceBusyError_DEFAULT
+var WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT
   }
-return p.ServiceBusyError
+return p.EntityNotExistError
 }
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSuccess() bool {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
-  return p.SessionAlreadyExistError != nil
-}
-
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetServiceBusyError() bool {
-  return p.ServiceBusyError != nil
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4610,10 +6949,6 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtoco
       if err := p.ReadField3(iprot); err != nil {
         return err
       }
-    case 4:
-      if err := p.ReadField4(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -4629,15 +6964,15 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.StartWorkflowExecutionResponse{}
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -4645,7 +6980,7 @@ func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -4653,31 +6988,22 @@ func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
-  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
-  p.ServiceBusyError = &shared.ServiceBusyError{}
-  if err := p.ServiceBusyError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecution_result"); err != nil {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
-    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4686,7 +7012,7 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtoc
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -4699,7 +7025,7 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) writeField0(oprot thrift.T
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -4709,77 +7035,64 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) writeField1(oprot thrift.T
     if err := oprot.WriteFieldEnd(); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
   }
-  return err
-}
-
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
-  if p.IsSetInternalServiceError() {
-    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
-    if err := p.InternalServiceError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSessionAlreadyExistError() {
-    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
-    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
-  if p.IsSetServiceBusyError() {
-    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
-    if err := p.ServiceBusyError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) String() string {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatResult(%+v)", *p)
 }
 
 // Attributes:
-//  - GetRequest
-type WorkflowServiceGetWorkflowExecutionHistoryArgs struct {
-  GetRequest *shared.GetWorkflowExecutionHistoryRequest `thrift:"getRequest,1" db:"getRequest" json:"getRequest"`
+//  - HeartbeatRequest
+type WorkflowServiceRecordActivityTaskHeartbeatByIDArgs struct {
+  HeartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
 }
 
-func NewWorkflowServiceGetWorkflowExecutionHistoryArgs() *WorkflowServiceGetWorkflowExecutionHistoryArgs {
-  return &WorkflowServiceGetWorkflowExecutionHistoryArgs{}
+func NewWorkflowServiceRecordActivityTaskHeartbeatByIDArgs() *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs {
+  return &WorkflowServiceRecordActivityTaskHeartbeatByIDArgs{}
 }
 
-var WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT *shared.GetWorkflowExecutionHistoryRequest
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) GetGetRequest() *shared.GetWorkflowExecutionHistoryRequest {
-  if !p.IsSetGetRequest() {
-    return WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT
+var WorkflowServiceRecordActivityTaskHeartbeatByIDArgs_HeartbeatRequest_DEFAULT *shared.RecordActivityTaskHeartbeatByIDRequest
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) GetHeartbeatRequest() *shared.RecordActivityTaskHeartbeatByIDRequest {
+  if !p.IsSetHeartbeatRequest() {
+    return WorkflowServiceRecordActivityTaskHeartbeatByIDArgs_HeartbeatRequest_DEFAULT
   }
-return p.GetRequest
+return p.HeartbeatRequest
 }
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) IsSetGetRequest() bool {
-  return p.GetRequest != nil
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) IsSetHeartbeatRequest() bool {
+  return p.HeartbeatRequest != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4811,16 +7124,16 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.GetRequest = &shared.GetWorkflowExecutionHistoryRequest{}
-  if err := p.GetRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GetRequest), err)
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.HeartbeatRequest = &shared.RecordActivityTaskHeartbeatByIDRequest{}
+  if err := p.HeartbeatRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_args"); err != nil {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatByID_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4832,22 +7145,22 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("getRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:getRequest: ", p), err) }
-  if err := p.GetRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GetRequest), err)
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
+  if err := p.HeartbeatRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:getRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) String() string {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatByIDArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -4855,75 +7168,62 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) String() string {
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-//  - ServiceBusyError
-type WorkflowServiceGetWorkflowExecutionHistoryResult struct {
-  Success *shared.GetWorkflowExecutionHistoryResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceRecordActivityTaskHeartbeatByIDResult struct {
+  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
-  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServiceGetWorkflowExecutionHistoryResult() *WorkflowServiceGetWorkflowExecutionHistoryResult {
-  return &WorkflowServiceGetWorkflowExecutionHistoryResult{}
+func NewWorkflowServiceRecordActivityTaskHeartbeatByIDResult() *WorkflowServiceRecordActivityTaskHeartbeatByIDResult {
+  return &WorkflowServiceRecordActivityTaskHeartbeatByIDResult{}
 }
 
-var WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT *shared.GetWorkflowExecutionHistoryResponse
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetSuccess() *shared.GetWorkflowExecutionHistoryResponse {
+var WorkflowServiceRecordActivityTaskHeartbeatByIDResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT
+    return WorkflowServiceRecordActivityTaskHeartbeatByIDResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRecordActivityTaskHeartbeatByIDResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT
+    return WorkflowServiceRecordActivityTaskHeartbeatByIDResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRecordActivityTaskHeartbeatByIDResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRecordActivityTaskHeartbeatByIDResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRecordActivityTaskHeartbeatByIDResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRecordActivityTaskHeartbeatByIDResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var WorkflowServiceGetWorkflowExecutionHistoryResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetServiceBusyError() *shared.ServiceBusyError {
-  if !p.IsSetServiceBusyError() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_ServiceBusyError_DEFAULT
-  }
-return p.ServiceBusyError
-}
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetSuccess() bool {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetServiceBusyError() bool {
-  return p.ServiceBusyError != nil
-}
-
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4952,10 +7252,6 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TPr
       if err := p.ReadField3(iprot); err != nil {
         return err
       }
-    case 4:
-      if err := p.ReadField4(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -4971,15 +7267,15 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.GetWorkflowExecutionHistoryResponse{}
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -4987,7 +7283,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -4995,7 +7291,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -5003,23 +7299,14 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField4(iprot thrift.TProtocol) error {
-  p.ServiceBusyError = &shared.ServiceBusyError{}
-  if err := p.ServiceBusyError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_result"); err != nil {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatByID_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
-    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5028,7 +7315,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -5041,7 +7328,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField0(oprot thr
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5054,7 +7341,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField1(oprot thr
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -5067,7 +7354,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField2(oprot thr
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -5080,48 +7367,35 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField3(oprot thr
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField4(oprot thrift.TProtocol) (err error) {
-  if p.IsSetServiceBusyError() {
-    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
-    if err := p.ServiceBusyError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) String() string {
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatByIDResult(%+v)", *p)
 }
 
 // Attributes:
-//  - PollRequest
-type WorkflowServicePollForDecisionTaskArgs struct {
-  PollRequest *shared.PollForDecisionTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+//  - CompleteRequest
+type WorkflowServiceRespondActivityTaskCompletedArgs struct {
+  CompleteRequest *shared.RespondActivityTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
 }
 
-func NewWorkflowServicePollForDecisionTaskArgs() *WorkflowServicePollForDecisionTaskArgs {
-  return &WorkflowServicePollForDecisionTaskArgs{}
+func NewWorkflowServiceRespondActivityTaskCompletedArgs() *WorkflowServiceRespondActivityTaskCompletedArgs {
+  return &WorkflowServiceRespondActivityTaskCompletedArgs{}
 }
 
-var WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT *shared.PollForDecisionTaskRequest
-func (p *WorkflowServicePollForDecisionTaskArgs) GetPollRequest() *shared.PollForDecisionTaskRequest {
-  if !p.IsSetPollRequest() {
-    return WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT
+var WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondActivityTaskCompletedRequest
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) GetCompleteRequest() *shared.RespondActivityTaskCompletedRequest {
+  if !p.IsSetCompleteRequest() {
+    return WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT
   }
-return p.PollRequest
+return p.CompleteRequest
 }
-func (p *WorkflowServicePollForDecisionTaskArgs) IsSetPollRequest() bool {
-  return p.PollRequest != nil
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5153,16 +7427,16 @@ func (p *WorkflowServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.PollRequest = &shared.PollForDecisionTaskRequest{}
-  if err := p.PollRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &shared.RespondActivityTaskCompletedRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTask_args"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -5174,85 +7448,72 @@ func (p *WorkflowServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
-  if err := p.PollRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) String() string {
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForDecisionTaskArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - ServiceBusyError
-type WorkflowServicePollForDecisionTaskResult struct {
-  Success *shared.PollForDecisionTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - EntityNotExistError
+type WorkflowServiceRespondActivityTaskCompletedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServicePollForDecisionTaskResult() *WorkflowServicePollForDecisionTaskResult {
-  return &WorkflowServicePollForDecisionTaskResult{}
+func NewWorkflowServiceRespondActivityTaskCompletedResult() *WorkflowServiceRespondActivityTaskCompletedResult {
+  return &WorkflowServiceRespondActivityTaskCompletedResult{}
 }
 
-var WorkflowServicePollForDecisionTaskResult_Success_DEFAULT *shared.PollForDecisionTaskResponse
-func (p *WorkflowServicePollForDecisionTaskResult) GetSuccess() *shared.PollForDecisionTaskResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServicePollForDecisionTaskResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServicePollForDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServicePollForDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServicePollForDecisionTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
-func (p *WorkflowServicePollForDecisionTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
-  if !p.IsSetServiceBusyError() {
-    return WorkflowServicePollForDecisionTaskResult_ServiceBusyError_DEFAULT
+var WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT
   }
-return p.ServiceBusyError
-}
-func (p *WorkflowServicePollForDecisionTaskResult) IsSetSuccess() bool {
-  return p.Success != nil
+return p.EntityNotExistError
 }
-
-func (p *WorkflowServicePollForDecisionTaskResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) IsSetServiceBusyError() bool {
-  return p.ServiceBusyError != nil
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5264,11 +7525,7 @@ func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
       return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
     if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
+    switch fieldId {
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -5296,15 +7553,7 @@ func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.PollForDecisionTaskResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5312,7 +7561,7 @@ func (p *WorkflowServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5320,19 +7569,18 @@ func (p *WorkflowServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.ServiceBusyError = &shared.ServiceBusyError{}
-  if err := p.ServiceBusyError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTask_result"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -5344,20 +7592,7 @@ func (p *WorkflowServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServicePollForDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5370,7 +7605,7 @@ func (p *WorkflowServicePollForDecisionTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -5383,48 +7618,48 @@ func (p *WorkflowServicePollForDecisionTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetServiceBusyError() {
-    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
-    if err := p.ServiceBusyError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) String() string {
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForDecisionTaskResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedResult(%+v)", *p)
 }
 
 // Attributes:
 //  - CompleteRequest
-type WorkflowServiceRespondDecisionTaskCompletedArgs struct {
-  CompleteRequest *shared.RespondDecisionTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+type WorkflowServiceRespondActivityTaskCompletedByIDArgs struct {
+  CompleteRequest *shared.RespondActivityTaskCompletedByIDRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
 }
 
-func NewWorkflowServiceRespondDecisionTaskCompletedArgs() *WorkflowServiceRespondDecisionTaskCompletedArgs {
-  return &WorkflowServiceRespondDecisionTaskCompletedArgs{}
+func NewWorkflowServiceRespondActivityTaskCompletedByIDArgs() *WorkflowServiceRespondActivityTaskCompletedByIDArgs {
+  return &WorkflowServiceRespondActivityTaskCompletedByIDArgs{}
 }
 
-var WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondDecisionTaskCompletedRequest
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) GetCompleteRequest() *shared.RespondDecisionTaskCompletedRequest {
+var WorkflowServiceRespondActivityTaskCompletedByIDArgs_CompleteRequest_DEFAULT *shared.RespondActivityTaskCompletedByIDRequest
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) GetCompleteRequest() *shared.RespondActivityTaskCompletedByIDRequest {
   if !p.IsSetCompleteRequest() {
-    return WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT
+    return WorkflowServiceRespondActivityTaskCompletedByIDArgs_CompleteRequest_DEFAULT
   }
 return p.CompleteRequest
 }
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) IsSetCompleteRequest() bool {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) IsSetCompleteRequest() bool {
   return p.CompleteRequest != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5456,16 +7691,16 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CompleteRequest = &shared.RespondDecisionTaskCompletedRequest{}
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &shared.RespondActivityTaskCompletedByIDRequest{}
   if err := p.CompleteRequest.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_args"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompletedByID_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -5477,7 +7712,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
   if err := p.CompleteRequest.Write(oprot); err != nil {
@@ -5488,61 +7723,61 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) writeField1(oprot thri
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) String() string {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedByIDArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRespondDecisionTaskCompletedResult struct {
+type WorkflowServiceRespondActivityTaskCompletedByIDResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRespondDecisionTaskCompletedResult() *WorkflowServiceRespondDecisionTaskCompletedResult {
-  return &WorkflowServiceRespondDecisionTaskCompletedResult{}
+func NewWorkflowServiceRespondActivityTaskCompletedByIDResult() *WorkflowServiceRespondActivityTaskCompletedByIDResult {
+  return &WorkflowServiceRespondActivityTaskCompletedByIDResult{}
 }
 
-var WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondActivityTaskCompletedByIDResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCompletedByIDResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondActivityTaskCompletedByIDResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCompletedByIDResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRespondActivityTaskCompletedByIDResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCompletedByIDResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5582,7 +7817,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5590,7 +7825,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5598,7 +7833,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -5606,8 +7841,8 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField3(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_result"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompletedByID_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -5621,7 +7856,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5634,7 +7869,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField1(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -5647,7 +7882,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField2(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -5660,35 +7895,35 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField3(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) String() string {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedByIDResult(%+v)", *p)
 }
 
 // Attributes:
-//  - PollRequest
-type WorkflowServicePollForActivityTaskArgs struct {
-  PollRequest *shared.PollForActivityTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+//  - FailRequest
+type WorkflowServiceRespondActivityTaskFailedArgs struct {
+  FailRequest *shared.RespondActivityTaskFailedRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
 }
 
-func NewWorkflowServicePollForActivityTaskArgs() *WorkflowServicePollForActivityTaskArgs {
-  return &WorkflowServicePollForActivityTaskArgs{}
+func NewWorkflowServiceRespondActivityTaskFailedArgs() *WorkflowServiceRespondActivityTaskFailedArgs {
+  return &WorkflowServiceRespondActivityTaskFailedArgs{}
 }
 
-var WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT *shared.PollForActivityTaskRequest
-func (p *WorkflowServicePollForActivityTaskArgs) GetPollRequest() *shared.PollForActivityTaskRequest {
-  if !p.IsSetPollRequest() {
-    return WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT
+var WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT *shared.RespondActivityTaskFailedRequest
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) GetFailRequest() *shared.RespondActivityTaskFailedRequest {
+  if !p.IsSetFailRequest() {
+    return WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT
   }
-return p.PollRequest
+return p.FailRequest
 }
-func (p *WorkflowServicePollForActivityTaskArgs) IsSetPollRequest() bool {
-  return p.PollRequest != nil
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) IsSetFailRequest() bool {
+  return p.FailRequest != nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5720,16 +7955,16 @@ func (p *WorkflowServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.PollRequest = &shared.PollForActivityTaskRequest{}
-  if err := p.PollRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+func (p *WorkflowServiceRespondActivityTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.FailRequest = &shared.RespondActivityTaskFailedRequest{}
+  if err := p.FailRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTask_args"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -5741,85 +7976,72 @@ func (p *WorkflowServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
-  if err := p.PollRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
+  if err := p.FailRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) String() string {
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForActivityTaskArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - ServiceBusyError
-type WorkflowServicePollForActivityTaskResult struct {
-  Success *shared.PollForActivityTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - EntityNotExistError
+type WorkflowServiceRespondActivityTaskFailedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServicePollForActivityTaskResult() *WorkflowServicePollForActivityTaskResult {
-  return &WorkflowServicePollForActivityTaskResult{}
+func NewWorkflowServiceRespondActivityTaskFailedResult() *WorkflowServiceRespondActivityTaskFailedResult {
+  return &WorkflowServiceRespondActivityTaskFailedResult{}
 }
 
-var WorkflowServicePollForActivityTaskResult_Success_DEFAULT *shared.PollForActivityTaskResponse
-func (p *WorkflowServicePollForActivityTaskResult) GetSuccess() *shared.PollForActivityTaskResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServicePollForActivityTaskResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServicePollForActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServicePollForActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServicePollForActivityTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
-func (p *WorkflowServicePollForActivityTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
-  if !p.IsSetServiceBusyError() {
-    return WorkflowServicePollForActivityTaskResult_ServiceBusyError_DEFAULT
+var WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT
   }
-return p.ServiceBusyError
-}
-func (p *WorkflowServicePollForActivityTaskResult) IsSetSuccess() bool {
-  return p.Success != nil
+return p.EntityNotExistError
 }
-
-func (p *WorkflowServicePollForActivityTaskResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) IsSetServiceBusyError() bool {
-  return p.ServiceBusyError != nil
+func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5832,10 +8054,6 @@ func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol)
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -5863,15 +8081,7 @@ func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.PollForActivityTaskResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServicePollForActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5879,7 +8089,7 @@ func (p *WorkflowServicePollForActivityTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5887,19 +8097,18 @@ func (p *WorkflowServicePollForActivityTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.ServiceBusyError = &shared.ServiceBusyError{}
-  if err := p.ServiceBusyError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTask_result"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -5907,24 +8116,11 @@ func (p *WorkflowServicePollForActivityTaskResult) Write(oprot thrift.TProtocol)
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
   if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
-}
-
-func (p *WorkflowServicePollForActivityTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5937,7 +8133,7 @@ func (p *WorkflowServicePollForActivityTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -5950,48 +8146,48 @@ func (p *WorkflowServicePollForActivityTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetServiceBusyError() {
-    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
-    if err := p.ServiceBusyError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) String() string {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForActivityTaskResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - HeartbeatRequest
-type WorkflowServiceRecordActivityTaskHeartbeatArgs struct {
-  HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
+//  - FailRequest
+type WorkflowServiceRespondActivityTaskFailedByIDArgs struct {
+  FailRequest *shared.RespondActivityTaskFailedByIDRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
 }
 
-func NewWorkflowServiceRecordActivityTaskHeartbeatArgs() *WorkflowServiceRecordActivityTaskHeartbeatArgs {
-  return &WorkflowServiceRecordActivityTaskHeartbeatArgs{}
+func NewWorkflowServiceRespondActivityTaskFailedByIDArgs() *WorkflowServiceRespondActivityTaskFailedByIDArgs {
+  return &WorkflowServiceRespondActivityTaskFailedByIDArgs{}
 }
 
-var WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT *shared.RecordActivityTaskHeartbeatRequest
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) GetHeartbeatRequest() *shared.RecordActivityTaskHeartbeatRequest {
-  if !p.IsSetHeartbeatRequest() {
-    return WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT
+var WorkflowServiceRespondActivityTaskFailedByIDArgs_FailRequest_DEFAULT *shared.RespondActivityTaskFailedByIDRequest
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) GetFailRequest() *shared.RespondActivityTaskFailedByIDRequest {
+  if !p.IsSetFailRequest() {
+    return WorkflowServiceRespondActivityTaskFailedByIDArgs_FailRequest_DEFAULT
   }
-return p.HeartbeatRequest
+return p.FailRequest
 }
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) IsSetHeartbeatRequest() bool {
-  return p.HeartbeatRequest != nil
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) IsSetFailRequest() bool {
+  return p.FailRequest != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6023,16 +8219,16 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.HeartbeatRequest = &shared.RecordActivityTaskHeartbeatRequest{}
-  if err := p.HeartbeatRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.FailRequest = &shared.RespondActivityTaskFailedByIDRequest{}
+  if err := p.FailRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_args"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailedByID_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6044,85 +8240,72 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
-  if err := p.HeartbeatRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
+  if err := p.FailRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) String() string {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedByIDArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRecordActivityTaskHeartbeatResult struct {
-  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceRespondActivityTaskFailedByIDResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRecordActivityTaskHeartbeatResult() *WorkflowServiceRecordActivityTaskHeartbeatResult {
-  return &WorkflowServiceRecordActivityTaskHeartbeatResult{}
+func NewWorkflowServiceRespondActivityTaskFailedByIDResult() *WorkflowServiceRespondActivityTaskFailedByIDResult {
+  return &WorkflowServiceRespondActivityTaskFailedByIDResult{}
 }
 
-var WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondActivityTaskFailedByIDResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondActivityTaskFailedByIDResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondActivityTaskFailedByIDResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondActivityTaskFailedByIDResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRespondActivityTaskFailedByIDResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRespondActivityTaskFailedByIDResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6135,10 +8318,6 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TPr
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -6166,15 +8345,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6182,7 +8353,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6190,7 +8361,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -6198,11 +8369,10 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_result"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailedByID_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -6214,20 +8384,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -6240,7 +8397,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6253,7 +8410,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -6266,35 +8423,35 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) String() string {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedByIDResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CompleteRequest
-type WorkflowServiceRespondActivityTaskCompletedArgs struct {
-  CompleteRequest *shared.RespondActivityTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+//  - CanceledRequest
+type WorkflowServiceRespondActivityTaskCanceledArgs struct {
+  CanceledRequest *shared.RespondActivityTaskCanceledRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCompletedArgs() *WorkflowServiceRespondActivityTaskCompletedArgs {
-  return &WorkflowServiceRespondActivityTaskCompletedArgs{}
+func NewWorkflowServiceRespondActivityTaskCanceledArgs() *WorkflowServiceRespondActivityTaskCanceledArgs {
+  return &WorkflowServiceRespondActivityTaskCanceledArgs{}
 }
 
-var WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondActivityTaskCompletedRequest
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) GetCompleteRequest() *shared.RespondActivityTaskCompletedRequest {
-  if !p.IsSetCompleteRequest() {
-    return WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT
+var WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT *shared.RespondActivityTaskCanceledRequest
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) GetCanceledRequest() *shared.RespondActivityTaskCanceledRequest {
+  if !p.IsSetCanceledRequest() {
+    return WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT
   }
-return p.CompleteRequest
+return p.CanceledRequest
 }
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) IsSetCompleteRequest() bool {
-  return p.CompleteRequest != nil
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) IsSetCanceledRequest() bool {
+  return p.CanceledRequest != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6326,16 +8483,16 @@ func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CompleteRequest = &shared.RespondActivityTaskCompletedRequest{}
-  if err := p.CompleteRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CanceledRequest = &shared.RespondActivityTaskCanceledRequest{}
+  if err := p.CanceledRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_args"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6347,72 +8504,72 @@ func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
-  if err := p.CompleteRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
+  if err := p.CanceledRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) String() string {
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRespondActivityTaskCompletedResult struct {
+type WorkflowServiceRespondActivityTaskCanceledResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCompletedResult() *WorkflowServiceRespondActivityTaskCompletedResult {
-  return &WorkflowServiceRespondActivityTaskCompletedResult{}
+func NewWorkflowServiceRespondActivityTaskCanceledResult() *WorkflowServiceRespondActivityTaskCanceledResult {
+  return &WorkflowServiceRespondActivityTaskCanceledResult{}
 }
 
-var WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6452,7 +8609,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6460,7 +8617,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField1(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6468,7 +8625,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField2(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -6476,8 +8633,8 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField3(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_result"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6491,7 +8648,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) Write(oprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -6504,7 +8661,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField1(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6517,7 +8674,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField2(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -6530,35 +8687,35 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField3(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) String() string {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledResult(%+v)", *p)
 }
 
 // Attributes:
-//  - FailRequest
-type WorkflowServiceRespondActivityTaskFailedArgs struct {
-  FailRequest *shared.RespondActivityTaskFailedRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
+//  - CanceledRequest
+type WorkflowServiceRespondActivityTaskCanceledByIDArgs struct {
+  CanceledRequest *shared.RespondActivityTaskCanceledByIDRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
 }
 
-func NewWorkflowServiceRespondActivityTaskFailedArgs() *WorkflowServiceRespondActivityTaskFailedArgs {
-  return &WorkflowServiceRespondActivityTaskFailedArgs{}
+func NewWorkflowServiceRespondActivityTaskCanceledByIDArgs() *WorkflowServiceRespondActivityTaskCanceledByIDArgs {
+  return &WorkflowServiceRespondActivityTaskCanceledByIDArgs{}
 }
 
-var WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT *shared.RespondActivityTaskFailedRequest
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) GetFailRequest() *shared.RespondActivityTaskFailedRequest {
-  if !p.IsSetFailRequest() {
-    return WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT
+var WorkflowServiceRespondActivityTaskCanceledByIDArgs_CanceledRequest_DEFAULT *shared.RespondActivityTaskCanceledByIDRequest
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) GetCanceledRequest() *shared.RespondActivityTaskCanceledByIDRequest {
+  if !p.IsSetCanceledRequest() {
+    return WorkflowServiceRespondActivityTaskCanceledByIDArgs_CanceledRequest_DEFAULT
   }
-return p.FailRequest
+return p.CanceledRequest
 }
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) IsSetFailRequest() bool {
-  return p.FailRequest != nil
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) IsSetCanceledRequest() bool {
+  return p.CanceledRequest != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6590,16 +8747,16 @@ func (p *WorkflowServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtoc
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.FailRequest = &shared.RespondActivityTaskFailedRequest{}
-  if err := p.FailRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CanceledRequest = &shared.RespondActivityTaskCanceledByIDRequest{}
+  if err := p.CanceledRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_args"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceledByID_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6611,72 +8768,72 @@ func (p *WorkflowServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProto
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
-  if err := p.FailRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
+  if err := p.CanceledRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) String() string {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledByIDArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRespondActivityTaskFailedResult struct {
+type WorkflowServiceRespondActivityTaskCanceledByIDResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRespondActivityTaskFailedResult() *WorkflowServiceRespondActivityTaskFailedResult {
-  return &WorkflowServiceRespondActivityTaskFailedResult{}
+func NewWorkflowServiceRespondActivityTaskCanceledByIDResult() *WorkflowServiceRespondActivityTaskCanceledByIDResult {
+  return &WorkflowServiceRespondActivityTaskCanceledByIDResult{}
 }
 
-var WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondActivityTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondActivityTaskCanceledByIDResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCanceledByIDResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondActivityTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondActivityTaskCanceledByIDResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCanceledByIDResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondActivityTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRespondActivityTaskCanceledByIDResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCanceledByIDResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6716,7 +8873,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6724,7 +8881,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrif
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6732,7 +8889,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrif
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -6740,8 +8897,8 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrif
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_result"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceledByID_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6755,7 +8912,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -6768,7 +8925,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField1(oprot thrif
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6781,7 +8938,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField2(oprot thrif
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -6794,35 +8951,35 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField3(oprot thrif
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) String() string {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledByIDResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CanceledRequest
-type WorkflowServiceRespondActivityTaskCanceledArgs struct {
-  CanceledRequest *shared.RespondActivityTaskCanceledRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
+//  - CancelRequest
+type WorkflowServiceRequestCancelWorkflowExecutionArgs struct {
+  CancelRequest *shared.RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,1" db:"cancelRequest" json:"cancelRequest"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCanceledArgs() *WorkflowServiceRespondActivityTaskCanceledArgs {
-  return &WorkflowServiceRespondActivityTaskCanceledArgs{}
+func NewWorkflowServiceRequestCancelWorkflowExecutionArgs() *WorkflowServiceRequestCancelWorkflowExecutionArgs {
+  return &WorkflowServiceRequestCancelWorkflowExecutionArgs{}
 }
 
-var WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT *shared.RespondActivityTaskCanceledRequest
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) GetCanceledRequest() *shared.RespondActivityTaskCanceledRequest {
-  if !p.IsSetCanceledRequest() {
-    return WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT
+var WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT *shared.RequestCancelWorkflowExecutionRequest
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) GetCancelRequest() *shared.RequestCancelWorkflowExecutionRequest {
+  if !p.IsSetCancelRequest() {
+    return WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT
   }
-return p.CanceledRequest
+return p.CancelRequest
 }
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) IsSetCanceledRequest() bool {
-  return p.CanceledRequest != nil
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) IsSetCancelRequest() bool {
+  return p.CancelRequest != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6854,16 +9011,16 @@ func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CanceledRequest = &shared.RespondActivityTaskCanceledRequest{}
-  if err := p.CanceledRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CancelRequest = &shared.RequestCancelWorkflowExecutionRequest{}
+  if err := p.CancelRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_args"); err != nil {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6875,72 +9032,98 @@ func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
-  if err := p.CanceledRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("cancelRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cancelRequest: ", p), err) }
+  if err := p.CancelRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cancelRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) String() string {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRespondActivityTaskCanceledResult struct {
+//  - CancellationAlreadyRequestedError
+//  - ServiceBusyError
+type WorkflowServiceRequestCancelWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  CancellationAlreadyRequestedError *shared.CancellationAlreadyRequestedError `thrift:"cancellationAlreadyRequestedError,4" db:"cancellationAlreadyRequestedError" json:"cancellationAlreadyRequestedError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,5" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCanceledResult() *WorkflowServiceRespondActivityTaskCanceledResult {
-  return &WorkflowServiceRespondActivityTaskCanceledResult{}
+func NewWorkflowServiceRequestCancelWorkflowExecutionResult() *WorkflowServiceRequestCancelWorkflowExecutionResult {
+  return &WorkflowServiceRequestCancelWorkflowExecutionResult{}
 }
 
-var WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetBadRequestError() bool {
+var WorkflowServiceRequestCancelWorkflowExecutionResult_CancellationAlreadyRequestedError_DEFAULT *shared.CancellationAlreadyRequestedError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetCancellationAlreadyRequestedError() *shared.CancellationAlreadyRequestedError {
+  if !p.IsSetCancellationAlreadyRequestedError() {
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_CancellationAlreadyRequestedError_DEFAULT
+  }
+return p.CancellationAlreadyRequestedError
+}
+var WorkflowServiceRequestCancelWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
+}
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetCancellationAlreadyRequestedError() bool {
+  return p.CancellationAlreadyRequestedError != nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6965,6 +9148,14 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TPr
       if err := p.ReadField3(iprot); err != nil {
         return err
       }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    case 5:
+      if err := p.ReadField5(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -6980,7 +9171,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6988,7 +9179,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6996,7 +9187,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7004,13 +9195,31 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_result"); err != nil {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.CancellationAlreadyRequestedError = &shared.CancellationAlreadyRequestedError{}
+  if err := p.CancellationAlreadyRequestedError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancellationAlreadyRequestedError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField5(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7019,7 +9228,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7032,7 +9241,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField1(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7045,7 +9254,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField2(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7058,35 +9267,61 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField3(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) String() string {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCancellationAlreadyRequestedError() {
+    if err := oprot.WriteFieldBegin("cancellationAlreadyRequestedError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:cancellationAlreadyRequestedError: ", p), err) }
+    if err := p.CancellationAlreadyRequestedError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancellationAlreadyRequestedError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:cancellationAlreadyRequestedError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField5(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 5); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 5:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CancelRequest
-type WorkflowServiceRequestCancelWorkflowExecutionArgs struct {
-  CancelRequest *shared.RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,1" db:"cancelRequest" json:"cancelRequest"`
+//  - SignalRequest
+type WorkflowServiceSignalWorkflowExecutionArgs struct {
+  SignalRequest *shared.SignalWorkflowExecutionRequest `thrift:"signalRequest,1" db:"signalRequest" json:"signalRequest"`
 }
 
-func NewWorkflowServiceRequestCancelWorkflowExecutionArgs() *WorkflowServiceRequestCancelWorkflowExecutionArgs {
-  return &WorkflowServiceRequestCancelWorkflowExecutionArgs{}
+func NewWorkflowServiceSignalWorkflowExecutionArgs() *WorkflowServiceSignalWorkflowExecutionArgs {
+  return &WorkflowServiceSignalWorkflowExecutionArgs{}
 }
 
-var WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT *shared.RequestCancelWorkflowExecutionRequest
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) GetCancelRequest() *shared.RequestCancelWorkflowExecutionRequest {
-  if !p.IsSetCancelRequest() {
-    return WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT
+var WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT *shared.SignalWorkflowExecutionRequest
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) GetSignalRequest() *shared.SignalWorkflowExecutionRequest {
+  if !p.IsSetSignalRequest() {
+    return WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT
   }
-return p.CancelRequest
+return p.SignalRequest
 }
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) IsSetCancelRequest() bool {
-  return p.CancelRequest != nil
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) IsSetSignalRequest() bool {
+  return p.SignalRequest != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7117,17 +9352,17 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TP
   }
   return nil
 }
-
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CancelRequest = &shared.RequestCancelWorkflowExecutionRequest{}
-  if err := p.CancelRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
+
+func (p *WorkflowServiceSignalWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.SignalRequest = &shared.SignalWorkflowExecutionRequest{}
+  if err := p.SignalRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_args"); err != nil {
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7139,98 +9374,85 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("cancelRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cancelRequest: ", p), err) }
-  if err := p.CancelRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelRequest), err)
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("signalRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalRequest: ", p), err) }
+  if err := p.SignalRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cancelRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) String() string {
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-//  - CancellationAlreadyRequestedError
 //  - ServiceBusyError
-type WorkflowServiceRequestCancelWorkflowExecutionResult struct {
+type WorkflowServiceSignalWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
-  CancellationAlreadyRequestedError *shared.CancellationAlreadyRequestedError `thrift:"cancellationAlreadyRequestedError,4" db:"cancellationAlreadyRequestedError" json:"cancellationAlreadyRequestedError,omitempty"`
-  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,5" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServiceRequestCancelWorkflowExecutionResult() *WorkflowServiceRequestCancelWorkflowExecutionResult {
-  return &WorkflowServiceRequestCancelWorkflowExecutionResult{}
+func NewWorkflowServiceSignalWorkflowExecutionResult() *WorkflowServiceSignalWorkflowExecutionResult {
+  return &WorkflowServiceSignalWorkflowExecutionResult{}
 }
 
-var WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceSignalWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT
+    return WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceSignalWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceSignalWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var WorkflowServiceRequestCancelWorkflowExecutionResult_CancellationAlreadyRequestedError_DEFAULT *shared.CancellationAlreadyRequestedError
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetCancellationAlreadyRequestedError() *shared.CancellationAlreadyRequestedError {
-  if !p.IsSetCancellationAlreadyRequestedError() {
-    return WorkflowServiceRequestCancelWorkflowExecutionResult_CancellationAlreadyRequestedError_DEFAULT
-  }
-return p.CancellationAlreadyRequestedError
-}
-var WorkflowServiceRequestCancelWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
+var WorkflowServiceSignalWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceSignalWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
   if !p.IsSetServiceBusyError() {
-    return WorkflowServiceRequestCancelWorkflowExecutionResult_ServiceBusyError_DEFAULT
+    return WorkflowServiceSignalWorkflowExecutionResult_ServiceBusyError_DEFAULT
   }
 return p.ServiceBusyError
 }
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetCancellationAlreadyRequestedError() bool {
-  return p.CancellationAlreadyRequestedError != nil
-}
-
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetServiceBusyError() bool {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetServiceBusyError() bool {
   return p.ServiceBusyError != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7259,10 +9481,6 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.
       if err := p.ReadField4(iprot); err != nil {
         return err
       }
-    case 5:
-      if err := p.ReadField5(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -7278,7 +9496,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7286,7 +9504,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7294,7 +9512,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7302,15 +9520,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
-  p.CancellationAlreadyRequestedError = &shared.CancellationAlreadyRequestedError{}
-  if err := p.CancellationAlreadyRequestedError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancellationAlreadyRequestedError), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField5(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ServiceBusyError = &shared.ServiceBusyError{}
   if err := p.ServiceBusyError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
@@ -7318,15 +9528,14 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField5(iprot
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_result"); err != nil {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
     if err := p.writeField4(oprot); err != nil { return err }
-    if err := p.writeField5(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7335,7 +9544,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7348,7 +9557,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField1(oprot
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7361,7 +9570,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField2(oprot
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7374,61 +9583,48 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField3(oprot
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
-  if p.IsSetCancellationAlreadyRequestedError() {
-    if err := oprot.WriteFieldBegin("cancellationAlreadyRequestedError", thrift.STRUCT, 4); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:cancellationAlreadyRequestedError: ", p), err) }
-    if err := p.CancellationAlreadyRequestedError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancellationAlreadyRequestedError), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:cancellationAlreadyRequestedError: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField5(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetServiceBusyError() {
-    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 5); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:serviceBusyError: ", p), err) }
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
     if err := p.ServiceBusyError.Write(oprot); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 5:serviceBusyError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) String() string {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - SignalRequest
-type WorkflowServiceSignalWorkflowExecutionArgs struct {
-  SignalRequest *shared.SignalWorkflowExecutionRequest `thrift:"signalRequest,1" db:"signalRequest" json:"signalRequest"`
+//  - SignalWithStartRequest
+type WorkflowServiceSignalWithStartWorkflowExecutionArgs struct {
+  SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest `thrift:"signalWithStartRequest,1" db:"signalWithStartRequest" json:"signalWithStartRequest"`
 }
 
-func NewWorkflowServiceSignalWorkflowExecutionArgs() *WorkflowServiceSignalWorkflowExecutionArgs {
-  return &WorkflowServiceSignalWorkflowExecutionArgs{}
+func NewWorkflowServiceSignalWithStartWorkflowExecutionArgs() *WorkflowServiceSignalWithStartWorkflowExecutionArgs {
+  return &WorkflowServiceSignalWithStartWorkflowExecutionArgs{}
 }
 
-var WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT *shared.SignalWorkflowExecutionRequest
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) GetSignalRequest() *shared.SignalWorkflowExecutionRequest {
-  if !p.IsSetSignalRequest() {
-    return WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT
+var WorkflowServiceSignalWithStartWorkflowExecutionArgs_SignalWithStartRequest_DEFAULT *shared.SignalWithStartWorkflowExecutionRequest
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) GetSignalWithStartRequest() *shared.SignalWithStartWorkflowExecutionRequest {
+  if !p.IsSetSignalWithStartRequest() {
+    return WorkflowServiceSignalWithStartWorkflowExecutionArgs_SignalWithStartRequest_DEFAULT
   }
-return p.SignalRequest
+return p.SignalWithStartRequest
 }
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) IsSetSignalRequest() bool {
-  return p.SignalRequest != nil
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) IsSetSignalWithStartRequest() bool {
+  return p.SignalWithStartRequest != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7460,16 +9656,16 @@ func (p *WorkflowServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.SignalRequest = &shared.SignalWorkflowExecutionRequest{}
-  if err := p.SignalRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalRequest), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.SignalWithStartRequest = &shared.SignalWithStartWorkflowExecutionRequest{}
+  if err := p.SignalWithStartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalWithStartRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecution_args"); err != nil {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7481,85 +9677,98 @@ func (p *WorkflowServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("signalRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalRequest: ", p), err) }
-  if err := p.SignalRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalRequest), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("signalWithStartRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalWithStartRequest: ", p), err) }
+  if err := p.SignalWithStartRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalWithStartRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalWithStartRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) String() string {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWithStartWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - EntityNotExistError
+//  - SessionAlreadyExistError
 //  - ServiceBusyError
-type WorkflowServiceSignalWorkflowExecutionResult struct {
+type WorkflowServiceSignalWithStartWorkflowExecutionResult struct {
+  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
   ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServiceSignalWorkflowExecutionResult() *WorkflowServiceSignalWorkflowExecutionResult {
-  return &WorkflowServiceSignalWorkflowExecutionResult{}
+func NewWorkflowServiceSignalWithStartWorkflowExecutionResult() *WorkflowServiceSignalWithStartWorkflowExecutionResult {
+  return &WorkflowServiceSignalWithStartWorkflowExecutionResult{}
 }
 
-var WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceSignalWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceSignalWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceSignalWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
-  if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
+  if !p.IsSetSessionAlreadyExistError() {
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
   }
-return p.EntityNotExistError
+return p.SessionAlreadyExistError
 }
-var WorkflowServiceSignalWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
-func (p *WorkflowServiceSignalWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
   if !p.IsSetServiceBusyError() {
-    return WorkflowServiceSignalWorkflowExecutionResult_ServiceBusyError_DEFAULT
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_ServiceBusyError_DEFAULT
   }
 return p.ServiceBusyError
 }
-func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetEntityNotExistError() bool {
-  return p.EntityNotExistError != nil
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
+  return p.SessionAlreadyExistError != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetServiceBusyError() bool {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetServiceBusyError() bool {
   return p.ServiceBusyError != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7572,6 +9781,10 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtoc
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -7603,7 +9816,15 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtoc
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.StartWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7611,7 +9832,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7619,15 +9840,15 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.EntityNotExistError = &shared.EntityNotExistsError{}
-  if err := p.EntityNotExistError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
+  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ServiceBusyError = &shared.ServiceBusyError{}
   if err := p.ServiceBusyError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
@@ -7635,10 +9856,11 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField4(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecution_result"); err != nil {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -7651,7 +9873,20 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProto
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7664,7 +9899,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7677,20 +9912,20 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEntityNotExistError() {
-    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
-    if err := p.EntityNotExistError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSessionAlreadyExistError() {
+    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
+    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetServiceBusyError() {
     if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
@@ -7703,11 +9938,11 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField4(oprot thrift.
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) String() string {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWithStartWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
@@ -47,13 +47,18 @@ type TChanWorkflowService interface {
 	PollForDecisionTask(ctx thrift.Context, pollRequest *shared.PollForDecisionTaskRequest) (*shared.PollForDecisionTaskResponse, error)
 	QueryWorkflow(ctx thrift.Context, queryRequest *shared.QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error)
 	RecordActivityTaskHeartbeat(ctx thrift.Context, heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(ctx thrift.Context, heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest) (*shared.RecordActivityTaskHeartbeatResponse, error)
 	RegisterDomain(ctx thrift.Context, registerRequest *shared.RegisterDomainRequest) error
 	RequestCancelWorkflowExecution(ctx thrift.Context, cancelRequest *shared.RequestCancelWorkflowExecutionRequest) error
 	RespondActivityTaskCanceled(ctx thrift.Context, canceledRequest *shared.RespondActivityTaskCanceledRequest) error
+	RespondActivityTaskCanceledByID(ctx thrift.Context, canceledRequest *shared.RespondActivityTaskCanceledByIDRequest) error
 	RespondActivityTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondActivityTaskCompletedRequest) error
+	RespondActivityTaskCompletedByID(ctx thrift.Context, completeRequest *shared.RespondActivityTaskCompletedByIDRequest) error
 	RespondActivityTaskFailed(ctx thrift.Context, failRequest *shared.RespondActivityTaskFailedRequest) error
+	RespondActivityTaskFailedByID(ctx thrift.Context, failRequest *shared.RespondActivityTaskFailedByIDRequest) error
 	RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondDecisionTaskCompletedRequest) error
 	RespondQueryTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondQueryTaskCompletedRequest) error
+	SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(ctx thrift.Context, signalRequest *shared.SignalWorkflowExecutionRequest) error
 	StartWorkflowExecution(ctx thrift.Context, startRequest *shared.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(ctx thrift.Context, terminateRequest *shared.TerminateWorkflowExecutionRequest) error
@@ -285,6 +290,28 @@ func (c *tchanWorkflowServiceClient) RecordActivityTaskHeartbeat(ctx thrift.Cont
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanWorkflowServiceClient) RecordActivityTaskHeartbeatByID(ctx thrift.Context, heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+	var resp WorkflowServiceRecordActivityTaskHeartbeatByIDResult
+	args := WorkflowServiceRecordActivityTaskHeartbeatByIDArgs{
+		HeartbeatRequest: heartbeatRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RecordActivityTaskHeartbeatByID", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RecordActivityTaskHeartbeatByID")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) RegisterDomain(ctx thrift.Context, registerRequest *shared.RegisterDomainRequest) error {
 	var resp WorkflowServiceRegisterDomainResult
 	args := WorkflowServiceRegisterDomainArgs{
@@ -355,6 +382,28 @@ func (c *tchanWorkflowServiceClient) RespondActivityTaskCanceled(ctx thrift.Cont
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) RespondActivityTaskCanceledByID(ctx thrift.Context, canceledRequest *shared.RespondActivityTaskCanceledByIDRequest) error {
+	var resp WorkflowServiceRespondActivityTaskCanceledByIDResult
+	args := WorkflowServiceRespondActivityTaskCanceledByIDArgs{
+		CanceledRequest: canceledRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RespondActivityTaskCanceledByID", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RespondActivityTaskCanceledByID")
+		}
+	}
+
+	return err
+}
+
 func (c *tchanWorkflowServiceClient) RespondActivityTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondActivityTaskCompletedRequest) error {
 	var resp WorkflowServiceRespondActivityTaskCompletedResult
 	args := WorkflowServiceRespondActivityTaskCompletedArgs{
@@ -377,6 +426,28 @@ func (c *tchanWorkflowServiceClient) RespondActivityTaskCompleted(ctx thrift.Con
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) RespondActivityTaskCompletedByID(ctx thrift.Context, completeRequest *shared.RespondActivityTaskCompletedByIDRequest) error {
+	var resp WorkflowServiceRespondActivityTaskCompletedByIDResult
+	args := WorkflowServiceRespondActivityTaskCompletedByIDArgs{
+		CompleteRequest: completeRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RespondActivityTaskCompletedByID", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RespondActivityTaskCompletedByID")
+		}
+	}
+
+	return err
+}
+
 func (c *tchanWorkflowServiceClient) RespondActivityTaskFailed(ctx thrift.Context, failRequest *shared.RespondActivityTaskFailedRequest) error {
 	var resp WorkflowServiceRespondActivityTaskFailedResult
 	args := WorkflowServiceRespondActivityTaskFailedArgs{
@@ -399,6 +470,28 @@ func (c *tchanWorkflowServiceClient) RespondActivityTaskFailed(ctx thrift.Contex
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) RespondActivityTaskFailedByID(ctx thrift.Context, failRequest *shared.RespondActivityTaskFailedByIDRequest) error {
+	var resp WorkflowServiceRespondActivityTaskFailedByIDResult
+	args := WorkflowServiceRespondActivityTaskFailedByIDArgs{
+		FailRequest: failRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RespondActivityTaskFailedByID", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RespondActivityTaskFailedByID")
+		}
+	}
+
+	return err
+}
+
 func (c *tchanWorkflowServiceClient) RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondDecisionTaskCompletedRequest) error {
 	var resp WorkflowServiceRespondDecisionTaskCompletedResult
 	args := WorkflowServiceRespondDecisionTaskCompletedArgs{
@@ -443,6 +536,30 @@ func (c *tchanWorkflowServiceClient) RespondQueryTaskCompleted(ctx thrift.Contex
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	var resp WorkflowServiceSignalWithStartWorkflowExecutionResult
+	args := WorkflowServiceSignalWithStartWorkflowExecutionArgs{
+		SignalWithStartRequest: signalWithStartRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "SignalWithStartWorkflowExecution", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.SessionAlreadyExistError != nil:
+			err = resp.SessionAlreadyExistError
+		case resp.ServiceBusyError != nil:
+			err = resp.ServiceBusyError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for SignalWithStartWorkflowExecution")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) SignalWorkflowExecution(ctx thrift.Context, signalRequest *shared.SignalWorkflowExecutionRequest) error {
 	var resp WorkflowServiceSignalWorkflowExecutionResult
 	args := WorkflowServiceSignalWorkflowExecutionArgs{
@@ -564,13 +681,18 @@ func (s *tchanWorkflowServiceServer) Methods() []string {
 		"PollForDecisionTask",
 		"QueryWorkflow",
 		"RecordActivityTaskHeartbeat",
+		"RecordActivityTaskHeartbeatByID",
 		"RegisterDomain",
 		"RequestCancelWorkflowExecution",
 		"RespondActivityTaskCanceled",
+		"RespondActivityTaskCanceledByID",
 		"RespondActivityTaskCompleted",
+		"RespondActivityTaskCompletedByID",
 		"RespondActivityTaskFailed",
+		"RespondActivityTaskFailedByID",
 		"RespondDecisionTaskCompleted",
 		"RespondQueryTaskCompleted",
+		"SignalWithStartWorkflowExecution",
 		"SignalWorkflowExecution",
 		"StartWorkflowExecution",
 		"TerminateWorkflowExecution",
@@ -598,20 +720,30 @@ func (s *tchanWorkflowServiceServer) Handle(ctx thrift.Context, methodName strin
 		return s.handleQueryWorkflow(ctx, protocol)
 	case "RecordActivityTaskHeartbeat":
 		return s.handleRecordActivityTaskHeartbeat(ctx, protocol)
+	case "RecordActivityTaskHeartbeatByID":
+		return s.handleRecordActivityTaskHeartbeatByID(ctx, protocol)
 	case "RegisterDomain":
 		return s.handleRegisterDomain(ctx, protocol)
 	case "RequestCancelWorkflowExecution":
 		return s.handleRequestCancelWorkflowExecution(ctx, protocol)
 	case "RespondActivityTaskCanceled":
 		return s.handleRespondActivityTaskCanceled(ctx, protocol)
+	case "RespondActivityTaskCanceledByID":
+		return s.handleRespondActivityTaskCanceledByID(ctx, protocol)
 	case "RespondActivityTaskCompleted":
 		return s.handleRespondActivityTaskCompleted(ctx, protocol)
+	case "RespondActivityTaskCompletedByID":
+		return s.handleRespondActivityTaskCompletedByID(ctx, protocol)
 	case "RespondActivityTaskFailed":
 		return s.handleRespondActivityTaskFailed(ctx, protocol)
+	case "RespondActivityTaskFailedByID":
+		return s.handleRespondActivityTaskFailedByID(ctx, protocol)
 	case "RespondDecisionTaskCompleted":
 		return s.handleRespondDecisionTaskCompleted(ctx, protocol)
 	case "RespondQueryTaskCompleted":
 		return s.handleRespondQueryTaskCompleted(ctx, protocol)
+	case "SignalWithStartWorkflowExecution":
+		return s.handleSignalWithStartWorkflowExecution(ctx, protocol)
 	case "SignalWorkflowExecution":
 		return s.handleSignalWorkflowExecution(ctx, protocol)
 	case "StartWorkflowExecution":
@@ -987,6 +1119,44 @@ func (s *tchanWorkflowServiceServer) handleRecordActivityTaskHeartbeat(ctx thrif
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleRecordActivityTaskHeartbeatByID(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceRecordActivityTaskHeartbeatByIDArgs
+	var res WorkflowServiceRecordActivityTaskHeartbeatByIDResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.RecordActivityTaskHeartbeatByID(ctx, req.HeartbeatRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleRegisterDomain(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceRegisterDomainArgs
 	var res WorkflowServiceRegisterDomainResult
@@ -1108,6 +1278,43 @@ func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCanceled(ctx thrif
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCanceledByID(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceRespondActivityTaskCanceledByIDArgs
+	var res WorkflowServiceRespondActivityTaskCanceledByIDResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.RespondActivityTaskCanceledByID(ctx, req.CanceledRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCompleted(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceRespondActivityTaskCompletedArgs
 	var res WorkflowServiceRespondActivityTaskCompletedResult
@@ -1145,6 +1352,43 @@ func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCompleted(ctx thri
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCompletedByID(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceRespondActivityTaskCompletedByIDArgs
+	var res WorkflowServiceRespondActivityTaskCompletedByIDResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.RespondActivityTaskCompletedByID(ctx, req.CompleteRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleRespondActivityTaskFailed(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceRespondActivityTaskFailedArgs
 	var res WorkflowServiceRespondActivityTaskFailedResult
@@ -1182,6 +1426,43 @@ func (s *tchanWorkflowServiceServer) handleRespondActivityTaskFailed(ctx thrift.
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleRespondActivityTaskFailedByID(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceRespondActivityTaskFailedByIDArgs
+	var res WorkflowServiceRespondActivityTaskFailedByIDResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.RespondActivityTaskFailedByID(ctx, req.FailRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleRespondDecisionTaskCompleted(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceRespondDecisionTaskCompletedArgs
 	var res WorkflowServiceRespondDecisionTaskCompletedResult
@@ -1256,6 +1537,49 @@ func (s *tchanWorkflowServiceServer) handleRespondQueryTaskCompleted(ctx thrift.
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleSignalWithStartWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceSignalWithStartWorkflowExecutionArgs
+	var res WorkflowServiceSignalWithStartWorkflowExecutionResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.SignalWithStartWorkflowExecution(ctx, req.SignalWithStartRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for sessionAlreadyExistError returned non-nil error type *shared.WorkflowExecutionAlreadyStartedError but nil value")
+			}
+			res.SessionAlreadyExistError = v
+		case *shared.ServiceBusyError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for serviceBusyError returned non-nil error type *shared.ServiceBusyError but nil value")
+			}
+			res.ServiceBusyError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleSignalWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceSignalWorkflowExecutionArgs
 	var res WorkflowServiceSignalWorkflowExecutionResult
@@ -164,6 +164,7 @@ const (
   DecisionType_RecordMarker DecisionType = 8
   DecisionType_ContinueAsNewWorkflowExecution DecisionType = 9
   DecisionType_StartChildWorkflowExecution DecisionType = 10
+  DecisionType_SignalExternalWorkflowExecution DecisionType = 11
 )
 
 func (p DecisionType) String() string {
@@ -179,6 +180,7 @@ func (p DecisionType) String() string {
   case DecisionType_RecordMarker: return "RecordMarker"
   case DecisionType_ContinueAsNewWorkflowExecution: return "ContinueAsNewWorkflowExecution"
   case DecisionType_StartChildWorkflowExecution: return "StartChildWorkflowExecution"
+  case DecisionType_SignalExternalWorkflowExecution: return "SignalExternalWorkflowExecution"
   }
   return "<UNSET>"
 }
@@ -196,6 +198,7 @@ func DecisionTypeFromString(s string) (DecisionType, error) {
   case "RecordMarker": return DecisionType_RecordMarker, nil 
   case "ContinueAsNewWorkflowExecution": return DecisionType_ContinueAsNewWorkflowExecution, nil 
   case "StartChildWorkflowExecution": return DecisionType_StartChildWorkflowExecution, nil 
+  case "SignalExternalWorkflowExecution": return DecisionType_SignalExternalWorkflowExecution, nil 
   }
   return DecisionType(0), fmt.Errorf("not a valid DecisionType string")
 }
@@ -271,6 +274,9 @@ const (
   EventType_ChildWorkflowExecutionCanceled EventType = 35
   EventType_ChildWorkflowExecutionTimedOut EventType = 36
   EventType_ChildWorkflowExecutionTerminated EventType = 37
+  EventType_SignalExternalWorkflowExecutionInitiated EventType = 38
+  EventType_SignalExternalWorkflowExecutionFailed EventType = 39
+  EventType_ExternalWorkflowExecutionSignaled EventType = 40
 )
 
 func (p EventType) String() string {
@@ -313,6 +319,9 @@ func (p EventType) String() string {
   case EventType_ChildWorkflowExecutionCanceled: return "ChildWorkflowExecutionCanceled"
   case EventType_ChildWorkflowExecutionTimedOut: return "ChildWorkflowExecutionTimedOut"
   case EventType_ChildWorkflowExecutionTerminated: return "ChildWorkflowExecutionTerminated"
+  case EventType_SignalExternalWorkflowExecutionInitiated: return "SignalExternalWorkflowExecutionInitiated"
+  case EventType_SignalExternalWorkflowExecutionFailed: return "SignalExternalWorkflowExecutionFailed"
+  case EventType_ExternalWorkflowExecutionSignaled: return "ExternalWorkflowExecutionSignaled"
   }
   return "<UNSET>"
 }
@@ -357,6 +366,9 @@ func EventTypeFromString(s string) (EventType, error) {
   case "ChildWorkflowExecutionCanceled": return EventType_ChildWorkflowExecutionCanceled, nil 
   case "ChildWorkflowExecutionTimedOut": return EventType_ChildWorkflowExecutionTimedOut, nil 
   case "ChildWorkflowExecutionTerminated": return EventType_ChildWorkflowExecutionTerminated, nil 
+  case "SignalExternalWorkflowExecutionInitiated": return EventType_SignalExternalWorkflowExecutionInitiated, nil 
+  case "SignalExternalWorkflowExecutionFailed": return EventType_SignalExternalWorkflowExecutionFailed, nil 
+  case "ExternalWorkflowExecutionSignaled": return EventType_ExternalWorkflowExecutionSignaled, nil 
   }
   return EventType(0), fmt.Errorf("not a valid EventType string")
 }
@@ -406,6 +418,7 @@ const (
   DecisionTaskFailedCause_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES DecisionTaskFailedCause = 9
   DecisionTaskFailedCause_BAD_CONTINUE_AS_NEW_ATTRIBUTES DecisionTaskFailedCause = 10
   DecisionTaskFailedCause_START_TIMER_DUPLICATE_ID DecisionTaskFailedCause = 11
+  DecisionTaskFailedCause_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES DecisionTaskFailedCause = 12
 )
 
 func (p DecisionTaskFailedCause) String() string {
@@ -422,6 +435,7 @@ func (p DecisionTaskFailedCause) String() string {
   case DecisionTaskFailedCause_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES: return "BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES"
   case DecisionTaskFailedCause_BAD_CONTINUE_AS_NEW_ATTRIBUTES: return "BAD_CONTINUE_AS_NEW_ATTRIBUTES"
   case DecisionTaskFailedCause_START_TIMER_DUPLICATE_ID: return "START_TIMER_DUPLICATE_ID"
+  case DecisionTaskFailedCause_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES: return "BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES"
   }
   return "<UNSET>"
 }
@@ -440,6 +454,7 @@ func DecisionTaskFailedCauseFromString(s string) (DecisionTaskFailedCause, error
   case "BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES": return DecisionTaskFailedCause_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES, nil 
   case "BAD_CONTINUE_AS_NEW_ATTRIBUTES": return DecisionTaskFailedCause_BAD_CONTINUE_AS_NEW_ATTRIBUTES, nil 
   case "START_TIMER_DUPLICATE_ID": return DecisionTaskFailedCause_START_TIMER_DUPLICATE_ID, nil 
+  case "BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES": return DecisionTaskFailedCause_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES, nil 
   }
   return DecisionTaskFailedCause(0), fmt.Errorf("not a valid DecisionTaskFailedCause string")
 }
@@ -525,6 +540,56 @@ func (p * CancelExternalWorkflowExecutionFailedCause) Value() (driver.Value, err
   }
 return int64(*p), nil
 }
+type SignalExternalWorkflowExecutionFailedCause int64
+const (
+  SignalExternalWorkflowExecutionFailedCause_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION SignalExternalWorkflowExecutionFailedCause = 0
+)
+
+func (p SignalExternalWorkflowExecutionFailedCause) String() string {
+  switch p {
+  case SignalExternalWorkflowExecutionFailedCause_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION: return "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"
+  }
+  return "<UNSET>"
+}
+
+func SignalExternalWorkflowExecutionFailedCauseFromString(s string) (SignalExternalWorkflowExecutionFailedCause, error) {
+  switch s {
+  case "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION": return SignalExternalWorkflowExecutionFailedCause_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION, nil 
+  }
+  return SignalExternalWorkflowExecutionFailedCause(0), fmt.Errorf("not a valid SignalExternalWorkflowExecutionFailedCause string")
+}
+
+
+func SignalExternalWorkflowExecutionFailedCausePtr(v SignalExternalWorkflowExecutionFailedCause) *SignalExternalWorkflowExecutionFailedCause { return &v }
+
+func (p SignalExternalWorkflowExecutionFailedCause) MarshalText() ([]byte, error) {
+return []byte(p.String()), nil
+}
+
+func (p *SignalExternalWorkflowExecutionFailedCause) UnmarshalText(text []byte) error {
+q, err := SignalExternalWorkflowExecutionFailedCauseFromString(string(text))
+if (err != nil) {
+return err
+}
+*p = q
+return nil
+}
+
+func (p *SignalExternalWorkflowExecutionFailedCause) Scan(value interface{}) error {
+v, ok := value.(int64)
+if !ok {
+return errors.New("Scan value is not int64")
+}
+*p = SignalExternalWorkflowExecutionFailedCause(v)
+return nil
+}
+
+func (p * SignalExternalWorkflowExecutionFailedCause) Value() (driver.Value, error) {
+  if p == nil {
+    return nil, nil
+  }
+return int64(*p), nil
+}
 type ChildWorkflowExecutionFailedCause int64
 const (
   ChildWorkflowExecutionFailedCause_WORKFLOW_ALREADY_RUNNING ChildWorkflowExecutionFailedCause = 0
@@ -3572,6 +3637,257 @@ func (p *RequestCancelExternalWorkflowExecutionDecisionAttributes) String() stri
   return fmt.Sprintf("RequestCancelExternalWorkflowExecutionDecisionAttributes(%+v)", *p)
 }
 
+// Attributes:
+//  - Domain
+//  - Execution
+//  - SignalName
+//  - Input
+//  - Control
+type SignalExternalWorkflowExecutionDecisionAttributes struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  Execution *WorkflowExecution `thrift:"execution,20" db:"execution" json:"execution,omitempty"`
+  // unused fields # 21 to 29
+  SignalName *string `thrift:"signalName,30" db:"signalName" json:"signalName,omitempty"`
+  // unused fields # 31 to 39
+  Input []byte `thrift:"input,40" db:"input" json:"input,omitempty"`
+  // unused fields # 41 to 49
+  Control []byte `thrift:"control,50" db:"control" json:"control,omitempty"`
+}
+
+func NewSignalExternalWorkflowExecutionDecisionAttributes() *SignalExternalWorkflowExecutionDecisionAttributes {
+  return &SignalExternalWorkflowExecutionDecisionAttributes{}
+}
+
+var SignalExternalWorkflowExecutionDecisionAttributes_Domain_DEFAULT string
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) GetDomain() string {
+  if !p.IsSetDomain() {
+    return SignalExternalWorkflowExecutionDecisionAttributes_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var SignalExternalWorkflowExecutionDecisionAttributes_Execution_DEFAULT *WorkflowExecution
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) GetExecution() *WorkflowExecution {
+  if !p.IsSetExecution() {
+    return SignalExternalWorkflowExecutionDecisionAttributes_Execution_DEFAULT
+  }
+return p.Execution
+}
+var SignalExternalWorkflowExecutionDecisionAttributes_SignalName_DEFAULT string
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) GetSignalName() string {
+  if !p.IsSetSignalName() {
+    return SignalExternalWorkflowExecutionDecisionAttributes_SignalName_DEFAULT
+  }
+return *p.SignalName
+}
+var SignalExternalWorkflowExecutionDecisionAttributes_Input_DEFAULT []byte
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) GetInput() []byte {
+  return p.Input
+}
+var SignalExternalWorkflowExecutionDecisionAttributes_Control_DEFAULT []byte
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) GetControl() []byte {
+  return p.Control
+}
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) IsSetExecution() bool {
+  return p.Execution != nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) IsSetSignalName() bool {
+  return p.SignalName != nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) IsSetInput() bool {
+  return p.Input != nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) IsSetControl() bool {
+  return p.Control != nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes)  ReadField20(iprot thrift.TProtocol) error {
+  p.Execution = &WorkflowExecution{}
+  if err := p.Execution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Execution), err)
+  }
+  return nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.SignalName = &v
+}
+  return nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.Input = v
+}
+  return nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Control = v
+}
+  return nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalExternalWorkflowExecutionDecisionAttributes"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecution() {
+    if err := oprot.WriteFieldBegin("execution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:execution: ", p), err) }
+    if err := p.Execution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Execution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:execution: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalName() {
+    if err := oprot.WriteFieldBegin("signalName", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:signalName: ", p), err) }
+    if err := oprot.WriteString(string(*p.SignalName)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.signalName (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:signalName: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInput() {
+    if err := oprot.WriteFieldBegin("input", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:input: ", p), err) }
+    if err := oprot.WriteBinary(p.Input); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.input (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:input: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetControl() {
+    if err := oprot.WriteFieldBegin("control", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:control: ", p), err) }
+    if err := oprot.WriteBinary(p.Control); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.control (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:control: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalExternalWorkflowExecutionDecisionAttributes) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("SignalExternalWorkflowExecutionDecisionAttributes(%+v)", *p)
+}
+
 // Attributes:
 //  - MarkerName
 //  - Details
@@ -4383,6 +4699,7 @@ func (p *StartChildWorkflowExecutionDecisionAttributes) String() string {
 //  - RecordMarkerDecisionAttributes
 //  - ContinueAsNewWorkflowExecutionDecisionAttributes
 //  - StartChildWorkflowExecutionDecisionAttributes
+//  - SignalExternalWorkflowExecutionDecisionAttributes
 type Decision struct {
   // unused fields # 1 to 9
   DecisionType *DecisionType `thrift:"decisionType,10" db:"decisionType" json:"decisionType,omitempty"`
@@ -4408,6 +4725,8 @@ type Decision struct {
   ContinueAsNewWorkflowExecutionDecisionAttributes *ContinueAsNewWorkflowExecutionDecisionAttributes `thrift:"continueAsNewWorkflowExecutionDecisionAttributes,90" db:"continueAsNewWorkflowExecutionDecisionAttributes" json:"continueAsNewWorkflowExecutionDecisionAttributes,omitempty"`
   // unused fields # 91 to 99
   StartChildWorkflowExecutionDecisionAttributes *StartChildWorkflowExecutionDecisionAttributes `thrift:"startChildWorkflowExecutionDecisionAttributes,100" db:"startChildWorkflowExecutionDecisionAttributes" json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
+  // unused fields # 101 to 109
+  SignalExternalWorkflowExecutionDecisionAttributes *SignalExternalWorkflowExecutionDecisionAttributes `thrift:"signalExternalWorkflowExecutionDecisionAttributes,110" db:"signalExternalWorkflowExecutionDecisionAttributes" json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
 }
 
 func NewDecision() *Decision {
@@ -4498,6 +4817,13 @@ func (p *Decision) GetStartChildWorkflowExecutionDecisionAttributes() *StartChil
   }
 return p.StartChildWorkflowExecutionDecisionAttributes
 }
+var Decision_SignalExternalWorkflowExecutionDecisionAttributes_DEFAULT *SignalExternalWorkflowExecutionDecisionAttributes
+func (p *Decision) GetSignalExternalWorkflowExecutionDecisionAttributes() *SignalExternalWorkflowExecutionDecisionAttributes {
+  if !p.IsSetSignalExternalWorkflowExecutionDecisionAttributes() {
+    return Decision_SignalExternalWorkflowExecutionDecisionAttributes_DEFAULT
+  }
+return p.SignalExternalWorkflowExecutionDecisionAttributes
+}
 func (p *Decision) IsSetDecisionType() bool {
   return p.DecisionType != nil
 }
@@ -4546,6 +4872,10 @@ func (p *Decision) IsSetStartChildWorkflowExecutionDecisionAttributes() bool {
   return p.StartChildWorkflowExecutionDecisionAttributes != nil
 }
 
+func (p *Decision) IsSetSignalExternalWorkflowExecutionDecisionAttributes() bool {
+  return p.SignalExternalWorkflowExecutionDecisionAttributes != nil
+}
+
 func (p *Decision) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -4607,6 +4937,10 @@ func (p *Decision) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField100(iprot); err != nil {
         return err
       }
+    case 110:
+      if err := p.ReadField110(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -4720,6 +5054,14 @@ func (p *Decision)  ReadField100(iprot thrift.TProtocol) error {
   return nil
 }
 
+func (p *Decision)  ReadField110(iprot thrift.TProtocol) error {
+  p.SignalExternalWorkflowExecutionDecisionAttributes = &SignalExternalWorkflowExecutionDecisionAttributes{}
+  if err := p.SignalExternalWorkflowExecutionDecisionAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalExternalWorkflowExecutionDecisionAttributes), err)
+  }
+  return nil
+}
+
 func (p *Decision) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("Decision"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -4736,6 +5078,7 @@ func (p *Decision) Write(oprot thrift.TProtocol) error {
     if err := p.writeField80(oprot); err != nil { return err }
     if err := p.writeField90(oprot); err != nil { return err }
     if err := p.writeField100(oprot); err != nil { return err }
+    if err := p.writeField110(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4899,6 +5242,19 @@ func (p *Decision) writeField100(oprot thrift.TProtocol) (err error) {
   return err
 }
 
+func (p *Decision) writeField110(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalExternalWorkflowExecutionDecisionAttributes() {
+    if err := oprot.WriteFieldBegin("signalExternalWorkflowExecutionDecisionAttributes", thrift.STRUCT, 110); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:signalExternalWorkflowExecutionDecisionAttributes: ", p), err) }
+    if err := p.SignalExternalWorkflowExecutionDecisionAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalExternalWorkflowExecutionDecisionAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:signalExternalWorkflowExecutionDecisionAttributes: ", p), err) }
+  }
+  return err
+}
+
 func (p *Decision) String() string {
   if p == nil {
     return "<nil>"
@@ -4913,6 +5269,7 @@ func (p *Decision) String() string {
 //  - ExecutionStartToCloseTimeoutSeconds
 //  - TaskStartToCloseTimeoutSeconds
 //  - Identity
+//  - ContinuedExecutionRunId
 type WorkflowExecutionStartedEventAttributes struct {
   // unused fields # 1 to 9
   WorkflowType *WorkflowType `thrift:"workflowType,10" db:"workflowType" json:"workflowType,omitempty"`
@@ -4926,6 +5283,8 @@ type WorkflowExecutionStartedEventAttributes struct {
   TaskStartToCloseTimeoutSeconds *int32 `thrift:"taskStartToCloseTimeoutSeconds,50" db:"taskStartToCloseTimeoutSeconds" json:"taskStartToCloseTimeoutSeconds,omitempty"`
   // unused fields # 51 to 59
   Identity *string `thrift:"identity,60" db:"identity" json:"identity,omitempty"`
+  // unused fields # 61 to 69
+  ContinuedExecutionRunId *string `thrift:"continuedExecutionRunId,70" db:"continuedExecutionRunId" json:"continuedExecutionRunId,omitempty"`
 }
 
 func NewWorkflowExecutionStartedEventAttributes() *WorkflowExecutionStartedEventAttributes {
@@ -4972,6 +5331,13 @@ func (p *WorkflowExecutionStartedEventAttributes) GetIdentity() string {
   }
 return *p.Identity
 }
+var WorkflowExecutionStartedEventAttributes_ContinuedExecutionRunId_DEFAULT string
+func (p *WorkflowExecutionStartedEventAttributes) GetContinuedExecutionRunId() string {
+  if !p.IsSetContinuedExecutionRunId() {
+    return WorkflowExecutionStartedEventAttributes_ContinuedExecutionRunId_DEFAULT
+  }
+return *p.ContinuedExecutionRunId
+}
 func (p *WorkflowExecutionStartedEventAttributes) IsSetWorkflowType() bool {
   return p.WorkflowType != nil
 }
@@ -4996,6 +5362,10 @@ func (p *WorkflowExecutionStartedEventAttributes) IsSetIdentity() bool {
   return p.Identity != nil
 }
 
+func (p *WorkflowExecutionStartedEventAttributes) IsSetContinuedExecutionRunId() bool {
+  return p.ContinuedExecutionRunId != nil
+}
+
 func (p *WorkflowExecutionStartedEventAttributes) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -5033,6 +5403,10 @@ func (p *WorkflowExecutionStartedEventAttributes) Read(iprot thrift.TProtocol) e
       if err := p.ReadField60(iprot); err != nil {
         return err
       }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -5100,6 +5474,15 @@ func (p *WorkflowExecutionStartedEventAttributes)  ReadField60(iprot thrift.TPro
   return nil
 }
 
+func (p *WorkflowExecutionStartedEventAttributes)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.ContinuedExecutionRunId = &v
+}
+  return nil
+}
+
 func (p *WorkflowExecutionStartedEventAttributes) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("WorkflowExecutionStartedEventAttributes"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -5110,6 +5493,7 @@ func (p *WorkflowExecutionStartedEventAttributes) Write(oprot thrift.TProtocol)
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
     if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5192,6 +5576,18 @@ func (p *WorkflowExecutionStartedEventAttributes) writeField60(oprot thrift.TPro
   return err
 }
 
+func (p *WorkflowExecutionStartedEventAttributes) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetContinuedExecutionRunId() {
+    if err := oprot.WriteFieldBegin("continuedExecutionRunId", thrift.STRING, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:continuedExecutionRunId: ", p), err) }
+    if err := oprot.WriteString(string(*p.ContinuedExecutionRunId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.continuedExecutionRunId (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:continuedExecutionRunId: ", p), err) }
+  }
+  return err
+}
+
 func (p *WorkflowExecutionStartedEventAttributes) String() string {
   if p == nil {
     return "<nil>"
@@ -11010,150 +11406,94 @@ func (p *ExternalWorkflowExecutionCancelRequestedEventAttributes) String() strin
 }
 
 // Attributes:
+//  - DecisionTaskCompletedEventId
 //  - Domain
-//  - WorkflowId
-//  - WorkflowType
-//  - TaskList
+//  - WorkflowExecution
+//  - SignalName
 //  - Input
-//  - ExecutionStartToCloseTimeoutSeconds
-//  - TaskStartToCloseTimeoutSeconds
-//  - ChildPolicy
 //  - Control
-//  - DecisionTaskCompletedEventId
-type StartChildWorkflowExecutionInitiatedEventAttributes struct {
+type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
   // unused fields # 1 to 9
-  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  DecisionTaskCompletedEventId *int64 `thrift:"decisionTaskCompletedEventId,10" db:"decisionTaskCompletedEventId" json:"decisionTaskCompletedEventId,omitempty"`
   // unused fields # 11 to 19
-  WorkflowId *string `thrift:"workflowId,20" db:"workflowId" json:"workflowId,omitempty"`
+  Domain *string `thrift:"domain,20" db:"domain" json:"domain,omitempty"`
   // unused fields # 21 to 29
-  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,30" db:"workflowExecution" json:"workflowExecution,omitempty"`
   // unused fields # 31 to 39
-  TaskList *TaskList `thrift:"taskList,40" db:"taskList" json:"taskList,omitempty"`
+  SignalName *string `thrift:"signalName,40" db:"signalName" json:"signalName,omitempty"`
   // unused fields # 41 to 49
   Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
   // unused fields # 51 to 59
-  ExecutionStartToCloseTimeoutSeconds *int32 `thrift:"executionStartToCloseTimeoutSeconds,60" db:"executionStartToCloseTimeoutSeconds" json:"executionStartToCloseTimeoutSeconds,omitempty"`
-  // unused fields # 61 to 69
-  TaskStartToCloseTimeoutSeconds *int32 `thrift:"taskStartToCloseTimeoutSeconds,70" db:"taskStartToCloseTimeoutSeconds" json:"taskStartToCloseTimeoutSeconds,omitempty"`
-  // unused fields # 71 to 79
-  ChildPolicy *ChildPolicy `thrift:"childPolicy,80" db:"childPolicy" json:"childPolicy,omitempty"`
-  // unused fields # 81 to 89
-  Control []byte `thrift:"control,90" db:"control" json:"control,omitempty"`
-  // unused fields # 91 to 99
-  DecisionTaskCompletedEventId *int64 `thrift:"decisionTaskCompletedEventId,100" db:"decisionTaskCompletedEventId" json:"decisionTaskCompletedEventId,omitempty"`
+  Control []byte `thrift:"control,60" db:"control" json:"control,omitempty"`
 }
 
-func NewStartChildWorkflowExecutionInitiatedEventAttributes() *StartChildWorkflowExecutionInitiatedEventAttributes {
-  return &StartChildWorkflowExecutionInitiatedEventAttributes{}
+func NewSignalExternalWorkflowExecutionInitiatedEventAttributes() *SignalExternalWorkflowExecutionInitiatedEventAttributes {
+  return &SignalExternalWorkflowExecutionInitiatedEventAttributes{}
 }
 
-var StartChildWorkflowExecutionInitiatedEventAttributes_Domain_DEFAULT string
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetDomain() string {
-  if !p.IsSetDomain() {
-    return StartChildWorkflowExecutionInitiatedEventAttributes_Domain_DEFAULT
+var SignalExternalWorkflowExecutionInitiatedEventAttributes_DecisionTaskCompletedEventId_DEFAULT int64
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+  if !p.IsSetDecisionTaskCompletedEventId() {
+    return SignalExternalWorkflowExecutionInitiatedEventAttributes_DecisionTaskCompletedEventId_DEFAULT
   }
-return *p.Domain
+return *p.DecisionTaskCompletedEventId
 }
-var StartChildWorkflowExecutionInitiatedEventAttributes_WorkflowId_DEFAULT string
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetWorkflowId() string {
-  if !p.IsSetWorkflowId() {
-    return StartChildWorkflowExecutionInitiatedEventAttributes_WorkflowId_DEFAULT
+var SignalExternalWorkflowExecutionInitiatedEventAttributes_Domain_DEFAULT string
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() string {
+  if !p.IsSetDomain() {
+    return SignalExternalWorkflowExecutionInitiatedEventAttributes_Domain_DEFAULT
   }
-return *p.WorkflowId
+return *p.Domain
 }
-var StartChildWorkflowExecutionInitiatedEventAttributes_WorkflowType_DEFAULT *WorkflowType
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetWorkflowType() *WorkflowType {
-  if !p.IsSetWorkflowType() {
-    return StartChildWorkflowExecutionInitiatedEventAttributes_WorkflowType_DEFAULT
+var SignalExternalWorkflowExecutionInitiatedEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return SignalExternalWorkflowExecutionInitiatedEventAttributes_WorkflowExecution_DEFAULT
   }
-return p.WorkflowType
+return p.WorkflowExecution
 }
-var StartChildWorkflowExecutionInitiatedEventAttributes_TaskList_DEFAULT *TaskList
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetTaskList() *TaskList {
-  if !p.IsSetTaskList() {
-    return StartChildWorkflowExecutionInitiatedEventAttributes_TaskList_DEFAULT
+var SignalExternalWorkflowExecutionInitiatedEventAttributes_SignalName_DEFAULT string
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetSignalName() string {
+  if !p.IsSetSignalName() {
+    return SignalExternalWorkflowExecutionInitiatedEventAttributes_SignalName_DEFAULT
   }
-return p.TaskList
+return *p.SignalName
 }
-var StartChildWorkflowExecutionInitiatedEventAttributes_Input_DEFAULT []byte
+var SignalExternalWorkflowExecutionInitiatedEventAttributes_Input_DEFAULT []byte
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetInput() []byte {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetInput() []byte {
   return p.Input
 }
-var StartChildWorkflowExecutionInitiatedEventAttributes_ExecutionStartToCloseTimeoutSeconds_DEFAULT int32
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetExecutionStartToCloseTimeoutSeconds() int32 {
-  if !p.IsSetExecutionStartToCloseTimeoutSeconds() {
-    return StartChildWorkflowExecutionInitiatedEventAttributes_ExecutionStartToCloseTimeoutSeconds_DEFAULT
-  }
-return *p.ExecutionStartToCloseTimeoutSeconds
-}
-var StartChildWorkflowExecutionInitiatedEventAttributes_TaskStartToCloseTimeoutSeconds_DEFAULT int32
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetTaskStartToCloseTimeoutSeconds() int32 {
-  if !p.IsSetTaskStartToCloseTimeoutSeconds() {
-    return StartChildWorkflowExecutionInitiatedEventAttributes_TaskStartToCloseTimeoutSeconds_DEFAULT
-  }
-return *p.TaskStartToCloseTimeoutSeconds
-}
-var StartChildWorkflowExecutionInitiatedEventAttributes_ChildPolicy_DEFAULT ChildPolicy
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetChildPolicy() ChildPolicy {
-  if !p.IsSetChildPolicy() {
-    return StartChildWorkflowExecutionInitiatedEventAttributes_ChildPolicy_DEFAULT
-  }
-return *p.ChildPolicy
-}
-var StartChildWorkflowExecutionInitiatedEventAttributes_Control_DEFAULT []byte
+var SignalExternalWorkflowExecutionInitiatedEventAttributes_Control_DEFAULT []byte
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetControl() []byte {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetControl() []byte {
   return p.Control
 }
-var StartChildWorkflowExecutionInitiatedEventAttributes_DecisionTaskCompletedEventId_DEFAULT int64
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
-  if !p.IsSetDecisionTaskCompletedEventId() {
-    return StartChildWorkflowExecutionInitiatedEventAttributes_DecisionTaskCompletedEventId_DEFAULT
-  }
-return *p.DecisionTaskCompletedEventId
-}
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetDomain() bool {
-  return p.Domain != nil
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+  return p.DecisionTaskCompletedEventId != nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetWorkflowId() bool {
-  return p.WorkflowId != nil
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetWorkflowType() bool {
-  return p.WorkflowType != nil
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetTaskList() bool {
-  return p.TaskList != nil
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) IsSetSignalName() bool {
+  return p.SignalName != nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetInput() bool {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) IsSetInput() bool {
   return p.Input != nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetExecutionStartToCloseTimeoutSeconds() bool {
-  return p.ExecutionStartToCloseTimeoutSeconds != nil
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetTaskStartToCloseTimeoutSeconds() bool {
-  return p.TaskStartToCloseTimeoutSeconds != nil
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetChildPolicy() bool {
-  return p.ChildPolicy != nil
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetControl() bool {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) IsSetControl() bool {
   return p.Control != nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-  return p.DecisionTaskCompletedEventId != nil
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) Read(iprot thrift.TProtocol) error {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -11190,22 +11530,6 @@ func (p *StartChildWorkflowExecutionInitiatedEventAttributes) Read(iprot thrift.
       if err := p.ReadField60(iprot); err != nil {
         return err
       }
-    case 70:
-      if err := p.ReadField70(iprot); err != nil {
-        return err
-      }
-    case 80:
-      if err := p.ReadField80(iprot); err != nil {
-        return err
-      }
-    case 90:
-      if err := p.ReadField90(iprot); err != nil {
-        return err
-      }
-    case 100:
-      if err := p.ReadField100(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -11221,41 +11545,42 @@ func (p *StartChildWorkflowExecutionInitiatedEventAttributes) Read(iprot thrift.
   return nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Domain = &v
+  p.DecisionTaskCompletedEventId = &v
 }
   return nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.WorkflowId = &v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
-  p.WorkflowType = &WorkflowType{}
-  if err := p.WorkflowType.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
   }
   return nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
-  p.TaskList = &TaskList{}
-  if err := p.TaskList.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
-  }
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.SignalName = &v
+}
   return nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 50: ", err)
 } else {
@@ -11264,54 +11589,17 @@ func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField50(iprot
   return nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 60: ", err)
-} else {
-  p.ExecutionStartToCloseTimeoutSeconds = &v
-}
-  return nil
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField70(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 70: ", err)
-} else {
-  p.TaskStartToCloseTimeoutSeconds = &v
-}
-  return nil
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField80(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 80: ", err)
-} else {
-  temp := ChildPolicy(v)
-  p.ChildPolicy = &temp
-}
-  return nil
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField90(iprot thrift.TProtocol) error {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 90: ", err)
+  return thrift.PrependError("error reading field 60: ", err)
 } else {
   p.Control = v
 }
   return nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField100(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 100: ", err)
-} else {
-  p.DecisionTaskCompletedEventId = &v
-}
-  return nil
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartChildWorkflowExecutionInitiatedEventAttributes"); err != nil {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalExternalWorkflowExecutionInitiatedEventAttributes"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -11320,10 +11608,6 @@ func (p *StartChildWorkflowExecutionInitiatedEventAttributes) Write(oprot thrift
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
     if err := p.writeField60(oprot); err != nil { return err }
-    if err := p.writeField70(oprot); err != nil { return err }
-    if err := p.writeField80(oprot); err != nil { return err }
-    if err := p.writeField90(oprot); err != nil { return err }
-    if err := p.writeField100(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -11332,57 +11616,56 @@ func (p *StartChildWorkflowExecutionInitiatedEventAttributes) Write(oprot thrift
   return nil
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
-  }
-  return err
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionTaskCompletedEventId() {
+    if err := oprot.WriteFieldBegin("decisionTaskCompletedEventId", thrift.I64, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:decisionTaskCompletedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.DecisionTaskCompletedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.decisionTaskCompletedEventId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:decisionTaskCompletedEventId: ", p), err) }
+  }
+  return err
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowId() {
-    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowId: ", p), err) }
-    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.workflowId (20) field write error: ", p), err) }
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:domain: ", p), err) }
   }
   return err
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowType() {
-    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
-    if err := p.WorkflowType.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowExecution: ", p), err) }
   }
   return err
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskList() {
-    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskList: ", p), err) }
-    if err := p.TaskList.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
-    }
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalName() {
+    if err := oprot.WriteFieldBegin("signalName", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:signalName: ", p), err) }
+    if err := oprot.WriteString(string(*p.SignalName)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.signalName (40) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskList: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:signalName: ", p), err) }
   }
   return err
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
   if p.IsSetInput() {
     if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
@@ -11394,178 +11677,116 @@ func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField50(oprot
   return err
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetExecutionStartToCloseTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("executionStartToCloseTimeoutSeconds", thrift.I32, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.ExecutionStartToCloseTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.executionStartToCloseTimeoutSeconds (60) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
-  }
-  return err
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField70(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskStartToCloseTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("taskStartToCloseTimeoutSeconds", thrift.I32, 70); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.TaskStartToCloseTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskStartToCloseTimeoutSeconds (70) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
-  }
-  return err
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField80(oprot thrift.TProtocol) (err error) {
-  if p.IsSetChildPolicy() {
-    if err := oprot.WriteFieldBegin("childPolicy", thrift.I32, 80); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:childPolicy: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.ChildPolicy)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.childPolicy (80) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:childPolicy: ", p), err) }
-  }
-  return err
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField90(oprot thrift.TProtocol) (err error) {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
   if p.IsSetControl() {
-    if err := oprot.WriteFieldBegin("control", thrift.STRING, 90); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:control: ", p), err) }
+    if err := oprot.WriteFieldBegin("control", thrift.STRING, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:control: ", p), err) }
     if err := oprot.WriteBinary(p.Control); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.control (90) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:control: ", p), err) }
-  }
-  return err
-}
-
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField100(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDecisionTaskCompletedEventId() {
-    if err := oprot.WriteFieldBegin("decisionTaskCompletedEventId", thrift.I64, 100); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:decisionTaskCompletedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.DecisionTaskCompletedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.decisionTaskCompletedEventId (100) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.control (60) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:decisionTaskCompletedEventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:control: ", p), err) }
   }
   return err
 }
 
-func (p *StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
+func (p *SignalExternalWorkflowExecutionInitiatedEventAttributes) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("StartChildWorkflowExecutionInitiatedEventAttributes(%+v)", *p)
+  return fmt.Sprintf("SignalExternalWorkflowExecutionInitiatedEventAttributes(%+v)", *p)
 }
 
 // Attributes:
-//  - Domain
-//  - WorkflowId
-//  - WorkflowType
 //  - Cause
-//  - Control
-//  - InitiatedEventId
 //  - DecisionTaskCompletedEventId
-type StartChildWorkflowExecutionFailedEventAttributes struct {
+//  - Domain
+//  - WorkflowExecution
+//  - InitiatedEventId
+//  - Control
+type SignalExternalWorkflowExecutionFailedEventAttributes struct {
   // unused fields # 1 to 9
-  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  Cause *SignalExternalWorkflowExecutionFailedCause `thrift:"cause,10" db:"cause" json:"cause,omitempty"`
   // unused fields # 11 to 19
-  WorkflowId *string `thrift:"workflowId,20" db:"workflowId" json:"workflowId,omitempty"`
+  DecisionTaskCompletedEventId *int64 `thrift:"decisionTaskCompletedEventId,20" db:"decisionTaskCompletedEventId" json:"decisionTaskCompletedEventId,omitempty"`
   // unused fields # 21 to 29
-  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
+  Domain *string `thrift:"domain,30" db:"domain" json:"domain,omitempty"`
   // unused fields # 31 to 39
-  Cause *ChildWorkflowExecutionFailedCause `thrift:"cause,40" db:"cause" json:"cause,omitempty"`
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,40" db:"workflowExecution" json:"workflowExecution,omitempty"`
   // unused fields # 41 to 49
-  Control []byte `thrift:"control,50" db:"control" json:"control,omitempty"`
+  InitiatedEventId *int64 `thrift:"initiatedEventId,50" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
   // unused fields # 51 to 59
-  InitiatedEventId *int64 `thrift:"initiatedEventId,60" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
-  // unused fields # 61 to 69
-  DecisionTaskCompletedEventId *int64 `thrift:"decisionTaskCompletedEventId,70" db:"decisionTaskCompletedEventId" json:"decisionTaskCompletedEventId,omitempty"`
+  Control []byte `thrift:"control,60" db:"control" json:"control,omitempty"`
 }
 
-func NewStartChildWorkflowExecutionFailedEventAttributes() *StartChildWorkflowExecutionFailedEventAttributes {
-  return &StartChildWorkflowExecutionFailedEventAttributes{}
+func NewSignalExternalWorkflowExecutionFailedEventAttributes() *SignalExternalWorkflowExecutionFailedEventAttributes {
+  return &SignalExternalWorkflowExecutionFailedEventAttributes{}
 }
 
-var StartChildWorkflowExecutionFailedEventAttributes_Domain_DEFAULT string
-func (p *StartChildWorkflowExecutionFailedEventAttributes) GetDomain() string {
-  if !p.IsSetDomain() {
-    return StartChildWorkflowExecutionFailedEventAttributes_Domain_DEFAULT
+var SignalExternalWorkflowExecutionFailedEventAttributes_Cause_DEFAULT SignalExternalWorkflowExecutionFailedCause
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) GetCause() SignalExternalWorkflowExecutionFailedCause {
+  if !p.IsSetCause() {
+    return SignalExternalWorkflowExecutionFailedEventAttributes_Cause_DEFAULT
   }
-return *p.Domain
+return *p.Cause
 }
-var StartChildWorkflowExecutionFailedEventAttributes_WorkflowId_DEFAULT string
-func (p *StartChildWorkflowExecutionFailedEventAttributes) GetWorkflowId() string {
-  if !p.IsSetWorkflowId() {
-    return StartChildWorkflowExecutionFailedEventAttributes_WorkflowId_DEFAULT
+var SignalExternalWorkflowExecutionFailedEventAttributes_DecisionTaskCompletedEventId_DEFAULT int64
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+  if !p.IsSetDecisionTaskCompletedEventId() {
+    return SignalExternalWorkflowExecutionFailedEventAttributes_DecisionTaskCompletedEventId_DEFAULT
   }
-return *p.WorkflowId
+return *p.DecisionTaskCompletedEventId
 }
-var StartChildWorkflowExecutionFailedEventAttributes_WorkflowType_DEFAULT *WorkflowType
-func (p *StartChildWorkflowExecutionFailedEventAttributes) GetWorkflowType() *WorkflowType {
-  if !p.IsSetWorkflowType() {
-    return StartChildWorkflowExecutionFailedEventAttributes_WorkflowType_DEFAULT
+var SignalExternalWorkflowExecutionFailedEventAttributes_Domain_DEFAULT string
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) GetDomain() string {
+  if !p.IsSetDomain() {
+    return SignalExternalWorkflowExecutionFailedEventAttributes_Domain_DEFAULT
   }
-return p.WorkflowType
+return *p.Domain
 }
-var StartChildWorkflowExecutionFailedEventAttributes_Cause_DEFAULT ChildWorkflowExecutionFailedCause
-func (p *StartChildWorkflowExecutionFailedEventAttributes) GetCause() ChildWorkflowExecutionFailedCause {
-  if !p.IsSetCause() {
-    return StartChildWorkflowExecutionFailedEventAttributes_Cause_DEFAULT
+var SignalExternalWorkflowExecutionFailedEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return SignalExternalWorkflowExecutionFailedEventAttributes_WorkflowExecution_DEFAULT
   }
-return *p.Cause
-}
-var StartChildWorkflowExecutionFailedEventAttributes_Control_DEFAULT []byte
-
-func (p *StartChildWorkflowExecutionFailedEventAttributes) GetControl() []byte {
-  return p.Control
+return p.WorkflowExecution
 }
-var StartChildWorkflowExecutionFailedEventAttributes_InitiatedEventId_DEFAULT int64
-func (p *StartChildWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() int64 {
+var SignalExternalWorkflowExecutionFailedEventAttributes_InitiatedEventId_DEFAULT int64
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() int64 {
   if !p.IsSetInitiatedEventId() {
-    return StartChildWorkflowExecutionFailedEventAttributes_InitiatedEventId_DEFAULT
+    return SignalExternalWorkflowExecutionFailedEventAttributes_InitiatedEventId_DEFAULT
   }
 return *p.InitiatedEventId
 }
-var StartChildWorkflowExecutionFailedEventAttributes_DecisionTaskCompletedEventId_DEFAULT int64
-func (p *StartChildWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
-  if !p.IsSetDecisionTaskCompletedEventId() {
-    return StartChildWorkflowExecutionFailedEventAttributes_DecisionTaskCompletedEventId_DEFAULT
-  }
-return *p.DecisionTaskCompletedEventId
-}
-func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetDomain() bool {
-  return p.Domain != nil
-}
+var SignalExternalWorkflowExecutionFailedEventAttributes_Control_DEFAULT []byte
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetWorkflowId() bool {
-  return p.WorkflowId != nil
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) GetControl() []byte {
+  return p.Control
+}
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) IsSetCause() bool {
+  return p.Cause != nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetWorkflowType() bool {
-  return p.WorkflowType != nil
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+  return p.DecisionTaskCompletedEventId != nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetCause() bool {
-  return p.Cause != nil
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetControl() bool {
-  return p.Control != nil
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetInitiatedEventId() bool {
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) IsSetInitiatedEventId() bool {
   return p.InitiatedEventId != nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-  return p.DecisionTaskCompletedEventId != nil
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) IsSetControl() bool {
+  return p.Control != nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) Read(iprot thrift.TProtocol) error {
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -11602,10 +11823,6 @@ func (p *StartChildWorkflowExecutionFailedEventAttributes) Read(iprot thrift.TPr
       if err := p.ReadField60(iprot); err != nil {
         return err
       }
-    case 70:
-      if err := p.ReadField70(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -11621,71 +11838,62 @@ func (p *StartChildWorkflowExecutionFailedEventAttributes) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Domain = &v
+  temp := SignalExternalWorkflowExecutionFailedCause(v)
+  p.Cause = &temp
 }
   return nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.WorkflowId = &v
-}
-  return nil
+  p.DecisionTaskCompletedEventId = &v
 }
-
-func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
-  p.WorkflowType = &WorkflowType{}
-  if err := p.WorkflowType.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
-  }
   return nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
 } else {
-  temp := ChildWorkflowExecutionFailedCause(v)
-  p.Cause = &temp
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
-} else {
-  p.Control = v
-}
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
+  }
   return nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 60: ", err)
+  return thrift.PrependError("error reading field 50: ", err)
 } else {
   p.InitiatedEventId = &v
 }
   return nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField70(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 70: ", err)
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
 } else {
-  p.DecisionTaskCompletedEventId = &v
+  p.Control = v
 }
   return nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartChildWorkflowExecutionFailedEventAttributes"); err != nil {
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalExternalWorkflowExecutionFailedEventAttributes"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -11694,7 +11902,6 @@ func (p *StartChildWorkflowExecutionFailedEventAttributes) Write(oprot thrift.TP
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
     if err := p.writeField60(oprot); err != nil { return err }
-    if err := p.writeField70(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -11703,163 +11910,149 @@ func (p *StartChildWorkflowExecutionFailedEventAttributes) Write(oprot thrift.TP
   return nil
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
-  }
-  return err
-}
-
-func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowId() {
-    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowId: ", p), err) }
-    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.workflowId (20) field write error: ", p), err) }
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCause() {
+    if err := oprot.WriteFieldBegin("cause", thrift.I32, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:cause: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.Cause)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.cause (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:cause: ", p), err) }
   }
   return err
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowType() {
-    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
-    if err := p.WorkflowType.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
-    }
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionTaskCompletedEventId() {
+    if err := oprot.WriteFieldBegin("decisionTaskCompletedEventId", thrift.I64, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:decisionTaskCompletedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.DecisionTaskCompletedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.decisionTaskCompletedEventId (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:decisionTaskCompletedEventId: ", p), err) }
   }
   return err
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetCause() {
-    if err := oprot.WriteFieldBegin("cause", thrift.I32, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:cause: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.Cause)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.cause (40) field write error: ", p), err) }
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:cause: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:domain: ", p), err) }
   }
   return err
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetControl() {
-    if err := oprot.WriteFieldBegin("control", thrift.STRING, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:control: ", p), err) }
-    if err := oprot.WriteBinary(p.Control); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.control (50) field write error: ", p), err) }
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:control: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowExecution: ", p), err) }
   }
   return err
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
   if p.IsSetInitiatedEventId() {
-    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:initiatedEventId: ", p), err) }
+    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:initiatedEventId: ", p), err) }
     if err := oprot.WriteI64(int64(*p.InitiatedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (60) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (50) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:initiatedEventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:initiatedEventId: ", p), err) }
   }
   return err
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField70(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDecisionTaskCompletedEventId() {
-    if err := oprot.WriteFieldBegin("decisionTaskCompletedEventId", thrift.I64, 70); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:decisionTaskCompletedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.DecisionTaskCompletedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.decisionTaskCompletedEventId (70) field write error: ", p), err) }
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetControl() {
+    if err := oprot.WriteFieldBegin("control", thrift.STRING, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:control: ", p), err) }
+    if err := oprot.WriteBinary(p.Control); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.control (60) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:decisionTaskCompletedEventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:control: ", p), err) }
   }
   return err
 }
 
-func (p *StartChildWorkflowExecutionFailedEventAttributes) String() string {
+func (p *SignalExternalWorkflowExecutionFailedEventAttributes) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("StartChildWorkflowExecutionFailedEventAttributes(%+v)", *p)
+  return fmt.Sprintf("SignalExternalWorkflowExecutionFailedEventAttributes(%+v)", *p)
 }
 
 // Attributes:
-//  - Domain
 //  - InitiatedEventId
+//  - Domain
 //  - WorkflowExecution
-//  - WorkflowType
-type ChildWorkflowExecutionStartedEventAttributes struct {
+//  - Control
+type ExternalWorkflowExecutionSignaledEventAttributes struct {
   // unused fields # 1 to 9
-  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  InitiatedEventId *int64 `thrift:"initiatedEventId,10" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
   // unused fields # 11 to 19
-  InitiatedEventId *int64 `thrift:"initiatedEventId,20" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
+  Domain *string `thrift:"domain,20" db:"domain" json:"domain,omitempty"`
   // unused fields # 21 to 29
   WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,30" db:"workflowExecution" json:"workflowExecution,omitempty"`
   // unused fields # 31 to 39
-  WorkflowType *WorkflowType `thrift:"workflowType,40" db:"workflowType" json:"workflowType,omitempty"`
+  Control []byte `thrift:"control,40" db:"control" json:"control,omitempty"`
 }
 
-func NewChildWorkflowExecutionStartedEventAttributes() *ChildWorkflowExecutionStartedEventAttributes {
-  return &ChildWorkflowExecutionStartedEventAttributes{}
+func NewExternalWorkflowExecutionSignaledEventAttributes() *ExternalWorkflowExecutionSignaledEventAttributes {
+  return &ExternalWorkflowExecutionSignaledEventAttributes{}
 }
 
-var ChildWorkflowExecutionStartedEventAttributes_Domain_DEFAULT string
-func (p *ChildWorkflowExecutionStartedEventAttributes) GetDomain() string {
-  if !p.IsSetDomain() {
-    return ChildWorkflowExecutionStartedEventAttributes_Domain_DEFAULT
-  }
-return *p.Domain
-}
-var ChildWorkflowExecutionStartedEventAttributes_InitiatedEventId_DEFAULT int64
-func (p *ChildWorkflowExecutionStartedEventAttributes) GetInitiatedEventId() int64 {
+var ExternalWorkflowExecutionSignaledEventAttributes_InitiatedEventId_DEFAULT int64
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) GetInitiatedEventId() int64 {
   if !p.IsSetInitiatedEventId() {
-    return ChildWorkflowExecutionStartedEventAttributes_InitiatedEventId_DEFAULT
+    return ExternalWorkflowExecutionSignaledEventAttributes_InitiatedEventId_DEFAULT
   }
 return *p.InitiatedEventId
 }
-var ChildWorkflowExecutionStartedEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *ChildWorkflowExecutionStartedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+var ExternalWorkflowExecutionSignaledEventAttributes_Domain_DEFAULT string
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) GetDomain() string {
+  if !p.IsSetDomain() {
+    return ExternalWorkflowExecutionSignaledEventAttributes_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var ExternalWorkflowExecutionSignaledEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) GetWorkflowExecution() *WorkflowExecution {
   if !p.IsSetWorkflowExecution() {
-    return ChildWorkflowExecutionStartedEventAttributes_WorkflowExecution_DEFAULT
+    return ExternalWorkflowExecutionSignaledEventAttributes_WorkflowExecution_DEFAULT
   }
 return p.WorkflowExecution
 }
-var ChildWorkflowExecutionStartedEventAttributes_WorkflowType_DEFAULT *WorkflowType
-func (p *ChildWorkflowExecutionStartedEventAttributes) GetWorkflowType() *WorkflowType {
-  if !p.IsSetWorkflowType() {
-    return ChildWorkflowExecutionStartedEventAttributes_WorkflowType_DEFAULT
-  }
-return p.WorkflowType
+var ExternalWorkflowExecutionSignaledEventAttributes_Control_DEFAULT []byte
+
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) GetControl() []byte {
+  return p.Control
 }
-func (p *ChildWorkflowExecutionStartedEventAttributes) IsSetDomain() bool {
-  return p.Domain != nil
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) IsSetInitiatedEventId() bool {
+  return p.InitiatedEventId != nil
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes) IsSetInitiatedEventId() bool {
-  return p.InitiatedEventId != nil
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes) IsSetWorkflowExecution() bool {
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) IsSetWorkflowExecution() bool {
   return p.WorkflowExecution != nil
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes) IsSetWorkflowType() bool {
-  return p.WorkflowType != nil
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) IsSetControl() bool {
+  return p.Control != nil
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes) Read(iprot thrift.TProtocol) error {
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -11903,25 +12096,25 @@ func (p *ChildWorkflowExecutionStartedEventAttributes) Read(iprot thrift.TProtoc
   return nil
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *ExternalWorkflowExecutionSignaledEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Domain = &v
+  p.InitiatedEventId = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
+func (p *ExternalWorkflowExecutionSignaledEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.InitiatedEventId = &v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+func (p *ExternalWorkflowExecutionSignaledEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
   p.WorkflowExecution = &WorkflowExecution{}
   if err := p.WorkflowExecution.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
@@ -11929,16 +12122,17 @@ func (p *ChildWorkflowExecutionStartedEventAttributes)  ReadField30(iprot thrift
   return nil
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
-  p.WorkflowType = &WorkflowType{}
-  if err := p.WorkflowType.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
-  }
+func (p *ExternalWorkflowExecutionSignaledEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.Control = v
+}
   return nil
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ChildWorkflowExecutionStartedEventAttributes"); err != nil {
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ExternalWorkflowExecutionSignaledEventAttributes"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -11953,31 +12147,31 @@ func (p *ChildWorkflowExecutionStartedEventAttributes) Write(oprot thrift.TProto
   return nil
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInitiatedEventId() {
+    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:initiatedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.InitiatedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:initiatedEventId: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetInitiatedEventId() {
-    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:initiatedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.InitiatedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (20) field write error: ", p), err) }
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:initiatedEventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:domain: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
   if p.IsSetWorkflowExecution() {
     if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 30); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowExecution: ", p), err) }
@@ -11990,117 +12184,170 @@ func (p *ChildWorkflowExecutionStartedEventAttributes) writeField30(oprot thrift
   return err
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowType() {
-    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowType: ", p), err) }
-    if err := p.WorkflowType.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
-    }
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetControl() {
+    if err := oprot.WriteFieldBegin("control", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:control: ", p), err) }
+    if err := oprot.WriteBinary(p.Control); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.control (40) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowType: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:control: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionStartedEventAttributes) String() string {
+func (p *ExternalWorkflowExecutionSignaledEventAttributes) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ChildWorkflowExecutionStartedEventAttributes(%+v)", *p)
+  return fmt.Sprintf("ExternalWorkflowExecutionSignaledEventAttributes(%+v)", *p)
 }
 
 // Attributes:
-//  - Result_
 //  - Domain
-//  - WorkflowExecution
+//  - WorkflowId
 //  - WorkflowType
-//  - InitiatedEventId
-//  - StartedEventId
-type ChildWorkflowExecutionCompletedEventAttributes struct {
+//  - TaskList
+//  - Input
+//  - ExecutionStartToCloseTimeoutSeconds
+//  - TaskStartToCloseTimeoutSeconds
+//  - ChildPolicy
+//  - Control
+//  - DecisionTaskCompletedEventId
+type StartChildWorkflowExecutionInitiatedEventAttributes struct {
   // unused fields # 1 to 9
-  Result_ []byte `thrift:"result,10" db:"result" json:"result,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  Domain *string `thrift:"domain,20" db:"domain" json:"domain,omitempty"`
+  WorkflowId *string `thrift:"workflowId,20" db:"workflowId" json:"workflowId,omitempty"`
   // unused fields # 21 to 29
-  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,30" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
   // unused fields # 31 to 39
-  WorkflowType *WorkflowType `thrift:"workflowType,40" db:"workflowType" json:"workflowType,omitempty"`
+  TaskList *TaskList `thrift:"taskList,40" db:"taskList" json:"taskList,omitempty"`
   // unused fields # 41 to 49
-  InitiatedEventId *int64 `thrift:"initiatedEventId,50" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
+  Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
   // unused fields # 51 to 59
-  StartedEventId *int64 `thrift:"startedEventId,60" db:"startedEventId" json:"startedEventId,omitempty"`
+  ExecutionStartToCloseTimeoutSeconds *int32 `thrift:"executionStartToCloseTimeoutSeconds,60" db:"executionStartToCloseTimeoutSeconds" json:"executionStartToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 61 to 69
+  TaskStartToCloseTimeoutSeconds *int32 `thrift:"taskStartToCloseTimeoutSeconds,70" db:"taskStartToCloseTimeoutSeconds" json:"taskStartToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 71 to 79
+  ChildPolicy *ChildPolicy `thrift:"childPolicy,80" db:"childPolicy" json:"childPolicy,omitempty"`
+  // unused fields # 81 to 89
+  Control []byte `thrift:"control,90" db:"control" json:"control,omitempty"`
+  // unused fields # 91 to 99
+  DecisionTaskCompletedEventId *int64 `thrift:"decisionTaskCompletedEventId,100" db:"decisionTaskCompletedEventId" json:"decisionTaskCompletedEventId,omitempty"`
 }
 
-func NewChildWorkflowExecutionCompletedEventAttributes() *ChildWorkflowExecutionCompletedEventAttributes {
-  return &ChildWorkflowExecutionCompletedEventAttributes{}
+func NewStartChildWorkflowExecutionInitiatedEventAttributes() *StartChildWorkflowExecutionInitiatedEventAttributes {
+  return &StartChildWorkflowExecutionInitiatedEventAttributes{}
 }
 
-var ChildWorkflowExecutionCompletedEventAttributes_Result__DEFAULT []byte
-
-func (p *ChildWorkflowExecutionCompletedEventAttributes) GetResult_() []byte {
-  return p.Result_
-}
-var ChildWorkflowExecutionCompletedEventAttributes_Domain_DEFAULT string
-func (p *ChildWorkflowExecutionCompletedEventAttributes) GetDomain() string {
+var StartChildWorkflowExecutionInitiatedEventAttributes_Domain_DEFAULT string
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetDomain() string {
   if !p.IsSetDomain() {
-    return ChildWorkflowExecutionCompletedEventAttributes_Domain_DEFAULT
+    return StartChildWorkflowExecutionInitiatedEventAttributes_Domain_DEFAULT
   }
 return *p.Domain
 }
-var ChildWorkflowExecutionCompletedEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *ChildWorkflowExecutionCompletedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
-  if !p.IsSetWorkflowExecution() {
-    return ChildWorkflowExecutionCompletedEventAttributes_WorkflowExecution_DEFAULT
+var StartChildWorkflowExecutionInitiatedEventAttributes_WorkflowId_DEFAULT string
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetWorkflowId() string {
+  if !p.IsSetWorkflowId() {
+    return StartChildWorkflowExecutionInitiatedEventAttributes_WorkflowId_DEFAULT
   }
-return p.WorkflowExecution
+return *p.WorkflowId
 }
-var ChildWorkflowExecutionCompletedEventAttributes_WorkflowType_DEFAULT *WorkflowType
-func (p *ChildWorkflowExecutionCompletedEventAttributes) GetWorkflowType() *WorkflowType {
+var StartChildWorkflowExecutionInitiatedEventAttributes_WorkflowType_DEFAULT *WorkflowType
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetWorkflowType() *WorkflowType {
   if !p.IsSetWorkflowType() {
-    return ChildWorkflowExecutionCompletedEventAttributes_WorkflowType_DEFAULT
+    return StartChildWorkflowExecutionInitiatedEventAttributes_WorkflowType_DEFAULT
   }
 return p.WorkflowType
 }
-var ChildWorkflowExecutionCompletedEventAttributes_InitiatedEventId_DEFAULT int64
-func (p *ChildWorkflowExecutionCompletedEventAttributes) GetInitiatedEventId() int64 {
-  if !p.IsSetInitiatedEventId() {
-    return ChildWorkflowExecutionCompletedEventAttributes_InitiatedEventId_DEFAULT
+var StartChildWorkflowExecutionInitiatedEventAttributes_TaskList_DEFAULT *TaskList
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetTaskList() *TaskList {
+  if !p.IsSetTaskList() {
+    return StartChildWorkflowExecutionInitiatedEventAttributes_TaskList_DEFAULT
   }
-return *p.InitiatedEventId
+return p.TaskList
 }
-var ChildWorkflowExecutionCompletedEventAttributes_StartedEventId_DEFAULT int64
-func (p *ChildWorkflowExecutionCompletedEventAttributes) GetStartedEventId() int64 {
-  if !p.IsSetStartedEventId() {
-    return ChildWorkflowExecutionCompletedEventAttributes_StartedEventId_DEFAULT
+var StartChildWorkflowExecutionInitiatedEventAttributes_Input_DEFAULT []byte
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetInput() []byte {
+  return p.Input
+}
+var StartChildWorkflowExecutionInitiatedEventAttributes_ExecutionStartToCloseTimeoutSeconds_DEFAULT int32
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetExecutionStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetExecutionStartToCloseTimeoutSeconds() {
+    return StartChildWorkflowExecutionInitiatedEventAttributes_ExecutionStartToCloseTimeoutSeconds_DEFAULT
   }
-return *p.StartedEventId
+return *p.ExecutionStartToCloseTimeoutSeconds
 }
-func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetResult_() bool {
-  return p.Result_ != nil
+var StartChildWorkflowExecutionInitiatedEventAttributes_TaskStartToCloseTimeoutSeconds_DEFAULT int32
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetTaskStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetTaskStartToCloseTimeoutSeconds() {
+    return StartChildWorkflowExecutionInitiatedEventAttributes_TaskStartToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.TaskStartToCloseTimeoutSeconds
+}
+var StartChildWorkflowExecutionInitiatedEventAttributes_ChildPolicy_DEFAULT ChildPolicy
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetChildPolicy() ChildPolicy {
+  if !p.IsSetChildPolicy() {
+    return StartChildWorkflowExecutionInitiatedEventAttributes_ChildPolicy_DEFAULT
+  }
+return *p.ChildPolicy
 }
+var StartChildWorkflowExecutionInitiatedEventAttributes_Control_DEFAULT []byte
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetDomain() bool {
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetControl() []byte {
+  return p.Control
+}
+var StartChildWorkflowExecutionInitiatedEventAttributes_DecisionTaskCompletedEventId_DEFAULT int64
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+  if !p.IsSetDecisionTaskCompletedEventId() {
+    return StartChildWorkflowExecutionInitiatedEventAttributes_DecisionTaskCompletedEventId_DEFAULT
+  }
+return *p.DecisionTaskCompletedEventId
+}
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetDomain() bool {
   return p.Domain != nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetWorkflowExecution() bool {
-  return p.WorkflowExecution != nil
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetWorkflowId() bool {
+  return p.WorkflowId != nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetWorkflowType() bool {
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetWorkflowType() bool {
   return p.WorkflowType != nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetInitiatedEventId() bool {
-  return p.InitiatedEventId != nil
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetTaskList() bool {
+  return p.TaskList != nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetStartedEventId() bool {
-  return p.StartedEventId != nil
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetInput() bool {
+  return p.Input != nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) Read(iprot thrift.TProtocol) error {
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetExecutionStartToCloseTimeoutSeconds() bool {
+  return p.ExecutionStartToCloseTimeoutSeconds != nil
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetTaskStartToCloseTimeoutSeconds() bool {
+  return p.TaskStartToCloseTimeoutSeconds != nil
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetChildPolicy() bool {
+  return p.ChildPolicy != nil
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetControl() bool {
+  return p.Control != nil
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+  return p.DecisionTaskCompletedEventId != nil
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -12137,6 +12384,22 @@ func (p *ChildWorkflowExecutionCompletedEventAttributes) Read(iprot thrift.TProt
       if err := p.ReadField60(iprot); err != nil {
         return err
       }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
+    case 100:
+      if err := p.ReadField100(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -12152,60 +12415,97 @@ func (p *ChildWorkflowExecutionCompletedEventAttributes) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Result_ = v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.Domain = &v
+  p.WorkflowId = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
-  p.WorkflowExecution = &WorkflowExecution{}
-  if err := p.WorkflowExecution.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowType = &WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
   }
   return nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
-  p.WorkflowType = &WorkflowType{}
-  if err := p.WorkflowType.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
+  p.TaskList = &TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
   }
   return nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 50: ", err)
 } else {
-  p.InitiatedEventId = &v
+  p.Input = v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
   return thrift.PrependError("error reading field 60: ", err)
 } else {
-  p.StartedEventId = &v
+  p.ExecutionStartToCloseTimeoutSeconds = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ChildWorkflowExecutionCompletedEventAttributes"); err != nil {
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.TaskStartToCloseTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  temp := ChildPolicy(v)
+  p.ChildPolicy = &temp
+}
+  return nil
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField90(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 90: ", err)
+} else {
+  p.Control = v
+}
+  return nil
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes)  ReadField100(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 100: ", err)
+} else {
+  p.DecisionTaskCompletedEventId = &v
+}
+  return nil
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartChildWorkflowExecutionInitiatedEventAttributes"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -12214,6 +12514,10 @@ func (p *ChildWorkflowExecutionCompletedEventAttributes) Write(oprot thrift.TPro
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
     if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
+    if err := p.writeField100(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -12222,192 +12526,240 @@ func (p *ChildWorkflowExecutionCompletedEventAttributes) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetResult_() {
-    if err := oprot.WriteFieldBegin("result", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:result: ", p), err) }
-    if err := oprot.WriteBinary(p.Result_); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.result (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:result: ", p), err) }
-  }
-  return err
-}
-
-func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:domain: ", p), err) }
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
     if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (20) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:domain: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecution() {
-    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowExecution: ", p), err) }
-    if err := p.WorkflowExecution.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
-    }
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowId() {
+    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowId: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowId (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowExecution: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowId: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
   if p.IsSetWorkflowType() {
-    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowType: ", p), err) }
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
     if err := p.WorkflowType.Write(oprot); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowType: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetInitiatedEventId() {
-    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:initiatedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.InitiatedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (50) field write error: ", p), err) }
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:initiatedEventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskList: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartedEventId() {
-    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:startedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (60) field write error: ", p), err) }
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInput() {
+    if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
+    if err := oprot.WriteBinary(p.Input); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.input (50) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:startedEventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:input: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionCompletedEventAttributes) String() string {
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutionStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("executionStartToCloseTimeoutSeconds", thrift.I32, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ExecutionStartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.executionStartToCloseTimeoutSeconds (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("taskStartToCloseTimeoutSeconds", thrift.I32, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.TaskStartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskStartToCloseTimeoutSeconds (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetChildPolicy() {
+    if err := oprot.WriteFieldBegin("childPolicy", thrift.I32, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:childPolicy: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ChildPolicy)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.childPolicy (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:childPolicy: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetControl() {
+    if err := oprot.WriteFieldBegin("control", thrift.STRING, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:control: ", p), err) }
+    if err := oprot.WriteBinary(p.Control); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.control (90) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:control: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) writeField100(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionTaskCompletedEventId() {
+    if err := oprot.WriteFieldBegin("decisionTaskCompletedEventId", thrift.I64, 100); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:decisionTaskCompletedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.DecisionTaskCompletedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.decisionTaskCompletedEventId (100) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:decisionTaskCompletedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ChildWorkflowExecutionCompletedEventAttributes(%+v)", *p)
+  return fmt.Sprintf("StartChildWorkflowExecutionInitiatedEventAttributes(%+v)", *p)
 }
 
 // Attributes:
-//  - Reason
-//  - Details
 //  - Domain
-//  - WorkflowExecution
+//  - WorkflowId
 //  - WorkflowType
+//  - Cause
+//  - Control
 //  - InitiatedEventId
-//  - StartedEventId
-type ChildWorkflowExecutionFailedEventAttributes struct {
+//  - DecisionTaskCompletedEventId
+type StartChildWorkflowExecutionFailedEventAttributes struct {
   // unused fields # 1 to 9
-  Reason *string `thrift:"reason,10" db:"reason" json:"reason,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  Details []byte `thrift:"details,20" db:"details" json:"details,omitempty"`
+  WorkflowId *string `thrift:"workflowId,20" db:"workflowId" json:"workflowId,omitempty"`
   // unused fields # 21 to 29
-  Domain *string `thrift:"domain,30" db:"domain" json:"domain,omitempty"`
+  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
   // unused fields # 31 to 39
-  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,40" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  Cause *ChildWorkflowExecutionFailedCause `thrift:"cause,40" db:"cause" json:"cause,omitempty"`
   // unused fields # 41 to 49
-  WorkflowType *WorkflowType `thrift:"workflowType,50" db:"workflowType" json:"workflowType,omitempty"`
+  Control []byte `thrift:"control,50" db:"control" json:"control,omitempty"`
   // unused fields # 51 to 59
   InitiatedEventId *int64 `thrift:"initiatedEventId,60" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
   // unused fields # 61 to 69
-  StartedEventId *int64 `thrift:"startedEventId,70" db:"startedEventId" json:"startedEventId,omitempty"`
-}
-
-func NewChildWorkflowExecutionFailedEventAttributes() *ChildWorkflowExecutionFailedEventAttributes {
-  return &ChildWorkflowExecutionFailedEventAttributes{}
+  DecisionTaskCompletedEventId *int64 `thrift:"decisionTaskCompletedEventId,70" db:"decisionTaskCompletedEventId" json:"decisionTaskCompletedEventId,omitempty"`
 }
 
-var ChildWorkflowExecutionFailedEventAttributes_Reason_DEFAULT string
-func (p *ChildWorkflowExecutionFailedEventAttributes) GetReason() string {
-  if !p.IsSetReason() {
-    return ChildWorkflowExecutionFailedEventAttributes_Reason_DEFAULT
-  }
-return *p.Reason
+func NewStartChildWorkflowExecutionFailedEventAttributes() *StartChildWorkflowExecutionFailedEventAttributes {
+  return &StartChildWorkflowExecutionFailedEventAttributes{}
 }
-var ChildWorkflowExecutionFailedEventAttributes_Details_DEFAULT []byte
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) GetDetails() []byte {
-  return p.Details
-}
-var ChildWorkflowExecutionFailedEventAttributes_Domain_DEFAULT string
-func (p *ChildWorkflowExecutionFailedEventAttributes) GetDomain() string {
+var StartChildWorkflowExecutionFailedEventAttributes_Domain_DEFAULT string
+func (p *StartChildWorkflowExecutionFailedEventAttributes) GetDomain() string {
   if !p.IsSetDomain() {
-    return ChildWorkflowExecutionFailedEventAttributes_Domain_DEFAULT
+    return StartChildWorkflowExecutionFailedEventAttributes_Domain_DEFAULT
   }
 return *p.Domain
 }
-var ChildWorkflowExecutionFailedEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *ChildWorkflowExecutionFailedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
-  if !p.IsSetWorkflowExecution() {
-    return ChildWorkflowExecutionFailedEventAttributes_WorkflowExecution_DEFAULT
+var StartChildWorkflowExecutionFailedEventAttributes_WorkflowId_DEFAULT string
+func (p *StartChildWorkflowExecutionFailedEventAttributes) GetWorkflowId() string {
+  if !p.IsSetWorkflowId() {
+    return StartChildWorkflowExecutionFailedEventAttributes_WorkflowId_DEFAULT
   }
-return p.WorkflowExecution
+return *p.WorkflowId
 }
-var ChildWorkflowExecutionFailedEventAttributes_WorkflowType_DEFAULT *WorkflowType
-func (p *ChildWorkflowExecutionFailedEventAttributes) GetWorkflowType() *WorkflowType {
+var StartChildWorkflowExecutionFailedEventAttributes_WorkflowType_DEFAULT *WorkflowType
+func (p *StartChildWorkflowExecutionFailedEventAttributes) GetWorkflowType() *WorkflowType {
   if !p.IsSetWorkflowType() {
-    return ChildWorkflowExecutionFailedEventAttributes_WorkflowType_DEFAULT
+    return StartChildWorkflowExecutionFailedEventAttributes_WorkflowType_DEFAULT
   }
 return p.WorkflowType
 }
-var ChildWorkflowExecutionFailedEventAttributes_InitiatedEventId_DEFAULT int64
-func (p *ChildWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() int64 {
+var StartChildWorkflowExecutionFailedEventAttributes_Cause_DEFAULT ChildWorkflowExecutionFailedCause
+func (p *StartChildWorkflowExecutionFailedEventAttributes) GetCause() ChildWorkflowExecutionFailedCause {
+  if !p.IsSetCause() {
+    return StartChildWorkflowExecutionFailedEventAttributes_Cause_DEFAULT
+  }
+return *p.Cause
+}
+var StartChildWorkflowExecutionFailedEventAttributes_Control_DEFAULT []byte
+
+func (p *StartChildWorkflowExecutionFailedEventAttributes) GetControl() []byte {
+  return p.Control
+}
+var StartChildWorkflowExecutionFailedEventAttributes_InitiatedEventId_DEFAULT int64
+func (p *StartChildWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() int64 {
   if !p.IsSetInitiatedEventId() {
-    return ChildWorkflowExecutionFailedEventAttributes_InitiatedEventId_DEFAULT
+    return StartChildWorkflowExecutionFailedEventAttributes_InitiatedEventId_DEFAULT
   }
 return *p.InitiatedEventId
 }
-var ChildWorkflowExecutionFailedEventAttributes_StartedEventId_DEFAULT int64
-func (p *ChildWorkflowExecutionFailedEventAttributes) GetStartedEventId() int64 {
-  if !p.IsSetStartedEventId() {
-    return ChildWorkflowExecutionFailedEventAttributes_StartedEventId_DEFAULT
+var StartChildWorkflowExecutionFailedEventAttributes_DecisionTaskCompletedEventId_DEFAULT int64
+func (p *StartChildWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+  if !p.IsSetDecisionTaskCompletedEventId() {
+    return StartChildWorkflowExecutionFailedEventAttributes_DecisionTaskCompletedEventId_DEFAULT
   }
-return *p.StartedEventId
+return *p.DecisionTaskCompletedEventId
 }
-func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetReason() bool {
-  return p.Reason != nil
+func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetDetails() bool {
-  return p.Details != nil
+func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetWorkflowId() bool {
+  return p.WorkflowId != nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetDomain() bool {
-  return p.Domain != nil
+func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetWorkflowExecution() bool {
-  return p.WorkflowExecution != nil
+func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetCause() bool {
+  return p.Cause != nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetWorkflowType() bool {
-  return p.WorkflowType != nil
+func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetControl() bool {
+  return p.Control != nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetInitiatedEventId() bool {
+func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetInitiatedEventId() bool {
   return p.InitiatedEventId != nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetStartedEventId() bool {
-  return p.StartedEventId != nil
+func (p *StartChildWorkflowExecutionFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+  return p.DecisionTaskCompletedEventId != nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) Read(iprot thrift.TProtocol) error {
+func (p *StartChildWorkflowExecutionFailedEventAttributes) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -12463,50 +12815,52 @@ func (p *ChildWorkflowExecutionFailedEventAttributes) Read(iprot thrift.TProtoco
   return nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
+func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Reason = &v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.Details = v
+  p.WorkflowId = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
-} else {
-  p.Domain = &v
-}
+func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowType = &WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+  }
   return nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
-  p.WorkflowExecution = &WorkflowExecution{}
-  if err := p.WorkflowExecution.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
-  }
+func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  temp := ChildWorkflowExecutionFailedCause(v)
+  p.Cause = &temp
+}
   return nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
-  p.WorkflowType = &WorkflowType{}
-  if err := p.WorkflowType.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
-  }
+func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Control = v
+}
   return nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
+func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 60: ", err)
 } else {
@@ -12515,17 +12869,17 @@ func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField60(iprot thrift.
   return nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField70(iprot thrift.TProtocol) error {
+func (p *StartChildWorkflowExecutionFailedEventAttributes)  ReadField70(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 70: ", err)
 } else {
-  p.StartedEventId = &v
+  p.DecisionTaskCompletedEventId = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ChildWorkflowExecutionFailedEventAttributes"); err != nil {
+func (p *StartChildWorkflowExecutionFailedEventAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartChildWorkflowExecutionFailedEventAttributes"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -12543,69 +12897,68 @@ func (p *ChildWorkflowExecutionFailedEventAttributes) Write(oprot thrift.TProtoc
   return nil
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetReason() {
-    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:reason: ", p), err) }
-    if err := oprot.WriteString(string(*p.Reason)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.reason (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:reason: ", p), err) }
-  }
-  return err
+func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDetails() {
-    if err := oprot.WriteFieldBegin("details", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:details: ", p), err) }
-    if err := oprot.WriteBinary(p.Details); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.details (20) field write error: ", p), err) }
+func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowId() {
+    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowId: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowId (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:details: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowId: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (30) field write error: ", p), err) }
+func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:domain: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecution() {
-    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowExecution: ", p), err) }
-    if err := p.WorkflowExecution.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
-    }
+func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCause() {
+    if err := oprot.WriteFieldBegin("cause", thrift.I32, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:cause: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.Cause)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.cause (40) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowExecution: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:cause: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowType() {
-    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:workflowType: ", p), err) }
-    if err := p.WorkflowType.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
-    }
+func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetControl() {
+    if err := oprot.WriteFieldBegin("control", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:control: ", p), err) }
+    if err := oprot.WriteBinary(p.Control); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.control (50) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:workflowType: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:control: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
+func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
   if p.IsSetInitiatedEventId() {
     if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 60); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:initiatedEventId: ", p), err) }
@@ -12617,116 +12970,90 @@ func (p *ChildWorkflowExecutionFailedEventAttributes) writeField60(oprot thrift.
   return err
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) writeField70(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartedEventId() {
-    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 70); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:startedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (70) field write error: ", p), err) }
+func (p *StartChildWorkflowExecutionFailedEventAttributes) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionTaskCompletedEventId() {
+    if err := oprot.WriteFieldBegin("decisionTaskCompletedEventId", thrift.I64, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:decisionTaskCompletedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.DecisionTaskCompletedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.decisionTaskCompletedEventId (70) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:startedEventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:decisionTaskCompletedEventId: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionFailedEventAttributes) String() string {
+func (p *StartChildWorkflowExecutionFailedEventAttributes) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ChildWorkflowExecutionFailedEventAttributes(%+v)", *p)
+  return fmt.Sprintf("StartChildWorkflowExecutionFailedEventAttributes(%+v)", *p)
 }
 
 // Attributes:
-//  - Details
 //  - Domain
+//  - InitiatedEventId
 //  - WorkflowExecution
 //  - WorkflowType
-//  - InitiatedEventId
-//  - StartedEventId
-type ChildWorkflowExecutionCanceledEventAttributes struct {
+type ChildWorkflowExecutionStartedEventAttributes struct {
   // unused fields # 1 to 9
-  Details []byte `thrift:"details,10" db:"details" json:"details,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  Domain *string `thrift:"domain,20" db:"domain" json:"domain,omitempty"`
+  InitiatedEventId *int64 `thrift:"initiatedEventId,20" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
   // unused fields # 21 to 29
   WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,30" db:"workflowExecution" json:"workflowExecution,omitempty"`
   // unused fields # 31 to 39
   WorkflowType *WorkflowType `thrift:"workflowType,40" db:"workflowType" json:"workflowType,omitempty"`
-  // unused fields # 41 to 49
-  InitiatedEventId *int64 `thrift:"initiatedEventId,50" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
-  // unused fields # 51 to 59
-  StartedEventId *int64 `thrift:"startedEventId,60" db:"startedEventId" json:"startedEventId,omitempty"`
 }
 
-func NewChildWorkflowExecutionCanceledEventAttributes() *ChildWorkflowExecutionCanceledEventAttributes {
-  return &ChildWorkflowExecutionCanceledEventAttributes{}
+func NewChildWorkflowExecutionStartedEventAttributes() *ChildWorkflowExecutionStartedEventAttributes {
+  return &ChildWorkflowExecutionStartedEventAttributes{}
 }
 
-var ChildWorkflowExecutionCanceledEventAttributes_Details_DEFAULT []byte
-
-func (p *ChildWorkflowExecutionCanceledEventAttributes) GetDetails() []byte {
-  return p.Details
-}
-var ChildWorkflowExecutionCanceledEventAttributes_Domain_DEFAULT string
-func (p *ChildWorkflowExecutionCanceledEventAttributes) GetDomain() string {
+var ChildWorkflowExecutionStartedEventAttributes_Domain_DEFAULT string
+func (p *ChildWorkflowExecutionStartedEventAttributes) GetDomain() string {
   if !p.IsSetDomain() {
-    return ChildWorkflowExecutionCanceledEventAttributes_Domain_DEFAULT
+    return ChildWorkflowExecutionStartedEventAttributes_Domain_DEFAULT
   }
 return *p.Domain
 }
-var ChildWorkflowExecutionCanceledEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *ChildWorkflowExecutionCanceledEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+var ChildWorkflowExecutionStartedEventAttributes_InitiatedEventId_DEFAULT int64
+func (p *ChildWorkflowExecutionStartedEventAttributes) GetInitiatedEventId() int64 {
+  if !p.IsSetInitiatedEventId() {
+    return ChildWorkflowExecutionStartedEventAttributes_InitiatedEventId_DEFAULT
+  }
+return *p.InitiatedEventId
+}
+var ChildWorkflowExecutionStartedEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *ChildWorkflowExecutionStartedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
   if !p.IsSetWorkflowExecution() {
-    return ChildWorkflowExecutionCanceledEventAttributes_WorkflowExecution_DEFAULT
+    return ChildWorkflowExecutionStartedEventAttributes_WorkflowExecution_DEFAULT
   }
 return p.WorkflowExecution
 }
-var ChildWorkflowExecutionCanceledEventAttributes_WorkflowType_DEFAULT *WorkflowType
-func (p *ChildWorkflowExecutionCanceledEventAttributes) GetWorkflowType() *WorkflowType {
+var ChildWorkflowExecutionStartedEventAttributes_WorkflowType_DEFAULT *WorkflowType
+func (p *ChildWorkflowExecutionStartedEventAttributes) GetWorkflowType() *WorkflowType {
   if !p.IsSetWorkflowType() {
-    return ChildWorkflowExecutionCanceledEventAttributes_WorkflowType_DEFAULT
+    return ChildWorkflowExecutionStartedEventAttributes_WorkflowType_DEFAULT
   }
 return p.WorkflowType
 }
-var ChildWorkflowExecutionCanceledEventAttributes_InitiatedEventId_DEFAULT int64
-func (p *ChildWorkflowExecutionCanceledEventAttributes) GetInitiatedEventId() int64 {
-  if !p.IsSetInitiatedEventId() {
-    return ChildWorkflowExecutionCanceledEventAttributes_InitiatedEventId_DEFAULT
-  }
-return *p.InitiatedEventId
-}
-var ChildWorkflowExecutionCanceledEventAttributes_StartedEventId_DEFAULT int64
-func (p *ChildWorkflowExecutionCanceledEventAttributes) GetStartedEventId() int64 {
-  if !p.IsSetStartedEventId() {
-    return ChildWorkflowExecutionCanceledEventAttributes_StartedEventId_DEFAULT
-  }
-return *p.StartedEventId
-}
-func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetDetails() bool {
-  return p.Details != nil
+func (p *ChildWorkflowExecutionStartedEventAttributes) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetDomain() bool {
-  return p.Domain != nil
+func (p *ChildWorkflowExecutionStartedEventAttributes) IsSetInitiatedEventId() bool {
+  return p.InitiatedEventId != nil
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetWorkflowExecution() bool {
+func (p *ChildWorkflowExecutionStartedEventAttributes) IsSetWorkflowExecution() bool {
   return p.WorkflowExecution != nil
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetWorkflowType() bool {
+func (p *ChildWorkflowExecutionStartedEventAttributes) IsSetWorkflowType() bool {
   return p.WorkflowType != nil
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetInitiatedEventId() bool {
-  return p.InitiatedEventId != nil
-}
-
-func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetStartedEventId() bool {
-  return p.StartedEventId != nil
-}
-
-func (p *ChildWorkflowExecutionCanceledEventAttributes) Read(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionStartedEventAttributes) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -12755,14 +13082,6 @@ func (p *ChildWorkflowExecutionCanceledEventAttributes) Read(iprot thrift.TProto
       if err := p.ReadField40(iprot); err != nil {
         return err
       }
-    case 50:
-      if err := p.ReadField50(iprot); err != nil {
-        return err
-      }
-    case 60:
-      if err := p.ReadField60(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -12778,25 +13097,25 @@ func (p *ChildWorkflowExecutionCanceledEventAttributes) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *ChildWorkflowExecutionStartedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Details = v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *ChildWorkflowExecutionStartedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.Domain = &v
+  p.InitiatedEventId = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionStartedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
   p.WorkflowExecution = &WorkflowExecution{}
   if err := p.WorkflowExecution.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
@@ -12804,7 +13123,7 @@ func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField30(iprot thrif
   return nil
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionStartedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
   p.WorkflowType = &WorkflowType{}
   if err := p.WorkflowType.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
@@ -12812,34 +13131,14 @@ func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField40(iprot thrif
   return nil
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
-} else {
-  p.InitiatedEventId = &v
-}
-  return nil
-}
-
-func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 60: ", err)
-} else {
-  p.StartedEventId = &v
-}
-  return nil
-}
-
-func (p *ChildWorkflowExecutionCanceledEventAttributes) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ChildWorkflowExecutionCanceledEventAttributes"); err != nil {
+func (p *ChildWorkflowExecutionStartedEventAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ChildWorkflowExecutionStartedEventAttributes"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
-    if err := p.writeField50(oprot); err != nil { return err }
-    if err := p.writeField60(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -12848,31 +13147,31 @@ func (p *ChildWorkflowExecutionCanceledEventAttributes) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDetails() {
-    if err := oprot.WriteFieldBegin("details", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:details: ", p), err) }
-    if err := oprot.WriteBinary(p.Details); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.details (10) field write error: ", p), err) }
+func (p *ChildWorkflowExecutionStartedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:details: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (20) field write error: ", p), err) }
+func (p *ChildWorkflowExecutionStartedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInitiatedEventId() {
+    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:initiatedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.InitiatedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:domain: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:initiatedEventId: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionStartedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
   if p.IsSetWorkflowExecution() {
     if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 30); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowExecution: ", p), err) }
@@ -12885,7 +13184,7 @@ func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField30(oprot thrif
   return err
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionStartedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
   if p.IsSetWorkflowType() {
     if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 40); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowType: ", p), err) }
@@ -12898,47 +13197,23 @@ func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField40(oprot thrif
   return err
 }
 
-func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetInitiatedEventId() {
-    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:initiatedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.InitiatedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (50) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:initiatedEventId: ", p), err) }
-  }
-  return err
-}
-
-func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartedEventId() {
-    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:startedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (60) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:startedEventId: ", p), err) }
-  }
-  return err
-}
-
-func (p *ChildWorkflowExecutionCanceledEventAttributes) String() string {
+func (p *ChildWorkflowExecutionStartedEventAttributes) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ChildWorkflowExecutionCanceledEventAttributes(%+v)", *p)
+  return fmt.Sprintf("ChildWorkflowExecutionStartedEventAttributes(%+v)", *p)
 }
 
 // Attributes:
-//  - TimeoutType
+//  - Result_
 //  - Domain
 //  - WorkflowExecution
 //  - WorkflowType
 //  - InitiatedEventId
 //  - StartedEventId
-type ChildWorkflowExecutionTimedOutEventAttributes struct {
+type ChildWorkflowExecutionCompletedEventAttributes struct {
   // unused fields # 1 to 9
-  TimeoutType *TimeoutType `thrift:"timeoutType,10" db:"timeoutType" json:"timeoutType,omitempty"`
+  Result_ []byte `thrift:"result,10" db:"result" json:"result,omitempty"`
   // unused fields # 11 to 19
   Domain *string `thrift:"domain,20" db:"domain" json:"domain,omitempty"`
   // unused fields # 21 to 29
@@ -12951,77 +13226,75 @@ type ChildWorkflowExecutionTimedOutEventAttributes struct {
   StartedEventId *int64 `thrift:"startedEventId,60" db:"startedEventId" json:"startedEventId,omitempty"`
 }
 
-func NewChildWorkflowExecutionTimedOutEventAttributes() *ChildWorkflowExecutionTimedOutEventAttributes {
-  return &ChildWorkflowExecutionTimedOutEventAttributes{}
+func NewChildWorkflowExecutionCompletedEventAttributes() *ChildWorkflowExecutionCompletedEventAttributes {
+  return &ChildWorkflowExecutionCompletedEventAttributes{}
 }
 
-var ChildWorkflowExecutionTimedOutEventAttributes_TimeoutType_DEFAULT TimeoutType
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetTimeoutType() TimeoutType {
-  if !p.IsSetTimeoutType() {
-    return ChildWorkflowExecutionTimedOutEventAttributes_TimeoutType_DEFAULT
-  }
-return *p.TimeoutType
+var ChildWorkflowExecutionCompletedEventAttributes_Result__DEFAULT []byte
+
+func (p *ChildWorkflowExecutionCompletedEventAttributes) GetResult_() []byte {
+  return p.Result_
 }
-var ChildWorkflowExecutionTimedOutEventAttributes_Domain_DEFAULT string
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetDomain() string {
+var ChildWorkflowExecutionCompletedEventAttributes_Domain_DEFAULT string
+func (p *ChildWorkflowExecutionCompletedEventAttributes) GetDomain() string {
   if !p.IsSetDomain() {
-    return ChildWorkflowExecutionTimedOutEventAttributes_Domain_DEFAULT
+    return ChildWorkflowExecutionCompletedEventAttributes_Domain_DEFAULT
   }
 return *p.Domain
 }
-var ChildWorkflowExecutionTimedOutEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+var ChildWorkflowExecutionCompletedEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *ChildWorkflowExecutionCompletedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
   if !p.IsSetWorkflowExecution() {
-    return ChildWorkflowExecutionTimedOutEventAttributes_WorkflowExecution_DEFAULT
+    return ChildWorkflowExecutionCompletedEventAttributes_WorkflowExecution_DEFAULT
   }
 return p.WorkflowExecution
 }
-var ChildWorkflowExecutionTimedOutEventAttributes_WorkflowType_DEFAULT *WorkflowType
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetWorkflowType() *WorkflowType {
+var ChildWorkflowExecutionCompletedEventAttributes_WorkflowType_DEFAULT *WorkflowType
+func (p *ChildWorkflowExecutionCompletedEventAttributes) GetWorkflowType() *WorkflowType {
   if !p.IsSetWorkflowType() {
-    return ChildWorkflowExecutionTimedOutEventAttributes_WorkflowType_DEFAULT
+    return ChildWorkflowExecutionCompletedEventAttributes_WorkflowType_DEFAULT
   }
 return p.WorkflowType
 }
-var ChildWorkflowExecutionTimedOutEventAttributes_InitiatedEventId_DEFAULT int64
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetInitiatedEventId() int64 {
+var ChildWorkflowExecutionCompletedEventAttributes_InitiatedEventId_DEFAULT int64
+func (p *ChildWorkflowExecutionCompletedEventAttributes) GetInitiatedEventId() int64 {
   if !p.IsSetInitiatedEventId() {
-    return ChildWorkflowExecutionTimedOutEventAttributes_InitiatedEventId_DEFAULT
+    return ChildWorkflowExecutionCompletedEventAttributes_InitiatedEventId_DEFAULT
   }
 return *p.InitiatedEventId
 }
-var ChildWorkflowExecutionTimedOutEventAttributes_StartedEventId_DEFAULT int64
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetStartedEventId() int64 {
+var ChildWorkflowExecutionCompletedEventAttributes_StartedEventId_DEFAULT int64
+func (p *ChildWorkflowExecutionCompletedEventAttributes) GetStartedEventId() int64 {
   if !p.IsSetStartedEventId() {
-    return ChildWorkflowExecutionTimedOutEventAttributes_StartedEventId_DEFAULT
+    return ChildWorkflowExecutionCompletedEventAttributes_StartedEventId_DEFAULT
   }
 return *p.StartedEventId
 }
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetTimeoutType() bool {
-  return p.TimeoutType != nil
+func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetResult_() bool {
+  return p.Result_ != nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetDomain() bool {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetDomain() bool {
   return p.Domain != nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetWorkflowExecution() bool {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetWorkflowExecution() bool {
   return p.WorkflowExecution != nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetWorkflowType() bool {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetWorkflowType() bool {
   return p.WorkflowType != nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetInitiatedEventId() bool {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetInitiatedEventId() bool {
   return p.InitiatedEventId != nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetStartedEventId() bool {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) IsSetStartedEventId() bool {
   return p.StartedEventId != nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) Read(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -13073,17 +13346,16 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
+func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  temp := TimeoutType(v)
-  p.TimeoutType = &temp
+  p.Result_ = v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
@@ -13092,7 +13364,7 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField20(iprot thrif
   return nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
   p.WorkflowExecution = &WorkflowExecution{}
   if err := p.WorkflowExecution.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
@@ -13100,7 +13372,7 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField30(iprot thrif
   return nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
   p.WorkflowType = &WorkflowType{}
   if err := p.WorkflowType.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
@@ -13108,7 +13380,7 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField40(iprot thrif
   return nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 50: ", err)
 } else {
@@ -13117,7 +13389,7 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField50(iprot thrif
   return nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionCompletedEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 60: ", err)
 } else {
@@ -13126,8 +13398,8 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField60(iprot thrif
   return nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ChildWorkflowExecutionTimedOutEventAttributes"); err != nil {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ChildWorkflowExecutionCompletedEventAttributes"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -13144,19 +13416,19 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTimeoutType() {
-    if err := oprot.WriteFieldBegin("timeoutType", thrift.I32, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:timeoutType: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.TimeoutType)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.timeoutType (10) field write error: ", p), err) }
+func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetResult_() {
+    if err := oprot.WriteFieldBegin("result", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:result: ", p), err) }
+    if err := oprot.WriteBinary(p.Result_); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.result (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:timeoutType: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:result: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
   if p.IsSetDomain() {
     if err := oprot.WriteFieldBegin("domain", thrift.STRING, 20); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:domain: ", p), err) }
@@ -13168,7 +13440,7 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField20(oprot thrif
   return err
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
   if p.IsSetWorkflowExecution() {
     if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 30); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowExecution: ", p), err) }
@@ -13181,7 +13453,7 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField30(oprot thrif
   return err
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
   if p.IsSetWorkflowType() {
     if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 40); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowType: ", p), err) }
@@ -13194,7 +13466,7 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField40(oprot thrif
   return err
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
   if p.IsSetInitiatedEventId() {
     if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 50); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:initiatedEventId: ", p), err) }
@@ -13206,7 +13478,7 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField50(oprot thrif
   return err
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
   if p.IsSetStartedEventId() {
     if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 60); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:startedEventId: ", p), err) }
@@ -13218,92 +13490,118 @@ func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField60(oprot thrif
   return err
 }
 
-func (p *ChildWorkflowExecutionTimedOutEventAttributes) String() string {
+func (p *ChildWorkflowExecutionCompletedEventAttributes) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ChildWorkflowExecutionTimedOutEventAttributes(%+v)", *p)
+  return fmt.Sprintf("ChildWorkflowExecutionCompletedEventAttributes(%+v)", *p)
 }
 
 // Attributes:
+//  - Reason
+//  - Details
 //  - Domain
 //  - WorkflowExecution
 //  - WorkflowType
 //  - InitiatedEventId
 //  - StartedEventId
-type ChildWorkflowExecutionTerminatedEventAttributes struct {
+type ChildWorkflowExecutionFailedEventAttributes struct {
   // unused fields # 1 to 9
-  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  Reason *string `thrift:"reason,10" db:"reason" json:"reason,omitempty"`
   // unused fields # 11 to 19
-  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  Details []byte `thrift:"details,20" db:"details" json:"details,omitempty"`
   // unused fields # 21 to 29
-  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
+  Domain *string `thrift:"domain,30" db:"domain" json:"domain,omitempty"`
   // unused fields # 31 to 39
-  InitiatedEventId *int64 `thrift:"initiatedEventId,40" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,40" db:"workflowExecution" json:"workflowExecution,omitempty"`
   // unused fields # 41 to 49
-  StartedEventId *int64 `thrift:"startedEventId,50" db:"startedEventId" json:"startedEventId,omitempty"`
+  WorkflowType *WorkflowType `thrift:"workflowType,50" db:"workflowType" json:"workflowType,omitempty"`
+  // unused fields # 51 to 59
+  InitiatedEventId *int64 `thrift:"initiatedEventId,60" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
+  // unused fields # 61 to 69
+  StartedEventId *int64 `thrift:"startedEventId,70" db:"startedEventId" json:"startedEventId,omitempty"`
 }
 
-func NewChildWorkflowExecutionTerminatedEventAttributes() *ChildWorkflowExecutionTerminatedEventAttributes {
-  return &ChildWorkflowExecutionTerminatedEventAttributes{}
+func NewChildWorkflowExecutionFailedEventAttributes() *ChildWorkflowExecutionFailedEventAttributes {
+  return &ChildWorkflowExecutionFailedEventAttributes{}
 }
 
-var ChildWorkflowExecutionTerminatedEventAttributes_Domain_DEFAULT string
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) GetDomain() string {
+var ChildWorkflowExecutionFailedEventAttributes_Reason_DEFAULT string
+func (p *ChildWorkflowExecutionFailedEventAttributes) GetReason() string {
+  if !p.IsSetReason() {
+    return ChildWorkflowExecutionFailedEventAttributes_Reason_DEFAULT
+  }
+return *p.Reason
+}
+var ChildWorkflowExecutionFailedEventAttributes_Details_DEFAULT []byte
+
+func (p *ChildWorkflowExecutionFailedEventAttributes) GetDetails() []byte {
+  return p.Details
+}
+var ChildWorkflowExecutionFailedEventAttributes_Domain_DEFAULT string
+func (p *ChildWorkflowExecutionFailedEventAttributes) GetDomain() string {
   if !p.IsSetDomain() {
-    return ChildWorkflowExecutionTerminatedEventAttributes_Domain_DEFAULT
+    return ChildWorkflowExecutionFailedEventAttributes_Domain_DEFAULT
   }
 return *p.Domain
 }
-var ChildWorkflowExecutionTerminatedEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+var ChildWorkflowExecutionFailedEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *ChildWorkflowExecutionFailedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
   if !p.IsSetWorkflowExecution() {
-    return ChildWorkflowExecutionTerminatedEventAttributes_WorkflowExecution_DEFAULT
+    return ChildWorkflowExecutionFailedEventAttributes_WorkflowExecution_DEFAULT
   }
 return p.WorkflowExecution
 }
-var ChildWorkflowExecutionTerminatedEventAttributes_WorkflowType_DEFAULT *WorkflowType
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) GetWorkflowType() *WorkflowType {
+var ChildWorkflowExecutionFailedEventAttributes_WorkflowType_DEFAULT *WorkflowType
+func (p *ChildWorkflowExecutionFailedEventAttributes) GetWorkflowType() *WorkflowType {
   if !p.IsSetWorkflowType() {
-    return ChildWorkflowExecutionTerminatedEventAttributes_WorkflowType_DEFAULT
+    return ChildWorkflowExecutionFailedEventAttributes_WorkflowType_DEFAULT
   }
 return p.WorkflowType
 }
-var ChildWorkflowExecutionTerminatedEventAttributes_InitiatedEventId_DEFAULT int64
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) GetInitiatedEventId() int64 {
+var ChildWorkflowExecutionFailedEventAttributes_InitiatedEventId_DEFAULT int64
+func (p *ChildWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() int64 {
   if !p.IsSetInitiatedEventId() {
-    return ChildWorkflowExecutionTerminatedEventAttributes_InitiatedEventId_DEFAULT
+    return ChildWorkflowExecutionFailedEventAttributes_InitiatedEventId_DEFAULT
   }
 return *p.InitiatedEventId
 }
-var ChildWorkflowExecutionTerminatedEventAttributes_StartedEventId_DEFAULT int64
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) GetStartedEventId() int64 {
+var ChildWorkflowExecutionFailedEventAttributes_StartedEventId_DEFAULT int64
+func (p *ChildWorkflowExecutionFailedEventAttributes) GetStartedEventId() int64 {
   if !p.IsSetStartedEventId() {
-    return ChildWorkflowExecutionTerminatedEventAttributes_StartedEventId_DEFAULT
+    return ChildWorkflowExecutionFailedEventAttributes_StartedEventId_DEFAULT
   }
 return *p.StartedEventId
 }
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) IsSetDomain() bool {
+func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetReason() bool {
+  return p.Reason != nil
+}
+
+func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetDetails() bool {
+  return p.Details != nil
+}
+
+func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetDomain() bool {
   return p.Domain != nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) IsSetWorkflowExecution() bool {
+func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetWorkflowExecution() bool {
   return p.WorkflowExecution != nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) IsSetWorkflowType() bool {
+func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetWorkflowType() bool {
   return p.WorkflowType != nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) IsSetInitiatedEventId() bool {
+func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetInitiatedEventId() bool {
   return p.InitiatedEventId != nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) IsSetStartedEventId() bool {
+func (p *ChildWorkflowExecutionFailedEventAttributes) IsSetStartedEventId() bool {
   return p.StartedEventId != nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) Read(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionFailedEventAttributes) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -13336,6 +13634,14 @@ func (p *ChildWorkflowExecutionTerminatedEventAttributes) Read(iprot thrift.TPro
       if err := p.ReadField50(iprot); err != nil {
         return err
       }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -13351,16 +13657,34 @@ func (p *ChildWorkflowExecutionTerminatedEventAttributes) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Reason = &v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.Details = v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
 } else {
   p.Domain = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
   p.WorkflowExecution = &WorkflowExecution{}
   if err := p.WorkflowExecution.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
@@ -13368,7 +13692,7 @@ func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField20(iprot thr
   return nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
   p.WorkflowType = &WorkflowType{}
   if err := p.WorkflowType.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
@@ -13376,26 +13700,26 @@ func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField30(iprot thr
   return nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
+  return thrift.PrependError("error reading field 60: ", err)
 } else {
   p.InitiatedEventId = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
+func (p *ChildWorkflowExecutionFailedEventAttributes)  ReadField70(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
+  return thrift.PrependError("error reading field 70: ", err)
 } else {
   p.StartedEventId = &v
 }
   return nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ChildWorkflowExecutionTerminatedEventAttributes"); err != nil {
+func (p *ChildWorkflowExecutionFailedEventAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ChildWorkflowExecutionFailedEventAttributes"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -13403,6 +13727,8 @@ func (p *ChildWorkflowExecutionTerminatedEventAttributes) Write(oprot thrift.TPr
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -13411,658 +13737,2780 @@ func (p *ChildWorkflowExecutionTerminatedEventAttributes) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionFailedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetReason() {
+    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:reason: ", p), err) }
+    if err := oprot.WriteString(string(*p.Reason)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.reason (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:reason: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionFailedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDetails() {
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:details: ", p), err) }
+    if err := oprot.WriteBinary(p.Details); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.details (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:details: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionFailedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
   if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:domain: ", p), err) }
     if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.domain (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:domain: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionFailedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
   if p.IsSetWorkflowExecution() {
-    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowExecution: ", p), err) }
     if err := p.WorkflowExecution.Write(oprot); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowExecution: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionFailedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
   if p.IsSetWorkflowType() {
-    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:workflowType: ", p), err) }
     if err := p.WorkflowType.Write(oprot); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:workflowType: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionFailedEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
   if p.IsSetInitiatedEventId() {
-    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:initiatedEventId: ", p), err) }
+    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:initiatedEventId: ", p), err) }
     if err := oprot.WriteI64(int64(*p.InitiatedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (40) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (60) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:initiatedEventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:initiatedEventId: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
+func (p *ChildWorkflowExecutionFailedEventAttributes) writeField70(oprot thrift.TProtocol) (err error) {
   if p.IsSetStartedEventId() {
-    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:startedEventId: ", p), err) }
+    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:startedEventId: ", p), err) }
     if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (50) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (70) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:startedEventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:startedEventId: ", p), err) }
   }
   return err
 }
 
-func (p *ChildWorkflowExecutionTerminatedEventAttributes) String() string {
+func (p *ChildWorkflowExecutionFailedEventAttributes) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ChildWorkflowExecutionTerminatedEventAttributes(%+v)", *p)
+  return fmt.Sprintf("ChildWorkflowExecutionFailedEventAttributes(%+v)", *p)
 }
 
 // Attributes:
-//  - EventId
-//  - Timestamp
-//  - EventType
-//  - WorkflowExecutionStartedEventAttributes
-//  - WorkflowExecutionCompletedEventAttributes
-//  - WorkflowExecutionFailedEventAttributes
-//  - WorkflowExecutionTimedOutEventAttributes
-//  - DecisionTaskScheduledEventAttributes
-//  - DecisionTaskStartedEventAttributes
-//  - DecisionTaskCompletedEventAttributes
-//  - DecisionTaskTimedOutEventAttributes
-//  - DecisionTaskFailedEventAttributes
-//  - ActivityTaskScheduledEventAttributes
-//  - ActivityTaskStartedEventAttributes
-//  - ActivityTaskCompletedEventAttributes
-//  - ActivityTaskFailedEventAttributes
-//  - ActivityTaskTimedOutEventAttributes
-//  - TimerStartedEventAttributes
-//  - TimerFiredEventAttributes
-//  - ActivityTaskCancelRequestedEventAttributes
-//  - RequestCancelActivityTaskFailedEventAttributes
-//  - ActivityTaskCanceledEventAttributes
-//  - TimerCanceledEventAttributes
-//  - CancelTimerFailedEventAttributes
-//  - MarkerRecordedEventAttributes
-//  - WorkflowExecutionSignaledEventAttributes
-//  - WorkflowExecutionTerminatedEventAttributes
-//  - WorkflowExecutionCancelRequestedEventAttributes
-//  - WorkflowExecutionCanceledEventAttributes
-//  - RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
-//  - RequestCancelExternalWorkflowExecutionFailedEventAttributes
-//  - ExternalWorkflowExecutionCancelRequestedEventAttributes
-//  - WorkflowExecutionContinuedAsNewEventAttributes
-//  - StartChildWorkflowExecutionInitiatedEventAttributes
-//  - StartChildWorkflowExecutionFailedEventAttributes
-//  - ChildWorkflowExecutionStartedEventAttributes
-//  - ChildWorkflowExecutionCompletedEventAttributes
-//  - ChildWorkflowExecutionFailedEventAttributes
-//  - ChildWorkflowExecutionCanceledEventAttributes
-//  - ChildWorkflowExecutionTimedOutEventAttributes
-//  - ChildWorkflowExecutionTerminatedEventAttributes
-type HistoryEvent struct {
+//  - Details
+//  - Domain
+//  - WorkflowExecution
+//  - WorkflowType
+//  - InitiatedEventId
+//  - StartedEventId
+type ChildWorkflowExecutionCanceledEventAttributes struct {
   // unused fields # 1 to 9
-  EventId *int64 `thrift:"eventId,10" db:"eventId" json:"eventId,omitempty"`
+  Details []byte `thrift:"details,10" db:"details" json:"details,omitempty"`
   // unused fields # 11 to 19
-  Timestamp *int64 `thrift:"timestamp,20" db:"timestamp" json:"timestamp,omitempty"`
+  Domain *string `thrift:"domain,20" db:"domain" json:"domain,omitempty"`
   // unused fields # 21 to 29
-  EventType *EventType `thrift:"eventType,30" db:"eventType" json:"eventType,omitempty"`
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,30" db:"workflowExecution" json:"workflowExecution,omitempty"`
   // unused fields # 31 to 39
-  WorkflowExecutionStartedEventAttributes *WorkflowExecutionStartedEventAttributes `thrift:"workflowExecutionStartedEventAttributes,40" db:"workflowExecutionStartedEventAttributes" json:"workflowExecutionStartedEventAttributes,omitempty"`
+  WorkflowType *WorkflowType `thrift:"workflowType,40" db:"workflowType" json:"workflowType,omitempty"`
   // unused fields # 41 to 49
-  WorkflowExecutionCompletedEventAttributes *WorkflowExecutionCompletedEventAttributes `thrift:"workflowExecutionCompletedEventAttributes,50" db:"workflowExecutionCompletedEventAttributes" json:"workflowExecutionCompletedEventAttributes,omitempty"`
+  InitiatedEventId *int64 `thrift:"initiatedEventId,50" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
   // unused fields # 51 to 59
-  WorkflowExecutionFailedEventAttributes *WorkflowExecutionFailedEventAttributes `thrift:"workflowExecutionFailedEventAttributes,60" db:"workflowExecutionFailedEventAttributes" json:"workflowExecutionFailedEventAttributes,omitempty"`
-  // unused fields # 61 to 69
-  WorkflowExecutionTimedOutEventAttributes *WorkflowExecutionTimedOutEventAttributes `thrift:"workflowExecutionTimedOutEventAttributes,70" db:"workflowExecutionTimedOutEventAttributes" json:"workflowExecutionTimedOutEventAttributes,omitempty"`
-  // unused fields # 71 to 79
-  DecisionTaskScheduledEventAttributes *DecisionTaskScheduledEventAttributes `thrift:"decisionTaskScheduledEventAttributes,80" db:"decisionTaskScheduledEventAttributes" json:"decisionTaskScheduledEventAttributes,omitempty"`
-  // unused fields # 81 to 89
-  DecisionTaskStartedEventAttributes *DecisionTaskStartedEventAttributes `thrift:"decisionTaskStartedEventAttributes,90" db:"decisionTaskStartedEventAttributes" json:"decisionTaskStartedEventAttributes,omitempty"`
-  // unused fields # 91 to 99
-  DecisionTaskCompletedEventAttributes *DecisionTaskCompletedEventAttributes `thrift:"decisionTaskCompletedEventAttributes,100" db:"decisionTaskCompletedEventAttributes" json:"decisionTaskCompletedEventAttributes,omitempty"`
-  // unused fields # 101 to 109
-  DecisionTaskTimedOutEventAttributes *DecisionTaskTimedOutEventAttributes `thrift:"decisionTaskTimedOutEventAttributes,110" db:"decisionTaskTimedOutEventAttributes" json:"decisionTaskTimedOutEventAttributes,omitempty"`
-  // unused fields # 111 to 119
-  DecisionTaskFailedEventAttributes *DecisionTaskFailedEventAttributes `thrift:"decisionTaskFailedEventAttributes,120" db:"decisionTaskFailedEventAttributes" json:"decisionTaskFailedEventAttributes,omitempty"`
-  // unused fields # 121 to 129
-  ActivityTaskScheduledEventAttributes *ActivityTaskScheduledEventAttributes `thrift:"activityTaskScheduledEventAttributes,130" db:"activityTaskScheduledEventAttributes" json:"activityTaskScheduledEventAttributes,omitempty"`
-  // unused fields # 131 to 139
-  ActivityTaskStartedEventAttributes *ActivityTaskStartedEventAttributes `thrift:"activityTaskStartedEventAttributes,140" db:"activityTaskStartedEventAttributes" json:"activityTaskStartedEventAttributes,omitempty"`
-  // unused fields # 141 to 149
-  ActivityTaskCompletedEventAttributes *ActivityTaskCompletedEventAttributes `thrift:"activityTaskCompletedEventAttributes,150" db:"activityTaskCompletedEventAttributes" json:"activityTaskCompletedEventAttributes,omitempty"`
-  // unused fields # 151 to 159
-  ActivityTaskFailedEventAttributes *ActivityTaskFailedEventAttributes `thrift:"activityTaskFailedEventAttributes,160" db:"activityTaskFailedEventAttributes" json:"activityTaskFailedEventAttributes,omitempty"`
-  // unused fields # 161 to 169
-  ActivityTaskTimedOutEventAttributes *ActivityTaskTimedOutEventAttributes `thrift:"activityTaskTimedOutEventAttributes,170" db:"activityTaskTimedOutEventAttributes" json:"activityTaskTimedOutEventAttributes,omitempty"`
-  // unused fields # 171 to 179
-  TimerStartedEventAttributes *TimerStartedEventAttributes `thrift:"timerStartedEventAttributes,180" db:"timerStartedEventAttributes" json:"timerStartedEventAttributes,omitempty"`
-  // unused fields # 181 to 189
-  TimerFiredEventAttributes *TimerFiredEventAttributes `thrift:"timerFiredEventAttributes,190" db:"timerFiredEventAttributes" json:"timerFiredEventAttributes,omitempty"`
-  // unused fields # 191 to 199
-  ActivityTaskCancelRequestedEventAttributes *ActivityTaskCancelRequestedEventAttributes `thrift:"activityTaskCancelRequestedEventAttributes,200" db:"activityTaskCancelRequestedEventAttributes" json:"activityTaskCancelRequestedEventAttributes,omitempty"`
-  // unused fields # 201 to 209
-  RequestCancelActivityTaskFailedEventAttributes *RequestCancelActivityTaskFailedEventAttributes `thrift:"requestCancelActivityTaskFailedEventAttributes,210" db:"requestCancelActivityTaskFailedEventAttributes" json:"requestCancelActivityTaskFailedEventAttributes,omitempty"`
-  // unused fields # 211 to 219
-  ActivityTaskCanceledEventAttributes *ActivityTaskCanceledEventAttributes `thrift:"activityTaskCanceledEventAttributes,220" db:"activityTaskCanceledEventAttributes" json:"activityTaskCanceledEventAttributes,omitempty"`
-  // unused fields # 221 to 229
-  TimerCanceledEventAttributes *TimerCanceledEventAttributes `thrift:"timerCanceledEventAttributes,230" db:"timerCanceledEventAttributes" json:"timerCanceledEventAttributes,omitempty"`
-  // unused fields # 231 to 239
-  CancelTimerFailedEventAttributes *CancelTimerFailedEventAttributes `thrift:"cancelTimerFailedEventAttributes,240" db:"cancelTimerFailedEventAttributes" json:"cancelTimerFailedEventAttributes,omitempty"`
-  // unused fields # 241 to 249
-  MarkerRecordedEventAttributes *MarkerRecordedEventAttributes `thrift:"markerRecordedEventAttributes,250" db:"markerRecordedEventAttributes" json:"markerRecordedEventAttributes,omitempty"`
-  // unused fields # 251 to 259
-  WorkflowExecutionSignaledEventAttributes *WorkflowExecutionSignaledEventAttributes `thrift:"workflowExecutionSignaledEventAttributes,260" db:"workflowExecutionSignaledEventAttributes" json:"workflowExecutionSignaledEventAttributes,omitempty"`
-  // unused fields # 261 to 269
-  WorkflowExecutionTerminatedEventAttributes *WorkflowExecutionTerminatedEventAttributes `thrift:"workflowExecutionTerminatedEventAttributes,270" db:"workflowExecutionTerminatedEventAttributes" json:"workflowExecutionTerminatedEventAttributes,omitempty"`
-  // unused fields # 271 to 279
-  WorkflowExecutionCancelRequestedEventAttributes *WorkflowExecutionCancelRequestedEventAttributes `thrift:"workflowExecutionCancelRequestedEventAttributes,280" db:"workflowExecutionCancelRequestedEventAttributes" json:"workflowExecutionCancelRequestedEventAttributes,omitempty"`
-  // unused fields # 281 to 289
-  WorkflowExecutionCanceledEventAttributes *WorkflowExecutionCanceledEventAttributes `thrift:"workflowExecutionCanceledEventAttributes,290" db:"workflowExecutionCanceledEventAttributes" json:"workflowExecutionCanceledEventAttributes,omitempty"`
-  // unused fields # 291 to 299
-  RequestCancelExternalWorkflowExecutionInitiatedEventAttributes *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes `thrift:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes,300" db:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes" json:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
-  // unused fields # 301 to 309
-  RequestCancelExternalWorkflowExecutionFailedEventAttributes *RequestCancelExternalWorkflowExecutionFailedEventAttributes `thrift:"requestCancelExternalWorkflowExecutionFailedEventAttributes,310" db:"requestCancelExternalWorkflowExecutionFailedEventAttributes" json:"requestCancelExternalWorkflowExecutionFailedEventAttributes,omitempty"`
-  // unused fields # 311 to 319
-  ExternalWorkflowExecutionCancelRequestedEventAttributes *ExternalWorkflowExecutionCancelRequestedEventAttributes `thrift:"externalWorkflowExecutionCancelRequestedEventAttributes,320" db:"externalWorkflowExecutionCancelRequestedEventAttributes" json:"externalWorkflowExecutionCancelRequestedEventAttributes,omitempty"`
-  // unused fields # 321 to 329
-  WorkflowExecutionContinuedAsNewEventAttributes *WorkflowExecutionContinuedAsNewEventAttributes `thrift:"workflowExecutionContinuedAsNewEventAttributes,330" db:"workflowExecutionContinuedAsNewEventAttributes" json:"workflowExecutionContinuedAsNewEventAttributes,omitempty"`
-  // unused fields # 331 to 339
-  StartChildWorkflowExecutionInitiatedEventAttributes *StartChildWorkflowExecutionInitiatedEventAttributes `thrift:"startChildWorkflowExecutionInitiatedEventAttributes,340" db:"startChildWorkflowExecutionInitiatedEventAttributes" json:"startChildWorkflowExecutionInitiatedEventAttributes,omitempty"`
-  // unused fields # 341 to 349
-  StartChildWorkflowExecutionFailedEventAttributes *StartChildWorkflowExecutionFailedEventAttributes `thrift:"startChildWorkflowExecutionFailedEventAttributes,350" db:"startChildWorkflowExecutionFailedEventAttributes" json:"startChildWorkflowExecutionFailedEventAttributes,omitempty"`
-  // unused fields # 351 to 359
-  ChildWorkflowExecutionStartedEventAttributes *ChildWorkflowExecutionStartedEventAttributes `thrift:"childWorkflowExecutionStartedEventAttributes,360" db:"childWorkflowExecutionStartedEventAttributes" json:"childWorkflowExecutionStartedEventAttributes,omitempty"`
-  // unused fields # 361 to 369
-  ChildWorkflowExecutionCompletedEventAttributes *ChildWorkflowExecutionCompletedEventAttributes `thrift:"childWorkflowExecutionCompletedEventAttributes,370" db:"childWorkflowExecutionCompletedEventAttributes" json:"childWorkflowExecutionCompletedEventAttributes,omitempty"`
-  // unused fields # 371 to 379
-  ChildWorkflowExecutionFailedEventAttributes *ChildWorkflowExecutionFailedEventAttributes `thrift:"childWorkflowExecutionFailedEventAttributes,380" db:"childWorkflowExecutionFailedEventAttributes" json:"childWorkflowExecutionFailedEventAttributes,omitempty"`
-  // unused fields # 381 to 389
-  ChildWorkflowExecutionCanceledEventAttributes *ChildWorkflowExecutionCanceledEventAttributes `thrift:"childWorkflowExecutionCanceledEventAttributes,390" db:"childWorkflowExecutionCanceledEventAttributes" json:"childWorkflowExecutionCanceledEventAttributes,omitempty"`
-  // unused fields # 391 to 399
-  ChildWorkflowExecutionTimedOutEventAttributes *ChildWorkflowExecutionTimedOutEventAttributes `thrift:"childWorkflowExecutionTimedOutEventAttributes,400" db:"childWorkflowExecutionTimedOutEventAttributes" json:"childWorkflowExecutionTimedOutEventAttributes,omitempty"`
-  // unused fields # 401 to 409
-  ChildWorkflowExecutionTerminatedEventAttributes *ChildWorkflowExecutionTerminatedEventAttributes `thrift:"childWorkflowExecutionTerminatedEventAttributes,410" db:"childWorkflowExecutionTerminatedEventAttributes" json:"childWorkflowExecutionTerminatedEventAttributes,omitempty"`
+  StartedEventId *int64 `thrift:"startedEventId,60" db:"startedEventId" json:"startedEventId,omitempty"`
 }
 
-func NewHistoryEvent() *HistoryEvent {
-  return &HistoryEvent{}
+func NewChildWorkflowExecutionCanceledEventAttributes() *ChildWorkflowExecutionCanceledEventAttributes {
+  return &ChildWorkflowExecutionCanceledEventAttributes{}
 }
 
-var HistoryEvent_EventId_DEFAULT int64
-func (p *HistoryEvent) GetEventId() int64 {
-  if !p.IsSetEventId() {
-    return HistoryEvent_EventId_DEFAULT
-  }
-return *p.EventId
+var ChildWorkflowExecutionCanceledEventAttributes_Details_DEFAULT []byte
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) GetDetails() []byte {
+  return p.Details
 }
-var HistoryEvent_Timestamp_DEFAULT int64
-func (p *HistoryEvent) GetTimestamp() int64 {
-  if !p.IsSetTimestamp() {
-    return HistoryEvent_Timestamp_DEFAULT
+var ChildWorkflowExecutionCanceledEventAttributes_Domain_DEFAULT string
+func (p *ChildWorkflowExecutionCanceledEventAttributes) GetDomain() string {
+  if !p.IsSetDomain() {
+    return ChildWorkflowExecutionCanceledEventAttributes_Domain_DEFAULT
   }
-return *p.Timestamp
+return *p.Domain
 }
-var HistoryEvent_EventType_DEFAULT EventType
-func (p *HistoryEvent) GetEventType() EventType {
-  if !p.IsSetEventType() {
-    return HistoryEvent_EventType_DEFAULT
+var ChildWorkflowExecutionCanceledEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *ChildWorkflowExecutionCanceledEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return ChildWorkflowExecutionCanceledEventAttributes_WorkflowExecution_DEFAULT
   }
-return *p.EventType
+return p.WorkflowExecution
 }
-var HistoryEvent_WorkflowExecutionStartedEventAttributes_DEFAULT *WorkflowExecutionStartedEventAttributes
-func (p *HistoryEvent) GetWorkflowExecutionStartedEventAttributes() *WorkflowExecutionStartedEventAttributes {
-  if !p.IsSetWorkflowExecutionStartedEventAttributes() {
+var ChildWorkflowExecutionCanceledEventAttributes_WorkflowType_DEFAULT *WorkflowType
+func (p *ChildWorkflowExecutionCanceledEventAttributes) GetWorkflowType() *WorkflowType {
+  if !p.IsSetWorkflowType() {
+    return ChildWorkflowExecutionCanceledEventAttributes_WorkflowType_DEFAULT
+  }
+return p.WorkflowType
+}
+var ChildWorkflowExecutionCanceledEventAttributes_InitiatedEventId_DEFAULT int64
+func (p *ChildWorkflowExecutionCanceledEventAttributes) GetInitiatedEventId() int64 {
+  if !p.IsSetInitiatedEventId() {
+    return ChildWorkflowExecutionCanceledEventAttributes_InitiatedEventId_DEFAULT
+  }
+return *p.InitiatedEventId
+}
+var ChildWorkflowExecutionCanceledEventAttributes_StartedEventId_DEFAULT int64
+func (p *ChildWorkflowExecutionCanceledEventAttributes) GetStartedEventId() int64 {
+  if !p.IsSetStartedEventId() {
+    return ChildWorkflowExecutionCanceledEventAttributes_StartedEventId_DEFAULT
+  }
+return *p.StartedEventId
+}
+func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetDetails() bool {
+  return p.Details != nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetInitiatedEventId() bool {
+  return p.InitiatedEventId != nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) IsSetStartedEventId() bool {
+  return p.StartedEventId != nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Details = v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
+  }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
+  p.WorkflowType = &WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+  }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.InitiatedEventId = &v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.StartedEventId = &v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ChildWorkflowExecutionCanceledEventAttributes"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDetails() {
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:details: ", p), err) }
+    if err := oprot.WriteBinary(p.Details); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.details (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:details: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowExecution: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowType: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInitiatedEventId() {
+    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:initiatedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.InitiatedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:initiatedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedEventId() {
+    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:startedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:startedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionCanceledEventAttributes) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ChildWorkflowExecutionCanceledEventAttributes(%+v)", *p)
+}
+
+// Attributes:
+//  - TimeoutType
+//  - Domain
+//  - WorkflowExecution
+//  - WorkflowType
+//  - InitiatedEventId
+//  - StartedEventId
+type ChildWorkflowExecutionTimedOutEventAttributes struct {
+  // unused fields # 1 to 9
+  TimeoutType *TimeoutType `thrift:"timeoutType,10" db:"timeoutType" json:"timeoutType,omitempty"`
+  // unused fields # 11 to 19
+  Domain *string `thrift:"domain,20" db:"domain" json:"domain,omitempty"`
+  // unused fields # 21 to 29
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,30" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  // unused fields # 31 to 39
+  WorkflowType *WorkflowType `thrift:"workflowType,40" db:"workflowType" json:"workflowType,omitempty"`
+  // unused fields # 41 to 49
+  InitiatedEventId *int64 `thrift:"initiatedEventId,50" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
+  // unused fields # 51 to 59
+  StartedEventId *int64 `thrift:"startedEventId,60" db:"startedEventId" json:"startedEventId,omitempty"`
+}
+
+func NewChildWorkflowExecutionTimedOutEventAttributes() *ChildWorkflowExecutionTimedOutEventAttributes {
+  return &ChildWorkflowExecutionTimedOutEventAttributes{}
+}
+
+var ChildWorkflowExecutionTimedOutEventAttributes_TimeoutType_DEFAULT TimeoutType
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetTimeoutType() TimeoutType {
+  if !p.IsSetTimeoutType() {
+    return ChildWorkflowExecutionTimedOutEventAttributes_TimeoutType_DEFAULT
+  }
+return *p.TimeoutType
+}
+var ChildWorkflowExecutionTimedOutEventAttributes_Domain_DEFAULT string
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetDomain() string {
+  if !p.IsSetDomain() {
+    return ChildWorkflowExecutionTimedOutEventAttributes_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var ChildWorkflowExecutionTimedOutEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return ChildWorkflowExecutionTimedOutEventAttributes_WorkflowExecution_DEFAULT
+  }
+return p.WorkflowExecution
+}
+var ChildWorkflowExecutionTimedOutEventAttributes_WorkflowType_DEFAULT *WorkflowType
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetWorkflowType() *WorkflowType {
+  if !p.IsSetWorkflowType() {
+    return ChildWorkflowExecutionTimedOutEventAttributes_WorkflowType_DEFAULT
+  }
+return p.WorkflowType
+}
+var ChildWorkflowExecutionTimedOutEventAttributes_InitiatedEventId_DEFAULT int64
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetInitiatedEventId() int64 {
+  if !p.IsSetInitiatedEventId() {
+    return ChildWorkflowExecutionTimedOutEventAttributes_InitiatedEventId_DEFAULT
+  }
+return *p.InitiatedEventId
+}
+var ChildWorkflowExecutionTimedOutEventAttributes_StartedEventId_DEFAULT int64
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) GetStartedEventId() int64 {
+  if !p.IsSetStartedEventId() {
+    return ChildWorkflowExecutionTimedOutEventAttributes_StartedEventId_DEFAULT
+  }
+return *p.StartedEventId
+}
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetTimeoutType() bool {
+  return p.TimeoutType != nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetInitiatedEventId() bool {
+  return p.InitiatedEventId != nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) IsSetStartedEventId() bool {
+  return p.StartedEventId != nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  temp := TimeoutType(v)
+  p.TimeoutType = &temp
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
+  }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
+  p.WorkflowType = &WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+  }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.InitiatedEventId = &v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.StartedEventId = &v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ChildWorkflowExecutionTimedOutEventAttributes"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTimeoutType() {
+    if err := oprot.WriteFieldBegin("timeoutType", thrift.I32, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:timeoutType: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.TimeoutType)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.timeoutType (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:timeoutType: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowExecution: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowType: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInitiatedEventId() {
+    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:initiatedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.InitiatedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:initiatedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedEventId() {
+    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:startedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:startedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionTimedOutEventAttributes) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ChildWorkflowExecutionTimedOutEventAttributes(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - WorkflowExecution
+//  - WorkflowType
+//  - InitiatedEventId
+//  - StartedEventId
+type ChildWorkflowExecutionTerminatedEventAttributes struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  // unused fields # 21 to 29
+  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
+  // unused fields # 31 to 39
+  InitiatedEventId *int64 `thrift:"initiatedEventId,40" db:"initiatedEventId" json:"initiatedEventId,omitempty"`
+  // unused fields # 41 to 49
+  StartedEventId *int64 `thrift:"startedEventId,50" db:"startedEventId" json:"startedEventId,omitempty"`
+}
+
+func NewChildWorkflowExecutionTerminatedEventAttributes() *ChildWorkflowExecutionTerminatedEventAttributes {
+  return &ChildWorkflowExecutionTerminatedEventAttributes{}
+}
+
+var ChildWorkflowExecutionTerminatedEventAttributes_Domain_DEFAULT string
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) GetDomain() string {
+  if !p.IsSetDomain() {
+    return ChildWorkflowExecutionTerminatedEventAttributes_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var ChildWorkflowExecutionTerminatedEventAttributes_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return ChildWorkflowExecutionTerminatedEventAttributes_WorkflowExecution_DEFAULT
+  }
+return p.WorkflowExecution
+}
+var ChildWorkflowExecutionTerminatedEventAttributes_WorkflowType_DEFAULT *WorkflowType
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) GetWorkflowType() *WorkflowType {
+  if !p.IsSetWorkflowType() {
+    return ChildWorkflowExecutionTerminatedEventAttributes_WorkflowType_DEFAULT
+  }
+return p.WorkflowType
+}
+var ChildWorkflowExecutionTerminatedEventAttributes_InitiatedEventId_DEFAULT int64
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) GetInitiatedEventId() int64 {
+  if !p.IsSetInitiatedEventId() {
+    return ChildWorkflowExecutionTerminatedEventAttributes_InitiatedEventId_DEFAULT
+  }
+return *p.InitiatedEventId
+}
+var ChildWorkflowExecutionTerminatedEventAttributes_StartedEventId_DEFAULT int64
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) GetStartedEventId() int64 {
+  if !p.IsSetStartedEventId() {
+    return ChildWorkflowExecutionTerminatedEventAttributes_StartedEventId_DEFAULT
+  }
+return *p.StartedEventId
+}
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) IsSetInitiatedEventId() bool {
+  return p.InitiatedEventId != nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) IsSetStartedEventId() bool {
+  return p.StartedEventId != nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField20(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
+  }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowType = &WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+  }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.InitiatedEventId = &v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.StartedEventId = &v
+}
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ChildWorkflowExecutionTerminatedEventAttributes"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInitiatedEventId() {
+    if err := oprot.WriteFieldBegin("initiatedEventId", thrift.I64, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:initiatedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.InitiatedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.initiatedEventId (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:initiatedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedEventId() {
+    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:startedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:startedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildWorkflowExecutionTerminatedEventAttributes) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ChildWorkflowExecutionTerminatedEventAttributes(%+v)", *p)
+}
+
+// Attributes:
+//  - EventId
+//  - Timestamp
+//  - EventType
+//  - WorkflowExecutionStartedEventAttributes
+//  - WorkflowExecutionCompletedEventAttributes
+//  - WorkflowExecutionFailedEventAttributes
+//  - WorkflowExecutionTimedOutEventAttributes
+//  - DecisionTaskScheduledEventAttributes
+//  - DecisionTaskStartedEventAttributes
+//  - DecisionTaskCompletedEventAttributes
+//  - DecisionTaskTimedOutEventAttributes
+//  - DecisionTaskFailedEventAttributes
+//  - ActivityTaskScheduledEventAttributes
+//  - ActivityTaskStartedEventAttributes
+//  - ActivityTaskCompletedEventAttributes
+//  - ActivityTaskFailedEventAttributes
+//  - ActivityTaskTimedOutEventAttributes
+//  - TimerStartedEventAttributes
+//  - TimerFiredEventAttributes
+//  - ActivityTaskCancelRequestedEventAttributes
+//  - RequestCancelActivityTaskFailedEventAttributes
+//  - ActivityTaskCanceledEventAttributes
+//  - TimerCanceledEventAttributes
+//  - CancelTimerFailedEventAttributes
+//  - MarkerRecordedEventAttributes
+//  - WorkflowExecutionSignaledEventAttributes
+//  - WorkflowExecutionTerminatedEventAttributes
+//  - WorkflowExecutionCancelRequestedEventAttributes
+//  - WorkflowExecutionCanceledEventAttributes
+//  - RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+//  - RequestCancelExternalWorkflowExecutionFailedEventAttributes
+//  - ExternalWorkflowExecutionCancelRequestedEventAttributes
+//  - WorkflowExecutionContinuedAsNewEventAttributes
+//  - StartChildWorkflowExecutionInitiatedEventAttributes
+//  - StartChildWorkflowExecutionFailedEventAttributes
+//  - ChildWorkflowExecutionStartedEventAttributes
+//  - ChildWorkflowExecutionCompletedEventAttributes
+//  - ChildWorkflowExecutionFailedEventAttributes
+//  - ChildWorkflowExecutionCanceledEventAttributes
+//  - ChildWorkflowExecutionTimedOutEventAttributes
+//  - ChildWorkflowExecutionTerminatedEventAttributes
+//  - SignalExternalWorkflowExecutionInitiatedEventAttributes
+//  - SignalExternalWorkflowExecutionFailedEventAttributes
+//  - ExternalWorkflowExecutionSignaledEventAttributes
+type HistoryEvent struct {
+  // unused fields # 1 to 9
+  EventId *int64 `thrift:"eventId,10" db:"eventId" json:"eventId,omitempty"`
+  // unused fields # 11 to 19
+  Timestamp *int64 `thrift:"timestamp,20" db:"timestamp" json:"timestamp,omitempty"`
+  // unused fields # 21 to 29
+  EventType *EventType `thrift:"eventType,30" db:"eventType" json:"eventType,omitempty"`
+  // unused fields # 31 to 39
+  WorkflowExecutionStartedEventAttributes *WorkflowExecutionStartedEventAttributes `thrift:"workflowExecutionStartedEventAttributes,40" db:"workflowExecutionStartedEventAttributes" json:"workflowExecutionStartedEventAttributes,omitempty"`
+  // unused fields # 41 to 49
+  WorkflowExecutionCompletedEventAttributes *WorkflowExecutionCompletedEventAttributes `thrift:"workflowExecutionCompletedEventAttributes,50" db:"workflowExecutionCompletedEventAttributes" json:"workflowExecutionCompletedEventAttributes,omitempty"`
+  // unused fields # 51 to 59
+  WorkflowExecutionFailedEventAttributes *WorkflowExecutionFailedEventAttributes `thrift:"workflowExecutionFailedEventAttributes,60" db:"workflowExecutionFailedEventAttributes" json:"workflowExecutionFailedEventAttributes,omitempty"`
+  // unused fields # 61 to 69
+  WorkflowExecutionTimedOutEventAttributes *WorkflowExecutionTimedOutEventAttributes `thrift:"workflowExecutionTimedOutEventAttributes,70" db:"workflowExecutionTimedOutEventAttributes" json:"workflowExecutionTimedOutEventAttributes,omitempty"`
+  // unused fields # 71 to 79
+  DecisionTaskScheduledEventAttributes *DecisionTaskScheduledEventAttributes `thrift:"decisionTaskScheduledEventAttributes,80" db:"decisionTaskScheduledEventAttributes" json:"decisionTaskScheduledEventAttributes,omitempty"`
+  // unused fields # 81 to 89
+  DecisionTaskStartedEventAttributes *DecisionTaskStartedEventAttributes `thrift:"decisionTaskStartedEventAttributes,90" db:"decisionTaskStartedEventAttributes" json:"decisionTaskStartedEventAttributes,omitempty"`
+  // unused fields # 91 to 99
+  DecisionTaskCompletedEventAttributes *DecisionTaskCompletedEventAttributes `thrift:"decisionTaskCompletedEventAttributes,100" db:"decisionTaskCompletedEventAttributes" json:"decisionTaskCompletedEventAttributes,omitempty"`
+  // unused fields # 101 to 109
+  DecisionTaskTimedOutEventAttributes *DecisionTaskTimedOutEventAttributes `thrift:"decisionTaskTimedOutEventAttributes,110" db:"decisionTaskTimedOutEventAttributes" json:"decisionTaskTimedOutEventAttributes,omitempty"`
+  // unused fields # 111 to 119
+  DecisionTaskFailedEventAttributes *DecisionTaskFailedEventAttributes `thrift:"decisionTaskFailedEventAttributes,120" db:"decisionTaskFailedEventAttributes" json:"decisionTaskFailedEventAttributes,omitempty"`
+  // unused fields # 121 to 129
+  ActivityTaskScheduledEventAttributes *ActivityTaskScheduledEventAttributes `thrift:"activityTaskScheduledEventAttributes,130" db:"activityTaskScheduledEventAttributes" json:"activityTaskScheduledEventAttributes,omitempty"`
+  // unused fields # 131 to 139
+  ActivityTaskStartedEventAttributes *ActivityTaskStartedEventAttributes `thrift:"activityTaskStartedEventAttributes,140" db:"activityTaskStartedEventAttributes" json:"activityTaskStartedEventAttributes,omitempty"`
+  // unused fields # 141 to 149
+  ActivityTaskCompletedEventAttributes *ActivityTaskCompletedEventAttributes `thrift:"activityTaskCompletedEventAttributes,150" db:"activityTaskCompletedEventAttributes" json:"activityTaskCompletedEventAttributes,omitempty"`
+  // unused fields # 151 to 159
+  ActivityTaskFailedEventAttributes *ActivityTaskFailedEventAttributes `thrift:"activityTaskFailedEventAttributes,160" db:"activityTaskFailedEventAttributes" json:"activityTaskFailedEventAttributes,omitempty"`
+  // unused fields # 161 to 169
+  ActivityTaskTimedOutEventAttributes *ActivityTaskTimedOutEventAttributes `thrift:"activityTaskTimedOutEventAttributes,170" db:"activityTaskTimedOutEventAttributes" json:"activityTaskTimedOutEventAttributes,omitempty"`
+  // unused fields # 171 to 179
+  TimerStartedEventAttributes *TimerStartedEventAttributes `thrift:"timerStartedEventAttributes,180" db:"timerStartedEventAttributes" json:"timerStartedEventAttributes,omitempty"`
+  // unused fields # 181 to 189
+  TimerFiredEventAttributes *TimerFiredEventAttributes `thrift:"timerFiredEventAttributes,190" db:"timerFiredEventAttributes" json:"timerFiredEventAttributes,omitempty"`
+  // unused fields # 191 to 199
+  ActivityTaskCancelRequestedEventAttributes *ActivityTaskCancelRequestedEventAttributes `thrift:"activityTaskCancelRequestedEventAttributes,200" db:"activityTaskCancelRequestedEventAttributes" json:"activityTaskCancelRequestedEventAttributes,omitempty"`
+  // unused fields # 201 to 209
+  RequestCancelActivityTaskFailedEventAttributes *RequestCancelActivityTaskFailedEventAttributes `thrift:"requestCancelActivityTaskFailedEventAttributes,210" db:"requestCancelActivityTaskFailedEventAttributes" json:"requestCancelActivityTaskFailedEventAttributes,omitempty"`
+  // unused fields # 211 to 219
+  ActivityTaskCanceledEventAttributes *ActivityTaskCanceledEventAttributes `thrift:"activityTaskCanceledEventAttributes,220" db:"activityTaskCanceledEventAttributes" json:"activityTaskCanceledEventAttributes,omitempty"`
+  // unused fields # 221 to 229
+  TimerCanceledEventAttributes *TimerCanceledEventAttributes `thrift:"timerCanceledEventAttributes,230" db:"timerCanceledEventAttributes" json:"timerCanceledEventAttributes,omitempty"`
+  // unused fields # 231 to 239
+  CancelTimerFailedEventAttributes *CancelTimerFailedEventAttributes `thrift:"cancelTimerFailedEventAttributes,240" db:"cancelTimerFailedEventAttributes" json:"cancelTimerFailedEventAttributes,omitempty"`
+  // unused fields # 241 to 249
+  MarkerRecordedEventAttributes *MarkerRecordedEventAttributes `thrift:"markerRecordedEventAttributes,250" db:"markerRecordedEventAttributes" json:"markerRecordedEventAttributes,omitempty"`
+  // unused fields # 251 to 259
+  WorkflowExecutionSignaledEventAttributes *WorkflowExecutionSignaledEventAttributes `thrift:"workflowExecutionSignaledEventAttributes,260" db:"workflowExecutionSignaledEventAttributes" json:"workflowExecutionSignaledEventAttributes,omitempty"`
+  // unused fields # 261 to 269
+  WorkflowExecutionTerminatedEventAttributes *WorkflowExecutionTerminatedEventAttributes `thrift:"workflowExecutionTerminatedEventAttributes,270" db:"workflowExecutionTerminatedEventAttributes" json:"workflowExecutionTerminatedEventAttributes,omitempty"`
+  // unused fields # 271 to 279
+  WorkflowExecutionCancelRequestedEventAttributes *WorkflowExecutionCancelRequestedEventAttributes `thrift:"workflowExecutionCancelRequestedEventAttributes,280" db:"workflowExecutionCancelRequestedEventAttributes" json:"workflowExecutionCancelRequestedEventAttributes,omitempty"`
+  // unused fields # 281 to 289
+  WorkflowExecutionCanceledEventAttributes *WorkflowExecutionCanceledEventAttributes `thrift:"workflowExecutionCanceledEventAttributes,290" db:"workflowExecutionCanceledEventAttributes" json:"workflowExecutionCanceledEventAttributes,omitempty"`
+  // unused fields # 291 to 299
+  RequestCancelExternalWorkflowExecutionInitiatedEventAttributes *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes `thrift:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes,300" db:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes" json:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
+  // unused fields # 301 to 309
+  RequestCancelExternalWorkflowExecutionFailedEventAttributes *RequestCancelExternalWorkflowExecutionFailedEventAttributes `thrift:"requestCancelExternalWorkflowExecutionFailedEventAttributes,310" db:"requestCancelExternalWorkflowExecutionFailedEventAttributes" json:"requestCancelExternalWorkflowExecutionFailedEventAttributes,omitempty"`
+  // unused fields # 311 to 319
+  ExternalWorkflowExecutionCancelRequestedEventAttributes *ExternalWorkflowExecutionCancelRequestedEventAttributes `thrift:"externalWorkflowExecutionCancelRequestedEventAttributes,320" db:"externalWorkflowExecutionCancelRequestedEventAttributes" json:"externalWorkflowExecutionCancelRequestedEventAttributes,omitempty"`
+  // unused fields # 321 to 329
+  WorkflowExecutionContinuedAsNewEventAttributes *WorkflowExecutionContinuedAsNewEventAttributes `thrift:"workflowExecutionContinuedAsNewEventAttributes,330" db:"workflowExecutionContinuedAsNewEventAttributes" json:"workflowExecutionContinuedAsNewEventAttributes,omitempty"`
+  // unused fields # 331 to 339
+  StartChildWorkflowExecutionInitiatedEventAttributes *StartChildWorkflowExecutionInitiatedEventAttributes `thrift:"startChildWorkflowExecutionInitiatedEventAttributes,340" db:"startChildWorkflowExecutionInitiatedEventAttributes" json:"startChildWorkflowExecutionInitiatedEventAttributes,omitempty"`
+  // unused fields # 341 to 349
+  StartChildWorkflowExecutionFailedEventAttributes *StartChildWorkflowExecutionFailedEventAttributes `thrift:"startChildWorkflowExecutionFailedEventAttributes,350" db:"startChildWorkflowExecutionFailedEventAttributes" json:"startChildWorkflowExecutionFailedEventAttributes,omitempty"`
+  // unused fields # 351 to 359
+  ChildWorkflowExecutionStartedEventAttributes *ChildWorkflowExecutionStartedEventAttributes `thrift:"childWorkflowExecutionStartedEventAttributes,360" db:"childWorkflowExecutionStartedEventAttributes" json:"childWorkflowExecutionStartedEventAttributes,omitempty"`
+  // unused fields # 361 to 369
+  ChildWorkflowExecutionCompletedEventAttributes *ChildWorkflowExecutionCompletedEventAttributes `thrift:"childWorkflowExecutionCompletedEventAttributes,370" db:"childWorkflowExecutionCompletedEventAttributes" json:"childWorkflowExecutionCompletedEventAttributes,omitempty"`
+  // unused fields # 371 to 379
+  ChildWorkflowExecutionFailedEventAttributes *ChildWorkflowExecutionFailedEventAttributes `thrift:"childWorkflowExecutionFailedEventAttributes,380" db:"childWorkflowExecutionFailedEventAttributes" json:"childWorkflowExecutionFailedEventAttributes,omitempty"`
+  // unused fields # 381 to 389
+  ChildWorkflowExecutionCanceledEventAttributes *ChildWorkflowExecutionCanceledEventAttributes `thrift:"childWorkflowExecutionCanceledEventAttributes,390" db:"childWorkflowExecutionCanceledEventAttributes" json:"childWorkflowExecutionCanceledEventAttributes,omitempty"`
+  // unused fields # 391 to 399
+  ChildWorkflowExecutionTimedOutEventAttributes *ChildWorkflowExecutionTimedOutEventAttributes `thrift:"childWorkflowExecutionTimedOutEventAttributes,400" db:"childWorkflowExecutionTimedOutEventAttributes" json:"childWorkflowExecutionTimedOutEventAttributes,omitempty"`
+  // unused fields # 401 to 409
+  ChildWorkflowExecutionTerminatedEventAttributes *ChildWorkflowExecutionTerminatedEventAttributes `thrift:"childWorkflowExecutionTerminatedEventAttributes,410" db:"childWorkflowExecutionTerminatedEventAttributes" json:"childWorkflowExecutionTerminatedEventAttributes,omitempty"`
+  // unused fields # 411 to 419
+  SignalExternalWorkflowExecutionInitiatedEventAttributes *SignalExternalWorkflowExecutionInitiatedEventAttributes `thrift:"signalExternalWorkflowExecutionInitiatedEventAttributes,420" db:"signalExternalWorkflowExecutionInitiatedEventAttributes" json:"signalExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
+  // unused fields # 421 to 429
+  SignalExternalWorkflowExecutionFailedEventAttributes *SignalExternalWorkflowExecutionFailedEventAttributes `thrift:"signalExternalWorkflowExecutionFailedEventAttributes,430" db:"signalExternalWorkflowExecutionFailedEventAttributes" json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
+  // unused fields # 431 to 439
+  ExternalWorkflowExecutionSignaledEventAttributes *ExternalWorkflowExecutionSignaledEventAttributes `thrift:"externalWorkflowExecutionSignaledEventAttributes,440" db:"externalWorkflowExecutionSignaledEventAttributes" json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
+}
+
+func NewHistoryEvent() *HistoryEvent {
+  return &HistoryEvent{}
+}
+
+var HistoryEvent_EventId_DEFAULT int64
+func (p *HistoryEvent) GetEventId() int64 {
+  if !p.IsSetEventId() {
+    return HistoryEvent_EventId_DEFAULT
+  }
+return *p.EventId
+}
+var HistoryEvent_Timestamp_DEFAULT int64
+func (p *HistoryEvent) GetTimestamp() int64 {
+  if !p.IsSetTimestamp() {
+    return HistoryEvent_Timestamp_DEFAULT
+  }
+return *p.Timestamp
+}
+var HistoryEvent_EventType_DEFAULT EventType
+func (p *HistoryEvent) GetEventType() EventType {
+  if !p.IsSetEventType() {
+    return HistoryEvent_EventType_DEFAULT
+  }
+return *p.EventType
+}
+var HistoryEvent_WorkflowExecutionStartedEventAttributes_DEFAULT *WorkflowExecutionStartedEventAttributes
+func (p *HistoryEvent) GetWorkflowExecutionStartedEventAttributes() *WorkflowExecutionStartedEventAttributes {
+  if !p.IsSetWorkflowExecutionStartedEventAttributes() {
     return HistoryEvent_WorkflowExecutionStartedEventAttributes_DEFAULT
   }
-return p.WorkflowExecutionStartedEventAttributes
+return p.WorkflowExecutionStartedEventAttributes
+}
+var HistoryEvent_WorkflowExecutionCompletedEventAttributes_DEFAULT *WorkflowExecutionCompletedEventAttributes
+func (p *HistoryEvent) GetWorkflowExecutionCompletedEventAttributes() *WorkflowExecutionCompletedEventAttributes {
+  if !p.IsSetWorkflowExecutionCompletedEventAttributes() {
+    return HistoryEvent_WorkflowExecutionCompletedEventAttributes_DEFAULT
+  }
+return p.WorkflowExecutionCompletedEventAttributes
+}
+var HistoryEvent_WorkflowExecutionFailedEventAttributes_DEFAULT *WorkflowExecutionFailedEventAttributes
+func (p *HistoryEvent) GetWorkflowExecutionFailedEventAttributes() *WorkflowExecutionFailedEventAttributes {
+  if !p.IsSetWorkflowExecutionFailedEventAttributes() {
+    return HistoryEvent_WorkflowExecutionFailedEventAttributes_DEFAULT
+  }
+return p.WorkflowExecutionFailedEventAttributes
+}
+var HistoryEvent_WorkflowExecutionTimedOutEventAttributes_DEFAULT *WorkflowExecutionTimedOutEventAttributes
+func (p *HistoryEvent) GetWorkflowExecutionTimedOutEventAttributes() *WorkflowExecutionTimedOutEventAttributes {
+  if !p.IsSetWorkflowExecutionTimedOutEventAttributes() {
+    return HistoryEvent_WorkflowExecutionTimedOutEventAttributes_DEFAULT
+  }
+return p.WorkflowExecutionTimedOutEventAttributes
+}
+var HistoryEvent_DecisionTaskScheduledEventAttributes_DEFAULT *DecisionTaskScheduledEventAttributes
+func (p *HistoryEvent) GetDecisionTaskScheduledEventAttributes() *DecisionTaskScheduledEventAttributes {
+  if !p.IsSetDecisionTaskScheduledEventAttributes() {
+    return HistoryEvent_DecisionTaskScheduledEventAttributes_DEFAULT
+  }
+return p.DecisionTaskScheduledEventAttributes
+}
+var HistoryEvent_DecisionTaskStartedEventAttributes_DEFAULT *DecisionTaskStartedEventAttributes
+func (p *HistoryEvent) GetDecisionTaskStartedEventAttributes() *DecisionTaskStartedEventAttributes {
+  if !p.IsSetDecisionTaskStartedEventAttributes() {
+    return HistoryEvent_DecisionTaskStartedEventAttributes_DEFAULT
+  }
+return p.DecisionTaskStartedEventAttributes
+}
+var HistoryEvent_DecisionTaskCompletedEventAttributes_DEFAULT *DecisionTaskCompletedEventAttributes
+func (p *HistoryEvent) GetDecisionTaskCompletedEventAttributes() *DecisionTaskCompletedEventAttributes {
+  if !p.IsSetDecisionTaskCompletedEventAttributes() {
+    return HistoryEvent_DecisionTaskCompletedEventAttributes_DEFAULT
+  }
+return p.DecisionTaskCompletedEventAttributes
+}
+var HistoryEvent_DecisionTaskTimedOutEventAttributes_DEFAULT *DecisionTaskTimedOutEventAttributes
+func (p *HistoryEvent) GetDecisionTaskTimedOutEventAttributes() *DecisionTaskTimedOutEventAttributes {
+  if !p.IsSetDecisionTaskTimedOutEventAttributes() {
+    return HistoryEvent_DecisionTaskTimedOutEventAttributes_DEFAULT
+  }
+return p.DecisionTaskTimedOutEventAttributes
+}
+var HistoryEvent_DecisionTaskFailedEventAttributes_DEFAULT *DecisionTaskFailedEventAttributes
+func (p *HistoryEvent) GetDecisionTaskFailedEventAttributes() *DecisionTaskFailedEventAttributes {
+  if !p.IsSetDecisionTaskFailedEventAttributes() {
+    return HistoryEvent_DecisionTaskFailedEventAttributes_DEFAULT
+  }
+return p.DecisionTaskFailedEventAttributes
+}
+var HistoryEvent_ActivityTaskScheduledEventAttributes_DEFAULT *ActivityTaskScheduledEventAttributes
+func (p *HistoryEvent) GetActivityTaskScheduledEventAttributes() *ActivityTaskScheduledEventAttributes {
+  if !p.IsSetActivityTaskScheduledEventAttributes() {
+    return HistoryEvent_ActivityTaskScheduledEventAttributes_DEFAULT
+  }
+return p.ActivityTaskScheduledEventAttributes
+}
+var HistoryEvent_ActivityTaskStartedEventAttributes_DEFAULT *ActivityTaskStartedEventAttributes
+func (p *HistoryEvent) GetActivityTaskStartedEventAttributes() *ActivityTaskStartedEventAttributes {
+  if !p.IsSetActivityTaskStartedEventAttributes() {
+    return HistoryEvent_ActivityTaskStartedEventAttributes_DEFAULT
+  }
+return p.ActivityTaskStartedEventAttributes
+}
+var HistoryEvent_ActivityTaskCompletedEventAttributes_DEFAULT *ActivityTaskCompletedEventAttributes
+func (p *HistoryEvent) GetActivityTaskCompletedEventAttributes() *ActivityTaskCompletedEventAttributes {
+  if !p.IsSetActivityTaskCompletedEventAttributes() {
+    return HistoryEvent_ActivityTaskCompletedEventAttributes_DEFAULT
+  }
+return p.ActivityTaskCompletedEventAttributes
+}
+var HistoryEvent_ActivityTaskFailedEventAttributes_DEFAULT *ActivityTaskFailedEventAttributes
+func (p *HistoryEvent) GetActivityTaskFailedEventAttributes() *ActivityTaskFailedEventAttributes {
+  if !p.IsSetActivityTaskFailedEventAttributes() {
+    return HistoryEvent_ActivityTaskFailedEventAttributes_DEFAULT
+  }
+return p.ActivityTaskFailedEventAttributes
+}
+var HistoryEvent_ActivityTaskTimedOutEventAttributes_DEFAULT *ActivityTaskTimedOutEventAttributes
+func (p *HistoryEvent) GetActivityTaskTimedOutEventAttributes() *ActivityTaskTimedOutEventAttributes {
+  if !p.IsSetActivityTaskTimedOutEventAttributes() {
+    return HistoryEvent_ActivityTaskTimedOutEventAttributes_DEFAULT
+  }
+return p.ActivityTaskTimedOutEventAttributes
+}
+var HistoryEvent_TimerStartedEventAttributes_DEFAULT *TimerStartedEventAttributes
+func (p *HistoryEvent) GetTimerStartedEventAttributes() *TimerStartedEventAttributes {
+  if !p.IsSetTimerStartedEventAttributes() {
+    return HistoryEvent_TimerStartedEventAttributes_DEFAULT
+  }
+return p.TimerStartedEventAttributes
+}
+var HistoryEvent_TimerFiredEventAttributes_DEFAULT *TimerFiredEventAttributes
+func (p *HistoryEvent) GetTimerFiredEventAttributes() *TimerFiredEventAttributes {
+  if !p.IsSetTimerFiredEventAttributes() {
+    return HistoryEvent_TimerFiredEventAttributes_DEFAULT
+  }
+return p.TimerFiredEventAttributes
+}
+var HistoryEvent_ActivityTaskCancelRequestedEventAttributes_DEFAULT *ActivityTaskCancelRequestedEventAttributes
+func (p *HistoryEvent) GetActivityTaskCancelRequestedEventAttributes() *ActivityTaskCancelRequestedEventAttributes {
+  if !p.IsSetActivityTaskCancelRequestedEventAttributes() {
+    return HistoryEvent_ActivityTaskCancelRequestedEventAttributes_DEFAULT
+  }
+return p.ActivityTaskCancelRequestedEventAttributes
+}
+var HistoryEvent_RequestCancelActivityTaskFailedEventAttributes_DEFAULT *RequestCancelActivityTaskFailedEventAttributes
+func (p *HistoryEvent) GetRequestCancelActivityTaskFailedEventAttributes() *RequestCancelActivityTaskFailedEventAttributes {
+  if !p.IsSetRequestCancelActivityTaskFailedEventAttributes() {
+    return HistoryEvent_RequestCancelActivityTaskFailedEventAttributes_DEFAULT
+  }
+return p.RequestCancelActivityTaskFailedEventAttributes
+}
+var HistoryEvent_ActivityTaskCanceledEventAttributes_DEFAULT *ActivityTaskCanceledEventAttributes
+func (p *HistoryEvent) GetActivityTaskCanceledEventAttributes() *ActivityTaskCanceledEventAttributes {
+  if !p.IsSetActivityTaskCanceledEventAttributes() {
+    return HistoryEvent_ActivityTaskCanceledEventAttributes_DEFAULT
+  }
+return p.ActivityTaskCanceledEventAttributes
+}
+var HistoryEvent_TimerCanceledEventAttributes_DEFAULT *TimerCanceledEventAttributes
+func (p *HistoryEvent) GetTimerCanceledEventAttributes() *TimerCanceledEventAttributes {
+  if !p.IsSetTimerCanceledEventAttributes() {
+    return HistoryEvent_TimerCanceledEventAttributes_DEFAULT
+  }
+return p.TimerCanceledEventAttributes
+}
+var HistoryEvent_CancelTimerFailedEventAttributes_DEFAULT *CancelTimerFailedEventAttributes
+func (p *HistoryEvent) GetCancelTimerFailedEventAttributes() *CancelTimerFailedEventAttributes {
+  if !p.IsSetCancelTimerFailedEventAttributes() {
+    return HistoryEvent_CancelTimerFailedEventAttributes_DEFAULT
+  }
+return p.CancelTimerFailedEventAttributes
+}
+var HistoryEvent_MarkerRecordedEventAttributes_DEFAULT *MarkerRecordedEventAttributes
+func (p *HistoryEvent) GetMarkerRecordedEventAttributes() *MarkerRecordedEventAttributes {
+  if !p.IsSetMarkerRecordedEventAttributes() {
+    return HistoryEvent_MarkerRecordedEventAttributes_DEFAULT
+  }
+return p.MarkerRecordedEventAttributes
+}
+var HistoryEvent_WorkflowExecutionSignaledEventAttributes_DEFAULT *WorkflowExecutionSignaledEventAttributes
+func (p *HistoryEvent) GetWorkflowExecutionSignaledEventAttributes() *WorkflowExecutionSignaledEventAttributes {
+  if !p.IsSetWorkflowExecutionSignaledEventAttributes() {
+    return HistoryEvent_WorkflowExecutionSignaledEventAttributes_DEFAULT
+  }
+return p.WorkflowExecutionSignaledEventAttributes
+}
+var HistoryEvent_WorkflowExecutionTerminatedEventAttributes_DEFAULT *WorkflowExecutionTerminatedEventAttributes
+func (p *HistoryEvent) GetWorkflowExecutionTerminatedEventAttributes() *WorkflowExecutionTerminatedEventAttributes {
+  if !p.IsSetWorkflowExecutionTerminatedEventAttributes() {
+    return HistoryEvent_WorkflowExecutionTerminatedEventAttributes_DEFAULT
+  }
+return p.WorkflowExecutionTerminatedEventAttributes
+}
+var HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes_DEFAULT *WorkflowExecutionCancelRequestedEventAttributes
+func (p *HistoryEvent) GetWorkflowExecutionCancelRequestedEventAttributes() *WorkflowExecutionCancelRequestedEventAttributes {
+  if !p.IsSetWorkflowExecutionCancelRequestedEventAttributes() {
+    return HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes_DEFAULT
+  }
+return p.WorkflowExecutionCancelRequestedEventAttributes
+}
+var HistoryEvent_WorkflowExecutionCanceledEventAttributes_DEFAULT *WorkflowExecutionCanceledEventAttributes
+func (p *HistoryEvent) GetWorkflowExecutionCanceledEventAttributes() *WorkflowExecutionCanceledEventAttributes {
+  if !p.IsSetWorkflowExecutionCanceledEventAttributes() {
+    return HistoryEvent_WorkflowExecutionCanceledEventAttributes_DEFAULT
+  }
+return p.WorkflowExecutionCanceledEventAttributes
+}
+var HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes_DEFAULT *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+func (p *HistoryEvent) GetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes() *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+  if !p.IsSetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes() {
+    return HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes_DEFAULT
+  }
+return p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+}
+var HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes_DEFAULT *RequestCancelExternalWorkflowExecutionFailedEventAttributes
+func (p *HistoryEvent) GetRequestCancelExternalWorkflowExecutionFailedEventAttributes() *RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+  if !p.IsSetRequestCancelExternalWorkflowExecutionFailedEventAttributes() {
+    return HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes_DEFAULT
+  }
+return p.RequestCancelExternalWorkflowExecutionFailedEventAttributes
+}
+var HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes_DEFAULT *ExternalWorkflowExecutionCancelRequestedEventAttributes
+func (p *HistoryEvent) GetExternalWorkflowExecutionCancelRequestedEventAttributes() *ExternalWorkflowExecutionCancelRequestedEventAttributes {
+  if !p.IsSetExternalWorkflowExecutionCancelRequestedEventAttributes() {
+    return HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes_DEFAULT
+  }
+return p.ExternalWorkflowExecutionCancelRequestedEventAttributes
+}
+var HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes_DEFAULT *WorkflowExecutionContinuedAsNewEventAttributes
+func (p *HistoryEvent) GetWorkflowExecutionContinuedAsNewEventAttributes() *WorkflowExecutionContinuedAsNewEventAttributes {
+  if !p.IsSetWorkflowExecutionContinuedAsNewEventAttributes() {
+    return HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes_DEFAULT
+  }
+return p.WorkflowExecutionContinuedAsNewEventAttributes
+}
+var HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes_DEFAULT *StartChildWorkflowExecutionInitiatedEventAttributes
+func (p *HistoryEvent) GetStartChildWorkflowExecutionInitiatedEventAttributes() *StartChildWorkflowExecutionInitiatedEventAttributes {
+  if !p.IsSetStartChildWorkflowExecutionInitiatedEventAttributes() {
+    return HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes_DEFAULT
+  }
+return p.StartChildWorkflowExecutionInitiatedEventAttributes
+}
+var HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes_DEFAULT *StartChildWorkflowExecutionFailedEventAttributes
+func (p *HistoryEvent) GetStartChildWorkflowExecutionFailedEventAttributes() *StartChildWorkflowExecutionFailedEventAttributes {
+  if !p.IsSetStartChildWorkflowExecutionFailedEventAttributes() {
+    return HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes_DEFAULT
+  }
+return p.StartChildWorkflowExecutionFailedEventAttributes
+}
+var HistoryEvent_ChildWorkflowExecutionStartedEventAttributes_DEFAULT *ChildWorkflowExecutionStartedEventAttributes
+func (p *HistoryEvent) GetChildWorkflowExecutionStartedEventAttributes() *ChildWorkflowExecutionStartedEventAttributes {
+  if !p.IsSetChildWorkflowExecutionStartedEventAttributes() {
+    return HistoryEvent_ChildWorkflowExecutionStartedEventAttributes_DEFAULT
+  }
+return p.ChildWorkflowExecutionStartedEventAttributes
+}
+var HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes_DEFAULT *ChildWorkflowExecutionCompletedEventAttributes
+func (p *HistoryEvent) GetChildWorkflowExecutionCompletedEventAttributes() *ChildWorkflowExecutionCompletedEventAttributes {
+  if !p.IsSetChildWorkflowExecutionCompletedEventAttributes() {
+    return HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes_DEFAULT
+  }
+return p.ChildWorkflowExecutionCompletedEventAttributes
+}
+var HistoryEvent_ChildWorkflowExecutionFailedEventAttributes_DEFAULT *ChildWorkflowExecutionFailedEventAttributes
+func (p *HistoryEvent) GetChildWorkflowExecutionFailedEventAttributes() *ChildWorkflowExecutionFailedEventAttributes {
+  if !p.IsSetChildWorkflowExecutionFailedEventAttributes() {
+    return HistoryEvent_ChildWorkflowExecutionFailedEventAttributes_DEFAULT
+  }
+return p.ChildWorkflowExecutionFailedEventAttributes
+}
+var HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes_DEFAULT *ChildWorkflowExecutionCanceledEventAttributes
+func (p *HistoryEvent) GetChildWorkflowExecutionCanceledEventAttributes() *ChildWorkflowExecutionCanceledEventAttributes {
+  if !p.IsSetChildWorkflowExecutionCanceledEventAttributes() {
+    return HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes_DEFAULT
+  }
+return p.ChildWorkflowExecutionCanceledEventAttributes
+}
+var HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes_DEFAULT *ChildWorkflowExecutionTimedOutEventAttributes
+func (p *HistoryEvent) GetChildWorkflowExecutionTimedOutEventAttributes() *ChildWorkflowExecutionTimedOutEventAttributes {
+  if !p.IsSetChildWorkflowExecutionTimedOutEventAttributes() {
+    return HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes_DEFAULT
+  }
+return p.ChildWorkflowExecutionTimedOutEventAttributes
+}
+var HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes_DEFAULT *ChildWorkflowExecutionTerminatedEventAttributes
+func (p *HistoryEvent) GetChildWorkflowExecutionTerminatedEventAttributes() *ChildWorkflowExecutionTerminatedEventAttributes {
+  if !p.IsSetChildWorkflowExecutionTerminatedEventAttributes() {
+    return HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes_DEFAULT
+  }
+return p.ChildWorkflowExecutionTerminatedEventAttributes
+}
+var HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes_DEFAULT *SignalExternalWorkflowExecutionInitiatedEventAttributes
+func (p *HistoryEvent) GetSignalExternalWorkflowExecutionInitiatedEventAttributes() *SignalExternalWorkflowExecutionInitiatedEventAttributes {
+  if !p.IsSetSignalExternalWorkflowExecutionInitiatedEventAttributes() {
+    return HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes_DEFAULT
+  }
+return p.SignalExternalWorkflowExecutionInitiatedEventAttributes
+}
+var HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes_DEFAULT *SignalExternalWorkflowExecutionFailedEventAttributes
+func (p *HistoryEvent) GetSignalExternalWorkflowExecutionFailedEventAttributes() *SignalExternalWorkflowExecutionFailedEventAttributes {
+  if !p.IsSetSignalExternalWorkflowExecutionFailedEventAttributes() {
+    return HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes_DEFAULT
+  }
+return p.SignalExternalWorkflowExecutionFailedEventAttributes
+}
+var HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes_DEFAULT *ExternalWorkflowExecutionSignaledEventAttributes
+func (p *HistoryEvent) GetExternalWorkflowExecutionSignaledEventAttributes() *ExternalWorkflowExecutionSignaledEventAttributes {
+  if !p.IsSetExternalWorkflowExecutionSignaledEventAttributes() {
+    return HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes_DEFAULT
+  }
+return p.ExternalWorkflowExecutionSignaledEventAttributes
+}
+func (p *HistoryEvent) IsSetEventId() bool {
+  return p.EventId != nil
+}
+
+func (p *HistoryEvent) IsSetTimestamp() bool {
+  return p.Timestamp != nil
+}
+
+func (p *HistoryEvent) IsSetEventType() bool {
+  return p.EventType != nil
+}
+
+func (p *HistoryEvent) IsSetWorkflowExecutionStartedEventAttributes() bool {
+  return p.WorkflowExecutionStartedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetWorkflowExecutionCompletedEventAttributes() bool {
+  return p.WorkflowExecutionCompletedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetWorkflowExecutionFailedEventAttributes() bool {
+  return p.WorkflowExecutionFailedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetWorkflowExecutionTimedOutEventAttributes() bool {
+  return p.WorkflowExecutionTimedOutEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetDecisionTaskScheduledEventAttributes() bool {
+  return p.DecisionTaskScheduledEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetDecisionTaskStartedEventAttributes() bool {
+  return p.DecisionTaskStartedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetDecisionTaskCompletedEventAttributes() bool {
+  return p.DecisionTaskCompletedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetDecisionTaskTimedOutEventAttributes() bool {
+  return p.DecisionTaskTimedOutEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetDecisionTaskFailedEventAttributes() bool {
+  return p.DecisionTaskFailedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetActivityTaskScheduledEventAttributes() bool {
+  return p.ActivityTaskScheduledEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetActivityTaskStartedEventAttributes() bool {
+  return p.ActivityTaskStartedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetActivityTaskCompletedEventAttributes() bool {
+  return p.ActivityTaskCompletedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetActivityTaskFailedEventAttributes() bool {
+  return p.ActivityTaskFailedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetActivityTaskTimedOutEventAttributes() bool {
+  return p.ActivityTaskTimedOutEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetTimerStartedEventAttributes() bool {
+  return p.TimerStartedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetTimerFiredEventAttributes() bool {
+  return p.TimerFiredEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetActivityTaskCancelRequestedEventAttributes() bool {
+  return p.ActivityTaskCancelRequestedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetRequestCancelActivityTaskFailedEventAttributes() bool {
+  return p.RequestCancelActivityTaskFailedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetActivityTaskCanceledEventAttributes() bool {
+  return p.ActivityTaskCanceledEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetTimerCanceledEventAttributes() bool {
+  return p.TimerCanceledEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetCancelTimerFailedEventAttributes() bool {
+  return p.CancelTimerFailedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetMarkerRecordedEventAttributes() bool {
+  return p.MarkerRecordedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetWorkflowExecutionSignaledEventAttributes() bool {
+  return p.WorkflowExecutionSignaledEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetWorkflowExecutionTerminatedEventAttributes() bool {
+  return p.WorkflowExecutionTerminatedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetWorkflowExecutionCancelRequestedEventAttributes() bool {
+  return p.WorkflowExecutionCancelRequestedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetWorkflowExecutionCanceledEventAttributes() bool {
+  return p.WorkflowExecutionCanceledEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes() bool {
+  return p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetRequestCancelExternalWorkflowExecutionFailedEventAttributes() bool {
+  return p.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetExternalWorkflowExecutionCancelRequestedEventAttributes() bool {
+  return p.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetWorkflowExecutionContinuedAsNewEventAttributes() bool {
+  return p.WorkflowExecutionContinuedAsNewEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetStartChildWorkflowExecutionInitiatedEventAttributes() bool {
+  return p.StartChildWorkflowExecutionInitiatedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetStartChildWorkflowExecutionFailedEventAttributes() bool {
+  return p.StartChildWorkflowExecutionFailedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetChildWorkflowExecutionStartedEventAttributes() bool {
+  return p.ChildWorkflowExecutionStartedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetChildWorkflowExecutionCompletedEventAttributes() bool {
+  return p.ChildWorkflowExecutionCompletedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetChildWorkflowExecutionFailedEventAttributes() bool {
+  return p.ChildWorkflowExecutionFailedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetChildWorkflowExecutionCanceledEventAttributes() bool {
+  return p.ChildWorkflowExecutionCanceledEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetChildWorkflowExecutionTimedOutEventAttributes() bool {
+  return p.ChildWorkflowExecutionTimedOutEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetChildWorkflowExecutionTerminatedEventAttributes() bool {
+  return p.ChildWorkflowExecutionTerminatedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetSignalExternalWorkflowExecutionInitiatedEventAttributes() bool {
+  return p.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetSignalExternalWorkflowExecutionFailedEventAttributes() bool {
+  return p.SignalExternalWorkflowExecutionFailedEventAttributes != nil
+}
+
+func (p *HistoryEvent) IsSetExternalWorkflowExecutionSignaledEventAttributes() bool {
+  return p.ExternalWorkflowExecutionSignaledEventAttributes != nil
+}
+
+func (p *HistoryEvent) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
+    case 100:
+      if err := p.ReadField100(iprot); err != nil {
+        return err
+      }
+    case 110:
+      if err := p.ReadField110(iprot); err != nil {
+        return err
+      }
+    case 120:
+      if err := p.ReadField120(iprot); err != nil {
+        return err
+      }
+    case 130:
+      if err := p.ReadField130(iprot); err != nil {
+        return err
+      }
+    case 140:
+      if err := p.ReadField140(iprot); err != nil {
+        return err
+      }
+    case 150:
+      if err := p.ReadField150(iprot); err != nil {
+        return err
+      }
+    case 160:
+      if err := p.ReadField160(iprot); err != nil {
+        return err
+      }
+    case 170:
+      if err := p.ReadField170(iprot); err != nil {
+        return err
+      }
+    case 180:
+      if err := p.ReadField180(iprot); err != nil {
+        return err
+      }
+    case 190:
+      if err := p.ReadField190(iprot); err != nil {
+        return err
+      }
+    case 200:
+      if err := p.ReadField200(iprot); err != nil {
+        return err
+      }
+    case 210:
+      if err := p.ReadField210(iprot); err != nil {
+        return err
+      }
+    case 220:
+      if err := p.ReadField220(iprot); err != nil {
+        return err
+      }
+    case 230:
+      if err := p.ReadField230(iprot); err != nil {
+        return err
+      }
+    case 240:
+      if err := p.ReadField240(iprot); err != nil {
+        return err
+      }
+    case 250:
+      if err := p.ReadField250(iprot); err != nil {
+        return err
+      }
+    case 260:
+      if err := p.ReadField260(iprot); err != nil {
+        return err
+      }
+    case 270:
+      if err := p.ReadField270(iprot); err != nil {
+        return err
+      }
+    case 280:
+      if err := p.ReadField280(iprot); err != nil {
+        return err
+      }
+    case 290:
+      if err := p.ReadField290(iprot); err != nil {
+        return err
+      }
+    case 300:
+      if err := p.ReadField300(iprot); err != nil {
+        return err
+      }
+    case 310:
+      if err := p.ReadField310(iprot); err != nil {
+        return err
+      }
+    case 320:
+      if err := p.ReadField320(iprot); err != nil {
+        return err
+      }
+    case 330:
+      if err := p.ReadField330(iprot); err != nil {
+        return err
+      }
+    case 340:
+      if err := p.ReadField340(iprot); err != nil {
+        return err
+      }
+    case 350:
+      if err := p.ReadField350(iprot); err != nil {
+        return err
+      }
+    case 360:
+      if err := p.ReadField360(iprot); err != nil {
+        return err
+      }
+    case 370:
+      if err := p.ReadField370(iprot); err != nil {
+        return err
+      }
+    case 380:
+      if err := p.ReadField380(iprot); err != nil {
+        return err
+      }
+    case 390:
+      if err := p.ReadField390(iprot); err != nil {
+        return err
+      }
+    case 400:
+      if err := p.ReadField400(iprot); err != nil {
+        return err
+      }
+    case 410:
+      if err := p.ReadField410(iprot); err != nil {
+        return err
+      }
+    case 420:
+      if err := p.ReadField420(iprot); err != nil {
+        return err
+      }
+    case 430:
+      if err := p.ReadField430(iprot); err != nil {
+        return err
+      }
+    case 440:
+      if err := p.ReadField440(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.EventId = &v
+}
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.Timestamp = &v
+}
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  temp := EventType(v)
+  p.EventType = &temp
+}
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField40(iprot thrift.TProtocol) error {
+  p.WorkflowExecutionStartedEventAttributes = &WorkflowExecutionStartedEventAttributes{}
+  if err := p.WorkflowExecutionStartedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionStartedEventAttributes), err)
+  }
+  return nil
 }
-var HistoryEvent_WorkflowExecutionCompletedEventAttributes_DEFAULT *WorkflowExecutionCompletedEventAttributes
-func (p *HistoryEvent) GetWorkflowExecutionCompletedEventAttributes() *WorkflowExecutionCompletedEventAttributes {
-  if !p.IsSetWorkflowExecutionCompletedEventAttributes() {
-    return HistoryEvent_WorkflowExecutionCompletedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField50(iprot thrift.TProtocol) error {
+  p.WorkflowExecutionCompletedEventAttributes = &WorkflowExecutionCompletedEventAttributes{}
+  if err := p.WorkflowExecutionCompletedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionCompletedEventAttributes), err)
   }
-return p.WorkflowExecutionCompletedEventAttributes
+  return nil
 }
-var HistoryEvent_WorkflowExecutionFailedEventAttributes_DEFAULT *WorkflowExecutionFailedEventAttributes
-func (p *HistoryEvent) GetWorkflowExecutionFailedEventAttributes() *WorkflowExecutionFailedEventAttributes {
-  if !p.IsSetWorkflowExecutionFailedEventAttributes() {
-    return HistoryEvent_WorkflowExecutionFailedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField60(iprot thrift.TProtocol) error {
+  p.WorkflowExecutionFailedEventAttributes = &WorkflowExecutionFailedEventAttributes{}
+  if err := p.WorkflowExecutionFailedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionFailedEventAttributes), err)
   }
-return p.WorkflowExecutionFailedEventAttributes
+  return nil
 }
-var HistoryEvent_WorkflowExecutionTimedOutEventAttributes_DEFAULT *WorkflowExecutionTimedOutEventAttributes
-func (p *HistoryEvent) GetWorkflowExecutionTimedOutEventAttributes() *WorkflowExecutionTimedOutEventAttributes {
-  if !p.IsSetWorkflowExecutionTimedOutEventAttributes() {
-    return HistoryEvent_WorkflowExecutionTimedOutEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField70(iprot thrift.TProtocol) error {
+  p.WorkflowExecutionTimedOutEventAttributes = &WorkflowExecutionTimedOutEventAttributes{}
+  if err := p.WorkflowExecutionTimedOutEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionTimedOutEventAttributes), err)
   }
-return p.WorkflowExecutionTimedOutEventAttributes
+  return nil
 }
-var HistoryEvent_DecisionTaskScheduledEventAttributes_DEFAULT *DecisionTaskScheduledEventAttributes
-func (p *HistoryEvent) GetDecisionTaskScheduledEventAttributes() *DecisionTaskScheduledEventAttributes {
-  if !p.IsSetDecisionTaskScheduledEventAttributes() {
-    return HistoryEvent_DecisionTaskScheduledEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField80(iprot thrift.TProtocol) error {
+  p.DecisionTaskScheduledEventAttributes = &DecisionTaskScheduledEventAttributes{}
+  if err := p.DecisionTaskScheduledEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionTaskScheduledEventAttributes), err)
   }
-return p.DecisionTaskScheduledEventAttributes
+  return nil
 }
-var HistoryEvent_DecisionTaskStartedEventAttributes_DEFAULT *DecisionTaskStartedEventAttributes
-func (p *HistoryEvent) GetDecisionTaskStartedEventAttributes() *DecisionTaskStartedEventAttributes {
-  if !p.IsSetDecisionTaskStartedEventAttributes() {
-    return HistoryEvent_DecisionTaskStartedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField90(iprot thrift.TProtocol) error {
+  p.DecisionTaskStartedEventAttributes = &DecisionTaskStartedEventAttributes{}
+  if err := p.DecisionTaskStartedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionTaskStartedEventAttributes), err)
   }
-return p.DecisionTaskStartedEventAttributes
+  return nil
 }
-var HistoryEvent_DecisionTaskCompletedEventAttributes_DEFAULT *DecisionTaskCompletedEventAttributes
-func (p *HistoryEvent) GetDecisionTaskCompletedEventAttributes() *DecisionTaskCompletedEventAttributes {
-  if !p.IsSetDecisionTaskCompletedEventAttributes() {
-    return HistoryEvent_DecisionTaskCompletedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField100(iprot thrift.TProtocol) error {
+  p.DecisionTaskCompletedEventAttributes = &DecisionTaskCompletedEventAttributes{}
+  if err := p.DecisionTaskCompletedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionTaskCompletedEventAttributes), err)
   }
-return p.DecisionTaskCompletedEventAttributes
+  return nil
 }
-var HistoryEvent_DecisionTaskTimedOutEventAttributes_DEFAULT *DecisionTaskTimedOutEventAttributes
-func (p *HistoryEvent) GetDecisionTaskTimedOutEventAttributes() *DecisionTaskTimedOutEventAttributes {
-  if !p.IsSetDecisionTaskTimedOutEventAttributes() {
-    return HistoryEvent_DecisionTaskTimedOutEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField110(iprot thrift.TProtocol) error {
+  p.DecisionTaskTimedOutEventAttributes = &DecisionTaskTimedOutEventAttributes{}
+  if err := p.DecisionTaskTimedOutEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionTaskTimedOutEventAttributes), err)
   }
-return p.DecisionTaskTimedOutEventAttributes
+  return nil
 }
-var HistoryEvent_DecisionTaskFailedEventAttributes_DEFAULT *DecisionTaskFailedEventAttributes
-func (p *HistoryEvent) GetDecisionTaskFailedEventAttributes() *DecisionTaskFailedEventAttributes {
-  if !p.IsSetDecisionTaskFailedEventAttributes() {
-    return HistoryEvent_DecisionTaskFailedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField120(iprot thrift.TProtocol) error {
+  p.DecisionTaskFailedEventAttributes = &DecisionTaskFailedEventAttributes{}
+  if err := p.DecisionTaskFailedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionTaskFailedEventAttributes), err)
   }
-return p.DecisionTaskFailedEventAttributes
+  return nil
 }
-var HistoryEvent_ActivityTaskScheduledEventAttributes_DEFAULT *ActivityTaskScheduledEventAttributes
-func (p *HistoryEvent) GetActivityTaskScheduledEventAttributes() *ActivityTaskScheduledEventAttributes {
-  if !p.IsSetActivityTaskScheduledEventAttributes() {
-    return HistoryEvent_ActivityTaskScheduledEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField130(iprot thrift.TProtocol) error {
+  p.ActivityTaskScheduledEventAttributes = &ActivityTaskScheduledEventAttributes{}
+  if err := p.ActivityTaskScheduledEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskScheduledEventAttributes), err)
   }
-return p.ActivityTaskScheduledEventAttributes
+  return nil
 }
-var HistoryEvent_ActivityTaskStartedEventAttributes_DEFAULT *ActivityTaskStartedEventAttributes
-func (p *HistoryEvent) GetActivityTaskStartedEventAttributes() *ActivityTaskStartedEventAttributes {
-  if !p.IsSetActivityTaskStartedEventAttributes() {
-    return HistoryEvent_ActivityTaskStartedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField140(iprot thrift.TProtocol) error {
+  p.ActivityTaskStartedEventAttributes = &ActivityTaskStartedEventAttributes{}
+  if err := p.ActivityTaskStartedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskStartedEventAttributes), err)
   }
-return p.ActivityTaskStartedEventAttributes
+  return nil
 }
-var HistoryEvent_ActivityTaskCompletedEventAttributes_DEFAULT *ActivityTaskCompletedEventAttributes
-func (p *HistoryEvent) GetActivityTaskCompletedEventAttributes() *ActivityTaskCompletedEventAttributes {
-  if !p.IsSetActivityTaskCompletedEventAttributes() {
-    return HistoryEvent_ActivityTaskCompletedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField150(iprot thrift.TProtocol) error {
+  p.ActivityTaskCompletedEventAttributes = &ActivityTaskCompletedEventAttributes{}
+  if err := p.ActivityTaskCompletedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskCompletedEventAttributes), err)
   }
-return p.ActivityTaskCompletedEventAttributes
+  return nil
 }
-var HistoryEvent_ActivityTaskFailedEventAttributes_DEFAULT *ActivityTaskFailedEventAttributes
-func (p *HistoryEvent) GetActivityTaskFailedEventAttributes() *ActivityTaskFailedEventAttributes {
-  if !p.IsSetActivityTaskFailedEventAttributes() {
-    return HistoryEvent_ActivityTaskFailedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField160(iprot thrift.TProtocol) error {
+  p.ActivityTaskFailedEventAttributes = &ActivityTaskFailedEventAttributes{}
+  if err := p.ActivityTaskFailedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskFailedEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField170(iprot thrift.TProtocol) error {
+  p.ActivityTaskTimedOutEventAttributes = &ActivityTaskTimedOutEventAttributes{}
+  if err := p.ActivityTaskTimedOutEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskTimedOutEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField180(iprot thrift.TProtocol) error {
+  p.TimerStartedEventAttributes = &TimerStartedEventAttributes{}
+  if err := p.TimerStartedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TimerStartedEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField190(iprot thrift.TProtocol) error {
+  p.TimerFiredEventAttributes = &TimerFiredEventAttributes{}
+  if err := p.TimerFiredEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TimerFiredEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField200(iprot thrift.TProtocol) error {
+  p.ActivityTaskCancelRequestedEventAttributes = &ActivityTaskCancelRequestedEventAttributes{}
+  if err := p.ActivityTaskCancelRequestedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskCancelRequestedEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField210(iprot thrift.TProtocol) error {
+  p.RequestCancelActivityTaskFailedEventAttributes = &RequestCancelActivityTaskFailedEventAttributes{}
+  if err := p.RequestCancelActivityTaskFailedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RequestCancelActivityTaskFailedEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField220(iprot thrift.TProtocol) error {
+  p.ActivityTaskCanceledEventAttributes = &ActivityTaskCanceledEventAttributes{}
+  if err := p.ActivityTaskCanceledEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskCanceledEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField230(iprot thrift.TProtocol) error {
+  p.TimerCanceledEventAttributes = &TimerCanceledEventAttributes{}
+  if err := p.TimerCanceledEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TimerCanceledEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField240(iprot thrift.TProtocol) error {
+  p.CancelTimerFailedEventAttributes = &CancelTimerFailedEventAttributes{}
+  if err := p.CancelTimerFailedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelTimerFailedEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField250(iprot thrift.TProtocol) error {
+  p.MarkerRecordedEventAttributes = &MarkerRecordedEventAttributes{}
+  if err := p.MarkerRecordedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MarkerRecordedEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField260(iprot thrift.TProtocol) error {
+  p.WorkflowExecutionSignaledEventAttributes = &WorkflowExecutionSignaledEventAttributes{}
+  if err := p.WorkflowExecutionSignaledEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionSignaledEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField270(iprot thrift.TProtocol) error {
+  p.WorkflowExecutionTerminatedEventAttributes = &WorkflowExecutionTerminatedEventAttributes{}
+  if err := p.WorkflowExecutionTerminatedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionTerminatedEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField280(iprot thrift.TProtocol) error {
+  p.WorkflowExecutionCancelRequestedEventAttributes = &WorkflowExecutionCancelRequestedEventAttributes{}
+  if err := p.WorkflowExecutionCancelRequestedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionCancelRequestedEventAttributes), err)
+  }
+  return nil
+}
+
+func (p *HistoryEvent)  ReadField290(iprot thrift.TProtocol) error {
+  p.WorkflowExecutionCanceledEventAttributes = &WorkflowExecutionCanceledEventAttributes{}
+  if err := p.WorkflowExecutionCanceledEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionCanceledEventAttributes), err)
   }
-return p.ActivityTaskFailedEventAttributes
+  return nil
 }
-var HistoryEvent_ActivityTaskTimedOutEventAttributes_DEFAULT *ActivityTaskTimedOutEventAttributes
-func (p *HistoryEvent) GetActivityTaskTimedOutEventAttributes() *ActivityTaskTimedOutEventAttributes {
-  if !p.IsSetActivityTaskTimedOutEventAttributes() {
-    return HistoryEvent_ActivityTaskTimedOutEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField300(iprot thrift.TProtocol) error {
+  p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes = &RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{}
+  if err := p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes), err)
   }
-return p.ActivityTaskTimedOutEventAttributes
+  return nil
 }
-var HistoryEvent_TimerStartedEventAttributes_DEFAULT *TimerStartedEventAttributes
-func (p *HistoryEvent) GetTimerStartedEventAttributes() *TimerStartedEventAttributes {
-  if !p.IsSetTimerStartedEventAttributes() {
-    return HistoryEvent_TimerStartedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField310(iprot thrift.TProtocol) error {
+  p.RequestCancelExternalWorkflowExecutionFailedEventAttributes = &RequestCancelExternalWorkflowExecutionFailedEventAttributes{}
+  if err := p.RequestCancelExternalWorkflowExecutionFailedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RequestCancelExternalWorkflowExecutionFailedEventAttributes), err)
   }
-return p.TimerStartedEventAttributes
+  return nil
 }
-var HistoryEvent_TimerFiredEventAttributes_DEFAULT *TimerFiredEventAttributes
-func (p *HistoryEvent) GetTimerFiredEventAttributes() *TimerFiredEventAttributes {
-  if !p.IsSetTimerFiredEventAttributes() {
-    return HistoryEvent_TimerFiredEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField320(iprot thrift.TProtocol) error {
+  p.ExternalWorkflowExecutionCancelRequestedEventAttributes = &ExternalWorkflowExecutionCancelRequestedEventAttributes{}
+  if err := p.ExternalWorkflowExecutionCancelRequestedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExternalWorkflowExecutionCancelRequestedEventAttributes), err)
   }
-return p.TimerFiredEventAttributes
+  return nil
 }
-var HistoryEvent_ActivityTaskCancelRequestedEventAttributes_DEFAULT *ActivityTaskCancelRequestedEventAttributes
-func (p *HistoryEvent) GetActivityTaskCancelRequestedEventAttributes() *ActivityTaskCancelRequestedEventAttributes {
-  if !p.IsSetActivityTaskCancelRequestedEventAttributes() {
-    return HistoryEvent_ActivityTaskCancelRequestedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField330(iprot thrift.TProtocol) error {
+  p.WorkflowExecutionContinuedAsNewEventAttributes = &WorkflowExecutionContinuedAsNewEventAttributes{}
+  if err := p.WorkflowExecutionContinuedAsNewEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionContinuedAsNewEventAttributes), err)
   }
-return p.ActivityTaskCancelRequestedEventAttributes
+  return nil
 }
-var HistoryEvent_RequestCancelActivityTaskFailedEventAttributes_DEFAULT *RequestCancelActivityTaskFailedEventAttributes
-func (p *HistoryEvent) GetRequestCancelActivityTaskFailedEventAttributes() *RequestCancelActivityTaskFailedEventAttributes {
-  if !p.IsSetRequestCancelActivityTaskFailedEventAttributes() {
-    return HistoryEvent_RequestCancelActivityTaskFailedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField340(iprot thrift.TProtocol) error {
+  p.StartChildWorkflowExecutionInitiatedEventAttributes = &StartChildWorkflowExecutionInitiatedEventAttributes{}
+  if err := p.StartChildWorkflowExecutionInitiatedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartChildWorkflowExecutionInitiatedEventAttributes), err)
   }
-return p.RequestCancelActivityTaskFailedEventAttributes
+  return nil
 }
-var HistoryEvent_ActivityTaskCanceledEventAttributes_DEFAULT *ActivityTaskCanceledEventAttributes
-func (p *HistoryEvent) GetActivityTaskCanceledEventAttributes() *ActivityTaskCanceledEventAttributes {
-  if !p.IsSetActivityTaskCanceledEventAttributes() {
-    return HistoryEvent_ActivityTaskCanceledEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField350(iprot thrift.TProtocol) error {
+  p.StartChildWorkflowExecutionFailedEventAttributes = &StartChildWorkflowExecutionFailedEventAttributes{}
+  if err := p.StartChildWorkflowExecutionFailedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartChildWorkflowExecutionFailedEventAttributes), err)
   }
-return p.ActivityTaskCanceledEventAttributes
+  return nil
 }
-var HistoryEvent_TimerCanceledEventAttributes_DEFAULT *TimerCanceledEventAttributes
-func (p *HistoryEvent) GetTimerCanceledEventAttributes() *TimerCanceledEventAttributes {
-  if !p.IsSetTimerCanceledEventAttributes() {
-    return HistoryEvent_TimerCanceledEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField360(iprot thrift.TProtocol) error {
+  p.ChildWorkflowExecutionStartedEventAttributes = &ChildWorkflowExecutionStartedEventAttributes{}
+  if err := p.ChildWorkflowExecutionStartedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionStartedEventAttributes), err)
   }
-return p.TimerCanceledEventAttributes
+  return nil
 }
-var HistoryEvent_CancelTimerFailedEventAttributes_DEFAULT *CancelTimerFailedEventAttributes
-func (p *HistoryEvent) GetCancelTimerFailedEventAttributes() *CancelTimerFailedEventAttributes {
-  if !p.IsSetCancelTimerFailedEventAttributes() {
-    return HistoryEvent_CancelTimerFailedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField370(iprot thrift.TProtocol) error {
+  p.ChildWorkflowExecutionCompletedEventAttributes = &ChildWorkflowExecutionCompletedEventAttributes{}
+  if err := p.ChildWorkflowExecutionCompletedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionCompletedEventAttributes), err)
   }
-return p.CancelTimerFailedEventAttributes
+  return nil
 }
-var HistoryEvent_MarkerRecordedEventAttributes_DEFAULT *MarkerRecordedEventAttributes
-func (p *HistoryEvent) GetMarkerRecordedEventAttributes() *MarkerRecordedEventAttributes {
-  if !p.IsSetMarkerRecordedEventAttributes() {
-    return HistoryEvent_MarkerRecordedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField380(iprot thrift.TProtocol) error {
+  p.ChildWorkflowExecutionFailedEventAttributes = &ChildWorkflowExecutionFailedEventAttributes{}
+  if err := p.ChildWorkflowExecutionFailedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionFailedEventAttributes), err)
   }
-return p.MarkerRecordedEventAttributes
+  return nil
 }
-var HistoryEvent_WorkflowExecutionSignaledEventAttributes_DEFAULT *WorkflowExecutionSignaledEventAttributes
-func (p *HistoryEvent) GetWorkflowExecutionSignaledEventAttributes() *WorkflowExecutionSignaledEventAttributes {
-  if !p.IsSetWorkflowExecutionSignaledEventAttributes() {
-    return HistoryEvent_WorkflowExecutionSignaledEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField390(iprot thrift.TProtocol) error {
+  p.ChildWorkflowExecutionCanceledEventAttributes = &ChildWorkflowExecutionCanceledEventAttributes{}
+  if err := p.ChildWorkflowExecutionCanceledEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionCanceledEventAttributes), err)
   }
-return p.WorkflowExecutionSignaledEventAttributes
+  return nil
 }
-var HistoryEvent_WorkflowExecutionTerminatedEventAttributes_DEFAULT *WorkflowExecutionTerminatedEventAttributes
-func (p *HistoryEvent) GetWorkflowExecutionTerminatedEventAttributes() *WorkflowExecutionTerminatedEventAttributes {
-  if !p.IsSetWorkflowExecutionTerminatedEventAttributes() {
-    return HistoryEvent_WorkflowExecutionTerminatedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField400(iprot thrift.TProtocol) error {
+  p.ChildWorkflowExecutionTimedOutEventAttributes = &ChildWorkflowExecutionTimedOutEventAttributes{}
+  if err := p.ChildWorkflowExecutionTimedOutEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionTimedOutEventAttributes), err)
   }
-return p.WorkflowExecutionTerminatedEventAttributes
+  return nil
 }
-var HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes_DEFAULT *WorkflowExecutionCancelRequestedEventAttributes
-func (p *HistoryEvent) GetWorkflowExecutionCancelRequestedEventAttributes() *WorkflowExecutionCancelRequestedEventAttributes {
-  if !p.IsSetWorkflowExecutionCancelRequestedEventAttributes() {
-    return HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField410(iprot thrift.TProtocol) error {
+  p.ChildWorkflowExecutionTerminatedEventAttributes = &ChildWorkflowExecutionTerminatedEventAttributes{}
+  if err := p.ChildWorkflowExecutionTerminatedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionTerminatedEventAttributes), err)
   }
-return p.WorkflowExecutionCancelRequestedEventAttributes
+  return nil
 }
-var HistoryEvent_WorkflowExecutionCanceledEventAttributes_DEFAULT *WorkflowExecutionCanceledEventAttributes
-func (p *HistoryEvent) GetWorkflowExecutionCanceledEventAttributes() *WorkflowExecutionCanceledEventAttributes {
-  if !p.IsSetWorkflowExecutionCanceledEventAttributes() {
-    return HistoryEvent_WorkflowExecutionCanceledEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField420(iprot thrift.TProtocol) error {
+  p.SignalExternalWorkflowExecutionInitiatedEventAttributes = &SignalExternalWorkflowExecutionInitiatedEventAttributes{}
+  if err := p.SignalExternalWorkflowExecutionInitiatedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalExternalWorkflowExecutionInitiatedEventAttributes), err)
   }
-return p.WorkflowExecutionCanceledEventAttributes
+  return nil
 }
-var HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes_DEFAULT *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
-func (p *HistoryEvent) GetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes() *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
-  if !p.IsSetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes() {
-    return HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField430(iprot thrift.TProtocol) error {
+  p.SignalExternalWorkflowExecutionFailedEventAttributes = &SignalExternalWorkflowExecutionFailedEventAttributes{}
+  if err := p.SignalExternalWorkflowExecutionFailedEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalExternalWorkflowExecutionFailedEventAttributes), err)
   }
-return p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+  return nil
 }
-var HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes_DEFAULT *RequestCancelExternalWorkflowExecutionFailedEventAttributes
-func (p *HistoryEvent) GetRequestCancelExternalWorkflowExecutionFailedEventAttributes() *RequestCancelExternalWorkflowExecutionFailedEventAttributes {
-  if !p.IsSetRequestCancelExternalWorkflowExecutionFailedEventAttributes() {
-    return HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes_DEFAULT
+
+func (p *HistoryEvent)  ReadField440(iprot thrift.TProtocol) error {
+  p.ExternalWorkflowExecutionSignaledEventAttributes = &ExternalWorkflowExecutionSignaledEventAttributes{}
+  if err := p.ExternalWorkflowExecutionSignaledEventAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExternalWorkflowExecutionSignaledEventAttributes), err)
   }
-return p.RequestCancelExternalWorkflowExecutionFailedEventAttributes
+  return nil
 }
-var HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes_DEFAULT *ExternalWorkflowExecutionCancelRequestedEventAttributes
-func (p *HistoryEvent) GetExternalWorkflowExecutionCancelRequestedEventAttributes() *ExternalWorkflowExecutionCancelRequestedEventAttributes {
-  if !p.IsSetExternalWorkflowExecutionCancelRequestedEventAttributes() {
-    return HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes_DEFAULT
+
+func (p *HistoryEvent) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("HistoryEvent"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
+    if err := p.writeField100(oprot); err != nil { return err }
+    if err := p.writeField110(oprot); err != nil { return err }
+    if err := p.writeField120(oprot); err != nil { return err }
+    if err := p.writeField130(oprot); err != nil { return err }
+    if err := p.writeField140(oprot); err != nil { return err }
+    if err := p.writeField150(oprot); err != nil { return err }
+    if err := p.writeField160(oprot); err != nil { return err }
+    if err := p.writeField170(oprot); err != nil { return err }
+    if err := p.writeField180(oprot); err != nil { return err }
+    if err := p.writeField190(oprot); err != nil { return err }
+    if err := p.writeField200(oprot); err != nil { return err }
+    if err := p.writeField210(oprot); err != nil { return err }
+    if err := p.writeField220(oprot); err != nil { return err }
+    if err := p.writeField230(oprot); err != nil { return err }
+    if err := p.writeField240(oprot); err != nil { return err }
+    if err := p.writeField250(oprot); err != nil { return err }
+    if err := p.writeField260(oprot); err != nil { return err }
+    if err := p.writeField270(oprot); err != nil { return err }
+    if err := p.writeField280(oprot); err != nil { return err }
+    if err := p.writeField290(oprot); err != nil { return err }
+    if err := p.writeField300(oprot); err != nil { return err }
+    if err := p.writeField310(oprot); err != nil { return err }
+    if err := p.writeField320(oprot); err != nil { return err }
+    if err := p.writeField330(oprot); err != nil { return err }
+    if err := p.writeField340(oprot); err != nil { return err }
+    if err := p.writeField350(oprot); err != nil { return err }
+    if err := p.writeField360(oprot); err != nil { return err }
+    if err := p.writeField370(oprot); err != nil { return err }
+    if err := p.writeField380(oprot); err != nil { return err }
+    if err := p.writeField390(oprot); err != nil { return err }
+    if err := p.writeField400(oprot); err != nil { return err }
+    if err := p.writeField410(oprot); err != nil { return err }
+    if err := p.writeField420(oprot); err != nil { return err }
+    if err := p.writeField430(oprot); err != nil { return err }
+    if err := p.writeField440(oprot); err != nil { return err }
   }
-return p.ExternalWorkflowExecutionCancelRequestedEventAttributes
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
-var HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes_DEFAULT *WorkflowExecutionContinuedAsNewEventAttributes
-func (p *HistoryEvent) GetWorkflowExecutionContinuedAsNewEventAttributes() *WorkflowExecutionContinuedAsNewEventAttributes {
-  if !p.IsSetWorkflowExecutionContinuedAsNewEventAttributes() {
-    return HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes_DEFAULT
+
+func (p *HistoryEvent) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEventId() {
+    if err := oprot.WriteFieldBegin("eventId", thrift.I64, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:eventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.EventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.eventId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:eventId: ", p), err) }
   }
-return p.WorkflowExecutionContinuedAsNewEventAttributes
+  return err
 }
-var HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes_DEFAULT *StartChildWorkflowExecutionInitiatedEventAttributes
-func (p *HistoryEvent) GetStartChildWorkflowExecutionInitiatedEventAttributes() *StartChildWorkflowExecutionInitiatedEventAttributes {
-  if !p.IsSetStartChildWorkflowExecutionInitiatedEventAttributes() {
-    return HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes_DEFAULT
+
+func (p *HistoryEvent) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTimestamp() {
+    if err := oprot.WriteFieldBegin("timestamp", thrift.I64, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:timestamp: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.Timestamp)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.timestamp (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:timestamp: ", p), err) }
   }
-return p.StartChildWorkflowExecutionInitiatedEventAttributes
+  return err
 }
-var HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes_DEFAULT *StartChildWorkflowExecutionFailedEventAttributes
-func (p *HistoryEvent) GetStartChildWorkflowExecutionFailedEventAttributes() *StartChildWorkflowExecutionFailedEventAttributes {
-  if !p.IsSetStartChildWorkflowExecutionFailedEventAttributes() {
-    return HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes_DEFAULT
+
+func (p *HistoryEvent) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEventType() {
+    if err := oprot.WriteFieldBegin("eventType", thrift.I32, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:eventType: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.EventType)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.eventType (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:eventType: ", p), err) }
   }
-return p.StartChildWorkflowExecutionFailedEventAttributes
+  return err
 }
-var HistoryEvent_ChildWorkflowExecutionStartedEventAttributes_DEFAULT *ChildWorkflowExecutionStartedEventAttributes
-func (p *HistoryEvent) GetChildWorkflowExecutionStartedEventAttributes() *ChildWorkflowExecutionStartedEventAttributes {
-  if !p.IsSetChildWorkflowExecutionStartedEventAttributes() {
-    return HistoryEvent_ChildWorkflowExecutionStartedEventAttributes_DEFAULT
+
+func (p *HistoryEvent) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecutionStartedEventAttributes() {
+    if err := oprot.WriteFieldBegin("workflowExecutionStartedEventAttributes", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowExecutionStartedEventAttributes: ", p), err) }
+    if err := p.WorkflowExecutionStartedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionStartedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowExecutionStartedEventAttributes: ", p), err) }
   }
-return p.ChildWorkflowExecutionStartedEventAttributes
+  return err
 }
-var HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes_DEFAULT *ChildWorkflowExecutionCompletedEventAttributes
-func (p *HistoryEvent) GetChildWorkflowExecutionCompletedEventAttributes() *ChildWorkflowExecutionCompletedEventAttributes {
-  if !p.IsSetChildWorkflowExecutionCompletedEventAttributes() {
-    return HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes_DEFAULT
+
+func (p *HistoryEvent) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecutionCompletedEventAttributes() {
+    if err := oprot.WriteFieldBegin("workflowExecutionCompletedEventAttributes", thrift.STRUCT, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:workflowExecutionCompletedEventAttributes: ", p), err) }
+    if err := p.WorkflowExecutionCompletedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionCompletedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:workflowExecutionCompletedEventAttributes: ", p), err) }
   }
-return p.ChildWorkflowExecutionCompletedEventAttributes
+  return err
 }
-var HistoryEvent_ChildWorkflowExecutionFailedEventAttributes_DEFAULT *ChildWorkflowExecutionFailedEventAttributes
-func (p *HistoryEvent) GetChildWorkflowExecutionFailedEventAttributes() *ChildWorkflowExecutionFailedEventAttributes {
-  if !p.IsSetChildWorkflowExecutionFailedEventAttributes() {
-    return HistoryEvent_ChildWorkflowExecutionFailedEventAttributes_DEFAULT
+
+func (p *HistoryEvent) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecutionFailedEventAttributes() {
+    if err := oprot.WriteFieldBegin("workflowExecutionFailedEventAttributes", thrift.STRUCT, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:workflowExecutionFailedEventAttributes: ", p), err) }
+    if err := p.WorkflowExecutionFailedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionFailedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:workflowExecutionFailedEventAttributes: ", p), err) }
   }
-return p.ChildWorkflowExecutionFailedEventAttributes
+  return err
 }
-var HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes_DEFAULT *ChildWorkflowExecutionCanceledEventAttributes
-func (p *HistoryEvent) GetChildWorkflowExecutionCanceledEventAttributes() *ChildWorkflowExecutionCanceledEventAttributes {
-  if !p.IsSetChildWorkflowExecutionCanceledEventAttributes() {
-    return HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes_DEFAULT
+
+func (p *HistoryEvent) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecutionTimedOutEventAttributes() {
+    if err := oprot.WriteFieldBegin("workflowExecutionTimedOutEventAttributes", thrift.STRUCT, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:workflowExecutionTimedOutEventAttributes: ", p), err) }
+    if err := p.WorkflowExecutionTimedOutEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionTimedOutEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:workflowExecutionTimedOutEventAttributes: ", p), err) }
   }
-return p.ChildWorkflowExecutionCanceledEventAttributes
+  return err
 }
-var HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes_DEFAULT *ChildWorkflowExecutionTimedOutEventAttributes
-func (p *HistoryEvent) GetChildWorkflowExecutionTimedOutEventAttributes() *ChildWorkflowExecutionTimedOutEventAttributes {
-  if !p.IsSetChildWorkflowExecutionTimedOutEventAttributes() {
-    return HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes_DEFAULT
+
+func (p *HistoryEvent) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionTaskScheduledEventAttributes() {
+    if err := oprot.WriteFieldBegin("decisionTaskScheduledEventAttributes", thrift.STRUCT, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:decisionTaskScheduledEventAttributes: ", p), err) }
+    if err := p.DecisionTaskScheduledEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionTaskScheduledEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:decisionTaskScheduledEventAttributes: ", p), err) }
   }
-return p.ChildWorkflowExecutionTimedOutEventAttributes
+  return err
 }
-var HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes_DEFAULT *ChildWorkflowExecutionTerminatedEventAttributes
-func (p *HistoryEvent) GetChildWorkflowExecutionTerminatedEventAttributes() *ChildWorkflowExecutionTerminatedEventAttributes {
-  if !p.IsSetChildWorkflowExecutionTerminatedEventAttributes() {
-    return HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes_DEFAULT
+
+func (p *HistoryEvent) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionTaskStartedEventAttributes() {
+    if err := oprot.WriteFieldBegin("decisionTaskStartedEventAttributes", thrift.STRUCT, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:decisionTaskStartedEventAttributes: ", p), err) }
+    if err := p.DecisionTaskStartedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionTaskStartedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:decisionTaskStartedEventAttributes: ", p), err) }
   }
-return p.ChildWorkflowExecutionTerminatedEventAttributes
+  return err
 }
-func (p *HistoryEvent) IsSetEventId() bool {
-  return p.EventId != nil
+
+func (p *HistoryEvent) writeField100(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionTaskCompletedEventAttributes() {
+    if err := oprot.WriteFieldBegin("decisionTaskCompletedEventAttributes", thrift.STRUCT, 100); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:decisionTaskCompletedEventAttributes: ", p), err) }
+    if err := p.DecisionTaskCompletedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionTaskCompletedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:decisionTaskCompletedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetTimestamp() bool {
-  return p.Timestamp != nil
+func (p *HistoryEvent) writeField110(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionTaskTimedOutEventAttributes() {
+    if err := oprot.WriteFieldBegin("decisionTaskTimedOutEventAttributes", thrift.STRUCT, 110); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:decisionTaskTimedOutEventAttributes: ", p), err) }
+    if err := p.DecisionTaskTimedOutEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionTaskTimedOutEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:decisionTaskTimedOutEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetEventType() bool {
-  return p.EventType != nil
+func (p *HistoryEvent) writeField120(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionTaskFailedEventAttributes() {
+    if err := oprot.WriteFieldBegin("decisionTaskFailedEventAttributes", thrift.STRUCT, 120); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 120:decisionTaskFailedEventAttributes: ", p), err) }
+    if err := p.DecisionTaskFailedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionTaskFailedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 120:decisionTaskFailedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetWorkflowExecutionStartedEventAttributes() bool {
-  return p.WorkflowExecutionStartedEventAttributes != nil
+func (p *HistoryEvent) writeField130(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityTaskScheduledEventAttributes() {
+    if err := oprot.WriteFieldBegin("activityTaskScheduledEventAttributes", thrift.STRUCT, 130); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 130:activityTaskScheduledEventAttributes: ", p), err) }
+    if err := p.ActivityTaskScheduledEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskScheduledEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 130:activityTaskScheduledEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetWorkflowExecutionCompletedEventAttributes() bool {
-  return p.WorkflowExecutionCompletedEventAttributes != nil
+func (p *HistoryEvent) writeField140(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityTaskStartedEventAttributes() {
+    if err := oprot.WriteFieldBegin("activityTaskStartedEventAttributes", thrift.STRUCT, 140); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 140:activityTaskStartedEventAttributes: ", p), err) }
+    if err := p.ActivityTaskStartedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskStartedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 140:activityTaskStartedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetWorkflowExecutionFailedEventAttributes() bool {
-  return p.WorkflowExecutionFailedEventAttributes != nil
+func (p *HistoryEvent) writeField150(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityTaskCompletedEventAttributes() {
+    if err := oprot.WriteFieldBegin("activityTaskCompletedEventAttributes", thrift.STRUCT, 150); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 150:activityTaskCompletedEventAttributes: ", p), err) }
+    if err := p.ActivityTaskCompletedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskCompletedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 150:activityTaskCompletedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetWorkflowExecutionTimedOutEventAttributes() bool {
-  return p.WorkflowExecutionTimedOutEventAttributes != nil
+func (p *HistoryEvent) writeField160(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityTaskFailedEventAttributes() {
+    if err := oprot.WriteFieldBegin("activityTaskFailedEventAttributes", thrift.STRUCT, 160); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 160:activityTaskFailedEventAttributes: ", p), err) }
+    if err := p.ActivityTaskFailedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskFailedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 160:activityTaskFailedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetDecisionTaskScheduledEventAttributes() bool {
-  return p.DecisionTaskScheduledEventAttributes != nil
+func (p *HistoryEvent) writeField170(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityTaskTimedOutEventAttributes() {
+    if err := oprot.WriteFieldBegin("activityTaskTimedOutEventAttributes", thrift.STRUCT, 170); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 170:activityTaskTimedOutEventAttributes: ", p), err) }
+    if err := p.ActivityTaskTimedOutEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskTimedOutEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 170:activityTaskTimedOutEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetDecisionTaskStartedEventAttributes() bool {
-  return p.DecisionTaskStartedEventAttributes != nil
+func (p *HistoryEvent) writeField180(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTimerStartedEventAttributes() {
+    if err := oprot.WriteFieldBegin("timerStartedEventAttributes", thrift.STRUCT, 180); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 180:timerStartedEventAttributes: ", p), err) }
+    if err := p.TimerStartedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TimerStartedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 180:timerStartedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetDecisionTaskCompletedEventAttributes() bool {
-  return p.DecisionTaskCompletedEventAttributes != nil
+func (p *HistoryEvent) writeField190(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTimerFiredEventAttributes() {
+    if err := oprot.WriteFieldBegin("timerFiredEventAttributes", thrift.STRUCT, 190); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 190:timerFiredEventAttributes: ", p), err) }
+    if err := p.TimerFiredEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TimerFiredEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 190:timerFiredEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetDecisionTaskTimedOutEventAttributes() bool {
-  return p.DecisionTaskTimedOutEventAttributes != nil
+func (p *HistoryEvent) writeField200(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityTaskCancelRequestedEventAttributes() {
+    if err := oprot.WriteFieldBegin("activityTaskCancelRequestedEventAttributes", thrift.STRUCT, 200); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 200:activityTaskCancelRequestedEventAttributes: ", p), err) }
+    if err := p.ActivityTaskCancelRequestedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskCancelRequestedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 200:activityTaskCancelRequestedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetDecisionTaskFailedEventAttributes() bool {
-  return p.DecisionTaskFailedEventAttributes != nil
+func (p *HistoryEvent) writeField210(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestCancelActivityTaskFailedEventAttributes() {
+    if err := oprot.WriteFieldBegin("requestCancelActivityTaskFailedEventAttributes", thrift.STRUCT, 210); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 210:requestCancelActivityTaskFailedEventAttributes: ", p), err) }
+    if err := p.RequestCancelActivityTaskFailedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RequestCancelActivityTaskFailedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 210:requestCancelActivityTaskFailedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetActivityTaskScheduledEventAttributes() bool {
-  return p.ActivityTaskScheduledEventAttributes != nil
+func (p *HistoryEvent) writeField220(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityTaskCanceledEventAttributes() {
+    if err := oprot.WriteFieldBegin("activityTaskCanceledEventAttributes", thrift.STRUCT, 220); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 220:activityTaskCanceledEventAttributes: ", p), err) }
+    if err := p.ActivityTaskCanceledEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskCanceledEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 220:activityTaskCanceledEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetActivityTaskStartedEventAttributes() bool {
-  return p.ActivityTaskStartedEventAttributes != nil
+func (p *HistoryEvent) writeField230(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTimerCanceledEventAttributes() {
+    if err := oprot.WriteFieldBegin("timerCanceledEventAttributes", thrift.STRUCT, 230); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 230:timerCanceledEventAttributes: ", p), err) }
+    if err := p.TimerCanceledEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TimerCanceledEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 230:timerCanceledEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetActivityTaskCompletedEventAttributes() bool {
-  return p.ActivityTaskCompletedEventAttributes != nil
+func (p *HistoryEvent) writeField240(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCancelTimerFailedEventAttributes() {
+    if err := oprot.WriteFieldBegin("cancelTimerFailedEventAttributes", thrift.STRUCT, 240); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 240:cancelTimerFailedEventAttributes: ", p), err) }
+    if err := p.CancelTimerFailedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelTimerFailedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 240:cancelTimerFailedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetActivityTaskFailedEventAttributes() bool {
-  return p.ActivityTaskFailedEventAttributes != nil
+func (p *HistoryEvent) writeField250(oprot thrift.TProtocol) (err error) {
+  if p.IsSetMarkerRecordedEventAttributes() {
+    if err := oprot.WriteFieldBegin("markerRecordedEventAttributes", thrift.STRUCT, 250); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 250:markerRecordedEventAttributes: ", p), err) }
+    if err := p.MarkerRecordedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MarkerRecordedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 250:markerRecordedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetActivityTaskTimedOutEventAttributes() bool {
-  return p.ActivityTaskTimedOutEventAttributes != nil
+func (p *HistoryEvent) writeField260(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecutionSignaledEventAttributes() {
+    if err := oprot.WriteFieldBegin("workflowExecutionSignaledEventAttributes", thrift.STRUCT, 260); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 260:workflowExecutionSignaledEventAttributes: ", p), err) }
+    if err := p.WorkflowExecutionSignaledEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionSignaledEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 260:workflowExecutionSignaledEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetTimerStartedEventAttributes() bool {
-  return p.TimerStartedEventAttributes != nil
+func (p *HistoryEvent) writeField270(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecutionTerminatedEventAttributes() {
+    if err := oprot.WriteFieldBegin("workflowExecutionTerminatedEventAttributes", thrift.STRUCT, 270); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 270:workflowExecutionTerminatedEventAttributes: ", p), err) }
+    if err := p.WorkflowExecutionTerminatedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionTerminatedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 270:workflowExecutionTerminatedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetTimerFiredEventAttributes() bool {
-  return p.TimerFiredEventAttributes != nil
+func (p *HistoryEvent) writeField280(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecutionCancelRequestedEventAttributes() {
+    if err := oprot.WriteFieldBegin("workflowExecutionCancelRequestedEventAttributes", thrift.STRUCT, 280); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 280:workflowExecutionCancelRequestedEventAttributes: ", p), err) }
+    if err := p.WorkflowExecutionCancelRequestedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionCancelRequestedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 280:workflowExecutionCancelRequestedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetActivityTaskCancelRequestedEventAttributes() bool {
-  return p.ActivityTaskCancelRequestedEventAttributes != nil
+func (p *HistoryEvent) writeField290(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecutionCanceledEventAttributes() {
+    if err := oprot.WriteFieldBegin("workflowExecutionCanceledEventAttributes", thrift.STRUCT, 290); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 290:workflowExecutionCanceledEventAttributes: ", p), err) }
+    if err := p.WorkflowExecutionCanceledEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionCanceledEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 290:workflowExecutionCanceledEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetRequestCancelActivityTaskFailedEventAttributes() bool {
-  return p.RequestCancelActivityTaskFailedEventAttributes != nil
+func (p *HistoryEvent) writeField300(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes() {
+    if err := oprot.WriteFieldBegin("requestCancelExternalWorkflowExecutionInitiatedEventAttributes", thrift.STRUCT, 300); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 300:requestCancelExternalWorkflowExecutionInitiatedEventAttributes: ", p), err) }
+    if err := p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 300:requestCancelExternalWorkflowExecutionInitiatedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetActivityTaskCanceledEventAttributes() bool {
-  return p.ActivityTaskCanceledEventAttributes != nil
+func (p *HistoryEvent) writeField310(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestCancelExternalWorkflowExecutionFailedEventAttributes() {
+    if err := oprot.WriteFieldBegin("requestCancelExternalWorkflowExecutionFailedEventAttributes", thrift.STRUCT, 310); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 310:requestCancelExternalWorkflowExecutionFailedEventAttributes: ", p), err) }
+    if err := p.RequestCancelExternalWorkflowExecutionFailedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RequestCancelExternalWorkflowExecutionFailedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 310:requestCancelExternalWorkflowExecutionFailedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetTimerCanceledEventAttributes() bool {
-  return p.TimerCanceledEventAttributes != nil
+func (p *HistoryEvent) writeField320(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExternalWorkflowExecutionCancelRequestedEventAttributes() {
+    if err := oprot.WriteFieldBegin("externalWorkflowExecutionCancelRequestedEventAttributes", thrift.STRUCT, 320); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 320:externalWorkflowExecutionCancelRequestedEventAttributes: ", p), err) }
+    if err := p.ExternalWorkflowExecutionCancelRequestedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ExternalWorkflowExecutionCancelRequestedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 320:externalWorkflowExecutionCancelRequestedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetCancelTimerFailedEventAttributes() bool {
-  return p.CancelTimerFailedEventAttributes != nil
+func (p *HistoryEvent) writeField330(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecutionContinuedAsNewEventAttributes() {
+    if err := oprot.WriteFieldBegin("workflowExecutionContinuedAsNewEventAttributes", thrift.STRUCT, 330); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 330:workflowExecutionContinuedAsNewEventAttributes: ", p), err) }
+    if err := p.WorkflowExecutionContinuedAsNewEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionContinuedAsNewEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 330:workflowExecutionContinuedAsNewEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetMarkerRecordedEventAttributes() bool {
-  return p.MarkerRecordedEventAttributes != nil
+func (p *HistoryEvent) writeField340(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartChildWorkflowExecutionInitiatedEventAttributes() {
+    if err := oprot.WriteFieldBegin("startChildWorkflowExecutionInitiatedEventAttributes", thrift.STRUCT, 340); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 340:startChildWorkflowExecutionInitiatedEventAttributes: ", p), err) }
+    if err := p.StartChildWorkflowExecutionInitiatedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartChildWorkflowExecutionInitiatedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 340:startChildWorkflowExecutionInitiatedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetWorkflowExecutionSignaledEventAttributes() bool {
-  return p.WorkflowExecutionSignaledEventAttributes != nil
+func (p *HistoryEvent) writeField350(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartChildWorkflowExecutionFailedEventAttributes() {
+    if err := oprot.WriteFieldBegin("startChildWorkflowExecutionFailedEventAttributes", thrift.STRUCT, 350); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 350:startChildWorkflowExecutionFailedEventAttributes: ", p), err) }
+    if err := p.StartChildWorkflowExecutionFailedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartChildWorkflowExecutionFailedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 350:startChildWorkflowExecutionFailedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetWorkflowExecutionTerminatedEventAttributes() bool {
-  return p.WorkflowExecutionTerminatedEventAttributes != nil
+func (p *HistoryEvent) writeField360(oprot thrift.TProtocol) (err error) {
+  if p.IsSetChildWorkflowExecutionStartedEventAttributes() {
+    if err := oprot.WriteFieldBegin("childWorkflowExecutionStartedEventAttributes", thrift.STRUCT, 360); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 360:childWorkflowExecutionStartedEventAttributes: ", p), err) }
+    if err := p.ChildWorkflowExecutionStartedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionStartedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 360:childWorkflowExecutionStartedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetWorkflowExecutionCancelRequestedEventAttributes() bool {
-  return p.WorkflowExecutionCancelRequestedEventAttributes != nil
+func (p *HistoryEvent) writeField370(oprot thrift.TProtocol) (err error) {
+  if p.IsSetChildWorkflowExecutionCompletedEventAttributes() {
+    if err := oprot.WriteFieldBegin("childWorkflowExecutionCompletedEventAttributes", thrift.STRUCT, 370); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 370:childWorkflowExecutionCompletedEventAttributes: ", p), err) }
+    if err := p.ChildWorkflowExecutionCompletedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionCompletedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 370:childWorkflowExecutionCompletedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetWorkflowExecutionCanceledEventAttributes() bool {
-  return p.WorkflowExecutionCanceledEventAttributes != nil
+func (p *HistoryEvent) writeField380(oprot thrift.TProtocol) (err error) {
+  if p.IsSetChildWorkflowExecutionFailedEventAttributes() {
+    if err := oprot.WriteFieldBegin("childWorkflowExecutionFailedEventAttributes", thrift.STRUCT, 380); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 380:childWorkflowExecutionFailedEventAttributes: ", p), err) }
+    if err := p.ChildWorkflowExecutionFailedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionFailedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 380:childWorkflowExecutionFailedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes() bool {
-  return p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil
+func (p *HistoryEvent) writeField390(oprot thrift.TProtocol) (err error) {
+  if p.IsSetChildWorkflowExecutionCanceledEventAttributes() {
+    if err := oprot.WriteFieldBegin("childWorkflowExecutionCanceledEventAttributes", thrift.STRUCT, 390); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 390:childWorkflowExecutionCanceledEventAttributes: ", p), err) }
+    if err := p.ChildWorkflowExecutionCanceledEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionCanceledEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 390:childWorkflowExecutionCanceledEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetRequestCancelExternalWorkflowExecutionFailedEventAttributes() bool {
-  return p.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil
+func (p *HistoryEvent) writeField400(oprot thrift.TProtocol) (err error) {
+  if p.IsSetChildWorkflowExecutionTimedOutEventAttributes() {
+    if err := oprot.WriteFieldBegin("childWorkflowExecutionTimedOutEventAttributes", thrift.STRUCT, 400); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 400:childWorkflowExecutionTimedOutEventAttributes: ", p), err) }
+    if err := p.ChildWorkflowExecutionTimedOutEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionTimedOutEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 400:childWorkflowExecutionTimedOutEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetExternalWorkflowExecutionCancelRequestedEventAttributes() bool {
-  return p.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil
+func (p *HistoryEvent) writeField410(oprot thrift.TProtocol) (err error) {
+  if p.IsSetChildWorkflowExecutionTerminatedEventAttributes() {
+    if err := oprot.WriteFieldBegin("childWorkflowExecutionTerminatedEventAttributes", thrift.STRUCT, 410); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 410:childWorkflowExecutionTerminatedEventAttributes: ", p), err) }
+    if err := p.ChildWorkflowExecutionTerminatedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionTerminatedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 410:childWorkflowExecutionTerminatedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetWorkflowExecutionContinuedAsNewEventAttributes() bool {
-  return p.WorkflowExecutionContinuedAsNewEventAttributes != nil
+func (p *HistoryEvent) writeField420(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalExternalWorkflowExecutionInitiatedEventAttributes() {
+    if err := oprot.WriteFieldBegin("signalExternalWorkflowExecutionInitiatedEventAttributes", thrift.STRUCT, 420); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 420:signalExternalWorkflowExecutionInitiatedEventAttributes: ", p), err) }
+    if err := p.SignalExternalWorkflowExecutionInitiatedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalExternalWorkflowExecutionInitiatedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 420:signalExternalWorkflowExecutionInitiatedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetStartChildWorkflowExecutionInitiatedEventAttributes() bool {
-  return p.StartChildWorkflowExecutionInitiatedEventAttributes != nil
+func (p *HistoryEvent) writeField430(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalExternalWorkflowExecutionFailedEventAttributes() {
+    if err := oprot.WriteFieldBegin("signalExternalWorkflowExecutionFailedEventAttributes", thrift.STRUCT, 430); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 430:signalExternalWorkflowExecutionFailedEventAttributes: ", p), err) }
+    if err := p.SignalExternalWorkflowExecutionFailedEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalExternalWorkflowExecutionFailedEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 430:signalExternalWorkflowExecutionFailedEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetStartChildWorkflowExecutionFailedEventAttributes() bool {
-  return p.StartChildWorkflowExecutionFailedEventAttributes != nil
+func (p *HistoryEvent) writeField440(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExternalWorkflowExecutionSignaledEventAttributes() {
+    if err := oprot.WriteFieldBegin("externalWorkflowExecutionSignaledEventAttributes", thrift.STRUCT, 440); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 440:externalWorkflowExecutionSignaledEventAttributes: ", p), err) }
+    if err := p.ExternalWorkflowExecutionSignaledEventAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ExternalWorkflowExecutionSignaledEventAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 440:externalWorkflowExecutionSignaledEventAttributes: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryEvent) IsSetChildWorkflowExecutionStartedEventAttributes() bool {
-  return p.ChildWorkflowExecutionStartedEventAttributes != nil
+func (p *HistoryEvent) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryEvent(%+v)", *p)
 }
 
-func (p *HistoryEvent) IsSetChildWorkflowExecutionCompletedEventAttributes() bool {
-  return p.ChildWorkflowExecutionCompletedEventAttributes != nil
+// Attributes:
+//  - Events
+type History struct {
+  // unused fields # 1 to 9
+  Events []*HistoryEvent `thrift:"events,10" db:"events" json:"events,omitempty"`
 }
 
-func (p *HistoryEvent) IsSetChildWorkflowExecutionFailedEventAttributes() bool {
-  return p.ChildWorkflowExecutionFailedEventAttributes != nil
+func NewHistory() *History {
+  return &History{}
 }
 
-func (p *HistoryEvent) IsSetChildWorkflowExecutionCanceledEventAttributes() bool {
-  return p.ChildWorkflowExecutionCanceledEventAttributes != nil
-}
+var History_Events_DEFAULT []*HistoryEvent
 
-func (p *HistoryEvent) IsSetChildWorkflowExecutionTimedOutEventAttributes() bool {
-  return p.ChildWorkflowExecutionTimedOutEventAttributes != nil
+func (p *History) GetEvents() []*HistoryEvent {
+  return p.Events
 }
-
-func (p *HistoryEvent) IsSetChildWorkflowExecutionTerminatedEventAttributes() bool {
-  return p.ChildWorkflowExecutionTerminatedEventAttributes != nil
+func (p *History) IsSetEvents() bool {
+  return p.Events != nil
 }
 
-func (p *HistoryEvent) Read(iprot thrift.TProtocol) error {
+func (p *History) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -14078,559 +16526,369 @@ func (p *HistoryEvent) Read(iprot thrift.TProtocol) error {
     case 10:
       if err := p.ReadField10(iprot); err != nil {
         return err
-      }
-    case 20:
-      if err := p.ReadField20(iprot); err != nil {
-        return err
-      }
-    case 30:
-      if err := p.ReadField30(iprot); err != nil {
-        return err
-      }
-    case 40:
-      if err := p.ReadField40(iprot); err != nil {
-        return err
-      }
-    case 50:
-      if err := p.ReadField50(iprot); err != nil {
-        return err
-      }
-    case 60:
-      if err := p.ReadField60(iprot); err != nil {
-        return err
-      }
-    case 70:
-      if err := p.ReadField70(iprot); err != nil {
-        return err
-      }
-    case 80:
-      if err := p.ReadField80(iprot); err != nil {
-        return err
-      }
-    case 90:
-      if err := p.ReadField90(iprot); err != nil {
-        return err
-      }
-    case 100:
-      if err := p.ReadField100(iprot); err != nil {
-        return err
-      }
-    case 110:
-      if err := p.ReadField110(iprot); err != nil {
-        return err
-      }
-    case 120:
-      if err := p.ReadField120(iprot); err != nil {
-        return err
-      }
-    case 130:
-      if err := p.ReadField130(iprot); err != nil {
-        return err
-      }
-    case 140:
-      if err := p.ReadField140(iprot); err != nil {
-        return err
-      }
-    case 150:
-      if err := p.ReadField150(iprot); err != nil {
-        return err
-      }
-    case 160:
-      if err := p.ReadField160(iprot); err != nil {
-        return err
-      }
-    case 170:
-      if err := p.ReadField170(iprot); err != nil {
-        return err
-      }
-    case 180:
-      if err := p.ReadField180(iprot); err != nil {
-        return err
-      }
-    case 190:
-      if err := p.ReadField190(iprot); err != nil {
-        return err
-      }
-    case 200:
-      if err := p.ReadField200(iprot); err != nil {
-        return err
-      }
-    case 210:
-      if err := p.ReadField210(iprot); err != nil {
-        return err
-      }
-    case 220:
-      if err := p.ReadField220(iprot); err != nil {
-        return err
-      }
-    case 230:
-      if err := p.ReadField230(iprot); err != nil {
-        return err
-      }
-    case 240:
-      if err := p.ReadField240(iprot); err != nil {
-        return err
-      }
-    case 250:
-      if err := p.ReadField250(iprot); err != nil {
-        return err
-      }
-    case 260:
-      if err := p.ReadField260(iprot); err != nil {
-        return err
-      }
-    case 270:
-      if err := p.ReadField270(iprot); err != nil {
-        return err
-      }
-    case 280:
-      if err := p.ReadField280(iprot); err != nil {
-        return err
-      }
-    case 290:
-      if err := p.ReadField290(iprot); err != nil {
-        return err
-      }
-    case 300:
-      if err := p.ReadField300(iprot); err != nil {
-        return err
-      }
-    case 310:
-      if err := p.ReadField310(iprot); err != nil {
-        return err
-      }
-    case 320:
-      if err := p.ReadField320(iprot); err != nil {
-        return err
-      }
-    case 330:
-      if err := p.ReadField330(iprot); err != nil {
-        return err
-      }
-    case 340:
-      if err := p.ReadField340(iprot); err != nil {
-        return err
-      }
-    case 350:
-      if err := p.ReadField350(iprot); err != nil {
-        return err
-      }
-    case 360:
-      if err := p.ReadField360(iprot); err != nil {
-        return err
-      }
-    case 370:
-      if err := p.ReadField370(iprot); err != nil {
-        return err
-      }
-    case 380:
-      if err := p.ReadField380(iprot); err != nil {
-        return err
-      }
-    case 390:
-      if err := p.ReadField390(iprot); err != nil {
-        return err
-      }
-    case 400:
-      if err := p.ReadField400(iprot); err != nil {
-        return err
-      }
-    case 410:
-      if err := p.ReadField410(iprot); err != nil {
-        return err
-      }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
-      }
-    }
-    if err := iprot.ReadFieldEnd(); err != nil {
-      return err
-    }
-  }
-  if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-  }
-  return nil
-}
-
-func (p *HistoryEvent)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
-} else {
-  p.EventId = &v
-}
-  return nil
-}
-
-func (p *HistoryEvent)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 20: ", err)
-} else {
-  p.Timestamp = &v
-}
-  return nil
-}
-
-func (p *HistoryEvent)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
-} else {
-  temp := EventType(v)
-  p.EventType = &temp
-}
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
   return nil
 }
 
-func (p *HistoryEvent)  ReadField40(iprot thrift.TProtocol) error {
-  p.WorkflowExecutionStartedEventAttributes = &WorkflowExecutionStartedEventAttributes{}
-  if err := p.WorkflowExecutionStartedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionStartedEventAttributes), err)
+func (p *History)  ReadField10(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*HistoryEvent, 0, size)
+  p.Events =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem0 := &HistoryEvent{}
+    if err := _elem0.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem0), err)
+    }
+    p.Events = append(p.Events, _elem0)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
   }
   return nil
 }
 
-func (p *HistoryEvent)  ReadField50(iprot thrift.TProtocol) error {
-  p.WorkflowExecutionCompletedEventAttributes = &WorkflowExecutionCompletedEventAttributes{}
-  if err := p.WorkflowExecutionCompletedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionCompletedEventAttributes), err)
+func (p *History) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("History"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
   }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
   return nil
 }
 
-func (p *HistoryEvent)  ReadField60(iprot thrift.TProtocol) error {
-  p.WorkflowExecutionFailedEventAttributes = &WorkflowExecutionFailedEventAttributes{}
-  if err := p.WorkflowExecutionFailedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionFailedEventAttributes), err)
+func (p *History) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEvents() {
+    if err := oprot.WriteFieldBegin("events", thrift.LIST, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:events: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Events)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Events {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:events: ", p), err) }
   }
-  return nil
+  return err
 }
 
-func (p *HistoryEvent)  ReadField70(iprot thrift.TProtocol) error {
-  p.WorkflowExecutionTimedOutEventAttributes = &WorkflowExecutionTimedOutEventAttributes{}
-  if err := p.WorkflowExecutionTimedOutEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionTimedOutEventAttributes), err)
+func (p *History) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return nil
+  return fmt.Sprintf("History(%+v)", *p)
 }
 
-func (p *HistoryEvent)  ReadField80(iprot thrift.TProtocol) error {
-  p.DecisionTaskScheduledEventAttributes = &DecisionTaskScheduledEventAttributes{}
-  if err := p.DecisionTaskScheduledEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionTaskScheduledEventAttributes), err)
-  }
-  return nil
+// Attributes:
+//  - WorkflowId
+type WorkflowExecutionFilter struct {
+  // unused fields # 1 to 9
+  WorkflowId *string `thrift:"workflowId,10" db:"workflowId" json:"workflowId,omitempty"`
 }
 
-func (p *HistoryEvent)  ReadField90(iprot thrift.TProtocol) error {
-  p.DecisionTaskStartedEventAttributes = &DecisionTaskStartedEventAttributes{}
-  if err := p.DecisionTaskStartedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionTaskStartedEventAttributes), err)
-  }
-  return nil
+func NewWorkflowExecutionFilter() *WorkflowExecutionFilter {
+  return &WorkflowExecutionFilter{}
 }
 
-func (p *HistoryEvent)  ReadField100(iprot thrift.TProtocol) error {
-  p.DecisionTaskCompletedEventAttributes = &DecisionTaskCompletedEventAttributes{}
-  if err := p.DecisionTaskCompletedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionTaskCompletedEventAttributes), err)
+var WorkflowExecutionFilter_WorkflowId_DEFAULT string
+func (p *WorkflowExecutionFilter) GetWorkflowId() string {
+  if !p.IsSetWorkflowId() {
+    return WorkflowExecutionFilter_WorkflowId_DEFAULT
   }
-  return nil
+return *p.WorkflowId
+}
+func (p *WorkflowExecutionFilter) IsSetWorkflowId() bool {
+  return p.WorkflowId != nil
 }
 
-func (p *HistoryEvent)  ReadField110(iprot thrift.TProtocol) error {
-  p.DecisionTaskTimedOutEventAttributes = &DecisionTaskTimedOutEventAttributes{}
-  if err := p.DecisionTaskTimedOutEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionTaskTimedOutEventAttributes), err)
+func (p *WorkflowExecutionFilter) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  return nil
-}
 
-func (p *HistoryEvent)  ReadField120(iprot thrift.TProtocol) error {
-  p.DecisionTaskFailedEventAttributes = &DecisionTaskFailedEventAttributes{}
-  if err := p.DecisionTaskFailedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionTaskFailedEventAttributes), err)
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
   return nil
 }
 
-func (p *HistoryEvent)  ReadField130(iprot thrift.TProtocol) error {
-  p.ActivityTaskScheduledEventAttributes = &ActivityTaskScheduledEventAttributes{}
-  if err := p.ActivityTaskScheduledEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskScheduledEventAttributes), err)
-  }
+func (p *WorkflowExecutionFilter)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.WorkflowId = &v
+}
   return nil
 }
 
-func (p *HistoryEvent)  ReadField140(iprot thrift.TProtocol) error {
-  p.ActivityTaskStartedEventAttributes = &ActivityTaskStartedEventAttributes{}
-  if err := p.ActivityTaskStartedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskStartedEventAttributes), err)
+func (p *WorkflowExecutionFilter) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("WorkflowExecutionFilter"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
   }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
   return nil
 }
 
-func (p *HistoryEvent)  ReadField150(iprot thrift.TProtocol) error {
-  p.ActivityTaskCompletedEventAttributes = &ActivityTaskCompletedEventAttributes{}
-  if err := p.ActivityTaskCompletedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskCompletedEventAttributes), err)
+func (p *WorkflowExecutionFilter) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowId() {
+    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:workflowId: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:workflowId: ", p), err) }
   }
-  return nil
+  return err
 }
 
-func (p *HistoryEvent)  ReadField160(iprot thrift.TProtocol) error {
-  p.ActivityTaskFailedEventAttributes = &ActivityTaskFailedEventAttributes{}
-  if err := p.ActivityTaskFailedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskFailedEventAttributes), err)
+func (p *WorkflowExecutionFilter) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return nil
+  return fmt.Sprintf("WorkflowExecutionFilter(%+v)", *p)
 }
 
-func (p *HistoryEvent)  ReadField170(iprot thrift.TProtocol) error {
-  p.ActivityTaskTimedOutEventAttributes = &ActivityTaskTimedOutEventAttributes{}
-  if err := p.ActivityTaskTimedOutEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskTimedOutEventAttributes), err)
-  }
-  return nil
+// Attributes:
+//  - Name
+type WorkflowTypeFilter struct {
+  // unused fields # 1 to 9
+  Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
 }
 
-func (p *HistoryEvent)  ReadField180(iprot thrift.TProtocol) error {
-  p.TimerStartedEventAttributes = &TimerStartedEventAttributes{}
-  if err := p.TimerStartedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TimerStartedEventAttributes), err)
-  }
-  return nil
+func NewWorkflowTypeFilter() *WorkflowTypeFilter {
+  return &WorkflowTypeFilter{}
 }
 
-func (p *HistoryEvent)  ReadField190(iprot thrift.TProtocol) error {
-  p.TimerFiredEventAttributes = &TimerFiredEventAttributes{}
-  if err := p.TimerFiredEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TimerFiredEventAttributes), err)
+var WorkflowTypeFilter_Name_DEFAULT string
+func (p *WorkflowTypeFilter) GetName() string {
+  if !p.IsSetName() {
+    return WorkflowTypeFilter_Name_DEFAULT
   }
-  return nil
+return *p.Name
+}
+func (p *WorkflowTypeFilter) IsSetName() bool {
+  return p.Name != nil
 }
 
-func (p *HistoryEvent)  ReadField200(iprot thrift.TProtocol) error {
-  p.ActivityTaskCancelRequestedEventAttributes = &ActivityTaskCancelRequestedEventAttributes{}
-  if err := p.ActivityTaskCancelRequestedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskCancelRequestedEventAttributes), err)
+func (p *WorkflowTypeFilter) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  return nil
-}
 
-func (p *HistoryEvent)  ReadField210(iprot thrift.TProtocol) error {
-  p.RequestCancelActivityTaskFailedEventAttributes = &RequestCancelActivityTaskFailedEventAttributes{}
-  if err := p.RequestCancelActivityTaskFailedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RequestCancelActivityTaskFailedEventAttributes), err)
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  return nil
-}
-
-func (p *HistoryEvent)  ReadField220(iprot thrift.TProtocol) error {
-  p.ActivityTaskCanceledEventAttributes = &ActivityTaskCanceledEventAttributes{}
-  if err := p.ActivityTaskCanceledEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTaskCanceledEventAttributes), err)
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
   return nil
 }
 
-func (p *HistoryEvent)  ReadField230(iprot thrift.TProtocol) error {
-  p.TimerCanceledEventAttributes = &TimerCanceledEventAttributes{}
-  if err := p.TimerCanceledEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TimerCanceledEventAttributes), err)
-  }
-  return nil
+func (p *WorkflowTypeFilter)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Name = &v
 }
-
-func (p *HistoryEvent)  ReadField240(iprot thrift.TProtocol) error {
-  p.CancelTimerFailedEventAttributes = &CancelTimerFailedEventAttributes{}
-  if err := p.CancelTimerFailedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelTimerFailedEventAttributes), err)
-  }
   return nil
 }
 
-func (p *HistoryEvent)  ReadField250(iprot thrift.TProtocol) error {
-  p.MarkerRecordedEventAttributes = &MarkerRecordedEventAttributes{}
-  if err := p.MarkerRecordedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MarkerRecordedEventAttributes), err)
+func (p *WorkflowTypeFilter) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("WorkflowTypeFilter"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
   }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
   return nil
 }
 
-func (p *HistoryEvent)  ReadField260(iprot thrift.TProtocol) error {
-  p.WorkflowExecutionSignaledEventAttributes = &WorkflowExecutionSignaledEventAttributes{}
-  if err := p.WorkflowExecutionSignaledEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionSignaledEventAttributes), err)
+func (p *WorkflowTypeFilter) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetName() {
+    if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
+    if err := oprot.WriteString(string(*p.Name)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
   }
-  return nil
+  return err
 }
 
-func (p *HistoryEvent)  ReadField270(iprot thrift.TProtocol) error {
-  p.WorkflowExecutionTerminatedEventAttributes = &WorkflowExecutionTerminatedEventAttributes{}
-  if err := p.WorkflowExecutionTerminatedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionTerminatedEventAttributes), err)
+func (p *WorkflowTypeFilter) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return nil
+  return fmt.Sprintf("WorkflowTypeFilter(%+v)", *p)
 }
 
-func (p *HistoryEvent)  ReadField280(iprot thrift.TProtocol) error {
-  p.WorkflowExecutionCancelRequestedEventAttributes = &WorkflowExecutionCancelRequestedEventAttributes{}
-  if err := p.WorkflowExecutionCancelRequestedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionCancelRequestedEventAttributes), err)
-  }
-  return nil
+// Attributes:
+//  - EarliestTime
+//  - LatestTime
+type StartTimeFilter struct {
+  // unused fields # 1 to 9
+  EarliestTime *int64 `thrift:"earliestTime,10" db:"earliestTime" json:"earliestTime,omitempty"`
+  // unused fields # 11 to 19
+  LatestTime *int64 `thrift:"latestTime,20" db:"latestTime" json:"latestTime,omitempty"`
 }
 
-func (p *HistoryEvent)  ReadField290(iprot thrift.TProtocol) error {
-  p.WorkflowExecutionCanceledEventAttributes = &WorkflowExecutionCanceledEventAttributes{}
-  if err := p.WorkflowExecutionCanceledEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionCanceledEventAttributes), err)
-  }
-  return nil
+func NewStartTimeFilter() *StartTimeFilter {
+  return &StartTimeFilter{}
 }
 
-func (p *HistoryEvent)  ReadField300(iprot thrift.TProtocol) error {
-  p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes = &RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{}
-  if err := p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes), err)
+var StartTimeFilter_EarliestTime_DEFAULT int64
+func (p *StartTimeFilter) GetEarliestTime() int64 {
+  if !p.IsSetEarliestTime() {
+    return StartTimeFilter_EarliestTime_DEFAULT
   }
-  return nil
+return *p.EarliestTime
 }
-
-func (p *HistoryEvent)  ReadField310(iprot thrift.TProtocol) error {
-  p.RequestCancelExternalWorkflowExecutionFailedEventAttributes = &RequestCancelExternalWorkflowExecutionFailedEventAttributes{}
-  if err := p.RequestCancelExternalWorkflowExecutionFailedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RequestCancelExternalWorkflowExecutionFailedEventAttributes), err)
+var StartTimeFilter_LatestTime_DEFAULT int64
+func (p *StartTimeFilter) GetLatestTime() int64 {
+  if !p.IsSetLatestTime() {
+    return StartTimeFilter_LatestTime_DEFAULT
   }
-  return nil
+return *p.LatestTime
 }
-
-func (p *HistoryEvent)  ReadField320(iprot thrift.TProtocol) error {
-  p.ExternalWorkflowExecutionCancelRequestedEventAttributes = &ExternalWorkflowExecutionCancelRequestedEventAttributes{}
-  if err := p.ExternalWorkflowExecutionCancelRequestedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExternalWorkflowExecutionCancelRequestedEventAttributes), err)
-  }
-  return nil
+func (p *StartTimeFilter) IsSetEarliestTime() bool {
+  return p.EarliestTime != nil
 }
 
-func (p *HistoryEvent)  ReadField330(iprot thrift.TProtocol) error {
-  p.WorkflowExecutionContinuedAsNewEventAttributes = &WorkflowExecutionContinuedAsNewEventAttributes{}
-  if err := p.WorkflowExecutionContinuedAsNewEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecutionContinuedAsNewEventAttributes), err)
-  }
-  return nil
+func (p *StartTimeFilter) IsSetLatestTime() bool {
+  return p.LatestTime != nil
 }
 
-func (p *HistoryEvent)  ReadField340(iprot thrift.TProtocol) error {
-  p.StartChildWorkflowExecutionInitiatedEventAttributes = &StartChildWorkflowExecutionInitiatedEventAttributes{}
-  if err := p.StartChildWorkflowExecutionInitiatedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartChildWorkflowExecutionInitiatedEventAttributes), err)
+func (p *StartTimeFilter) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  return nil
-}
 
-func (p *HistoryEvent)  ReadField350(iprot thrift.TProtocol) error {
-  p.StartChildWorkflowExecutionFailedEventAttributes = &StartChildWorkflowExecutionFailedEventAttributes{}
-  if err := p.StartChildWorkflowExecutionFailedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartChildWorkflowExecutionFailedEventAttributes), err)
-  }
-  return nil
-}
 
-func (p *HistoryEvent)  ReadField360(iprot thrift.TProtocol) error {
-  p.ChildWorkflowExecutionStartedEventAttributes = &ChildWorkflowExecutionStartedEventAttributes{}
-  if err := p.ChildWorkflowExecutionStartedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionStartedEventAttributes), err)
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  return nil
-}
-
-func (p *HistoryEvent)  ReadField370(iprot thrift.TProtocol) error {
-  p.ChildWorkflowExecutionCompletedEventAttributes = &ChildWorkflowExecutionCompletedEventAttributes{}
-  if err := p.ChildWorkflowExecutionCompletedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionCompletedEventAttributes), err)
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
   return nil
 }
 
-func (p *HistoryEvent)  ReadField380(iprot thrift.TProtocol) error {
-  p.ChildWorkflowExecutionFailedEventAttributes = &ChildWorkflowExecutionFailedEventAttributes{}
-  if err := p.ChildWorkflowExecutionFailedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionFailedEventAttributes), err)
-  }
-  return nil
+func (p *StartTimeFilter)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.EarliestTime = &v
 }
-
-func (p *HistoryEvent)  ReadField390(iprot thrift.TProtocol) error {
-  p.ChildWorkflowExecutionCanceledEventAttributes = &ChildWorkflowExecutionCanceledEventAttributes{}
-  if err := p.ChildWorkflowExecutionCanceledEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionCanceledEventAttributes), err)
-  }
   return nil
 }
 
-func (p *HistoryEvent)  ReadField400(iprot thrift.TProtocol) error {
-  p.ChildWorkflowExecutionTimedOutEventAttributes = &ChildWorkflowExecutionTimedOutEventAttributes{}
-  if err := p.ChildWorkflowExecutionTimedOutEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionTimedOutEventAttributes), err)
-  }
-  return nil
+func (p *StartTimeFilter)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.LatestTime = &v
 }
-
-func (p *HistoryEvent)  ReadField410(iprot thrift.TProtocol) error {
-  p.ChildWorkflowExecutionTerminatedEventAttributes = &ChildWorkflowExecutionTerminatedEventAttributes{}
-  if err := p.ChildWorkflowExecutionTerminatedEventAttributes.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ChildWorkflowExecutionTerminatedEventAttributes), err)
-  }
   return nil
 }
 
-func (p *HistoryEvent) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("HistoryEvent"); err != nil {
+func (p *StartTimeFilter) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartTimeFilter"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField10(oprot); err != nil { return err }
-    if err := p.writeField20(oprot); err != nil { return err }
-    if err := p.writeField30(oprot); err != nil { return err }
-    if err := p.writeField40(oprot); err != nil { return err }
-    if err := p.writeField50(oprot); err != nil { return err }
-    if err := p.writeField60(oprot); err != nil { return err }
-    if err := p.writeField70(oprot); err != nil { return err }
-    if err := p.writeField80(oprot); err != nil { return err }
-    if err := p.writeField90(oprot); err != nil { return err }
-    if err := p.writeField100(oprot); err != nil { return err }
-    if err := p.writeField110(oprot); err != nil { return err }
-    if err := p.writeField120(oprot); err != nil { return err }
-    if err := p.writeField130(oprot); err != nil { return err }
-    if err := p.writeField140(oprot); err != nil { return err }
-    if err := p.writeField150(oprot); err != nil { return err }
-    if err := p.writeField160(oprot); err != nil { return err }
-    if err := p.writeField170(oprot); err != nil { return err }
-    if err := p.writeField180(oprot); err != nil { return err }
-    if err := p.writeField190(oprot); err != nil { return err }
-    if err := p.writeField200(oprot); err != nil { return err }
-    if err := p.writeField210(oprot); err != nil { return err }
-    if err := p.writeField220(oprot); err != nil { return err }
-    if err := p.writeField230(oprot); err != nil { return err }
-    if err := p.writeField240(oprot); err != nil { return err }
-    if err := p.writeField250(oprot); err != nil { return err }
-    if err := p.writeField260(oprot); err != nil { return err }
-    if err := p.writeField270(oprot); err != nil { return err }
-    if err := p.writeField280(oprot); err != nil { return err }
-    if err := p.writeField290(oprot); err != nil { return err }
-    if err := p.writeField300(oprot); err != nil { return err }
-    if err := p.writeField310(oprot); err != nil { return err }
-    if err := p.writeField320(oprot); err != nil { return err }
-    if err := p.writeField330(oprot); err != nil { return err }
-    if err := p.writeField340(oprot); err != nil { return err }
-    if err := p.writeField350(oprot); err != nil { return err }
-    if err := p.writeField360(oprot); err != nil { return err }
-    if err := p.writeField370(oprot); err != nil { return err }
-    if err := p.writeField380(oprot); err != nil { return err }
-    if err := p.writeField390(oprot); err != nil { return err }
-    if err := p.writeField400(oprot); err != nil { return err }
-    if err := p.writeField410(oprot); err != nil { return err }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -14639,564 +16897,425 @@ func (p *HistoryEvent) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *HistoryEvent) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEventId() {
-    if err := oprot.WriteFieldBegin("eventId", thrift.I64, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:eventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.EventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.eventId (10) field write error: ", p), err) }
+func (p *StartTimeFilter) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEarliestTime() {
+    if err := oprot.WriteFieldBegin("earliestTime", thrift.I64, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:earliestTime: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.EarliestTime)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.earliestTime (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:eventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:earliestTime: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryEvent) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTimestamp() {
-    if err := oprot.WriteFieldBegin("timestamp", thrift.I64, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:timestamp: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.Timestamp)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.timestamp (20) field write error: ", p), err) }
+func (p *StartTimeFilter) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetLatestTime() {
+    if err := oprot.WriteFieldBegin("latestTime", thrift.I64, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:latestTime: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.LatestTime)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.latestTime (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:timestamp: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:latestTime: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryEvent) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEventType() {
-    if err := oprot.WriteFieldBegin("eventType", thrift.I32, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:eventType: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.EventType)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.eventType (30) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:eventType: ", p), err) }
+func (p *StartTimeFilter) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return err
+  return fmt.Sprintf("StartTimeFilter(%+v)", *p)
 }
 
-func (p *HistoryEvent) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecutionStartedEventAttributes() {
-    if err := oprot.WriteFieldBegin("workflowExecutionStartedEventAttributes", thrift.STRUCT, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowExecutionStartedEventAttributes: ", p), err) }
-    if err := p.WorkflowExecutionStartedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionStartedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowExecutionStartedEventAttributes: ", p), err) }
-  }
-  return err
+// Attributes:
+//  - Name
+//  - Status
+//  - Description
+//  - OwnerEmail
+type DomainInfo struct {
+  // unused fields # 1 to 9
+  Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
+  // unused fields # 11 to 19
+  Status *DomainStatus `thrift:"status,20" db:"status" json:"status,omitempty"`
+  // unused fields # 21 to 29
+  Description *string `thrift:"description,30" db:"description" json:"description,omitempty"`
+  // unused fields # 31 to 39
+  OwnerEmail *string `thrift:"ownerEmail,40" db:"ownerEmail" json:"ownerEmail,omitempty"`
 }
 
-func (p *HistoryEvent) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecutionCompletedEventAttributes() {
-    if err := oprot.WriteFieldBegin("workflowExecutionCompletedEventAttributes", thrift.STRUCT, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:workflowExecutionCompletedEventAttributes: ", p), err) }
-    if err := p.WorkflowExecutionCompletedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionCompletedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:workflowExecutionCompletedEventAttributes: ", p), err) }
-  }
-  return err
+func NewDomainInfo() *DomainInfo {
+  return &DomainInfo{}
 }
 
-func (p *HistoryEvent) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecutionFailedEventAttributes() {
-    if err := oprot.WriteFieldBegin("workflowExecutionFailedEventAttributes", thrift.STRUCT, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:workflowExecutionFailedEventAttributes: ", p), err) }
-    if err := p.WorkflowExecutionFailedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionFailedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:workflowExecutionFailedEventAttributes: ", p), err) }
+var DomainInfo_Name_DEFAULT string
+func (p *DomainInfo) GetName() string {
+  if !p.IsSetName() {
+    return DomainInfo_Name_DEFAULT
   }
-  return err
+return *p.Name
 }
-
-func (p *HistoryEvent) writeField70(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecutionTimedOutEventAttributes() {
-    if err := oprot.WriteFieldBegin("workflowExecutionTimedOutEventAttributes", thrift.STRUCT, 70); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:workflowExecutionTimedOutEventAttributes: ", p), err) }
-    if err := p.WorkflowExecutionTimedOutEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionTimedOutEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:workflowExecutionTimedOutEventAttributes: ", p), err) }
+var DomainInfo_Status_DEFAULT DomainStatus
+func (p *DomainInfo) GetStatus() DomainStatus {
+  if !p.IsSetStatus() {
+    return DomainInfo_Status_DEFAULT
   }
-  return err
+return *p.Status
 }
-
-func (p *HistoryEvent) writeField80(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDecisionTaskScheduledEventAttributes() {
-    if err := oprot.WriteFieldBegin("decisionTaskScheduledEventAttributes", thrift.STRUCT, 80); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:decisionTaskScheduledEventAttributes: ", p), err) }
-    if err := p.DecisionTaskScheduledEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionTaskScheduledEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:decisionTaskScheduledEventAttributes: ", p), err) }
+var DomainInfo_Description_DEFAULT string
+func (p *DomainInfo) GetDescription() string {
+  if !p.IsSetDescription() {
+    return DomainInfo_Description_DEFAULT
   }
-  return err
+return *p.Description
 }
-
-func (p *HistoryEvent) writeField90(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDecisionTaskStartedEventAttributes() {
-    if err := oprot.WriteFieldBegin("decisionTaskStartedEventAttributes", thrift.STRUCT, 90); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:decisionTaskStartedEventAttributes: ", p), err) }
-    if err := p.DecisionTaskStartedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionTaskStartedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:decisionTaskStartedEventAttributes: ", p), err) }
+var DomainInfo_OwnerEmail_DEFAULT string
+func (p *DomainInfo) GetOwnerEmail() string {
+  if !p.IsSetOwnerEmail() {
+    return DomainInfo_OwnerEmail_DEFAULT
   }
-  return err
+return *p.OwnerEmail
 }
-
-func (p *HistoryEvent) writeField100(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDecisionTaskCompletedEventAttributes() {
-    if err := oprot.WriteFieldBegin("decisionTaskCompletedEventAttributes", thrift.STRUCT, 100); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:decisionTaskCompletedEventAttributes: ", p), err) }
-    if err := p.DecisionTaskCompletedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionTaskCompletedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:decisionTaskCompletedEventAttributes: ", p), err) }
-  }
-  return err
+func (p *DomainInfo) IsSetName() bool {
+  return p.Name != nil
 }
 
-func (p *HistoryEvent) writeField110(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDecisionTaskTimedOutEventAttributes() {
-    if err := oprot.WriteFieldBegin("decisionTaskTimedOutEventAttributes", thrift.STRUCT, 110); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:decisionTaskTimedOutEventAttributes: ", p), err) }
-    if err := p.DecisionTaskTimedOutEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionTaskTimedOutEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:decisionTaskTimedOutEventAttributes: ", p), err) }
-  }
-  return err
+func (p *DomainInfo) IsSetStatus() bool {
+  return p.Status != nil
 }
 
-func (p *HistoryEvent) writeField120(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDecisionTaskFailedEventAttributes() {
-    if err := oprot.WriteFieldBegin("decisionTaskFailedEventAttributes", thrift.STRUCT, 120); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 120:decisionTaskFailedEventAttributes: ", p), err) }
-    if err := p.DecisionTaskFailedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionTaskFailedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 120:decisionTaskFailedEventAttributes: ", p), err) }
-  }
-  return err
+func (p *DomainInfo) IsSetDescription() bool {
+  return p.Description != nil
 }
 
-func (p *HistoryEvent) writeField130(oprot thrift.TProtocol) (err error) {
-  if p.IsSetActivityTaskScheduledEventAttributes() {
-    if err := oprot.WriteFieldBegin("activityTaskScheduledEventAttributes", thrift.STRUCT, 130); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 130:activityTaskScheduledEventAttributes: ", p), err) }
-    if err := p.ActivityTaskScheduledEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskScheduledEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 130:activityTaskScheduledEventAttributes: ", p), err) }
-  }
-  return err
+func (p *DomainInfo) IsSetOwnerEmail() bool {
+  return p.OwnerEmail != nil
 }
 
-func (p *HistoryEvent) writeField140(oprot thrift.TProtocol) (err error) {
-  if p.IsSetActivityTaskStartedEventAttributes() {
-    if err := oprot.WriteFieldBegin("activityTaskStartedEventAttributes", thrift.STRUCT, 140); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 140:activityTaskStartedEventAttributes: ", p), err) }
-    if err := p.ActivityTaskStartedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskStartedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 140:activityTaskStartedEventAttributes: ", p), err) }
+func (p *DomainInfo) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  return err
-}
 
-func (p *HistoryEvent) writeField150(oprot thrift.TProtocol) (err error) {
-  if p.IsSetActivityTaskCompletedEventAttributes() {
-    if err := oprot.WriteFieldBegin("activityTaskCompletedEventAttributes", thrift.STRUCT, 150); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 150:activityTaskCompletedEventAttributes: ", p), err) }
-    if err := p.ActivityTaskCompletedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskCompletedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 150:activityTaskCompletedEventAttributes: ", p), err) }
-  }
-  return err
-}
 
-func (p *HistoryEvent) writeField160(oprot thrift.TProtocol) (err error) {
-  if p.IsSetActivityTaskFailedEventAttributes() {
-    if err := oprot.WriteFieldBegin("activityTaskFailedEventAttributes", thrift.STRUCT, 160); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 160:activityTaskFailedEventAttributes: ", p), err) }
-    if err := p.ActivityTaskFailedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskFailedEventAttributes), err)
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
     }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 160:activityTaskFailedEventAttributes: ", p), err) }
-  }
-  return err
-}
-
-func (p *HistoryEvent) writeField170(oprot thrift.TProtocol) (err error) {
-  if p.IsSetActivityTaskTimedOutEventAttributes() {
-    if err := oprot.WriteFieldBegin("activityTaskTimedOutEventAttributes", thrift.STRUCT, 170); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 170:activityTaskTimedOutEventAttributes: ", p), err) }
-    if err := p.ActivityTaskTimedOutEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskTimedOutEventAttributes), err)
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 170:activityTaskTimedOutEventAttributes: ", p), err) }
   }
-  return err
-}
-
-func (p *HistoryEvent) writeField180(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTimerStartedEventAttributes() {
-    if err := oprot.WriteFieldBegin("timerStartedEventAttributes", thrift.STRUCT, 180); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 180:timerStartedEventAttributes: ", p), err) }
-    if err := p.TimerStartedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TimerStartedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 180:timerStartedEventAttributes: ", p), err) }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return err
+  return nil
 }
 
-func (p *HistoryEvent) writeField190(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTimerFiredEventAttributes() {
-    if err := oprot.WriteFieldBegin("timerFiredEventAttributes", thrift.STRUCT, 190); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 190:timerFiredEventAttributes: ", p), err) }
-    if err := p.TimerFiredEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TimerFiredEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 190:timerFiredEventAttributes: ", p), err) }
-  }
-  return err
+func (p *DomainInfo)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Name = &v
 }
-
-func (p *HistoryEvent) writeField200(oprot thrift.TProtocol) (err error) {
-  if p.IsSetActivityTaskCancelRequestedEventAttributes() {
-    if err := oprot.WriteFieldBegin("activityTaskCancelRequestedEventAttributes", thrift.STRUCT, 200); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 200:activityTaskCancelRequestedEventAttributes: ", p), err) }
-    if err := p.ActivityTaskCancelRequestedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskCancelRequestedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 200:activityTaskCancelRequestedEventAttributes: ", p), err) }
-  }
-  return err
+  return nil
 }
 
-func (p *HistoryEvent) writeField210(oprot thrift.TProtocol) (err error) {
-  if p.IsSetRequestCancelActivityTaskFailedEventAttributes() {
-    if err := oprot.WriteFieldBegin("requestCancelActivityTaskFailedEventAttributes", thrift.STRUCT, 210); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 210:requestCancelActivityTaskFailedEventAttributes: ", p), err) }
-    if err := p.RequestCancelActivityTaskFailedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RequestCancelActivityTaskFailedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 210:requestCancelActivityTaskFailedEventAttributes: ", p), err) }
-  }
-  return err
+func (p *DomainInfo)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  temp := DomainStatus(v)
+  p.Status = &temp
+}
+  return nil
 }
 
-func (p *HistoryEvent) writeField220(oprot thrift.TProtocol) (err error) {
-  if p.IsSetActivityTaskCanceledEventAttributes() {
-    if err := oprot.WriteFieldBegin("activityTaskCanceledEventAttributes", thrift.STRUCT, 220); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 220:activityTaskCanceledEventAttributes: ", p), err) }
-    if err := p.ActivityTaskCanceledEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTaskCanceledEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 220:activityTaskCanceledEventAttributes: ", p), err) }
-  }
-  return err
+func (p *DomainInfo)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Description = &v
+}
+  return nil
 }
 
-func (p *HistoryEvent) writeField230(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTimerCanceledEventAttributes() {
-    if err := oprot.WriteFieldBegin("timerCanceledEventAttributes", thrift.STRUCT, 230); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 230:timerCanceledEventAttributes: ", p), err) }
-    if err := p.TimerCanceledEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TimerCanceledEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 230:timerCanceledEventAttributes: ", p), err) }
-  }
-  return err
+func (p *DomainInfo)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.OwnerEmail = &v
+}
+  return nil
 }
 
-func (p *HistoryEvent) writeField240(oprot thrift.TProtocol) (err error) {
-  if p.IsSetCancelTimerFailedEventAttributes() {
-    if err := oprot.WriteFieldBegin("cancelTimerFailedEventAttributes", thrift.STRUCT, 240); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 240:cancelTimerFailedEventAttributes: ", p), err) }
-    if err := p.CancelTimerFailedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelTimerFailedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 240:cancelTimerFailedEventAttributes: ", p), err) }
+func (p *DomainInfo) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DomainInfo"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
   }
-  return err
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-func (p *HistoryEvent) writeField250(oprot thrift.TProtocol) (err error) {
-  if p.IsSetMarkerRecordedEventAttributes() {
-    if err := oprot.WriteFieldBegin("markerRecordedEventAttributes", thrift.STRUCT, 250); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 250:markerRecordedEventAttributes: ", p), err) }
-    if err := p.MarkerRecordedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MarkerRecordedEventAttributes), err)
-    }
+func (p *DomainInfo) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetName() {
+    if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
+    if err := oprot.WriteString(string(*p.Name)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 250:markerRecordedEventAttributes: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryEvent) writeField260(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecutionSignaledEventAttributes() {
-    if err := oprot.WriteFieldBegin("workflowExecutionSignaledEventAttributes", thrift.STRUCT, 260); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 260:workflowExecutionSignaledEventAttributes: ", p), err) }
-    if err := p.WorkflowExecutionSignaledEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionSignaledEventAttributes), err)
-    }
+func (p *DomainInfo) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStatus() {
+    if err := oprot.WriteFieldBegin("status", thrift.I32, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:status: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.Status)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.status (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 260:workflowExecutionSignaledEventAttributes: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:status: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryEvent) writeField270(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecutionTerminatedEventAttributes() {
-    if err := oprot.WriteFieldBegin("workflowExecutionTerminatedEventAttributes", thrift.STRUCT, 270); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 270:workflowExecutionTerminatedEventAttributes: ", p), err) }
-    if err := p.WorkflowExecutionTerminatedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionTerminatedEventAttributes), err)
-    }
+func (p *DomainInfo) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDescription() {
+    if err := oprot.WriteFieldBegin("description", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:description: ", p), err) }
+    if err := oprot.WriteString(string(*p.Description)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.description (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 270:workflowExecutionTerminatedEventAttributes: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:description: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryEvent) writeField280(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecutionCancelRequestedEventAttributes() {
-    if err := oprot.WriteFieldBegin("workflowExecutionCancelRequestedEventAttributes", thrift.STRUCT, 280); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 280:workflowExecutionCancelRequestedEventAttributes: ", p), err) }
-    if err := p.WorkflowExecutionCancelRequestedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionCancelRequestedEventAttributes), err)
-    }
+func (p *DomainInfo) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetOwnerEmail() {
+    if err := oprot.WriteFieldBegin("ownerEmail", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:ownerEmail: ", p), err) }
+    if err := oprot.WriteString(string(*p.OwnerEmail)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.ownerEmail (40) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 280:workflowExecutionCancelRequestedEventAttributes: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:ownerEmail: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryEvent) writeField290(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecutionCanceledEventAttributes() {
-    if err := oprot.WriteFieldBegin("workflowExecutionCanceledEventAttributes", thrift.STRUCT, 290); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 290:workflowExecutionCanceledEventAttributes: ", p), err) }
-    if err := p.WorkflowExecutionCanceledEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionCanceledEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 290:workflowExecutionCanceledEventAttributes: ", p), err) }
+func (p *DomainInfo) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return err
+  return fmt.Sprintf("DomainInfo(%+v)", *p)
 }
 
-func (p *HistoryEvent) writeField300(oprot thrift.TProtocol) (err error) {
-  if p.IsSetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes() {
-    if err := oprot.WriteFieldBegin("requestCancelExternalWorkflowExecutionInitiatedEventAttributes", thrift.STRUCT, 300); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 300:requestCancelExternalWorkflowExecutionInitiatedEventAttributes: ", p), err) }
-    if err := p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 300:requestCancelExternalWorkflowExecutionInitiatedEventAttributes: ", p), err) }
-  }
-  return err
+// Attributes:
+//  - WorkflowExecutionRetentionPeriodInDays
+//  - EmitMetric
+type DomainConfiguration struct {
+  // unused fields # 1 to 9
+  WorkflowExecutionRetentionPeriodInDays *int32 `thrift:"workflowExecutionRetentionPeriodInDays,10" db:"workflowExecutionRetentionPeriodInDays" json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+  // unused fields # 11 to 19
+  EmitMetric *bool `thrift:"emitMetric,20" db:"emitMetric" json:"emitMetric,omitempty"`
 }
 
-func (p *HistoryEvent) writeField310(oprot thrift.TProtocol) (err error) {
-  if p.IsSetRequestCancelExternalWorkflowExecutionFailedEventAttributes() {
-    if err := oprot.WriteFieldBegin("requestCancelExternalWorkflowExecutionFailedEventAttributes", thrift.STRUCT, 310); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 310:requestCancelExternalWorkflowExecutionFailedEventAttributes: ", p), err) }
-    if err := p.RequestCancelExternalWorkflowExecutionFailedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RequestCancelExternalWorkflowExecutionFailedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 310:requestCancelExternalWorkflowExecutionFailedEventAttributes: ", p), err) }
-  }
-  return err
+func NewDomainConfiguration() *DomainConfiguration {
+  return &DomainConfiguration{}
 }
 
-func (p *HistoryEvent) writeField320(oprot thrift.TProtocol) (err error) {
-  if p.IsSetExternalWorkflowExecutionCancelRequestedEventAttributes() {
-    if err := oprot.WriteFieldBegin("externalWorkflowExecutionCancelRequestedEventAttributes", thrift.STRUCT, 320); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 320:externalWorkflowExecutionCancelRequestedEventAttributes: ", p), err) }
-    if err := p.ExternalWorkflowExecutionCancelRequestedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ExternalWorkflowExecutionCancelRequestedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 320:externalWorkflowExecutionCancelRequestedEventAttributes: ", p), err) }
+var DomainConfiguration_WorkflowExecutionRetentionPeriodInDays_DEFAULT int32
+func (p *DomainConfiguration) GetWorkflowExecutionRetentionPeriodInDays() int32 {
+  if !p.IsSetWorkflowExecutionRetentionPeriodInDays() {
+    return DomainConfiguration_WorkflowExecutionRetentionPeriodInDays_DEFAULT
   }
-  return err
+return *p.WorkflowExecutionRetentionPeriodInDays
 }
-
-func (p *HistoryEvent) writeField330(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecutionContinuedAsNewEventAttributes() {
-    if err := oprot.WriteFieldBegin("workflowExecutionContinuedAsNewEventAttributes", thrift.STRUCT, 330); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 330:workflowExecutionContinuedAsNewEventAttributes: ", p), err) }
-    if err := p.WorkflowExecutionContinuedAsNewEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecutionContinuedAsNewEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 330:workflowExecutionContinuedAsNewEventAttributes: ", p), err) }
+var DomainConfiguration_EmitMetric_DEFAULT bool
+func (p *DomainConfiguration) GetEmitMetric() bool {
+  if !p.IsSetEmitMetric() {
+    return DomainConfiguration_EmitMetric_DEFAULT
   }
-  return err
+return *p.EmitMetric
+}
+func (p *DomainConfiguration) IsSetWorkflowExecutionRetentionPeriodInDays() bool {
+  return p.WorkflowExecutionRetentionPeriodInDays != nil
 }
 
-func (p *HistoryEvent) writeField340(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartChildWorkflowExecutionInitiatedEventAttributes() {
-    if err := oprot.WriteFieldBegin("startChildWorkflowExecutionInitiatedEventAttributes", thrift.STRUCT, 340); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 340:startChildWorkflowExecutionInitiatedEventAttributes: ", p), err) }
-    if err := p.StartChildWorkflowExecutionInitiatedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartChildWorkflowExecutionInitiatedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 340:startChildWorkflowExecutionInitiatedEventAttributes: ", p), err) }
-  }
-  return err
+func (p *DomainConfiguration) IsSetEmitMetric() bool {
+  return p.EmitMetric != nil
 }
 
-func (p *HistoryEvent) writeField350(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartChildWorkflowExecutionFailedEventAttributes() {
-    if err := oprot.WriteFieldBegin("startChildWorkflowExecutionFailedEventAttributes", thrift.STRUCT, 350); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 350:startChildWorkflowExecutionFailedEventAttributes: ", p), err) }
-    if err := p.StartChildWorkflowExecutionFailedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartChildWorkflowExecutionFailedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 350:startChildWorkflowExecutionFailedEventAttributes: ", p), err) }
+func (p *DomainConfiguration) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  return err
-}
 
-func (p *HistoryEvent) writeField360(oprot thrift.TProtocol) (err error) {
-  if p.IsSetChildWorkflowExecutionStartedEventAttributes() {
-    if err := oprot.WriteFieldBegin("childWorkflowExecutionStartedEventAttributes", thrift.STRUCT, 360); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 360:childWorkflowExecutionStartedEventAttributes: ", p), err) }
-    if err := p.ChildWorkflowExecutionStartedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionStartedEventAttributes), err)
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 360:childWorkflowExecutionStartedEventAttributes: ", p), err) }
   }
-  return err
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
 
-func (p *HistoryEvent) writeField370(oprot thrift.TProtocol) (err error) {
-  if p.IsSetChildWorkflowExecutionCompletedEventAttributes() {
-    if err := oprot.WriteFieldBegin("childWorkflowExecutionCompletedEventAttributes", thrift.STRUCT, 370); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 370:childWorkflowExecutionCompletedEventAttributes: ", p), err) }
-    if err := p.ChildWorkflowExecutionCompletedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionCompletedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 370:childWorkflowExecutionCompletedEventAttributes: ", p), err) }
-  }
-  return err
+func (p *DomainConfiguration)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.WorkflowExecutionRetentionPeriodInDays = &v
+}
+  return nil
 }
 
-func (p *HistoryEvent) writeField380(oprot thrift.TProtocol) (err error) {
-  if p.IsSetChildWorkflowExecutionFailedEventAttributes() {
-    if err := oprot.WriteFieldBegin("childWorkflowExecutionFailedEventAttributes", thrift.STRUCT, 380); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 380:childWorkflowExecutionFailedEventAttributes: ", p), err) }
-    if err := p.ChildWorkflowExecutionFailedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionFailedEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 380:childWorkflowExecutionFailedEventAttributes: ", p), err) }
-  }
-  return err
+func (p *DomainConfiguration)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.EmitMetric = &v
+}
+  return nil
 }
 
-func (p *HistoryEvent) writeField390(oprot thrift.TProtocol) (err error) {
-  if p.IsSetChildWorkflowExecutionCanceledEventAttributes() {
-    if err := oprot.WriteFieldBegin("childWorkflowExecutionCanceledEventAttributes", thrift.STRUCT, 390); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 390:childWorkflowExecutionCanceledEventAttributes: ", p), err) }
-    if err := p.ChildWorkflowExecutionCanceledEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionCanceledEventAttributes), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 390:childWorkflowExecutionCanceledEventAttributes: ", p), err) }
+func (p *DomainConfiguration) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DomainConfiguration"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
   }
-  return err
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-func (p *HistoryEvent) writeField400(oprot thrift.TProtocol) (err error) {
-  if p.IsSetChildWorkflowExecutionTimedOutEventAttributes() {
-    if err := oprot.WriteFieldBegin("childWorkflowExecutionTimedOutEventAttributes", thrift.STRUCT, 400); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 400:childWorkflowExecutionTimedOutEventAttributes: ", p), err) }
-    if err := p.ChildWorkflowExecutionTimedOutEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionTimedOutEventAttributes), err)
-    }
+func (p *DomainConfiguration) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecutionRetentionPeriodInDays() {
+    if err := oprot.WriteFieldBegin("workflowExecutionRetentionPeriodInDays", thrift.I32, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:workflowExecutionRetentionPeriodInDays: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.WorkflowExecutionRetentionPeriodInDays)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowExecutionRetentionPeriodInDays (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 400:childWorkflowExecutionTimedOutEventAttributes: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:workflowExecutionRetentionPeriodInDays: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryEvent) writeField410(oprot thrift.TProtocol) (err error) {
-  if p.IsSetChildWorkflowExecutionTerminatedEventAttributes() {
-    if err := oprot.WriteFieldBegin("childWorkflowExecutionTerminatedEventAttributes", thrift.STRUCT, 410); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 410:childWorkflowExecutionTerminatedEventAttributes: ", p), err) }
-    if err := p.ChildWorkflowExecutionTerminatedEventAttributes.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ChildWorkflowExecutionTerminatedEventAttributes), err)
-    }
+func (p *DomainConfiguration) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEmitMetric() {
+    if err := oprot.WriteFieldBegin("emitMetric", thrift.BOOL, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:emitMetric: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.EmitMetric)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.emitMetric (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 410:childWorkflowExecutionTerminatedEventAttributes: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:emitMetric: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryEvent) String() string {
+func (p *DomainConfiguration) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryEvent(%+v)", *p)
+  return fmt.Sprintf("DomainConfiguration(%+v)", *p)
 }
 
 // Attributes:
-//  - Events
-type History struct {
+//  - Description
+//  - OwnerEmail
+type UpdateDomainInfo struct {
   // unused fields # 1 to 9
-  Events []*HistoryEvent `thrift:"events,10" db:"events" json:"events,omitempty"`
+  Description *string `thrift:"description,10" db:"description" json:"description,omitempty"`
+  // unused fields # 11 to 19
+  OwnerEmail *string `thrift:"ownerEmail,20" db:"ownerEmail" json:"ownerEmail,omitempty"`
 }
 
-func NewHistory() *History {
-  return &History{}
+func NewUpdateDomainInfo() *UpdateDomainInfo {
+  return &UpdateDomainInfo{}
 }
 
-var History_Events_DEFAULT []*HistoryEvent
-
-func (p *History) GetEvents() []*HistoryEvent {
-  return p.Events
+var UpdateDomainInfo_Description_DEFAULT string
+func (p *UpdateDomainInfo) GetDescription() string {
+  if !p.IsSetDescription() {
+    return UpdateDomainInfo_Description_DEFAULT
+  }
+return *p.Description
 }
-func (p *History) IsSetEvents() bool {
-  return p.Events != nil
+var UpdateDomainInfo_OwnerEmail_DEFAULT string
+func (p *UpdateDomainInfo) GetOwnerEmail() string {
+  if !p.IsSetOwnerEmail() {
+    return UpdateDomainInfo_OwnerEmail_DEFAULT
+  }
+return *p.OwnerEmail
+}
+func (p *UpdateDomainInfo) IsSetDescription() bool {
+  return p.Description != nil
 }
 
-func (p *History) Read(iprot thrift.TProtocol) error {
+func (p *UpdateDomainInfo) IsSetOwnerEmail() bool {
+  return p.OwnerEmail != nil
+}
+
+func (p *UpdateDomainInfo) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -15213,6 +17332,10 @@ func (p *History) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField10(iprot); err != nil {
         return err
       }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -15228,31 +17351,30 @@ func (p *History) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *History)  ReadField10(iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin()
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]*HistoryEvent, 0, size)
-  p.Events =  tSlice
-  for i := 0; i < size; i ++ {
-    _elem0 := &HistoryEvent{}
-    if err := _elem0.Read(iprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem0), err)
-    }
-    p.Events = append(p.Events, _elem0)
-  }
-  if err := iprot.ReadListEnd(); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
-  }
+func (p *UpdateDomainInfo)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Description = &v
+}
   return nil
 }
 
-func (p *History) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("History"); err != nil {
+func (p *UpdateDomainInfo)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.OwnerEmail = &v
+}
+  return nil
+}
+
+func (p *UpdateDomainInfo) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("UpdateDomainInfo"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -15261,57 +17383,116 @@ func (p *History) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *History) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEvents() {
-    if err := oprot.WriteFieldBegin("events", thrift.LIST, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:events: ", p), err) }
-    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Events)); err != nil {
-      return thrift.PrependError("error writing list begin: ", err)
-    }
-    for _, v := range p.Events {
-      if err := v.Write(oprot); err != nil {
-        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-      }
-    }
-    if err := oprot.WriteListEnd(); err != nil {
-      return thrift.PrependError("error writing list end: ", err)
-    }
+func (p *UpdateDomainInfo) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDescription() {
+    if err := oprot.WriteFieldBegin("description", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:description: ", p), err) }
+    if err := oprot.WriteString(string(*p.Description)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.description (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:events: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:description: ", p), err) }
   }
   return err
 }
 
-func (p *History) String() string {
+func (p *UpdateDomainInfo) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetOwnerEmail() {
+    if err := oprot.WriteFieldBegin("ownerEmail", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:ownerEmail: ", p), err) }
+    if err := oprot.WriteString(string(*p.OwnerEmail)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.ownerEmail (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:ownerEmail: ", p), err) }
+  }
+  return err
+}
+
+func (p *UpdateDomainInfo) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("History(%+v)", *p)
+  return fmt.Sprintf("UpdateDomainInfo(%+v)", *p)
 }
 
 // Attributes:
-//  - WorkflowId
-type WorkflowExecutionFilter struct {
+//  - Name
+//  - Description
+//  - OwnerEmail
+//  - WorkflowExecutionRetentionPeriodInDays
+//  - EmitMetric
+type RegisterDomainRequest struct {
   // unused fields # 1 to 9
-  WorkflowId *string `thrift:"workflowId,10" db:"workflowId" json:"workflowId,omitempty"`
+  Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
+  // unused fields # 11 to 19
+  Description *string `thrift:"description,20" db:"description" json:"description,omitempty"`
+  // unused fields # 21 to 29
+  OwnerEmail *string `thrift:"ownerEmail,30" db:"ownerEmail" json:"ownerEmail,omitempty"`
+  // unused fields # 31 to 39
+  WorkflowExecutionRetentionPeriodInDays *int32 `thrift:"workflowExecutionRetentionPeriodInDays,40" db:"workflowExecutionRetentionPeriodInDays" json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+  // unused fields # 41 to 49
+  EmitMetric *bool `thrift:"emitMetric,50" db:"emitMetric" json:"emitMetric,omitempty"`
 }
 
-func NewWorkflowExecutionFilter() *WorkflowExecutionFilter {
-  return &WorkflowExecutionFilter{}
+func NewRegisterDomainRequest() *RegisterDomainRequest {
+  return &RegisterDomainRequest{}
 }
 
-var WorkflowExecutionFilter_WorkflowId_DEFAULT string
-func (p *WorkflowExecutionFilter) GetWorkflowId() string {
-  if !p.IsSetWorkflowId() {
-    return WorkflowExecutionFilter_WorkflowId_DEFAULT
+var RegisterDomainRequest_Name_DEFAULT string
+func (p *RegisterDomainRequest) GetName() string {
+  if !p.IsSetName() {
+    return RegisterDomainRequest_Name_DEFAULT
   }
-return *p.WorkflowId
+return *p.Name
 }
-func (p *WorkflowExecutionFilter) IsSetWorkflowId() bool {
-  return p.WorkflowId != nil
+var RegisterDomainRequest_Description_DEFAULT string
+func (p *RegisterDomainRequest) GetDescription() string {
+  if !p.IsSetDescription() {
+    return RegisterDomainRequest_Description_DEFAULT
+  }
+return *p.Description
+}
+var RegisterDomainRequest_OwnerEmail_DEFAULT string
+func (p *RegisterDomainRequest) GetOwnerEmail() string {
+  if !p.IsSetOwnerEmail() {
+    return RegisterDomainRequest_OwnerEmail_DEFAULT
+  }
+return *p.OwnerEmail
+}
+var RegisterDomainRequest_WorkflowExecutionRetentionPeriodInDays_DEFAULT int32
+func (p *RegisterDomainRequest) GetWorkflowExecutionRetentionPeriodInDays() int32 {
+  if !p.IsSetWorkflowExecutionRetentionPeriodInDays() {
+    return RegisterDomainRequest_WorkflowExecutionRetentionPeriodInDays_DEFAULT
+  }
+return *p.WorkflowExecutionRetentionPeriodInDays
+}
+var RegisterDomainRequest_EmitMetric_DEFAULT bool
+func (p *RegisterDomainRequest) GetEmitMetric() bool {
+  if !p.IsSetEmitMetric() {
+    return RegisterDomainRequest_EmitMetric_DEFAULT
+  }
+return *p.EmitMetric
+}
+func (p *RegisterDomainRequest) IsSetName() bool {
+  return p.Name != nil
 }
 
-func (p *WorkflowExecutionFilter) Read(iprot thrift.TProtocol) error {
+func (p *RegisterDomainRequest) IsSetDescription() bool {
+  return p.Description != nil
+}
+
+func (p *RegisterDomainRequest) IsSetOwnerEmail() bool {
+  return p.OwnerEmail != nil
+}
+
+func (p *RegisterDomainRequest) IsSetWorkflowExecutionRetentionPeriodInDays() bool {
+  return p.WorkflowExecutionRetentionPeriodInDays != nil
+}
+
+func (p *RegisterDomainRequest) IsSetEmitMetric() bool {
+  return p.EmitMetric != nil
+}
+
+func (p *RegisterDomainRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -15328,6 +17509,22 @@ func (p *WorkflowExecutionFilter) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField10(iprot); err != nil {
         return err
       }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -15343,20 +17540,60 @@ func (p *WorkflowExecutionFilter) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowExecutionFilter)  ReadField10(iprot thrift.TProtocol) error {
+func (p *RegisterDomainRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.WorkflowId = &v
+  p.Name = &v
 }
   return nil
 }
 
-func (p *WorkflowExecutionFilter) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("WorkflowExecutionFilter"); err != nil {
+func (p *RegisterDomainRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.Description = &v
+}
+  return nil
+}
+
+func (p *RegisterDomainRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.OwnerEmail = &v
+}
+  return nil
+}
+
+func (p *RegisterDomainRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.WorkflowExecutionRetentionPeriodInDays = &v
+}
+  return nil
+}
+
+func (p *RegisterDomainRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.EmitMetric = &v
+}
+  return nil
+}
+
+func (p *RegisterDomainRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RegisterDomainRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -15365,48 +17602,96 @@ func (p *WorkflowExecutionFilter) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowExecutionFilter) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowId() {
-    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:workflowId: ", p), err) }
-    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.workflowId (10) field write error: ", p), err) }
+func (p *RegisterDomainRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetName() {
+    if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
+    if err := oprot.WriteString(string(*p.Name)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:workflowId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowExecutionFilter) String() string {
+func (p *RegisterDomainRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDescription() {
+    if err := oprot.WriteFieldBegin("description", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:description: ", p), err) }
+    if err := oprot.WriteString(string(*p.Description)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.description (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:description: ", p), err) }
+  }
+  return err
+}
+
+func (p *RegisterDomainRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetOwnerEmail() {
+    if err := oprot.WriteFieldBegin("ownerEmail", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:ownerEmail: ", p), err) }
+    if err := oprot.WriteString(string(*p.OwnerEmail)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.ownerEmail (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:ownerEmail: ", p), err) }
+  }
+  return err
+}
+
+func (p *RegisterDomainRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecutionRetentionPeriodInDays() {
+    if err := oprot.WriteFieldBegin("workflowExecutionRetentionPeriodInDays", thrift.I32, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowExecutionRetentionPeriodInDays: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.WorkflowExecutionRetentionPeriodInDays)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowExecutionRetentionPeriodInDays (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowExecutionRetentionPeriodInDays: ", p), err) }
+  }
+  return err
+}
+
+func (p *RegisterDomainRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEmitMetric() {
+    if err := oprot.WriteFieldBegin("emitMetric", thrift.BOOL, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:emitMetric: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.EmitMetric)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.emitMetric (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:emitMetric: ", p), err) }
+  }
+  return err
+}
+
+func (p *RegisterDomainRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowExecutionFilter(%+v)", *p)
+  return fmt.Sprintf("RegisterDomainRequest(%+v)", *p)
 }
 
 // Attributes:
 //  - Name
-type WorkflowTypeFilter struct {
+type DescribeDomainRequest struct {
   // unused fields # 1 to 9
   Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
 }
 
-func NewWorkflowTypeFilter() *WorkflowTypeFilter {
-  return &WorkflowTypeFilter{}
+func NewDescribeDomainRequest() *DescribeDomainRequest {
+  return &DescribeDomainRequest{}
 }
 
-var WorkflowTypeFilter_Name_DEFAULT string
-func (p *WorkflowTypeFilter) GetName() string {
+var DescribeDomainRequest_Name_DEFAULT string
+func (p *DescribeDomainRequest) GetName() string {
   if !p.IsSetName() {
-    return WorkflowTypeFilter_Name_DEFAULT
+    return DescribeDomainRequest_Name_DEFAULT
   }
 return *p.Name
 }
-func (p *WorkflowTypeFilter) IsSetName() bool {
+func (p *DescribeDomainRequest) IsSetName() bool {
   return p.Name != nil
 }
 
-func (p *WorkflowTypeFilter) Read(iprot thrift.TProtocol) error {
+func (p *DescribeDomainRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -15438,7 +17723,7 @@ func (p *WorkflowTypeFilter) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowTypeFilter)  ReadField10(iprot thrift.TProtocol) error {
+func (p *DescribeDomainRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -15447,8 +17732,8 @@ func (p *WorkflowTypeFilter)  ReadField10(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowTypeFilter) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("WorkflowTypeFilter"); err != nil {
+func (p *DescribeDomainRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeDomainRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -15460,7 +17745,7 @@ func (p *WorkflowTypeFilter) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowTypeFilter) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *DescribeDomainRequest) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetName() {
     if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
@@ -15472,50 +17757,50 @@ func (p *WorkflowTypeFilter) writeField10(oprot thrift.TProtocol) (err error) {
   return err
 }
 
-func (p *WorkflowTypeFilter) String() string {
+func (p *DescribeDomainRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowTypeFilter(%+v)", *p)
+  return fmt.Sprintf("DescribeDomainRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - EarliestTime
-//  - LatestTime
-type StartTimeFilter struct {
+//  - DomainInfo
+//  - Configuration
+type DescribeDomainResponse struct {
   // unused fields # 1 to 9
-  EarliestTime *int64 `thrift:"earliestTime,10" db:"earliestTime" json:"earliestTime,omitempty"`
+  DomainInfo *DomainInfo `thrift:"domainInfo,10" db:"domainInfo" json:"domainInfo,omitempty"`
   // unused fields # 11 to 19
-  LatestTime *int64 `thrift:"latestTime,20" db:"latestTime" json:"latestTime,omitempty"`
+  Configuration *DomainConfiguration `thrift:"configuration,20" db:"configuration" json:"configuration,omitempty"`
 }
 
-func NewStartTimeFilter() *StartTimeFilter {
-  return &StartTimeFilter{}
+func NewDescribeDomainResponse() *DescribeDomainResponse {
+  return &DescribeDomainResponse{}
 }
 
-var StartTimeFilter_EarliestTime_DEFAULT int64
-func (p *StartTimeFilter) GetEarliestTime() int64 {
-  if !p.IsSetEarliestTime() {
-    return StartTimeFilter_EarliestTime_DEFAULT
+var DescribeDomainResponse_DomainInfo_DEFAULT *DomainInfo
+func (p *DescribeDomainResponse) GetDomainInfo() *DomainInfo {
+  if !p.IsSetDomainInfo() {
+    return DescribeDomainResponse_DomainInfo_DEFAULT
   }
-return *p.EarliestTime
+return p.DomainInfo
 }
-var StartTimeFilter_LatestTime_DEFAULT int64
-func (p *StartTimeFilter) GetLatestTime() int64 {
-  if !p.IsSetLatestTime() {
-    return StartTimeFilter_LatestTime_DEFAULT
+var DescribeDomainResponse_Configuration_DEFAULT *DomainConfiguration
+func (p *DescribeDomainResponse) GetConfiguration() *DomainConfiguration {
+  if !p.IsSetConfiguration() {
+    return DescribeDomainResponse_Configuration_DEFAULT
   }
-return *p.LatestTime
+return p.Configuration
 }
-func (p *StartTimeFilter) IsSetEarliestTime() bool {
-  return p.EarliestTime != nil
+func (p *DescribeDomainResponse) IsSetDomainInfo() bool {
+  return p.DomainInfo != nil
 }
 
-func (p *StartTimeFilter) IsSetLatestTime() bool {
-  return p.LatestTime != nil
+func (p *DescribeDomainResponse) IsSetConfiguration() bool {
+  return p.Configuration != nil
 }
 
-func (p *StartTimeFilter) Read(iprot thrift.TProtocol) error {
+func (p *DescribeDomainResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -15551,26 +17836,24 @@ func (p *StartTimeFilter) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *StartTimeFilter)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
-} else {
-  p.EarliestTime = &v
-}
+func (p *DescribeDomainResponse)  ReadField10(iprot thrift.TProtocol) error {
+  p.DomainInfo = &DomainInfo{}
+  if err := p.DomainInfo.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DomainInfo), err)
+  }
   return nil
 }
 
-func (p *StartTimeFilter)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 20: ", err)
-} else {
-  p.LatestTime = &v
-}
+func (p *DescribeDomainResponse)  ReadField20(iprot thrift.TProtocol) error {
+  p.Configuration = &DomainConfiguration{}
+  if err := p.Configuration.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Configuration), err)
+  }
   return nil
 }
 
-func (p *StartTimeFilter) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartTimeFilter"); err != nil {
+func (p *DescribeDomainResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeDomainResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -15583,102 +17866,90 @@ func (p *StartTimeFilter) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *StartTimeFilter) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEarliestTime() {
-    if err := oprot.WriteFieldBegin("earliestTime", thrift.I64, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:earliestTime: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.EarliestTime)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.earliestTime (10) field write error: ", p), err) }
+func (p *DescribeDomainResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainInfo() {
+    if err := oprot.WriteFieldBegin("domainInfo", thrift.STRUCT, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainInfo: ", p), err) }
+    if err := p.DomainInfo.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DomainInfo), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:earliestTime: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainInfo: ", p), err) }
   }
   return err
 }
 
-func (p *StartTimeFilter) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetLatestTime() {
-    if err := oprot.WriteFieldBegin("latestTime", thrift.I64, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:latestTime: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.LatestTime)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.latestTime (20) field write error: ", p), err) }
+func (p *DescribeDomainResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetConfiguration() {
+    if err := oprot.WriteFieldBegin("configuration", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:configuration: ", p), err) }
+    if err := p.Configuration.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Configuration), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:latestTime: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:configuration: ", p), err) }
   }
   return err
 }
 
-func (p *StartTimeFilter) String() string {
+func (p *DescribeDomainResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("StartTimeFilter(%+v)", *p)
+  return fmt.Sprintf("DescribeDomainResponse(%+v)", *p)
 }
 
 // Attributes:
 //  - Name
-//  - Status
-//  - Description
-//  - OwnerEmail
-type DomainInfo struct {
+//  - UpdatedInfo
+//  - Configuration
+type UpdateDomainRequest struct {
   // unused fields # 1 to 9
   Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
   // unused fields # 11 to 19
-  Status *DomainStatus `thrift:"status,20" db:"status" json:"status,omitempty"`
+  UpdatedInfo *UpdateDomainInfo `thrift:"updatedInfo,20" db:"updatedInfo" json:"updatedInfo,omitempty"`
   // unused fields # 21 to 29
-  Description *string `thrift:"description,30" db:"description" json:"description,omitempty"`
-  // unused fields # 31 to 39
-  OwnerEmail *string `thrift:"ownerEmail,40" db:"ownerEmail" json:"ownerEmail,omitempty"`
+  Configuration *DomainConfiguration `thrift:"configuration,30" db:"configuration" json:"configuration,omitempty"`
 }
 
-func NewDomainInfo() *DomainInfo {
-  return &DomainInfo{}
+func NewUpdateDomainRequest() *UpdateDomainRequest {
+  return &UpdateDomainRequest{}
 }
 
-var DomainInfo_Name_DEFAULT string
-func (p *DomainInfo) GetName() string {
+var UpdateDomainRequest_Name_DEFAULT string
+func (p *UpdateDomainRequest) GetName() string {
   if !p.IsSetName() {
-    return DomainInfo_Name_DEFAULT
+    return UpdateDomainRequest_Name_DEFAULT
   }
 return *p.Name
 }
-var DomainInfo_Status_DEFAULT DomainStatus
-func (p *DomainInfo) GetStatus() DomainStatus {
-  if !p.IsSetStatus() {
-    return DomainInfo_Status_DEFAULT
-  }
-return *p.Status
-}
-var DomainInfo_Description_DEFAULT string
-func (p *DomainInfo) GetDescription() string {
-  if !p.IsSetDescription() {
-    return DomainInfo_Description_DEFAULT
+var UpdateDomainRequest_UpdatedInfo_DEFAULT *UpdateDomainInfo
+func (p *UpdateDomainRequest) GetUpdatedInfo() *UpdateDomainInfo {
+  if !p.IsSetUpdatedInfo() {
+    return UpdateDomainRequest_UpdatedInfo_DEFAULT
   }
-return *p.Description
+return p.UpdatedInfo
 }
-var DomainInfo_OwnerEmail_DEFAULT string
-func (p *DomainInfo) GetOwnerEmail() string {
-  if !p.IsSetOwnerEmail() {
-    return DomainInfo_OwnerEmail_DEFAULT
+var UpdateDomainRequest_Configuration_DEFAULT *DomainConfiguration
+func (p *UpdateDomainRequest) GetConfiguration() *DomainConfiguration {
+  if !p.IsSetConfiguration() {
+    return UpdateDomainRequest_Configuration_DEFAULT
   }
-return *p.OwnerEmail
+return p.Configuration
 }
-func (p *DomainInfo) IsSetName() bool {
+func (p *UpdateDomainRequest) IsSetName() bool {
   return p.Name != nil
 }
 
-func (p *DomainInfo) IsSetStatus() bool {
-  return p.Status != nil
-}
-
-func (p *DomainInfo) IsSetDescription() bool {
-  return p.Description != nil
+func (p *UpdateDomainRequest) IsSetUpdatedInfo() bool {
+  return p.UpdatedInfo != nil
 }
 
-func (p *DomainInfo) IsSetOwnerEmail() bool {
-  return p.OwnerEmail != nil
+func (p *UpdateDomainRequest) IsSetConfiguration() bool {
+  return p.Configuration != nil
 }
 
-func (p *DomainInfo) Read(iprot thrift.TProtocol) error {
+func (p *UpdateDomainRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -15703,10 +17974,6 @@ func (p *DomainInfo) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField30(iprot); err != nil {
         return err
       }
-    case 40:
-      if err := p.ReadField40(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -15722,7 +17989,7 @@ func (p *DomainInfo) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *DomainInfo)  ReadField10(iprot thrift.TProtocol) error {
+func (p *UpdateDomainRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -15731,42 +17998,29 @@ func (p *DomainInfo)  ReadField10(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *DomainInfo)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 20: ", err)
-} else {
-  temp := DomainStatus(v)
-  p.Status = &temp
-}
-  return nil
-}
-
-func (p *DomainInfo)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
-} else {
-  p.Description = &v
-}
+func (p *UpdateDomainRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.UpdatedInfo = &UpdateDomainInfo{}
+  if err := p.UpdatedInfo.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UpdatedInfo), err)
+  }
   return nil
 }
 
-func (p *DomainInfo)  ReadField40(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
-} else {
-  p.OwnerEmail = &v
-}
+func (p *UpdateDomainRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.Configuration = &DomainConfiguration{}
+  if err := p.Configuration.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Configuration), err)
+  }
   return nil
 }
 
-func (p *DomainInfo) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DomainInfo"); err != nil {
+func (p *UpdateDomainRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("UpdateDomainRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
-    if err := p.writeField40(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -15775,98 +18029,88 @@ func (p *DomainInfo) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *DomainInfo) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDomainRequest) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetName() {
     if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
-    if err := oprot.WriteString(string(*p.Name)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
-  }
-  return err
-}
-
-func (p *DomainInfo) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStatus() {
-    if err := oprot.WriteFieldBegin("status", thrift.I32, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:status: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.Status)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.status (20) field write error: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
+    if err := oprot.WriteString(string(*p.Name)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:status: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
   }
   return err
 }
 
-func (p *DomainInfo) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDescription() {
-    if err := oprot.WriteFieldBegin("description", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:description: ", p), err) }
-    if err := oprot.WriteString(string(*p.Description)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.description (30) field write error: ", p), err) }
+func (p *UpdateDomainRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetUpdatedInfo() {
+    if err := oprot.WriteFieldBegin("updatedInfo", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:updatedInfo: ", p), err) }
+    if err := p.UpdatedInfo.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UpdatedInfo), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:description: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:updatedInfo: ", p), err) }
   }
   return err
 }
 
-func (p *DomainInfo) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetOwnerEmail() {
-    if err := oprot.WriteFieldBegin("ownerEmail", thrift.STRING, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:ownerEmail: ", p), err) }
-    if err := oprot.WriteString(string(*p.OwnerEmail)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.ownerEmail (40) field write error: ", p), err) }
+func (p *UpdateDomainRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetConfiguration() {
+    if err := oprot.WriteFieldBegin("configuration", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:configuration: ", p), err) }
+    if err := p.Configuration.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Configuration), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:ownerEmail: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:configuration: ", p), err) }
   }
   return err
 }
 
-func (p *DomainInfo) String() string {
+func (p *UpdateDomainRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("DomainInfo(%+v)", *p)
+  return fmt.Sprintf("UpdateDomainRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - WorkflowExecutionRetentionPeriodInDays
-//  - EmitMetric
-type DomainConfiguration struct {
+//  - DomainInfo
+//  - Configuration
+type UpdateDomainResponse struct {
   // unused fields # 1 to 9
-  WorkflowExecutionRetentionPeriodInDays *int32 `thrift:"workflowExecutionRetentionPeriodInDays,10" db:"workflowExecutionRetentionPeriodInDays" json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+  DomainInfo *DomainInfo `thrift:"domainInfo,10" db:"domainInfo" json:"domainInfo,omitempty"`
   // unused fields # 11 to 19
-  EmitMetric *bool `thrift:"emitMetric,20" db:"emitMetric" json:"emitMetric,omitempty"`
+  Configuration *DomainConfiguration `thrift:"configuration,20" db:"configuration" json:"configuration,omitempty"`
 }
 
-func NewDomainConfiguration() *DomainConfiguration {
-  return &DomainConfiguration{}
+func NewUpdateDomainResponse() *UpdateDomainResponse {
+  return &UpdateDomainResponse{}
 }
 
-var DomainConfiguration_WorkflowExecutionRetentionPeriodInDays_DEFAULT int32
-func (p *DomainConfiguration) GetWorkflowExecutionRetentionPeriodInDays() int32 {
-  if !p.IsSetWorkflowExecutionRetentionPeriodInDays() {
-    return DomainConfiguration_WorkflowExecutionRetentionPeriodInDays_DEFAULT
+var UpdateDomainResponse_DomainInfo_DEFAULT *DomainInfo
+func (p *UpdateDomainResponse) GetDomainInfo() *DomainInfo {
+  if !p.IsSetDomainInfo() {
+    return UpdateDomainResponse_DomainInfo_DEFAULT
   }
-return *p.WorkflowExecutionRetentionPeriodInDays
+return p.DomainInfo
 }
-var DomainConfiguration_EmitMetric_DEFAULT bool
-func (p *DomainConfiguration) GetEmitMetric() bool {
-  if !p.IsSetEmitMetric() {
-    return DomainConfiguration_EmitMetric_DEFAULT
+var UpdateDomainResponse_Configuration_DEFAULT *DomainConfiguration
+func (p *UpdateDomainResponse) GetConfiguration() *DomainConfiguration {
+  if !p.IsSetConfiguration() {
+    return UpdateDomainResponse_Configuration_DEFAULT
   }
-return *p.EmitMetric
+return p.Configuration
 }
-func (p *DomainConfiguration) IsSetWorkflowExecutionRetentionPeriodInDays() bool {
-  return p.WorkflowExecutionRetentionPeriodInDays != nil
+func (p *UpdateDomainResponse) IsSetDomainInfo() bool {
+  return p.DomainInfo != nil
 }
 
-func (p *DomainConfiguration) IsSetEmitMetric() bool {
-  return p.EmitMetric != nil
+func (p *UpdateDomainResponse) IsSetConfiguration() bool {
+  return p.Configuration != nil
 }
 
-func (p *DomainConfiguration) Read(iprot thrift.TProtocol) error {
+func (p *UpdateDomainResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -15902,26 +18146,24 @@ func (p *DomainConfiguration) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *DomainConfiguration)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
-} else {
-  p.WorkflowExecutionRetentionPeriodInDays = &v
-}
+func (p *UpdateDomainResponse)  ReadField10(iprot thrift.TProtocol) error {
+  p.DomainInfo = &DomainInfo{}
+  if err := p.DomainInfo.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DomainInfo), err)
+  }
   return nil
 }
 
-func (p *DomainConfiguration)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(); err != nil {
-  return thrift.PrependError("error reading field 20: ", err)
-} else {
-  p.EmitMetric = &v
-}
+func (p *UpdateDomainResponse)  ReadField20(iprot thrift.TProtocol) error {
+  p.Configuration = &DomainConfiguration{}
+  if err := p.Configuration.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Configuration), err)
+  }
   return nil
 }
 
-func (p *DomainConfiguration) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DomainConfiguration"); err != nil {
+func (p *UpdateDomainResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("UpdateDomainResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -15934,74 +18176,62 @@ func (p *DomainConfiguration) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *DomainConfiguration) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecutionRetentionPeriodInDays() {
-    if err := oprot.WriteFieldBegin("workflowExecutionRetentionPeriodInDays", thrift.I32, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:workflowExecutionRetentionPeriodInDays: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.WorkflowExecutionRetentionPeriodInDays)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.workflowExecutionRetentionPeriodInDays (10) field write error: ", p), err) }
+func (p *UpdateDomainResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainInfo() {
+    if err := oprot.WriteFieldBegin("domainInfo", thrift.STRUCT, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainInfo: ", p), err) }
+    if err := p.DomainInfo.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DomainInfo), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:workflowExecutionRetentionPeriodInDays: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainInfo: ", p), err) }
   }
   return err
 }
 
-func (p *DomainConfiguration) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEmitMetric() {
-    if err := oprot.WriteFieldBegin("emitMetric", thrift.BOOL, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:emitMetric: ", p), err) }
-    if err := oprot.WriteBool(bool(*p.EmitMetric)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.emitMetric (20) field write error: ", p), err) }
+func (p *UpdateDomainResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetConfiguration() {
+    if err := oprot.WriteFieldBegin("configuration", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:configuration: ", p), err) }
+    if err := p.Configuration.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Configuration), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:emitMetric: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:configuration: ", p), err) }
   }
   return err
 }
 
-func (p *DomainConfiguration) String() string {
+func (p *UpdateDomainResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("DomainConfiguration(%+v)", *p)
+  return fmt.Sprintf("UpdateDomainResponse(%+v)", *p)
 }
 
 // Attributes:
-//  - Description
-//  - OwnerEmail
-type UpdateDomainInfo struct {
+//  - Name
+type DeprecateDomainRequest struct {
   // unused fields # 1 to 9
-  Description *string `thrift:"description,10" db:"description" json:"description,omitempty"`
-  // unused fields # 11 to 19
-  OwnerEmail *string `thrift:"ownerEmail,20" db:"ownerEmail" json:"ownerEmail,omitempty"`
+  Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
 }
 
-func NewUpdateDomainInfo() *UpdateDomainInfo {
-  return &UpdateDomainInfo{}
+func NewDeprecateDomainRequest() *DeprecateDomainRequest {
+  return &DeprecateDomainRequest{}
 }
 
-var UpdateDomainInfo_Description_DEFAULT string
-func (p *UpdateDomainInfo) GetDescription() string {
-  if !p.IsSetDescription() {
-    return UpdateDomainInfo_Description_DEFAULT
-  }
-return *p.Description
-}
-var UpdateDomainInfo_OwnerEmail_DEFAULT string
-func (p *UpdateDomainInfo) GetOwnerEmail() string {
-  if !p.IsSetOwnerEmail() {
-    return UpdateDomainInfo_OwnerEmail_DEFAULT
+var DeprecateDomainRequest_Name_DEFAULT string
+func (p *DeprecateDomainRequest) GetName() string {
+  if !p.IsSetName() {
+    return DeprecateDomainRequest_Name_DEFAULT
   }
-return *p.OwnerEmail
-}
-func (p *UpdateDomainInfo) IsSetDescription() bool {
-  return p.Description != nil
+return *p.Name
 }
-
-func (p *UpdateDomainInfo) IsSetOwnerEmail() bool {
-  return p.OwnerEmail != nil
+func (p *DeprecateDomainRequest) IsSetName() bool {
+  return p.Name != nil
 }
 
-func (p *UpdateDomainInfo) Read(iprot thrift.TProtocol) error {
+func (p *DeprecateDomainRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -16018,10 +18248,6 @@ func (p *UpdateDomainInfo) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField10(iprot); err != nil {
         return err
       }
-    case 20:
-      if err := p.ReadField20(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -16037,30 +18263,20 @@ func (p *UpdateDomainInfo) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *UpdateDomainInfo)  ReadField10(iprot thrift.TProtocol) error {
+func (p *DeprecateDomainRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Description = &v
-}
-  return nil
-}
-
-func (p *UpdateDomainInfo)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 20: ", err)
-} else {
-  p.OwnerEmail = &v
+  p.Name = &v
 }
   return nil
 }
 
-func (p *UpdateDomainInfo) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("UpdateDomainInfo"); err != nil {
+func (p *DeprecateDomainRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DeprecateDomainRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
-    if err := p.writeField20(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -16069,116 +18285,158 @@ func (p *UpdateDomainInfo) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *UpdateDomainInfo) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDescription() {
-    if err := oprot.WriteFieldBegin("description", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:description: ", p), err) }
-    if err := oprot.WriteString(string(*p.Description)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.description (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:description: ", p), err) }
-  }
-  return err
-}
-
-func (p *UpdateDomainInfo) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetOwnerEmail() {
-    if err := oprot.WriteFieldBegin("ownerEmail", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:ownerEmail: ", p), err) }
-    if err := oprot.WriteString(string(*p.OwnerEmail)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.ownerEmail (20) field write error: ", p), err) }
+func (p *DeprecateDomainRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetName() {
+    if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
+    if err := oprot.WriteString(string(*p.Name)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:ownerEmail: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
   }
   return err
 }
 
-func (p *UpdateDomainInfo) String() string {
+func (p *DeprecateDomainRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("UpdateDomainInfo(%+v)", *p)
+  return fmt.Sprintf("DeprecateDomainRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - Name
-//  - Description
-//  - OwnerEmail
-//  - WorkflowExecutionRetentionPeriodInDays
-//  - EmitMetric
-type RegisterDomainRequest struct {
+//  - Domain
+//  - WorkflowId
+//  - WorkflowType
+//  - TaskList
+//  - Input
+//  - ExecutionStartToCloseTimeoutSeconds
+//  - TaskStartToCloseTimeoutSeconds
+//  - Identity
+//  - RequestId
+type StartWorkflowExecutionRequest struct {
   // unused fields # 1 to 9
-  Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  Description *string `thrift:"description,20" db:"description" json:"description,omitempty"`
+  WorkflowId *string `thrift:"workflowId,20" db:"workflowId" json:"workflowId,omitempty"`
   // unused fields # 21 to 29
-  OwnerEmail *string `thrift:"ownerEmail,30" db:"ownerEmail" json:"ownerEmail,omitempty"`
+  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
   // unused fields # 31 to 39
-  WorkflowExecutionRetentionPeriodInDays *int32 `thrift:"workflowExecutionRetentionPeriodInDays,40" db:"workflowExecutionRetentionPeriodInDays" json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+  TaskList *TaskList `thrift:"taskList,40" db:"taskList" json:"taskList,omitempty"`
   // unused fields # 41 to 49
-  EmitMetric *bool `thrift:"emitMetric,50" db:"emitMetric" json:"emitMetric,omitempty"`
+  Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
+  // unused fields # 51 to 59
+  ExecutionStartToCloseTimeoutSeconds *int32 `thrift:"executionStartToCloseTimeoutSeconds,60" db:"executionStartToCloseTimeoutSeconds" json:"executionStartToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 61 to 69
+  TaskStartToCloseTimeoutSeconds *int32 `thrift:"taskStartToCloseTimeoutSeconds,70" db:"taskStartToCloseTimeoutSeconds" json:"taskStartToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 71 to 79
+  Identity *string `thrift:"identity,80" db:"identity" json:"identity,omitempty"`
+  // unused fields # 81 to 89
+  RequestId *string `thrift:"requestId,90" db:"requestId" json:"requestId,omitempty"`
 }
 
-func NewRegisterDomainRequest() *RegisterDomainRequest {
-  return &RegisterDomainRequest{}
+func NewStartWorkflowExecutionRequest() *StartWorkflowExecutionRequest {
+  return &StartWorkflowExecutionRequest{}
 }
 
-var RegisterDomainRequest_Name_DEFAULT string
-func (p *RegisterDomainRequest) GetName() string {
-  if !p.IsSetName() {
-    return RegisterDomainRequest_Name_DEFAULT
+var StartWorkflowExecutionRequest_Domain_DEFAULT string
+func (p *StartWorkflowExecutionRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return StartWorkflowExecutionRequest_Domain_DEFAULT
   }
-return *p.Name
+return *p.Domain
 }
-var RegisterDomainRequest_Description_DEFAULT string
-func (p *RegisterDomainRequest) GetDescription() string {
-  if !p.IsSetDescription() {
-    return RegisterDomainRequest_Description_DEFAULT
+var StartWorkflowExecutionRequest_WorkflowId_DEFAULT string
+func (p *StartWorkflowExecutionRequest) GetWorkflowId() string {
+  if !p.IsSetWorkflowId() {
+    return StartWorkflowExecutionRequest_WorkflowId_DEFAULT
   }
-return *p.Description
+return *p.WorkflowId
 }
-var RegisterDomainRequest_OwnerEmail_DEFAULT string
-func (p *RegisterDomainRequest) GetOwnerEmail() string {
-  if !p.IsSetOwnerEmail() {
-    return RegisterDomainRequest_OwnerEmail_DEFAULT
+var StartWorkflowExecutionRequest_WorkflowType_DEFAULT *WorkflowType
+func (p *StartWorkflowExecutionRequest) GetWorkflowType() *WorkflowType {
+  if !p.IsSetWorkflowType() {
+    return StartWorkflowExecutionRequest_WorkflowType_DEFAULT
   }
-return *p.OwnerEmail
+return p.WorkflowType
 }
-var RegisterDomainRequest_WorkflowExecutionRetentionPeriodInDays_DEFAULT int32
-func (p *RegisterDomainRequest) GetWorkflowExecutionRetentionPeriodInDays() int32 {
-  if !p.IsSetWorkflowExecutionRetentionPeriodInDays() {
-    return RegisterDomainRequest_WorkflowExecutionRetentionPeriodInDays_DEFAULT
+var StartWorkflowExecutionRequest_TaskList_DEFAULT *TaskList
+func (p *StartWorkflowExecutionRequest) GetTaskList() *TaskList {
+  if !p.IsSetTaskList() {
+    return StartWorkflowExecutionRequest_TaskList_DEFAULT
   }
-return *p.WorkflowExecutionRetentionPeriodInDays
+return p.TaskList
 }
-var RegisterDomainRequest_EmitMetric_DEFAULT bool
-func (p *RegisterDomainRequest) GetEmitMetric() bool {
-  if !p.IsSetEmitMetric() {
-    return RegisterDomainRequest_EmitMetric_DEFAULT
+var StartWorkflowExecutionRequest_Input_DEFAULT []byte
+
+func (p *StartWorkflowExecutionRequest) GetInput() []byte {
+  return p.Input
+}
+var StartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT int32
+func (p *StartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetExecutionStartToCloseTimeoutSeconds() {
+    return StartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT
   }
-return *p.EmitMetric
+return *p.ExecutionStartToCloseTimeoutSeconds
 }
-func (p *RegisterDomainRequest) IsSetName() bool {
-  return p.Name != nil
+var StartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT int32
+func (p *StartWorkflowExecutionRequest) GetTaskStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetTaskStartToCloseTimeoutSeconds() {
+    return StartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.TaskStartToCloseTimeoutSeconds
+}
+var StartWorkflowExecutionRequest_Identity_DEFAULT string
+func (p *StartWorkflowExecutionRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return StartWorkflowExecutionRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+var StartWorkflowExecutionRequest_RequestId_DEFAULT string
+func (p *StartWorkflowExecutionRequest) GetRequestId() string {
+  if !p.IsSetRequestId() {
+    return StartWorkflowExecutionRequest_RequestId_DEFAULT
+  }
+return *p.RequestId
+}
+func (p *StartWorkflowExecutionRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *RegisterDomainRequest) IsSetDescription() bool {
-  return p.Description != nil
+func (p *StartWorkflowExecutionRequest) IsSetWorkflowId() bool {
+  return p.WorkflowId != nil
 }
 
-func (p *RegisterDomainRequest) IsSetOwnerEmail() bool {
-  return p.OwnerEmail != nil
+func (p *StartWorkflowExecutionRequest) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
 }
 
-func (p *RegisterDomainRequest) IsSetWorkflowExecutionRetentionPeriodInDays() bool {
-  return p.WorkflowExecutionRetentionPeriodInDays != nil
+func (p *StartWorkflowExecutionRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
 }
 
-func (p *RegisterDomainRequest) IsSetEmitMetric() bool {
-  return p.EmitMetric != nil
+func (p *StartWorkflowExecutionRequest) IsSetInput() bool {
+  return p.Input != nil
 }
 
-func (p *RegisterDomainRequest) Read(iprot thrift.TProtocol) error {
+func (p *StartWorkflowExecutionRequest) IsSetExecutionStartToCloseTimeoutSeconds() bool {
+  return p.ExecutionStartToCloseTimeoutSeconds != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetTaskStartToCloseTimeoutSeconds() bool {
+  return p.TaskStartToCloseTimeoutSeconds != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetRequestId() bool {
+  return p.RequestId != nil
+}
+
+func (p *StartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -16211,6 +18469,22 @@ func (p *RegisterDomainRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField50(iprot); err != nil {
         return err
       }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -16226,53 +18500,87 @@ func (p *RegisterDomainRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *RegisterDomainRequest)  ReadField10(iprot thrift.TProtocol) error {
+func (p *StartWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Name = &v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *RegisterDomainRequest)  ReadField20(iprot thrift.TProtocol) error {
+func (p *StartWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.Description = &v
+  p.WorkflowId = &v
 }
   return nil
 }
 
-func (p *RegisterDomainRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
+func (p *StartWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowType = &WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+  }
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.TaskList = &TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
+  }
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
 } else {
-  p.OwnerEmail = &v
+  p.Input = v
 }
   return nil
 }
 
-func (p *RegisterDomainRequest)  ReadField40(iprot thrift.TProtocol) error {
+func (p *StartWorkflowExecutionRequest)  ReadField60(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
+  return thrift.PrependError("error reading field 60: ", err)
 } else {
-  p.WorkflowExecutionRetentionPeriodInDays = &v
+  p.ExecutionStartToCloseTimeoutSeconds = &v
 }
   return nil
 }
 
-func (p *RegisterDomainRequest)  ReadField50(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
+func (p *StartWorkflowExecutionRequest)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
 } else {
-  p.EmitMetric = &v
+  p.TaskStartToCloseTimeoutSeconds = &v
 }
   return nil
 }
 
-func (p *RegisterDomainRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RegisterDomainRequest"); err != nil {
+func (p *StartWorkflowExecutionRequest)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField90(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 90: ", err)
+} else {
+  p.RequestId = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecutionRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -16280,6 +18588,10 @@ func (p *RegisterDomainRequest) Write(oprot thrift.TProtocol) error {
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -16288,205 +18600,146 @@ func (p *RegisterDomainRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *RegisterDomainRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetName() {
-    if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
-    if err := oprot.WriteString(string(*p.Name)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
-  }
-  return err
-}
-
-func (p *RegisterDomainRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDescription() {
-    if err := oprot.WriteFieldBegin("description", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:description: ", p), err) }
-    if err := oprot.WriteString(string(*p.Description)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.description (20) field write error: ", p), err) }
+func (p *StartWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:description: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *RegisterDomainRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetOwnerEmail() {
-    if err := oprot.WriteFieldBegin("ownerEmail", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:ownerEmail: ", p), err) }
-    if err := oprot.WriteString(string(*p.OwnerEmail)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.ownerEmail (30) field write error: ", p), err) }
+func (p *StartWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowId() {
+    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowId: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowId (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:ownerEmail: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowId: ", p), err) }
   }
   return err
 }
 
-func (p *RegisterDomainRequest) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecutionRetentionPeriodInDays() {
-    if err := oprot.WriteFieldBegin("workflowExecutionRetentionPeriodInDays", thrift.I32, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowExecutionRetentionPeriodInDays: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.WorkflowExecutionRetentionPeriodInDays)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.workflowExecutionRetentionPeriodInDays (40) field write error: ", p), err) }
+func (p *StartWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowExecutionRetentionPeriodInDays: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
   }
   return err
 }
 
-func (p *RegisterDomainRequest) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEmitMetric() {
-    if err := oprot.WriteFieldBegin("emitMetric", thrift.BOOL, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:emitMetric: ", p), err) }
-    if err := oprot.WriteBool(bool(*p.EmitMetric)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.emitMetric (50) field write error: ", p), err) }
+func (p *StartWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:emitMetric: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskList: ", p), err) }
   }
   return err
 }
 
-func (p *RegisterDomainRequest) String() string {
-  if p == nil {
-    return "<nil>"
+func (p *StartWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInput() {
+    if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
+    if err := oprot.WriteBinary(p.Input); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.input (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:input: ", p), err) }
   }
-  return fmt.Sprintf("RegisterDomainRequest(%+v)", *p)
-}
-
-// Attributes:
-//  - Name
-type DescribeDomainRequest struct {
-  // unused fields # 1 to 9
-  Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
-}
-
-func NewDescribeDomainRequest() *DescribeDomainRequest {
-  return &DescribeDomainRequest{}
+  return err
 }
 
-var DescribeDomainRequest_Name_DEFAULT string
-func (p *DescribeDomainRequest) GetName() string {
-  if !p.IsSetName() {
-    return DescribeDomainRequest_Name_DEFAULT
+func (p *StartWorkflowExecutionRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutionStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("executionStartToCloseTimeoutSeconds", thrift.I32, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ExecutionStartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.executionStartToCloseTimeoutSeconds (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
   }
-return *p.Name
-}
-func (p *DescribeDomainRequest) IsSetName() bool {
-  return p.Name != nil
+  return err
 }
 
-func (p *DescribeDomainRequest) Read(iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-  }
-
-
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 10:
-      if err := p.ReadField10(iprot); err != nil {
-        return err
-      }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
-      }
-    }
-    if err := iprot.ReadFieldEnd(); err != nil {
-      return err
-    }
-  }
-  if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+func (p *StartWorkflowExecutionRequest) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("taskStartToCloseTimeoutSeconds", thrift.I32, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.TaskStartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskStartToCloseTimeoutSeconds (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
   }
-  return nil
-}
-
-func (p *DescribeDomainRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
-} else {
-  p.Name = &v
-}
-  return nil
+  return err
 }
 
-func (p *DescribeDomainRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DescribeDomainRequest"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField10(oprot); err != nil { return err }
+func (p *StartWorkflowExecutionRequest) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:identity: ", p), err) }
   }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
+  return err
 }
 
-func (p *DescribeDomainRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetName() {
-    if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
-    if err := oprot.WriteString(string(*p.Name)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
+func (p *StartWorkflowExecutionRequest) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestId() {
+    if err := oprot.WriteFieldBegin("requestId", thrift.STRING, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:requestId: ", p), err) }
+    if err := oprot.WriteString(string(*p.RequestId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.requestId (90) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:requestId: ", p), err) }
   }
   return err
 }
 
-func (p *DescribeDomainRequest) String() string {
+func (p *StartWorkflowExecutionRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("DescribeDomainRequest(%+v)", *p)
+  return fmt.Sprintf("StartWorkflowExecutionRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - DomainInfo
-//  - Configuration
-type DescribeDomainResponse struct {
+//  - RunId
+type StartWorkflowExecutionResponse struct {
   // unused fields # 1 to 9
-  DomainInfo *DomainInfo `thrift:"domainInfo,10" db:"domainInfo" json:"domainInfo,omitempty"`
-  // unused fields # 11 to 19
-  Configuration *DomainConfiguration `thrift:"configuration,20" db:"configuration" json:"configuration,omitempty"`
+  RunId *string `thrift:"runId,10" db:"runId" json:"runId,omitempty"`
 }
 
-func NewDescribeDomainResponse() *DescribeDomainResponse {
-  return &DescribeDomainResponse{}
+func NewStartWorkflowExecutionResponse() *StartWorkflowExecutionResponse {
+  return &StartWorkflowExecutionResponse{}
 }
 
-var DescribeDomainResponse_DomainInfo_DEFAULT *DomainInfo
-func (p *DescribeDomainResponse) GetDomainInfo() *DomainInfo {
-  if !p.IsSetDomainInfo() {
-    return DescribeDomainResponse_DomainInfo_DEFAULT
-  }
-return p.DomainInfo
-}
-var DescribeDomainResponse_Configuration_DEFAULT *DomainConfiguration
-func (p *DescribeDomainResponse) GetConfiguration() *DomainConfiguration {
-  if !p.IsSetConfiguration() {
-    return DescribeDomainResponse_Configuration_DEFAULT
+var StartWorkflowExecutionResponse_RunId_DEFAULT string
+func (p *StartWorkflowExecutionResponse) GetRunId() string {
+  if !p.IsSetRunId() {
+    return StartWorkflowExecutionResponse_RunId_DEFAULT
   }
-return p.Configuration
-}
-func (p *DescribeDomainResponse) IsSetDomainInfo() bool {
-  return p.DomainInfo != nil
+return *p.RunId
 }
-
-func (p *DescribeDomainResponse) IsSetConfiguration() bool {
-  return p.Configuration != nil
+func (p *StartWorkflowExecutionResponse) IsSetRunId() bool {
+  return p.RunId != nil
 }
 
-func (p *DescribeDomainResponse) Read(iprot thrift.TProtocol) error {
+func (p *StartWorkflowExecutionResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -16503,10 +18756,6 @@ func (p *DescribeDomainResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField10(iprot); err != nil {
         return err
       }
-    case 20:
-      if err := p.ReadField20(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -16522,28 +18771,20 @@ func (p *DescribeDomainResponse) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *DescribeDomainResponse)  ReadField10(iprot thrift.TProtocol) error {
-  p.DomainInfo = &DomainInfo{}
-  if err := p.DomainInfo.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DomainInfo), err)
-  }
-  return nil
+func (p *StartWorkflowExecutionResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.RunId = &v
 }
-
-func (p *DescribeDomainResponse)  ReadField20(iprot thrift.TProtocol) error {
-  p.Configuration = &DomainConfiguration{}
-  if err := p.Configuration.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Configuration), err)
-  }
   return nil
 }
 
-func (p *DescribeDomainResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DescribeDomainResponse"); err != nil {
+func (p *StartWorkflowExecutionResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecutionResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
-    if err := p.writeField20(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -16552,90 +18793,76 @@ func (p *DescribeDomainResponse) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *DescribeDomainResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomainInfo() {
-    if err := oprot.WriteFieldBegin("domainInfo", thrift.STRUCT, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainInfo: ", p), err) }
-    if err := p.DomainInfo.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DomainInfo), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainInfo: ", p), err) }
-  }
-  return err
-}
-
-func (p *DescribeDomainResponse) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetConfiguration() {
-    if err := oprot.WriteFieldBegin("configuration", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:configuration: ", p), err) }
-    if err := p.Configuration.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Configuration), err)
-    }
+func (p *StartWorkflowExecutionResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRunId() {
+    if err := oprot.WriteFieldBegin("runId", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:runId: ", p), err) }
+    if err := oprot.WriteString(string(*p.RunId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.runId (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:configuration: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:runId: ", p), err) }
   }
   return err
 }
 
-func (p *DescribeDomainResponse) String() string {
+func (p *StartWorkflowExecutionResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("DescribeDomainResponse(%+v)", *p)
+  return fmt.Sprintf("StartWorkflowExecutionResponse(%+v)", *p)
 }
 
 // Attributes:
-//  - Name
-//  - UpdatedInfo
-//  - Configuration
-type UpdateDomainRequest struct {
+//  - Domain
+//  - TaskList
+//  - Identity
+type PollForDecisionTaskRequest struct {
   // unused fields # 1 to 9
-  Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  UpdatedInfo *UpdateDomainInfo `thrift:"updatedInfo,20" db:"updatedInfo" json:"updatedInfo,omitempty"`
+  TaskList *TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
   // unused fields # 21 to 29
-  Configuration *DomainConfiguration `thrift:"configuration,30" db:"configuration" json:"configuration,omitempty"`
+  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
 }
 
-func NewUpdateDomainRequest() *UpdateDomainRequest {
-  return &UpdateDomainRequest{}
+func NewPollForDecisionTaskRequest() *PollForDecisionTaskRequest {
+  return &PollForDecisionTaskRequest{}
 }
 
-var UpdateDomainRequest_Name_DEFAULT string
-func (p *UpdateDomainRequest) GetName() string {
-  if !p.IsSetName() {
-    return UpdateDomainRequest_Name_DEFAULT
+var PollForDecisionTaskRequest_Domain_DEFAULT string
+func (p *PollForDecisionTaskRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return PollForDecisionTaskRequest_Domain_DEFAULT
   }
-return *p.Name
+return *p.Domain
 }
-var UpdateDomainRequest_UpdatedInfo_DEFAULT *UpdateDomainInfo
-func (p *UpdateDomainRequest) GetUpdatedInfo() *UpdateDomainInfo {
-  if !p.IsSetUpdatedInfo() {
-    return UpdateDomainRequest_UpdatedInfo_DEFAULT
+var PollForDecisionTaskRequest_TaskList_DEFAULT *TaskList
+func (p *PollForDecisionTaskRequest) GetTaskList() *TaskList {
+  if !p.IsSetTaskList() {
+    return PollForDecisionTaskRequest_TaskList_DEFAULT
   }
-return p.UpdatedInfo
+return p.TaskList
 }
-var UpdateDomainRequest_Configuration_DEFAULT *DomainConfiguration
-func (p *UpdateDomainRequest) GetConfiguration() *DomainConfiguration {
-  if !p.IsSetConfiguration() {
-    return UpdateDomainRequest_Configuration_DEFAULT
+var PollForDecisionTaskRequest_Identity_DEFAULT string
+func (p *PollForDecisionTaskRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return PollForDecisionTaskRequest_Identity_DEFAULT
   }
-return p.Configuration
+return *p.Identity
 }
-func (p *UpdateDomainRequest) IsSetName() bool {
-  return p.Name != nil
+func (p *PollForDecisionTaskRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *UpdateDomainRequest) IsSetUpdatedInfo() bool {
-  return p.UpdatedInfo != nil
+func (p *PollForDecisionTaskRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
 }
 
-func (p *UpdateDomainRequest) IsSetConfiguration() bool {
-  return p.Configuration != nil
+func (p *PollForDecisionTaskRequest) IsSetIdentity() bool {
+  return p.Identity != nil
 }
 
-func (p *UpdateDomainRequest) Read(iprot thrift.TProtocol) error {
+func (p *PollForDecisionTaskRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -16675,33 +18902,34 @@ func (p *UpdateDomainRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *UpdateDomainRequest)  ReadField10(iprot thrift.TProtocol) error {
+func (p *PollForDecisionTaskRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Name = &v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *UpdateDomainRequest)  ReadField20(iprot thrift.TProtocol) error {
-  p.UpdatedInfo = &UpdateDomainInfo{}
-  if err := p.UpdatedInfo.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UpdatedInfo), err)
+func (p *PollForDecisionTaskRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.TaskList = &TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
   }
   return nil
 }
 
-func (p *UpdateDomainRequest)  ReadField30(iprot thrift.TProtocol) error {
-  p.Configuration = &DomainConfiguration{}
-  if err := p.Configuration.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Configuration), err)
-  }
+func (p *PollForDecisionTaskRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Identity = &v
+}
   return nil
 }
 
-func (p *UpdateDomainRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("UpdateDomainRequest"); err != nil {
+func (p *PollForDecisionTaskRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTaskRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -16715,88 +18943,167 @@ func (p *UpdateDomainRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *UpdateDomainRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetName() {
-    if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
-    if err := oprot.WriteString(string(*p.Name)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
+func (p *PollForDecisionTaskRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *UpdateDomainRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetUpdatedInfo() {
-    if err := oprot.WriteFieldBegin("updatedInfo", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:updatedInfo: ", p), err) }
-    if err := p.UpdatedInfo.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UpdatedInfo), err)
+func (p *PollForDecisionTaskRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:updatedInfo: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
   }
   return err
 }
 
-func (p *UpdateDomainRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetConfiguration() {
-    if err := oprot.WriteFieldBegin("configuration", thrift.STRUCT, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:configuration: ", p), err) }
-    if err := p.Configuration.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Configuration), err)
-    }
+func (p *PollForDecisionTaskRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:configuration: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
   }
   return err
 }
 
-func (p *UpdateDomainRequest) String() string {
+func (p *PollForDecisionTaskRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("UpdateDomainRequest(%+v)", *p)
+  return fmt.Sprintf("PollForDecisionTaskRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - DomainInfo
-//  - Configuration
-type UpdateDomainResponse struct {
+//  - TaskToken
+//  - WorkflowExecution
+//  - WorkflowType
+//  - PreviousStartedEventId
+//  - StartedEventId
+//  - History
+//  - NextPageToken
+//  - Query
+type PollForDecisionTaskResponse struct {
   // unused fields # 1 to 9
-  DomainInfo *DomainInfo `thrift:"domainInfo,10" db:"domainInfo" json:"domainInfo,omitempty"`
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
   // unused fields # 11 to 19
-  Configuration *DomainConfiguration `thrift:"configuration,20" db:"configuration" json:"configuration,omitempty"`
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  // unused fields # 21 to 29
+  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
+  // unused fields # 31 to 39
+  PreviousStartedEventId *int64 `thrift:"previousStartedEventId,40" db:"previousStartedEventId" json:"previousStartedEventId,omitempty"`
+  // unused fields # 41 to 49
+  StartedEventId *int64 `thrift:"startedEventId,50" db:"startedEventId" json:"startedEventId,omitempty"`
+  // unused fields # 51 to 59
+  History *History `thrift:"history,60" db:"history" json:"history,omitempty"`
+  // unused fields # 61 to 69
+  NextPageToken []byte `thrift:"nextPageToken,70" db:"nextPageToken" json:"nextPageToken,omitempty"`
+  // unused fields # 71 to 79
+  Query *WorkflowQuery `thrift:"query,80" db:"query" json:"query,omitempty"`
 }
 
-func NewUpdateDomainResponse() *UpdateDomainResponse {
-  return &UpdateDomainResponse{}
+func NewPollForDecisionTaskResponse() *PollForDecisionTaskResponse {
+  return &PollForDecisionTaskResponse{}
 }
 
-var UpdateDomainResponse_DomainInfo_DEFAULT *DomainInfo
-func (p *UpdateDomainResponse) GetDomainInfo() *DomainInfo {
-  if !p.IsSetDomainInfo() {
-    return UpdateDomainResponse_DomainInfo_DEFAULT
+var PollForDecisionTaskResponse_TaskToken_DEFAULT []byte
+
+func (p *PollForDecisionTaskResponse) GetTaskToken() []byte {
+  return p.TaskToken
+}
+var PollForDecisionTaskResponse_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *PollForDecisionTaskResponse) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return PollForDecisionTaskResponse_WorkflowExecution_DEFAULT
   }
-return p.DomainInfo
+return p.WorkflowExecution
 }
-var UpdateDomainResponse_Configuration_DEFAULT *DomainConfiguration
-func (p *UpdateDomainResponse) GetConfiguration() *DomainConfiguration {
-  if !p.IsSetConfiguration() {
-    return UpdateDomainResponse_Configuration_DEFAULT
+var PollForDecisionTaskResponse_WorkflowType_DEFAULT *WorkflowType
+func (p *PollForDecisionTaskResponse) GetWorkflowType() *WorkflowType {
+  if !p.IsSetWorkflowType() {
+    return PollForDecisionTaskResponse_WorkflowType_DEFAULT
   }
-return p.Configuration
+return p.WorkflowType
 }
-func (p *UpdateDomainResponse) IsSetDomainInfo() bool {
-  return p.DomainInfo != nil
+var PollForDecisionTaskResponse_PreviousStartedEventId_DEFAULT int64
+func (p *PollForDecisionTaskResponse) GetPreviousStartedEventId() int64 {
+  if !p.IsSetPreviousStartedEventId() {
+    return PollForDecisionTaskResponse_PreviousStartedEventId_DEFAULT
+  }
+return *p.PreviousStartedEventId
+}
+var PollForDecisionTaskResponse_StartedEventId_DEFAULT int64
+func (p *PollForDecisionTaskResponse) GetStartedEventId() int64 {
+  if !p.IsSetStartedEventId() {
+    return PollForDecisionTaskResponse_StartedEventId_DEFAULT
+  }
+return *p.StartedEventId
 }
+var PollForDecisionTaskResponse_History_DEFAULT *History
+func (p *PollForDecisionTaskResponse) GetHistory() *History {
+  if !p.IsSetHistory() {
+    return PollForDecisionTaskResponse_History_DEFAULT
+  }
+return p.History
+}
+var PollForDecisionTaskResponse_NextPageToken_DEFAULT []byte
 
-func (p *UpdateDomainResponse) IsSetConfiguration() bool {
-  return p.Configuration != nil
+func (p *PollForDecisionTaskResponse) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+var PollForDecisionTaskResponse_Query_DEFAULT *WorkflowQuery
+func (p *PollForDecisionTaskResponse) GetQuery() *WorkflowQuery {
+  if !p.IsSetQuery() {
+    return PollForDecisionTaskResponse_Query_DEFAULT
+  }
+return p.Query
+}
+func (p *PollForDecisionTaskResponse) IsSetTaskToken() bool {
+  return p.TaskToken != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetPreviousStartedEventId() bool {
+  return p.PreviousStartedEventId != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
+  return p.StartedEventId != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetHistory() bool {
+  return p.History != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetQuery() bool {
+  return p.Query != nil
 }
 
-func (p *UpdateDomainResponse) Read(iprot thrift.TProtocol) error {
+func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -16817,6 +19124,30 @@ func (p *UpdateDomainResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -16832,28 +19163,86 @@ func (p *UpdateDomainResponse) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *UpdateDomainResponse)  ReadField10(iprot thrift.TProtocol) error {
-  p.DomainInfo = &DomainInfo{}
-  if err := p.DomainInfo.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DomainInfo), err)
+func (p *PollForDecisionTaskResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.TaskToken = v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField20(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
   }
   return nil
 }
 
-func (p *UpdateDomainResponse)  ReadField20(iprot thrift.TProtocol) error {
-  p.Configuration = &DomainConfiguration{}
-  if err := p.Configuration.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Configuration), err)
+func (p *PollForDecisionTaskResponse)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowType = &WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
   }
   return nil
 }
 
-func (p *UpdateDomainResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("UpdateDomainResponse"); err != nil {
+func (p *PollForDecisionTaskResponse)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.PreviousStartedEventId = &v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.StartedEventId = &v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField60(iprot thrift.TProtocol) error {
+  p.History = &History{}
+  if err := p.History.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.History), err)
+  }
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.NextPageToken = v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField80(iprot thrift.TProtocol) error {
+  p.Query = &WorkflowQuery{}
+  if err := p.Query.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Query), err)
+  }
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTaskResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -16862,62 +19251,172 @@ func (p *UpdateDomainResponse) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *UpdateDomainResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomainInfo() {
-    if err := oprot.WriteFieldBegin("domainInfo", thrift.STRUCT, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainInfo: ", p), err) }
-    if err := p.DomainInfo.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DomainInfo), err)
+func (p *PollForDecisionTaskResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainInfo: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
   }
   return err
 }
 
-func (p *UpdateDomainResponse) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetConfiguration() {
-    if err := oprot.WriteFieldBegin("configuration", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:configuration: ", p), err) }
-    if err := p.Configuration.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Configuration), err)
+func (p *PollForDecisionTaskResponse) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:configuration: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
   }
   return err
 }
 
-func (p *UpdateDomainResponse) String() string {
+func (p *PollForDecisionTaskResponse) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetPreviousStartedEventId() {
+    if err := oprot.WriteFieldBegin("previousStartedEventId", thrift.I64, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:previousStartedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.PreviousStartedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.previousStartedEventId (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:previousStartedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedEventId() {
+    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:startedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:startedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetHistory() {
+    if err := oprot.WriteFieldBegin("history", thrift.STRUCT, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:history: ", p), err) }
+    if err := p.History.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.History), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:history: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:nextPageToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQuery() {
+    if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:query: ", p), err) }
+    if err := p.Query.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:query: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("UpdateDomainResponse(%+v)", *p)
+  return fmt.Sprintf("PollForDecisionTaskResponse(%+v)", *p)
 }
 
 // Attributes:
-//  - Name
-type DeprecateDomainRequest struct {
+//  - TaskToken
+//  - Decisions
+//  - ExecutionContext
+//  - Identity
+type RespondDecisionTaskCompletedRequest struct {
   // unused fields # 1 to 9
-  Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  // unused fields # 11 to 19
+  Decisions []*Decision `thrift:"decisions,20" db:"decisions" json:"decisions,omitempty"`
+  // unused fields # 21 to 29
+  ExecutionContext []byte `thrift:"executionContext,30" db:"executionContext" json:"executionContext,omitempty"`
+  // unused fields # 31 to 39
+  Identity *string `thrift:"identity,40" db:"identity" json:"identity,omitempty"`
+}
+
+func NewRespondDecisionTaskCompletedRequest() *RespondDecisionTaskCompletedRequest {
+  return &RespondDecisionTaskCompletedRequest{}
+}
+
+var RespondDecisionTaskCompletedRequest_TaskToken_DEFAULT []byte
+
+func (p *RespondDecisionTaskCompletedRequest) GetTaskToken() []byte {
+  return p.TaskToken
+}
+var RespondDecisionTaskCompletedRequest_Decisions_DEFAULT []*Decision
+
+func (p *RespondDecisionTaskCompletedRequest) GetDecisions() []*Decision {
+  return p.Decisions
+}
+var RespondDecisionTaskCompletedRequest_ExecutionContext_DEFAULT []byte
+
+func (p *RespondDecisionTaskCompletedRequest) GetExecutionContext() []byte {
+  return p.ExecutionContext
+}
+var RespondDecisionTaskCompletedRequest_Identity_DEFAULT string
+func (p *RespondDecisionTaskCompletedRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return RespondDecisionTaskCompletedRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+func (p *RespondDecisionTaskCompletedRequest) IsSetTaskToken() bool {
+  return p.TaskToken != nil
 }
 
-func NewDeprecateDomainRequest() *DeprecateDomainRequest {
-  return &DeprecateDomainRequest{}
+func (p *RespondDecisionTaskCompletedRequest) IsSetDecisions() bool {
+  return p.Decisions != nil
 }
 
-var DeprecateDomainRequest_Name_DEFAULT string
-func (p *DeprecateDomainRequest) GetName() string {
-  if !p.IsSetName() {
-    return DeprecateDomainRequest_Name_DEFAULT
-  }
-return *p.Name
+func (p *RespondDecisionTaskCompletedRequest) IsSetExecutionContext() bool {
+  return p.ExecutionContext != nil
 }
-func (p *DeprecateDomainRequest) IsSetName() bool {
-  return p.Name != nil
+
+func (p *RespondDecisionTaskCompletedRequest) IsSetIdentity() bool {
+  return p.Identity != nil
 }
 
-func (p *DeprecateDomainRequest) Read(iprot thrift.TProtocol) error {
+func (p *RespondDecisionTaskCompletedRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -16934,6 +19433,18 @@ func (p *DeprecateDomainRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField10(iprot); err != nil {
         return err
       }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -16949,20 +19460,61 @@ func (p *DeprecateDomainRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *DeprecateDomainRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *RespondDecisionTaskCompletedRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Name = &v
+  p.TaskToken = v
 }
   return nil
 }
 
-func (p *DeprecateDomainRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DeprecateDomainRequest"); err != nil {
+func (p *RespondDecisionTaskCompletedRequest)  ReadField20(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*Decision, 0, size)
+  p.Decisions =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem1 := &Decision{}
+    if err := _elem1.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem1), err)
+    }
+    p.Decisions = append(p.Decisions, _elem1)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.ExecutionContext = v
+}
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskCompletedRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -16971,158 +19523,121 @@ func (p *DeprecateDomainRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *DeprecateDomainRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetName() {
-    if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
-    if err := oprot.WriteString(string(*p.Name)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
+func (p *RespondDecisionTaskCompletedRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
   }
   return err
 }
 
-func (p *DeprecateDomainRequest) String() string {
+func (p *RespondDecisionTaskCompletedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisions() {
+    if err := oprot.WriteFieldBegin("decisions", thrift.LIST, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:decisions: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Decisions)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Decisions {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:decisions: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondDecisionTaskCompletedRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutionContext() {
+    if err := oprot.WriteFieldBegin("executionContext", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:executionContext: ", p), err) }
+    if err := oprot.WriteBinary(p.ExecutionContext); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.executionContext (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:executionContext: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondDecisionTaskCompletedRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondDecisionTaskCompletedRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("DeprecateDomainRequest(%+v)", *p)
+  return fmt.Sprintf("RespondDecisionTaskCompletedRequest(%+v)", *p)
 }
 
 // Attributes:
 //  - Domain
-//  - WorkflowId
-//  - WorkflowType
 //  - TaskList
-//  - Input
-//  - ExecutionStartToCloseTimeoutSeconds
-//  - TaskStartToCloseTimeoutSeconds
 //  - Identity
-//  - RequestId
-type StartWorkflowExecutionRequest struct {
+type PollForActivityTaskRequest struct {
   // unused fields # 1 to 9
   Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  WorkflowId *string `thrift:"workflowId,20" db:"workflowId" json:"workflowId,omitempty"`
+  TaskList *TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
   // unused fields # 21 to 29
-  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
-  // unused fields # 31 to 39
-  TaskList *TaskList `thrift:"taskList,40" db:"taskList" json:"taskList,omitempty"`
-  // unused fields # 41 to 49
-  Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
-  // unused fields # 51 to 59
-  ExecutionStartToCloseTimeoutSeconds *int32 `thrift:"executionStartToCloseTimeoutSeconds,60" db:"executionStartToCloseTimeoutSeconds" json:"executionStartToCloseTimeoutSeconds,omitempty"`
-  // unused fields # 61 to 69
-  TaskStartToCloseTimeoutSeconds *int32 `thrift:"taskStartToCloseTimeoutSeconds,70" db:"taskStartToCloseTimeoutSeconds" json:"taskStartToCloseTimeoutSeconds,omitempty"`
-  // unused fields # 71 to 79
-  Identity *string `thrift:"identity,80" db:"identity" json:"identity,omitempty"`
-  // unused fields # 81 to 89
-  RequestId *string `thrift:"requestId,90" db:"requestId" json:"requestId,omitempty"`
+  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
 }
 
-func NewStartWorkflowExecutionRequest() *StartWorkflowExecutionRequest {
-  return &StartWorkflowExecutionRequest{}
+func NewPollForActivityTaskRequest() *PollForActivityTaskRequest {
+  return &PollForActivityTaskRequest{}
 }
 
-var StartWorkflowExecutionRequest_Domain_DEFAULT string
-func (p *StartWorkflowExecutionRequest) GetDomain() string {
+var PollForActivityTaskRequest_Domain_DEFAULT string
+func (p *PollForActivityTaskRequest) GetDomain() string {
   if !p.IsSetDomain() {
-    return StartWorkflowExecutionRequest_Domain_DEFAULT
+    return PollForActivityTaskRequest_Domain_DEFAULT
   }
 return *p.Domain
 }
-var StartWorkflowExecutionRequest_WorkflowId_DEFAULT string
-func (p *StartWorkflowExecutionRequest) GetWorkflowId() string {
-  if !p.IsSetWorkflowId() {
-    return StartWorkflowExecutionRequest_WorkflowId_DEFAULT
-  }
-return *p.WorkflowId
-}
-var StartWorkflowExecutionRequest_WorkflowType_DEFAULT *WorkflowType
-func (p *StartWorkflowExecutionRequest) GetWorkflowType() *WorkflowType {
-  if !p.IsSetWorkflowType() {
-    return StartWorkflowExecutionRequest_WorkflowType_DEFAULT
-  }
-return p.WorkflowType
-}
-var StartWorkflowExecutionRequest_TaskList_DEFAULT *TaskList
-func (p *StartWorkflowExecutionRequest) GetTaskList() *TaskList {
+var PollForActivityTaskRequest_TaskList_DEFAULT *TaskList
+func (p *PollForActivityTaskRequest) GetTaskList() *TaskList {
   if !p.IsSetTaskList() {
-    return StartWorkflowExecutionRequest_TaskList_DEFAULT
+    return PollForActivityTaskRequest_TaskList_DEFAULT
   }
 return p.TaskList
 }
-var StartWorkflowExecutionRequest_Input_DEFAULT []byte
-
-func (p *StartWorkflowExecutionRequest) GetInput() []byte {
-  return p.Input
-}
-var StartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT int32
-func (p *StartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() int32 {
-  if !p.IsSetExecutionStartToCloseTimeoutSeconds() {
-    return StartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT
-  }
-return *p.ExecutionStartToCloseTimeoutSeconds
-}
-var StartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT int32
-func (p *StartWorkflowExecutionRequest) GetTaskStartToCloseTimeoutSeconds() int32 {
-  if !p.IsSetTaskStartToCloseTimeoutSeconds() {
-    return StartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT
-  }
-return *p.TaskStartToCloseTimeoutSeconds
-}
-var StartWorkflowExecutionRequest_Identity_DEFAULT string
-func (p *StartWorkflowExecutionRequest) GetIdentity() string {
+var PollForActivityTaskRequest_Identity_DEFAULT string
+func (p *PollForActivityTaskRequest) GetIdentity() string {
   if !p.IsSetIdentity() {
-    return StartWorkflowExecutionRequest_Identity_DEFAULT
-  }
-return *p.Identity
-}
-var StartWorkflowExecutionRequest_RequestId_DEFAULT string
-func (p *StartWorkflowExecutionRequest) GetRequestId() string {
-  if !p.IsSetRequestId() {
-    return StartWorkflowExecutionRequest_RequestId_DEFAULT
+    return PollForActivityTaskRequest_Identity_DEFAULT
   }
-return *p.RequestId
-}
-func (p *StartWorkflowExecutionRequest) IsSetDomain() bool {
-  return p.Domain != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetWorkflowId() bool {
-  return p.WorkflowId != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetWorkflowType() bool {
-  return p.WorkflowType != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetTaskList() bool {
-  return p.TaskList != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetInput() bool {
-  return p.Input != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetExecutionStartToCloseTimeoutSeconds() bool {
-  return p.ExecutionStartToCloseTimeoutSeconds != nil
+return *p.Identity
 }
-
-func (p *StartWorkflowExecutionRequest) IsSetTaskStartToCloseTimeoutSeconds() bool {
-  return p.TaskStartToCloseTimeoutSeconds != nil
+func (p *PollForActivityTaskRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *StartWorkflowExecutionRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+func (p *PollForActivityTaskRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
 }
 
-func (p *StartWorkflowExecutionRequest) IsSetRequestId() bool {
-  return p.RequestId != nil
+func (p *PollForActivityTaskRequest) IsSetIdentity() bool {
+  return p.Identity != nil
 }
 
-func (p *StartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+func (p *PollForActivityTaskRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -17147,30 +19662,6 @@ func (p *StartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField30(iprot); err != nil {
         return err
       }
-    case 40:
-      if err := p.ReadField40(iprot); err != nil {
-        return err
-      }
-    case 50:
-      if err := p.ReadField50(iprot); err != nil {
-        return err
-      }
-    case 60:
-      if err := p.ReadField60(iprot); err != nil {
-        return err
-      }
-    case 70:
-      if err := p.ReadField70(iprot); err != nil {
-        return err
-      }
-    case 80:
-      if err := p.ReadField80(iprot); err != nil {
-        return err
-      }
-    case 90:
-      if err := p.ReadField90(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -17186,7 +19677,7 @@ func (p *StartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+func (p *PollForActivityTaskRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -17195,24 +19686,7 @@ func (p *StartWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) err
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 20: ", err)
-} else {
-  p.WorkflowId = &v
-}
-  return nil
-}
-
-func (p *StartWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
-  p.WorkflowType = &WorkflowType{}
-  if err := p.WorkflowType.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
-  }
-  return nil
-}
-
-func (p *StartWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+func (p *PollForActivityTaskRequest)  ReadField20(iprot thrift.TProtocol) error {
   p.TaskList = &TaskList{}
   if err := p.TaskList.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
@@ -17220,64 +19694,22 @@ func (p *StartWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) err
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
-} else {
-  p.Input = v
-}
-  return nil
-}
-
-func (p *StartWorkflowExecutionRequest)  ReadField60(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 60: ", err)
-} else {
-  p.ExecutionStartToCloseTimeoutSeconds = &v
-}
-  return nil
-}
-
-func (p *StartWorkflowExecutionRequest)  ReadField70(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 70: ", err)
-} else {
-  p.TaskStartToCloseTimeoutSeconds = &v
-}
-  return nil
-}
-
-func (p *StartWorkflowExecutionRequest)  ReadField80(iprot thrift.TProtocol) error {
+func (p *PollForActivityTaskRequest)  ReadField30(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 80: ", err)
+  return thrift.PrependError("error reading field 30: ", err)
 } else {
   p.Identity = &v
 }
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest)  ReadField90(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 90: ", err)
-} else {
-  p.RequestId = &v
-}
-  return nil
-}
-
-func (p *StartWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecutionRequest"); err != nil {
+func (p *PollForActivityTaskRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTaskRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
-    if err := p.writeField40(oprot); err != nil { return err }
-    if err := p.writeField50(oprot); err != nil { return err }
-    if err := p.writeField60(oprot); err != nil { return err }
-    if err := p.writeField70(oprot); err != nil { return err }
-    if err := p.writeField80(oprot); err != nil { return err }
-    if err := p.writeField90(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -17286,7 +19718,7 @@ func (p *StartWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *PollForActivityTaskRequest) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetDomain() {
     if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
@@ -17298,134 +19730,197 @@ func (p *StartWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (er
   return err
 }
 
-func (p *StartWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowId() {
-    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowId: ", p), err) }
-    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.workflowId (20) field write error: ", p), err) }
+func (p *PollForActivityTaskRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
   }
   return err
 }
 
-func (p *StartWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowType() {
-    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
-    if err := p.WorkflowType.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
-    }
+func (p *PollForActivityTaskRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("PollForActivityTaskRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - TaskToken
+//  - WorkflowExecution
+//  - ActivityId
+//  - ActivityType
+//  - Input
+//  - StartedEventId
+//  - ScheduledTimestamp
+//  - ScheduleToCloseTimeoutSeconds
+//  - StartedTimestamp
+//  - StartToCloseTimeoutSeconds
+//  - HeartbeatTimeoutSeconds
+type PollForActivityTaskResponse struct {
+  // unused fields # 1 to 9
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  // unused fields # 21 to 29
+  ActivityId *string `thrift:"activityId,30" db:"activityId" json:"activityId,omitempty"`
+  // unused fields # 31 to 39
+  ActivityType *ActivityType `thrift:"activityType,40" db:"activityType" json:"activityType,omitempty"`
+  // unused fields # 41 to 49
+  Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
+  // unused fields # 51 to 59
+  StartedEventId *int64 `thrift:"startedEventId,60" db:"startedEventId" json:"startedEventId,omitempty"`
+  // unused fields # 61 to 69
+  ScheduledTimestamp *int64 `thrift:"scheduledTimestamp,70" db:"scheduledTimestamp" json:"scheduledTimestamp,omitempty"`
+  // unused fields # 71 to 79
+  ScheduleToCloseTimeoutSeconds *int32 `thrift:"scheduleToCloseTimeoutSeconds,80" db:"scheduleToCloseTimeoutSeconds" json:"scheduleToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 81 to 89
+  StartedTimestamp *int64 `thrift:"startedTimestamp,90" db:"startedTimestamp" json:"startedTimestamp,omitempty"`
+  // unused fields # 91 to 99
+  StartToCloseTimeoutSeconds *int32 `thrift:"startToCloseTimeoutSeconds,100" db:"startToCloseTimeoutSeconds" json:"startToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 101 to 109
+  HeartbeatTimeoutSeconds *int32 `thrift:"heartbeatTimeoutSeconds,110" db:"heartbeatTimeoutSeconds" json:"heartbeatTimeoutSeconds,omitempty"`
+}
+
+func NewPollForActivityTaskResponse() *PollForActivityTaskResponse {
+  return &PollForActivityTaskResponse{}
+}
+
+var PollForActivityTaskResponse_TaskToken_DEFAULT []byte
+
+func (p *PollForActivityTaskResponse) GetTaskToken() []byte {
+  return p.TaskToken
+}
+var PollForActivityTaskResponse_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *PollForActivityTaskResponse) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return PollForActivityTaskResponse_WorkflowExecution_DEFAULT
+  }
+return p.WorkflowExecution
+}
+var PollForActivityTaskResponse_ActivityId_DEFAULT string
+func (p *PollForActivityTaskResponse) GetActivityId() string {
+  if !p.IsSetActivityId() {
+    return PollForActivityTaskResponse_ActivityId_DEFAULT
+  }
+return *p.ActivityId
+}
+var PollForActivityTaskResponse_ActivityType_DEFAULT *ActivityType
+func (p *PollForActivityTaskResponse) GetActivityType() *ActivityType {
+  if !p.IsSetActivityType() {
+    return PollForActivityTaskResponse_ActivityType_DEFAULT
+  }
+return p.ActivityType
+}
+var PollForActivityTaskResponse_Input_DEFAULT []byte
+
+func (p *PollForActivityTaskResponse) GetInput() []byte {
+  return p.Input
+}
+var PollForActivityTaskResponse_StartedEventId_DEFAULT int64
+func (p *PollForActivityTaskResponse) GetStartedEventId() int64 {
+  if !p.IsSetStartedEventId() {
+    return PollForActivityTaskResponse_StartedEventId_DEFAULT
+  }
+return *p.StartedEventId
+}
+var PollForActivityTaskResponse_ScheduledTimestamp_DEFAULT int64
+func (p *PollForActivityTaskResponse) GetScheduledTimestamp() int64 {
+  if !p.IsSetScheduledTimestamp() {
+    return PollForActivityTaskResponse_ScheduledTimestamp_DEFAULT
+  }
+return *p.ScheduledTimestamp
+}
+var PollForActivityTaskResponse_ScheduleToCloseTimeoutSeconds_DEFAULT int32
+func (p *PollForActivityTaskResponse) GetScheduleToCloseTimeoutSeconds() int32 {
+  if !p.IsSetScheduleToCloseTimeoutSeconds() {
+    return PollForActivityTaskResponse_ScheduleToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.ScheduleToCloseTimeoutSeconds
+}
+var PollForActivityTaskResponse_StartedTimestamp_DEFAULT int64
+func (p *PollForActivityTaskResponse) GetStartedTimestamp() int64 {
+  if !p.IsSetStartedTimestamp() {
+    return PollForActivityTaskResponse_StartedTimestamp_DEFAULT
+  }
+return *p.StartedTimestamp
+}
+var PollForActivityTaskResponse_StartToCloseTimeoutSeconds_DEFAULT int32
+func (p *PollForActivityTaskResponse) GetStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetStartToCloseTimeoutSeconds() {
+    return PollForActivityTaskResponse_StartToCloseTimeoutSeconds_DEFAULT
   }
-  return err
+return *p.StartToCloseTimeoutSeconds
 }
-
-func (p *StartWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskList() {
-    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskList: ", p), err) }
-    if err := p.TaskList.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskList: ", p), err) }
+var PollForActivityTaskResponse_HeartbeatTimeoutSeconds_DEFAULT int32
+func (p *PollForActivityTaskResponse) GetHeartbeatTimeoutSeconds() int32 {
+  if !p.IsSetHeartbeatTimeoutSeconds() {
+    return PollForActivityTaskResponse_HeartbeatTimeoutSeconds_DEFAULT
   }
-  return err
+return *p.HeartbeatTimeoutSeconds
+}
+func (p *PollForActivityTaskResponse) IsSetTaskToken() bool {
+  return p.TaskToken != nil
 }
 
-func (p *StartWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetInput() {
-    if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
-    if err := oprot.WriteBinary(p.Input); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.input (50) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:input: ", p), err) }
-  }
-  return err
+func (p *PollForActivityTaskResponse) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
 }
 
-func (p *StartWorkflowExecutionRequest) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetExecutionStartToCloseTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("executionStartToCloseTimeoutSeconds", thrift.I32, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.ExecutionStartToCloseTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.executionStartToCloseTimeoutSeconds (60) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
-  }
-  return err
+func (p *PollForActivityTaskResponse) IsSetActivityId() bool {
+  return p.ActivityId != nil
 }
 
-func (p *StartWorkflowExecutionRequest) writeField70(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskStartToCloseTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("taskStartToCloseTimeoutSeconds", thrift.I32, 70); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.TaskStartToCloseTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskStartToCloseTimeoutSeconds (70) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
-  }
-  return err
+func (p *PollForActivityTaskResponse) IsSetActivityType() bool {
+  return p.ActivityType != nil
 }
 
-func (p *StartWorkflowExecutionRequest) writeField80(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 80); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (80) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:identity: ", p), err) }
-  }
-  return err
+func (p *PollForActivityTaskResponse) IsSetInput() bool {
+  return p.Input != nil
 }
 
-func (p *StartWorkflowExecutionRequest) writeField90(oprot thrift.TProtocol) (err error) {
-  if p.IsSetRequestId() {
-    if err := oprot.WriteFieldBegin("requestId", thrift.STRING, 90); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:requestId: ", p), err) }
-    if err := oprot.WriteString(string(*p.RequestId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.requestId (90) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:requestId: ", p), err) }
-  }
-  return err
+func (p *PollForActivityTaskResponse) IsSetStartedEventId() bool {
+  return p.StartedEventId != nil
 }
 
-func (p *StartWorkflowExecutionRequest) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("StartWorkflowExecutionRequest(%+v)", *p)
+func (p *PollForActivityTaskResponse) IsSetScheduledTimestamp() bool {
+  return p.ScheduledTimestamp != nil
 }
 
-// Attributes:
-//  - RunId
-type StartWorkflowExecutionResponse struct {
-  // unused fields # 1 to 9
-  RunId *string `thrift:"runId,10" db:"runId" json:"runId,omitempty"`
+func (p *PollForActivityTaskResponse) IsSetScheduleToCloseTimeoutSeconds() bool {
+  return p.ScheduleToCloseTimeoutSeconds != nil
 }
 
-func NewStartWorkflowExecutionResponse() *StartWorkflowExecutionResponse {
-  return &StartWorkflowExecutionResponse{}
+func (p *PollForActivityTaskResponse) IsSetStartedTimestamp() bool {
+  return p.StartedTimestamp != nil
 }
 
-var StartWorkflowExecutionResponse_RunId_DEFAULT string
-func (p *StartWorkflowExecutionResponse) GetRunId() string {
-  if !p.IsSetRunId() {
-    return StartWorkflowExecutionResponse_RunId_DEFAULT
-  }
-return *p.RunId
+func (p *PollForActivityTaskResponse) IsSetStartToCloseTimeoutSeconds() bool {
+  return p.StartToCloseTimeoutSeconds != nil
 }
-func (p *StartWorkflowExecutionResponse) IsSetRunId() bool {
-  return p.RunId != nil
+
+func (p *PollForActivityTaskResponse) IsSetHeartbeatTimeoutSeconds() bool {
+  return p.HeartbeatTimeoutSeconds != nil
 }
 
-func (p *StartWorkflowExecutionResponse) Read(iprot thrift.TProtocol) error {
+func (p *PollForActivityTaskResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -17442,6 +19937,46 @@ func (p *StartWorkflowExecutionResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField10(iprot); err != nil {
         return err
       }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
+    case 100:
+      if err := p.ReadField100(iprot); err != nil {
+        return err
+      }
+    case 110:
+      if err := p.ReadField110(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -17457,170 +19992,118 @@ func (p *StartWorkflowExecutionResponse) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *StartWorkflowExecutionResponse)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *PollForActivityTaskResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.RunId = &v
+  p.TaskToken = v
 }
   return nil
 }
 
-func (p *StartWorkflowExecutionResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecutionResponse"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField10(oprot); err != nil { return err }
+func (p *PollForActivityTaskResponse)  ReadField20(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
   }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
   return nil
 }
 
-func (p *StartWorkflowExecutionResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetRunId() {
-    if err := oprot.WriteFieldBegin("runId", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:runId: ", p), err) }
-    if err := oprot.WriteString(string(*p.RunId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.runId (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:runId: ", p), err) }
-  }
-  return err
+func (p *PollForActivityTaskResponse)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.ActivityId = &v
+}
+  return nil
 }
 
-func (p *StartWorkflowExecutionResponse) String() string {
-  if p == nil {
-    return "<nil>"
+func (p *PollForActivityTaskResponse)  ReadField40(iprot thrift.TProtocol) error {
+  p.ActivityType = &ActivityType{}
+  if err := p.ActivityType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityType), err)
   }
-  return fmt.Sprintf("StartWorkflowExecutionResponse(%+v)", *p)
+  return nil
 }
 
-// Attributes:
-//  - Domain
-//  - TaskList
-//  - Identity
-type PollForDecisionTaskRequest struct {
-  // unused fields # 1 to 9
-  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
-  // unused fields # 11 to 19
-  TaskList *TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
-  // unused fields # 21 to 29
-  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+func (p *PollForActivityTaskResponse)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Input = v
 }
-
-func NewPollForDecisionTaskRequest() *PollForDecisionTaskRequest {
-  return &PollForDecisionTaskRequest{}
+  return nil
 }
 
-var PollForDecisionTaskRequest_Domain_DEFAULT string
-func (p *PollForDecisionTaskRequest) GetDomain() string {
-  if !p.IsSetDomain() {
-    return PollForDecisionTaskRequest_Domain_DEFAULT
-  }
-return *p.Domain
-}
-var PollForDecisionTaskRequest_TaskList_DEFAULT *TaskList
-func (p *PollForDecisionTaskRequest) GetTaskList() *TaskList {
-  if !p.IsSetTaskList() {
-    return PollForDecisionTaskRequest_TaskList_DEFAULT
-  }
-return p.TaskList
-}
-var PollForDecisionTaskRequest_Identity_DEFAULT string
-func (p *PollForDecisionTaskRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return PollForDecisionTaskRequest_Identity_DEFAULT
-  }
-return *p.Identity
+func (p *PollForActivityTaskResponse)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.StartedEventId = &v
 }
-func (p *PollForDecisionTaskRequest) IsSetDomain() bool {
-  return p.Domain != nil
+  return nil
 }
 
-func (p *PollForDecisionTaskRequest) IsSetTaskList() bool {
-  return p.TaskList != nil
+func (p *PollForActivityTaskResponse)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.ScheduledTimestamp = &v
 }
-
-func (p *PollForDecisionTaskRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+  return nil
 }
 
-func (p *PollForDecisionTaskRequest) Read(iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-  }
-
-
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 10:
-      if err := p.ReadField10(iprot); err != nil {
-        return err
-      }
-    case 20:
-      if err := p.ReadField20(iprot); err != nil {
-        return err
-      }
-    case 30:
-      if err := p.ReadField30(iprot); err != nil {
-        return err
-      }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
-      }
-    }
-    if err := iprot.ReadFieldEnd(); err != nil {
-      return err
-    }
-  }
-  if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-  }
+func (p *PollForActivityTaskResponse)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.ScheduleToCloseTimeoutSeconds = &v
+}
   return nil
 }
 
-func (p *PollForDecisionTaskRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
+func (p *PollForActivityTaskResponse)  ReadField90(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 90: ", err)
 } else {
-  p.Domain = &v
+  p.StartedTimestamp = &v
 }
   return nil
 }
 
-func (p *PollForDecisionTaskRequest)  ReadField20(iprot thrift.TProtocol) error {
-  p.TaskList = &TaskList{}
-  if err := p.TaskList.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
-  }
+func (p *PollForActivityTaskResponse)  ReadField100(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 100: ", err)
+} else {
+  p.StartToCloseTimeoutSeconds = &v
+}
   return nil
 }
 
-func (p *PollForDecisionTaskRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
+func (p *PollForActivityTaskResponse)  ReadField110(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 110: ", err)
 } else {
-  p.Identity = &v
+  p.HeartbeatTimeoutSeconds = &v
 }
   return nil
 }
 
-func (p *PollForDecisionTaskRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTaskRequest"); err != nil {
+func (p *PollForActivityTaskResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTaskResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
+    if err := p.writeField100(oprot); err != nil { return err }
+    if err := p.writeField110(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -17629,167 +20112,194 @@ func (p *PollForDecisionTaskRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForDecisionTaskRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+func (p *PollForActivityTaskResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskList() {
-    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
-    if err := p.TaskList.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+func (p *PollForActivityTaskResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+func (p *PollForActivityTaskResponse) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityId() {
+    if err := oprot.WriteFieldBegin("activityId", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:activityId: ", p), err) }
+    if err := oprot.WriteString(string(*p.ActivityId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.activityId (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:activityId: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskRequest) String() string {
-  if p == nil {
-    return "<nil>"
+func (p *PollForActivityTaskResponse) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityType() {
+    if err := oprot.WriteFieldBegin("activityType", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:activityType: ", p), err) }
+    if err := p.ActivityType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityType), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:activityType: ", p), err) }
   }
-  return fmt.Sprintf("PollForDecisionTaskRequest(%+v)", *p)
-}
-
-// Attributes:
-//  - TaskToken
-//  - WorkflowExecution
-//  - WorkflowType
-//  - PreviousStartedEventId
-//  - StartedEventId
-//  - History
-//  - NextPageToken
-//  - Query
-type PollForDecisionTaskResponse struct {
-  // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
-  // unused fields # 11 to 19
-  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
-  // unused fields # 21 to 29
-  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
-  // unused fields # 31 to 39
-  PreviousStartedEventId *int64 `thrift:"previousStartedEventId,40" db:"previousStartedEventId" json:"previousStartedEventId,omitempty"`
-  // unused fields # 41 to 49
-  StartedEventId *int64 `thrift:"startedEventId,50" db:"startedEventId" json:"startedEventId,omitempty"`
-  // unused fields # 51 to 59
-  History *History `thrift:"history,60" db:"history" json:"history,omitempty"`
-  // unused fields # 61 to 69
-  NextPageToken []byte `thrift:"nextPageToken,70" db:"nextPageToken" json:"nextPageToken,omitempty"`
-  // unused fields # 71 to 79
-  Query *WorkflowQuery `thrift:"query,80" db:"query" json:"query,omitempty"`
+  return err
 }
 
-func NewPollForDecisionTaskResponse() *PollForDecisionTaskResponse {
-  return &PollForDecisionTaskResponse{}
+func (p *PollForActivityTaskResponse) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInput() {
+    if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
+    if err := oprot.WriteBinary(p.Input); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.input (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:input: ", p), err) }
+  }
+  return err
 }
 
-var PollForDecisionTaskResponse_TaskToken_DEFAULT []byte
-
-func (p *PollForDecisionTaskResponse) GetTaskToken() []byte {
-  return p.TaskToken
-}
-var PollForDecisionTaskResponse_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *PollForDecisionTaskResponse) GetWorkflowExecution() *WorkflowExecution {
-  if !p.IsSetWorkflowExecution() {
-    return PollForDecisionTaskResponse_WorkflowExecution_DEFAULT
+func (p *PollForActivityTaskResponse) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedEventId() {
+    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:startedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:startedEventId: ", p), err) }
   }
-return p.WorkflowExecution
+  return err
 }
-var PollForDecisionTaskResponse_WorkflowType_DEFAULT *WorkflowType
-func (p *PollForDecisionTaskResponse) GetWorkflowType() *WorkflowType {
-  if !p.IsSetWorkflowType() {
-    return PollForDecisionTaskResponse_WorkflowType_DEFAULT
+
+func (p *PollForActivityTaskResponse) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduledTimestamp() {
+    if err := oprot.WriteFieldBegin("scheduledTimestamp", thrift.I64, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:scheduledTimestamp: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.ScheduledTimestamp)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduledTimestamp (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:scheduledTimestamp: ", p), err) }
   }
-return p.WorkflowType
+  return err
 }
-var PollForDecisionTaskResponse_PreviousStartedEventId_DEFAULT int64
-func (p *PollForDecisionTaskResponse) GetPreviousStartedEventId() int64 {
-  if !p.IsSetPreviousStartedEventId() {
-    return PollForDecisionTaskResponse_PreviousStartedEventId_DEFAULT
+
+func (p *PollForActivityTaskResponse) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduleToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("scheduleToCloseTimeoutSeconds", thrift.I32, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:scheduleToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ScheduleToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduleToCloseTimeoutSeconds (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:scheduleToCloseTimeoutSeconds: ", p), err) }
   }
-return *p.PreviousStartedEventId
+  return err
 }
-var PollForDecisionTaskResponse_StartedEventId_DEFAULT int64
-func (p *PollForDecisionTaskResponse) GetStartedEventId() int64 {
-  if !p.IsSetStartedEventId() {
-    return PollForDecisionTaskResponse_StartedEventId_DEFAULT
+
+func (p *PollForActivityTaskResponse) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedTimestamp() {
+    if err := oprot.WriteFieldBegin("startedTimestamp", thrift.I64, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:startedTimestamp: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedTimestamp)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedTimestamp (90) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:startedTimestamp: ", p), err) }
   }
-return *p.StartedEventId
+  return err
 }
-var PollForDecisionTaskResponse_History_DEFAULT *History
-func (p *PollForDecisionTaskResponse) GetHistory() *History {
-  if !p.IsSetHistory() {
-    return PollForDecisionTaskResponse_History_DEFAULT
+
+func (p *PollForActivityTaskResponse) writeField100(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("startToCloseTimeoutSeconds", thrift.I32, 100); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:startToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.StartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startToCloseTimeoutSeconds (100) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:startToCloseTimeoutSeconds: ", p), err) }
   }
-return p.History
+  return err
 }
-var PollForDecisionTaskResponse_NextPageToken_DEFAULT []byte
 
-func (p *PollForDecisionTaskResponse) GetNextPageToken() []byte {
-  return p.NextPageToken
-}
-var PollForDecisionTaskResponse_Query_DEFAULT *WorkflowQuery
-func (p *PollForDecisionTaskResponse) GetQuery() *WorkflowQuery {
-  if !p.IsSetQuery() {
-    return PollForDecisionTaskResponse_Query_DEFAULT
+func (p *PollForActivityTaskResponse) writeField110(oprot thrift.TProtocol) (err error) {
+  if p.IsSetHeartbeatTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("heartbeatTimeoutSeconds", thrift.I32, 110); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:heartbeatTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.HeartbeatTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.heartbeatTimeoutSeconds (110) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:heartbeatTimeoutSeconds: ", p), err) }
   }
-return p.Query
-}
-func (p *PollForDecisionTaskResponse) IsSetTaskToken() bool {
-  return p.TaskToken != nil
+  return err
 }
 
-func (p *PollForDecisionTaskResponse) IsSetWorkflowExecution() bool {
-  return p.WorkflowExecution != nil
+func (p *PollForActivityTaskResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("PollForActivityTaskResponse(%+v)", *p)
 }
 
-func (p *PollForDecisionTaskResponse) IsSetWorkflowType() bool {
-  return p.WorkflowType != nil
+// Attributes:
+//  - TaskToken
+//  - Details
+//  - Identity
+type RecordActivityTaskHeartbeatRequest struct {
+  // unused fields # 1 to 9
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  // unused fields # 11 to 19
+  Details []byte `thrift:"details,20" db:"details" json:"details,omitempty"`
+  // unused fields # 21 to 29
+  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
 }
 
-func (p *PollForDecisionTaskResponse) IsSetPreviousStartedEventId() bool {
-  return p.PreviousStartedEventId != nil
+func NewRecordActivityTaskHeartbeatRequest() *RecordActivityTaskHeartbeatRequest {
+  return &RecordActivityTaskHeartbeatRequest{}
 }
 
-func (p *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
-  return p.StartedEventId != nil
+var RecordActivityTaskHeartbeatRequest_TaskToken_DEFAULT []byte
+
+func (p *RecordActivityTaskHeartbeatRequest) GetTaskToken() []byte {
+  return p.TaskToken
 }
+var RecordActivityTaskHeartbeatRequest_Details_DEFAULT []byte
 
-func (p *PollForDecisionTaskResponse) IsSetHistory() bool {
-  return p.History != nil
+func (p *RecordActivityTaskHeartbeatRequest) GetDetails() []byte {
+  return p.Details
+}
+var RecordActivityTaskHeartbeatRequest_Identity_DEFAULT string
+func (p *RecordActivityTaskHeartbeatRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return RecordActivityTaskHeartbeatRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+func (p *RecordActivityTaskHeartbeatRequest) IsSetTaskToken() bool {
+  return p.TaskToken != nil
 }
 
-func (p *PollForDecisionTaskResponse) IsSetNextPageToken() bool {
-  return p.NextPageToken != nil
+func (p *RecordActivityTaskHeartbeatRequest) IsSetDetails() bool {
+  return p.Details != nil
 }
 
-func (p *PollForDecisionTaskResponse) IsSetQuery() bool {
-  return p.Query != nil
+func (p *RecordActivityTaskHeartbeatRequest) IsSetIdentity() bool {
+  return p.Identity != nil
 }
 
-func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
+func (p *RecordActivityTaskHeartbeatRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -17814,26 +20324,6 @@ func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField30(iprot); err != nil {
         return err
       }
-    case 40:
-      if err := p.ReadField40(iprot); err != nil {
-        return err
-      }
-    case 50:
-      if err := p.ReadField50(iprot); err != nil {
-        return err
-      }
-    case 60:
-      if err := p.ReadField60(iprot); err != nil {
-        return err
-      }
-    case 70:
-      if err := p.ReadField70(iprot); err != nil {
-        return err
-      }
-    case 80:
-      if err := p.ReadField80(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -17849,7 +20339,7 @@ func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForDecisionTaskResponse)  ReadField10(iprot thrift.TProtocol) error {
+func (p *RecordActivityTaskHeartbeatRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -17858,77 +20348,31 @@ func (p *PollForDecisionTaskResponse)  ReadField10(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *PollForDecisionTaskResponse)  ReadField20(iprot thrift.TProtocol) error {
-  p.WorkflowExecution = &WorkflowExecution{}
-  if err := p.WorkflowExecution.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
-  }
-  return nil
-}
-
-func (p *PollForDecisionTaskResponse)  ReadField30(iprot thrift.TProtocol) error {
-  p.WorkflowType = &WorkflowType{}
-  if err := p.WorkflowType.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
-  }
-  return nil
-}
-
-func (p *PollForDecisionTaskResponse)  ReadField40(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
-} else {
-  p.PreviousStartedEventId = &v
-}
-  return nil
-}
-
-func (p *PollForDecisionTaskResponse)  ReadField50(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
+func (p *RecordActivityTaskHeartbeatRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.StartedEventId = &v
-}
-  return nil
+  p.Details = v
 }
-
-func (p *PollForDecisionTaskResponse)  ReadField60(iprot thrift.TProtocol) error {
-  p.History = &History{}
-  if err := p.History.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.History), err)
-  }
   return nil
 }
 
-func (p *PollForDecisionTaskResponse)  ReadField70(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 70: ", err)
+func (p *RecordActivityTaskHeartbeatRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
 } else {
-  p.NextPageToken = v
-}
-  return nil
+  p.Identity = &v
 }
-
-func (p *PollForDecisionTaskResponse)  ReadField80(iprot thrift.TProtocol) error {
-  p.Query = &WorkflowQuery{}
-  if err := p.Query.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Query), err)
-  }
   return nil
 }
 
-func (p *PollForDecisionTaskResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTaskResponse"); err != nil {
+func (p *RecordActivityTaskHeartbeatRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
-    if err := p.writeField40(oprot); err != nil { return err }
-    if err := p.writeField50(oprot); err != nil { return err }
-    if err := p.writeField60(oprot); err != nil { return err }
-    if err := p.writeField70(oprot); err != nil { return err }
-    if err := p.writeField80(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -17937,7 +20381,7 @@ func (p *PollForDecisionTaskResponse) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForDecisionTaskResponse) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *RecordActivityTaskHeartbeatRequest) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetTaskToken() {
     if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
@@ -17949,160 +20393,223 @@ func (p *PollForDecisionTaskResponse) writeField10(oprot thrift.TProtocol) (err
   return err
 }
 
-func (p *PollForDecisionTaskResponse) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecution() {
-    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
-    if err := p.WorkflowExecution.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
-    }
+func (p *RecordActivityTaskHeartbeatRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDetails() {
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:details: ", p), err) }
+    if err := oprot.WriteBinary(p.Details); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.details (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:details: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskResponse) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowType() {
-    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
-    if err := p.WorkflowType.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
-    }
+func (p *RecordActivityTaskHeartbeatRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskResponse) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetPreviousStartedEventId() {
-    if err := oprot.WriteFieldBegin("previousStartedEventId", thrift.I64, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:previousStartedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.PreviousStartedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.previousStartedEventId (40) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:previousStartedEventId: ", p), err) }
+func (p *RecordActivityTaskHeartbeatRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RecordActivityTaskHeartbeatRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - CancelRequested
+type RecordActivityTaskHeartbeatResponse struct {
+  // unused fields # 1 to 9
+  CancelRequested *bool `thrift:"cancelRequested,10" db:"cancelRequested" json:"cancelRequested,omitempty"`
+}
+
+func NewRecordActivityTaskHeartbeatResponse() *RecordActivityTaskHeartbeatResponse {
+  return &RecordActivityTaskHeartbeatResponse{}
+}
+
+var RecordActivityTaskHeartbeatResponse_CancelRequested_DEFAULT bool
+func (p *RecordActivityTaskHeartbeatResponse) GetCancelRequested() bool {
+  if !p.IsSetCancelRequested() {
+    return RecordActivityTaskHeartbeatResponse_CancelRequested_DEFAULT
+  }
+return *p.CancelRequested
+}
+func (p *RecordActivityTaskHeartbeatResponse) IsSetCancelRequested() bool {
+  return p.CancelRequested != nil
+}
+
+func (p *RecordActivityTaskHeartbeatResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  return err
-}
-
-func (p *PollForDecisionTaskResponse) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartedEventId() {
-    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:startedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (50) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:startedEventId: ", p), err) }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return err
+  return nil
 }
 
-func (p *PollForDecisionTaskResponse) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetHistory() {
-    if err := oprot.WriteFieldBegin("history", thrift.STRUCT, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:history: ", p), err) }
-    if err := p.History.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.History), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:history: ", p), err) }
-  }
-  return err
+func (p *RecordActivityTaskHeartbeatResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.CancelRequested = &v
+}
+  return nil
 }
 
-func (p *PollForDecisionTaskResponse) writeField70(oprot thrift.TProtocol) (err error) {
-  if p.IsSetNextPageToken() {
-    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 70); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:nextPageToken: ", p), err) }
-    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (70) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:nextPageToken: ", p), err) }
+func (p *RecordActivityTaskHeartbeatResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
   }
-  return err
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-func (p *PollForDecisionTaskResponse) writeField80(oprot thrift.TProtocol) (err error) {
-  if p.IsSetQuery() {
-    if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 80); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:query: ", p), err) }
-    if err := p.Query.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
-    }
+func (p *RecordActivityTaskHeartbeatResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCancelRequested() {
+    if err := oprot.WriteFieldBegin("cancelRequested", thrift.BOOL, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:cancelRequested: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.CancelRequested)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.cancelRequested (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:query: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:cancelRequested: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskResponse) String() string {
+func (p *RecordActivityTaskHeartbeatResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("PollForDecisionTaskResponse(%+v)", *p)
+  return fmt.Sprintf("RecordActivityTaskHeartbeatResponse(%+v)", *p)
 }
 
 // Attributes:
-//  - TaskToken
-//  - Decisions
-//  - ExecutionContext
+//  - Domain
+//  - WorkflowID
+//  - RunID
+//  - ActivityID
+//  - Details
 //  - Identity
-type RespondDecisionTaskCompletedRequest struct {
+type RecordActivityTaskHeartbeatByIDRequest struct {
   // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  Decisions []*Decision `thrift:"decisions,20" db:"decisions" json:"decisions,omitempty"`
+  WorkflowID *string `thrift:"workflowID,20" db:"workflowID" json:"workflowID,omitempty"`
   // unused fields # 21 to 29
-  ExecutionContext []byte `thrift:"executionContext,30" db:"executionContext" json:"executionContext,omitempty"`
+  RunID *string `thrift:"runID,30" db:"runID" json:"runID,omitempty"`
   // unused fields # 31 to 39
-  Identity *string `thrift:"identity,40" db:"identity" json:"identity,omitempty"`
+  ActivityID *string `thrift:"activityID,40" db:"activityID" json:"activityID,omitempty"`
+  // unused fields # 41 to 49
+  Details []byte `thrift:"details,50" db:"details" json:"details,omitempty"`
+  // unused fields # 51 to 59
+  Identity *string `thrift:"identity,60" db:"identity" json:"identity,omitempty"`
 }
 
-func NewRespondDecisionTaskCompletedRequest() *RespondDecisionTaskCompletedRequest {
-  return &RespondDecisionTaskCompletedRequest{}
+func NewRecordActivityTaskHeartbeatByIDRequest() *RecordActivityTaskHeartbeatByIDRequest {
+  return &RecordActivityTaskHeartbeatByIDRequest{}
 }
 
-var RespondDecisionTaskCompletedRequest_TaskToken_DEFAULT []byte
-
-func (p *RespondDecisionTaskCompletedRequest) GetTaskToken() []byte {
-  return p.TaskToken
+var RecordActivityTaskHeartbeatByIDRequest_Domain_DEFAULT string
+func (p *RecordActivityTaskHeartbeatByIDRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return RecordActivityTaskHeartbeatByIDRequest_Domain_DEFAULT
+  }
+return *p.Domain
 }
-var RespondDecisionTaskCompletedRequest_Decisions_DEFAULT []*Decision
-
-func (p *RespondDecisionTaskCompletedRequest) GetDecisions() []*Decision {
-  return p.Decisions
+var RecordActivityTaskHeartbeatByIDRequest_WorkflowID_DEFAULT string
+func (p *RecordActivityTaskHeartbeatByIDRequest) GetWorkflowID() string {
+  if !p.IsSetWorkflowID() {
+    return RecordActivityTaskHeartbeatByIDRequest_WorkflowID_DEFAULT
+  }
+return *p.WorkflowID
 }
-var RespondDecisionTaskCompletedRequest_ExecutionContext_DEFAULT []byte
+var RecordActivityTaskHeartbeatByIDRequest_RunID_DEFAULT string
+func (p *RecordActivityTaskHeartbeatByIDRequest) GetRunID() string {
+  if !p.IsSetRunID() {
+    return RecordActivityTaskHeartbeatByIDRequest_RunID_DEFAULT
+  }
+return *p.RunID
+}
+var RecordActivityTaskHeartbeatByIDRequest_ActivityID_DEFAULT string
+func (p *RecordActivityTaskHeartbeatByIDRequest) GetActivityID() string {
+  if !p.IsSetActivityID() {
+    return RecordActivityTaskHeartbeatByIDRequest_ActivityID_DEFAULT
+  }
+return *p.ActivityID
+}
+var RecordActivityTaskHeartbeatByIDRequest_Details_DEFAULT []byte
 
-func (p *RespondDecisionTaskCompletedRequest) GetExecutionContext() []byte {
-  return p.ExecutionContext
+func (p *RecordActivityTaskHeartbeatByIDRequest) GetDetails() []byte {
+  return p.Details
 }
-var RespondDecisionTaskCompletedRequest_Identity_DEFAULT string
-func (p *RespondDecisionTaskCompletedRequest) GetIdentity() string {
+var RecordActivityTaskHeartbeatByIDRequest_Identity_DEFAULT string
+func (p *RecordActivityTaskHeartbeatByIDRequest) GetIdentity() string {
   if !p.IsSetIdentity() {
-    return RespondDecisionTaskCompletedRequest_Identity_DEFAULT
+    return RecordActivityTaskHeartbeatByIDRequest_Identity_DEFAULT
   }
 return *p.Identity
 }
-func (p *RespondDecisionTaskCompletedRequest) IsSetTaskToken() bool {
-  return p.TaskToken != nil
+func (p *RecordActivityTaskHeartbeatByIDRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest) IsSetDecisions() bool {
-  return p.Decisions != nil
+func (p *RecordActivityTaskHeartbeatByIDRequest) IsSetWorkflowID() bool {
+  return p.WorkflowID != nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest) IsSetExecutionContext() bool {
-  return p.ExecutionContext != nil
+func (p *RecordActivityTaskHeartbeatByIDRequest) IsSetRunID() bool {
+  return p.RunID != nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest) IsSetIdentity() bool {
+func (p *RecordActivityTaskHeartbeatByIDRequest) IsSetActivityID() bool {
+  return p.ActivityID != nil
+}
+
+func (p *RecordActivityTaskHeartbeatByIDRequest) IsSetDetails() bool {
+  return p.Details != nil
+}
+
+func (p *RecordActivityTaskHeartbeatByIDRequest) IsSetIdentity() bool {
   return p.Identity != nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest) Read(iprot thrift.TProtocol) error {
+func (p *RecordActivityTaskHeartbeatByIDRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -18131,6 +20638,14 @@ func (p *RespondDecisionTaskCompletedRequest) Read(iprot thrift.TProtocol) error
       if err := p.ReadField40(iprot); err != nil {
         return err
       }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -18146,61 +20661,70 @@ func (p *RespondDecisionTaskCompletedRequest) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *RecordActivityTaskHeartbeatByIDRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.TaskToken = v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest)  ReadField20(iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin()
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]*Decision, 0, size)
-  p.Decisions =  tSlice
-  for i := 0; i < size; i ++ {
-    _elem1 := &Decision{}
-    if err := _elem1.Read(iprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem1), err)
-    }
-    p.Decisions = append(p.Decisions, _elem1)
-  }
-  if err := iprot.ReadListEnd(); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
-  }
+func (p *RecordActivityTaskHeartbeatByIDRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.WorkflowID = &v
+}
   return nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *RecordActivityTaskHeartbeatByIDRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 30: ", err)
 } else {
-  p.ExecutionContext = v
+  p.RunID = &v
 }
   return nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest)  ReadField40(iprot thrift.TProtocol) error {
+func (p *RecordActivityTaskHeartbeatByIDRequest)  ReadField40(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.ActivityID = &v
+}
+  return nil
+}
+
+func (p *RecordActivityTaskHeartbeatByIDRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Details = v
+}
+  return nil
+}
+
+func (p *RecordActivityTaskHeartbeatByIDRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
 } else {
   p.Identity = &v
 }
   return nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondDecisionTaskCompletedRequest"); err != nil {
+func (p *RecordActivityTaskHeartbeatByIDRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatByIDRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -18209,121 +20733,132 @@ func (p *RespondDecisionTaskCompletedRequest) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskToken() {
-    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
-    if err := oprot.WriteBinary(p.TaskToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+func (p *RecordActivityTaskHeartbeatByIDRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *RespondDecisionTaskCompletedRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDecisions() {
-    if err := oprot.WriteFieldBegin("decisions", thrift.LIST, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:decisions: ", p), err) }
-    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Decisions)); err != nil {
-      return thrift.PrependError("error writing list begin: ", err)
-    }
-    for _, v := range p.Decisions {
-      if err := v.Write(oprot); err != nil {
-        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-      }
-    }
-    if err := oprot.WriteListEnd(); err != nil {
-      return thrift.PrependError("error writing list end: ", err)
-    }
+func (p *RecordActivityTaskHeartbeatByIDRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowID() {
+    if err := oprot.WriteFieldBegin("workflowID", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowID: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowID (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:decisions: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowID: ", p), err) }
   }
   return err
 }
 
-func (p *RespondDecisionTaskCompletedRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetExecutionContext() {
-    if err := oprot.WriteFieldBegin("executionContext", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:executionContext: ", p), err) }
-    if err := oprot.WriteBinary(p.ExecutionContext); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.executionContext (30) field write error: ", p), err) }
+func (p *RecordActivityTaskHeartbeatByIDRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRunID() {
+    if err := oprot.WriteFieldBegin("runID", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:runID: ", p), err) }
+    if err := oprot.WriteString(string(*p.RunID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.runID (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:executionContext: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:runID: ", p), err) }
   }
   return err
 }
 
-func (p *RespondDecisionTaskCompletedRequest) writeField40(oprot thrift.TProtocol) (err error) {
+func (p *RecordActivityTaskHeartbeatByIDRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityID() {
+    if err := oprot.WriteFieldBegin("activityID", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:activityID: ", p), err) }
+    if err := oprot.WriteString(string(*p.ActivityID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.activityID (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:activityID: ", p), err) }
+  }
+  return err
+}
+
+func (p *RecordActivityTaskHeartbeatByIDRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDetails() {
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:details: ", p), err) }
+    if err := oprot.WriteBinary(p.Details); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.details (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:details: ", p), err) }
+  }
+  return err
+}
+
+func (p *RecordActivityTaskHeartbeatByIDRequest) writeField60(oprot thrift.TProtocol) (err error) {
   if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:identity: ", p), err) }
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:identity: ", p), err) }
     if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (40) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.identity (60) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:identity: ", p), err) }
   }
   return err
 }
 
-func (p *RespondDecisionTaskCompletedRequest) String() string {
+func (p *RecordActivityTaskHeartbeatByIDRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RespondDecisionTaskCompletedRequest(%+v)", *p)
+  return fmt.Sprintf("RecordActivityTaskHeartbeatByIDRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - Domain
-//  - TaskList
+//  - TaskToken
+//  - Result_
 //  - Identity
-type PollForActivityTaskRequest struct {
+type RespondActivityTaskCompletedRequest struct {
   // unused fields # 1 to 9
-  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
   // unused fields # 11 to 19
-  TaskList *TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
+  Result_ []byte `thrift:"result,20" db:"result" json:"result,omitempty"`
   // unused fields # 21 to 29
   Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
 }
 
-func NewPollForActivityTaskRequest() *PollForActivityTaskRequest {
-  return &PollForActivityTaskRequest{}
+func NewRespondActivityTaskCompletedRequest() *RespondActivityTaskCompletedRequest {
+  return &RespondActivityTaskCompletedRequest{}
 }
 
-var PollForActivityTaskRequest_Domain_DEFAULT string
-func (p *PollForActivityTaskRequest) GetDomain() string {
-  if !p.IsSetDomain() {
-    return PollForActivityTaskRequest_Domain_DEFAULT
-  }
-return *p.Domain
+var RespondActivityTaskCompletedRequest_TaskToken_DEFAULT []byte
+
+func (p *RespondActivityTaskCompletedRequest) GetTaskToken() []byte {
+  return p.TaskToken
 }
-var PollForActivityTaskRequest_TaskList_DEFAULT *TaskList
-func (p *PollForActivityTaskRequest) GetTaskList() *TaskList {
-  if !p.IsSetTaskList() {
-    return PollForActivityTaskRequest_TaskList_DEFAULT
-  }
-return p.TaskList
+var RespondActivityTaskCompletedRequest_Result__DEFAULT []byte
+
+func (p *RespondActivityTaskCompletedRequest) GetResult_() []byte {
+  return p.Result_
 }
-var PollForActivityTaskRequest_Identity_DEFAULT string
-func (p *PollForActivityTaskRequest) GetIdentity() string {
+var RespondActivityTaskCompletedRequest_Identity_DEFAULT string
+func (p *RespondActivityTaskCompletedRequest) GetIdentity() string {
   if !p.IsSetIdentity() {
-    return PollForActivityTaskRequest_Identity_DEFAULT
+    return RespondActivityTaskCompletedRequest_Identity_DEFAULT
   }
 return *p.Identity
 }
-func (p *PollForActivityTaskRequest) IsSetDomain() bool {
-  return p.Domain != nil
+func (p *RespondActivityTaskCompletedRequest) IsSetTaskToken() bool {
+  return p.TaskToken != nil
 }
 
-func (p *PollForActivityTaskRequest) IsSetTaskList() bool {
-  return p.TaskList != nil
+func (p *RespondActivityTaskCompletedRequest) IsSetResult_() bool {
+  return p.Result_ != nil
 }
 
-func (p *PollForActivityTaskRequest) IsSetIdentity() bool {
+func (p *RespondActivityTaskCompletedRequest) IsSetIdentity() bool {
   return p.Identity != nil
 }
 
-func (p *PollForActivityTaskRequest) Read(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskCompletedRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -18363,24 +20898,25 @@ func (p *PollForActivityTaskRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForActivityTaskRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *RespondActivityTaskCompletedRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Domain = &v
+  p.TaskToken = v
 }
   return nil
 }
 
-func (p *PollForActivityTaskRequest)  ReadField20(iprot thrift.TProtocol) error {
-  p.TaskList = &TaskList{}
-  if err := p.TaskList.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
-  }
+func (p *RespondActivityTaskCompletedRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.Result_ = v
+}
   return nil
 }
 
-func (p *PollForActivityTaskRequest)  ReadField30(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskCompletedRequest)  ReadField30(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 30: ", err)
 } else {
@@ -18389,8 +20925,8 @@ func (p *PollForActivityTaskRequest)  ReadField30(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *PollForActivityTaskRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTaskRequest"); err != nil {
+func (p *RespondActivityTaskCompletedRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompletedRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -18404,32 +20940,31 @@ func (p *PollForActivityTaskRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForActivityTaskRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+func (p *RespondActivityTaskCompletedRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskList() {
-    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
-    if err := p.TaskList.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
-    }
+func (p *RespondActivityTaskCompletedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetResult_() {
+    if err := oprot.WriteFieldBegin("result", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:result: ", p), err) }
+    if err := oprot.WriteBinary(p.Result_); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.result (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:result: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskRequest) writeField30(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskCompletedRequest) writeField30(oprot thrift.TProtocol) (err error) {
   if p.IsSetIdentity() {
     if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
@@ -18441,172 +20976,74 @@ func (p *PollForActivityTaskRequest) writeField30(oprot thrift.TProtocol) (err e
   return err
 }
 
-func (p *PollForActivityTaskRequest) String() string {
+func (p *RespondActivityTaskCompletedRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("PollForActivityTaskRequest(%+v)", *p)
+  return fmt.Sprintf("RespondActivityTaskCompletedRequest(%+v)", *p)
 }
 
 // Attributes:
 //  - TaskToken
-//  - WorkflowExecution
-//  - ActivityId
-//  - ActivityType
-//  - Input
-//  - StartedEventId
-//  - ScheduledTimestamp
-//  - ScheduleToCloseTimeoutSeconds
-//  - StartedTimestamp
-//  - StartToCloseTimeoutSeconds
-//  - HeartbeatTimeoutSeconds
-type PollForActivityTaskResponse struct {
+//  - Reason
+//  - Details
+//  - Identity
+type RespondActivityTaskFailedRequest struct {
   // unused fields # 1 to 9
   TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
   // unused fields # 11 to 19
-  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  Reason *string `thrift:"reason,20" db:"reason" json:"reason,omitempty"`
   // unused fields # 21 to 29
-  ActivityId *string `thrift:"activityId,30" db:"activityId" json:"activityId,omitempty"`
+  Details []byte `thrift:"details,30" db:"details" json:"details,omitempty"`
   // unused fields # 31 to 39
-  ActivityType *ActivityType `thrift:"activityType,40" db:"activityType" json:"activityType,omitempty"`
-  // unused fields # 41 to 49
-  Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
-  // unused fields # 51 to 59
-  StartedEventId *int64 `thrift:"startedEventId,60" db:"startedEventId" json:"startedEventId,omitempty"`
-  // unused fields # 61 to 69
-  ScheduledTimestamp *int64 `thrift:"scheduledTimestamp,70" db:"scheduledTimestamp" json:"scheduledTimestamp,omitempty"`
-  // unused fields # 71 to 79
-  ScheduleToCloseTimeoutSeconds *int32 `thrift:"scheduleToCloseTimeoutSeconds,80" db:"scheduleToCloseTimeoutSeconds" json:"scheduleToCloseTimeoutSeconds,omitempty"`
-  // unused fields # 81 to 89
-  StartedTimestamp *int64 `thrift:"startedTimestamp,90" db:"startedTimestamp" json:"startedTimestamp,omitempty"`
-  // unused fields # 91 to 99
-  StartToCloseTimeoutSeconds *int32 `thrift:"startToCloseTimeoutSeconds,100" db:"startToCloseTimeoutSeconds" json:"startToCloseTimeoutSeconds,omitempty"`
-  // unused fields # 101 to 109
-  HeartbeatTimeoutSeconds *int32 `thrift:"heartbeatTimeoutSeconds,110" db:"heartbeatTimeoutSeconds" json:"heartbeatTimeoutSeconds,omitempty"`
+  Identity *string `thrift:"identity,40" db:"identity" json:"identity,omitempty"`
 }
 
-func NewPollForActivityTaskResponse() *PollForActivityTaskResponse {
-  return &PollForActivityTaskResponse{}
+func NewRespondActivityTaskFailedRequest() *RespondActivityTaskFailedRequest {
+  return &RespondActivityTaskFailedRequest{}
 }
 
-var PollForActivityTaskResponse_TaskToken_DEFAULT []byte
+var RespondActivityTaskFailedRequest_TaskToken_DEFAULT []byte
 
-func (p *PollForActivityTaskResponse) GetTaskToken() []byte {
+func (p *RespondActivityTaskFailedRequest) GetTaskToken() []byte {
   return p.TaskToken
 }
-var PollForActivityTaskResponse_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *PollForActivityTaskResponse) GetWorkflowExecution() *WorkflowExecution {
-  if !p.IsSetWorkflowExecution() {
-    return PollForActivityTaskResponse_WorkflowExecution_DEFAULT
-  }
-return p.WorkflowExecution
-}
-var PollForActivityTaskResponse_ActivityId_DEFAULT string
-func (p *PollForActivityTaskResponse) GetActivityId() string {
-  if !p.IsSetActivityId() {
-    return PollForActivityTaskResponse_ActivityId_DEFAULT
-  }
-return *p.ActivityId
-}
-var PollForActivityTaskResponse_ActivityType_DEFAULT *ActivityType
-func (p *PollForActivityTaskResponse) GetActivityType() *ActivityType {
-  if !p.IsSetActivityType() {
-    return PollForActivityTaskResponse_ActivityType_DEFAULT
+var RespondActivityTaskFailedRequest_Reason_DEFAULT string
+func (p *RespondActivityTaskFailedRequest) GetReason() string {
+  if !p.IsSetReason() {
+    return RespondActivityTaskFailedRequest_Reason_DEFAULT
   }
-return p.ActivityType
+return *p.Reason
 }
-var PollForActivityTaskResponse_Input_DEFAULT []byte
+var RespondActivityTaskFailedRequest_Details_DEFAULT []byte
 
-func (p *PollForActivityTaskResponse) GetInput() []byte {
-  return p.Input
-}
-var PollForActivityTaskResponse_StartedEventId_DEFAULT int64
-func (p *PollForActivityTaskResponse) GetStartedEventId() int64 {
-  if !p.IsSetStartedEventId() {
-    return PollForActivityTaskResponse_StartedEventId_DEFAULT
-  }
-return *p.StartedEventId
-}
-var PollForActivityTaskResponse_ScheduledTimestamp_DEFAULT int64
-func (p *PollForActivityTaskResponse) GetScheduledTimestamp() int64 {
-  if !p.IsSetScheduledTimestamp() {
-    return PollForActivityTaskResponse_ScheduledTimestamp_DEFAULT
-  }
-return *p.ScheduledTimestamp
-}
-var PollForActivityTaskResponse_ScheduleToCloseTimeoutSeconds_DEFAULT int32
-func (p *PollForActivityTaskResponse) GetScheduleToCloseTimeoutSeconds() int32 {
-  if !p.IsSetScheduleToCloseTimeoutSeconds() {
-    return PollForActivityTaskResponse_ScheduleToCloseTimeoutSeconds_DEFAULT
-  }
-return *p.ScheduleToCloseTimeoutSeconds
-}
-var PollForActivityTaskResponse_StartedTimestamp_DEFAULT int64
-func (p *PollForActivityTaskResponse) GetStartedTimestamp() int64 {
-  if !p.IsSetStartedTimestamp() {
-    return PollForActivityTaskResponse_StartedTimestamp_DEFAULT
-  }
-return *p.StartedTimestamp
-}
-var PollForActivityTaskResponse_StartToCloseTimeoutSeconds_DEFAULT int32
-func (p *PollForActivityTaskResponse) GetStartToCloseTimeoutSeconds() int32 {
-  if !p.IsSetStartToCloseTimeoutSeconds() {
-    return PollForActivityTaskResponse_StartToCloseTimeoutSeconds_DEFAULT
-  }
-return *p.StartToCloseTimeoutSeconds
+func (p *RespondActivityTaskFailedRequest) GetDetails() []byte {
+  return p.Details
 }
-var PollForActivityTaskResponse_HeartbeatTimeoutSeconds_DEFAULT int32
-func (p *PollForActivityTaskResponse) GetHeartbeatTimeoutSeconds() int32 {
-  if !p.IsSetHeartbeatTimeoutSeconds() {
-    return PollForActivityTaskResponse_HeartbeatTimeoutSeconds_DEFAULT
+var RespondActivityTaskFailedRequest_Identity_DEFAULT string
+func (p *RespondActivityTaskFailedRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return RespondActivityTaskFailedRequest_Identity_DEFAULT
   }
-return *p.HeartbeatTimeoutSeconds
+return *p.Identity
 }
-func (p *PollForActivityTaskResponse) IsSetTaskToken() bool {
+func (p *RespondActivityTaskFailedRequest) IsSetTaskToken() bool {
   return p.TaskToken != nil
 }
 
-func (p *PollForActivityTaskResponse) IsSetWorkflowExecution() bool {
-  return p.WorkflowExecution != nil
-}
-
-func (p *PollForActivityTaskResponse) IsSetActivityId() bool {
-  return p.ActivityId != nil
-}
-
-func (p *PollForActivityTaskResponse) IsSetActivityType() bool {
-  return p.ActivityType != nil
-}
-
-func (p *PollForActivityTaskResponse) IsSetInput() bool {
-  return p.Input != nil
-}
-
-func (p *PollForActivityTaskResponse) IsSetStartedEventId() bool {
-  return p.StartedEventId != nil
-}
-
-func (p *PollForActivityTaskResponse) IsSetScheduledTimestamp() bool {
-  return p.ScheduledTimestamp != nil
-}
-
-func (p *PollForActivityTaskResponse) IsSetScheduleToCloseTimeoutSeconds() bool {
-  return p.ScheduleToCloseTimeoutSeconds != nil
-}
-
-func (p *PollForActivityTaskResponse) IsSetStartedTimestamp() bool {
-  return p.StartedTimestamp != nil
+func (p *RespondActivityTaskFailedRequest) IsSetReason() bool {
+  return p.Reason != nil
 }
 
-func (p *PollForActivityTaskResponse) IsSetStartToCloseTimeoutSeconds() bool {
-  return p.StartToCloseTimeoutSeconds != nil
+func (p *RespondActivityTaskFailedRequest) IsSetDetails() bool {
+  return p.Details != nil
 }
 
-func (p *PollForActivityTaskResponse) IsSetHeartbeatTimeoutSeconds() bool {
-  return p.HeartbeatTimeoutSeconds != nil
+func (p *RespondActivityTaskFailedRequest) IsSetIdentity() bool {
+  return p.Identity != nil
 }
 
-func (p *PollForActivityTaskResponse) Read(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskFailedRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -18635,34 +21072,6 @@ func (p *PollForActivityTaskResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField40(iprot); err != nil {
         return err
       }
-    case 50:
-      if err := p.ReadField50(iprot); err != nil {
-        return err
-      }
-    case 60:
-      if err := p.ReadField60(iprot); err != nil {
-        return err
-      }
-    case 70:
-      if err := p.ReadField70(iprot); err != nil {
-        return err
-      }
-    case 80:
-      if err := p.ReadField80(iprot); err != nil {
-        return err
-      }
-    case 90:
-      if err := p.ReadField90(iprot); err != nil {
-        return err
-      }
-    case 100:
-      if err := p.ReadField100(iprot); err != nil {
-        return err
-      }
-    case 110:
-      if err := p.ReadField110(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -18678,7 +21087,7 @@ func (p *PollForActivityTaskResponse) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForActivityTaskResponse)  ReadField10(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskFailedRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -18687,109 +21096,41 @@ func (p *PollForActivityTaskResponse)  ReadField10(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *PollForActivityTaskResponse)  ReadField20(iprot thrift.TProtocol) error {
-  p.WorkflowExecution = &WorkflowExecution{}
-  if err := p.WorkflowExecution.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
-  }
-  return nil
-}
-
-func (p *PollForActivityTaskResponse)  ReadField30(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskFailedRequest)  ReadField20(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
+  return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.ActivityId = &v
-}
-  return nil
+  p.Reason = &v
 }
-
-func (p *PollForActivityTaskResponse)  ReadField40(iprot thrift.TProtocol) error {
-  p.ActivityType = &ActivityType{}
-  if err := p.ActivityType.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityType), err)
-  }
   return nil
 }
 
-func (p *PollForActivityTaskResponse)  ReadField50(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskFailedRequest)  ReadField30(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
-} else {
-  p.Input = v
-}
-  return nil
-}
-
-func (p *PollForActivityTaskResponse)  ReadField60(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 60: ", err)
-} else {
-  p.StartedEventId = &v
-}
-  return nil
-}
-
-func (p *PollForActivityTaskResponse)  ReadField70(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 70: ", err)
-} else {
-  p.ScheduledTimestamp = &v
-}
-  return nil
-}
-
-func (p *PollForActivityTaskResponse)  ReadField80(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 80: ", err)
-} else {
-  p.ScheduleToCloseTimeoutSeconds = &v
-}
-  return nil
-}
-
-func (p *PollForActivityTaskResponse)  ReadField90(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 90: ", err)
-} else {
-  p.StartedTimestamp = &v
-}
-  return nil
-}
-
-func (p *PollForActivityTaskResponse)  ReadField100(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 100: ", err)
+  return thrift.PrependError("error reading field 30: ", err)
 } else {
-  p.StartToCloseTimeoutSeconds = &v
+  p.Details = v
 }
   return nil
 }
 
-func (p *PollForActivityTaskResponse)  ReadField110(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 110: ", err)
+func (p *RespondActivityTaskFailedRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
 } else {
-  p.HeartbeatTimeoutSeconds = &v
+  p.Identity = &v
 }
   return nil
 }
 
-func (p *PollForActivityTaskResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTaskResponse"); err != nil {
+func (p *RespondActivityTaskFailedRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailedRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
-    if err := p.writeField50(oprot); err != nil { return err }
-    if err := p.writeField60(oprot); err != nil { return err }
-    if err := p.writeField70(oprot); err != nil { return err }
-    if err := p.writeField80(oprot); err != nil { return err }
-    if err := p.writeField90(oprot); err != nil { return err }
-    if err := p.writeField100(oprot); err != nil { return err }
-    if err := p.writeField110(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -18798,7 +21139,7 @@ func (p *PollForActivityTaskResponse) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForActivityTaskResponse) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskFailedRequest) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetTaskToken() {
     if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
@@ -18810,140 +21151,54 @@ func (p *PollForActivityTaskResponse) writeField10(oprot thrift.TProtocol) (err
   return err
 }
 
-func (p *PollForActivityTaskResponse) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecution() {
-    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
-    if err := p.WorkflowExecution.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetActivityId() {
-    if err := oprot.WriteFieldBegin("activityId", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:activityId: ", p), err) }
-    if err := oprot.WriteString(string(*p.ActivityId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.activityId (30) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:activityId: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetActivityType() {
-    if err := oprot.WriteFieldBegin("activityType", thrift.STRUCT, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:activityType: ", p), err) }
-    if err := p.ActivityType.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityType), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:activityType: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetInput() {
-    if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
-    if err := oprot.WriteBinary(p.Input); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.input (50) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:input: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartedEventId() {
-    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:startedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (60) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:startedEventId: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField70(oprot thrift.TProtocol) (err error) {
-  if p.IsSetScheduledTimestamp() {
-    if err := oprot.WriteFieldBegin("scheduledTimestamp", thrift.I64, 70); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:scheduledTimestamp: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.ScheduledTimestamp)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.scheduledTimestamp (70) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:scheduledTimestamp: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField80(oprot thrift.TProtocol) (err error) {
-  if p.IsSetScheduleToCloseTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("scheduleToCloseTimeoutSeconds", thrift.I32, 80); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:scheduleToCloseTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.ScheduleToCloseTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.scheduleToCloseTimeoutSeconds (80) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:scheduleToCloseTimeoutSeconds: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField90(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartedTimestamp() {
-    if err := oprot.WriteFieldBegin("startedTimestamp", thrift.I64, 90); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:startedTimestamp: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.StartedTimestamp)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startedTimestamp (90) field write error: ", p), err) }
+func (p *RespondActivityTaskFailedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetReason() {
+    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:reason: ", p), err) }
+    if err := oprot.WriteString(string(*p.Reason)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.reason (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:startedTimestamp: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:reason: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskResponse) writeField100(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartToCloseTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("startToCloseTimeoutSeconds", thrift.I32, 100); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:startToCloseTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.StartToCloseTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startToCloseTimeoutSeconds (100) field write error: ", p), err) }
+func (p *RespondActivityTaskFailedRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDetails() {
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:details: ", p), err) }
+    if err := oprot.WriteBinary(p.Details); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.details (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:startToCloseTimeoutSeconds: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:details: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskResponse) writeField110(oprot thrift.TProtocol) (err error) {
-  if p.IsSetHeartbeatTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("heartbeatTimeoutSeconds", thrift.I32, 110); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:heartbeatTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.HeartbeatTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.heartbeatTimeoutSeconds (110) field write error: ", p), err) }
+func (p *RespondActivityTaskFailedRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (40) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:heartbeatTimeoutSeconds: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:identity: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskResponse) String() string {
+func (p *RespondActivityTaskFailedRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("PollForActivityTaskResponse(%+v)", *p)
+  return fmt.Sprintf("RespondActivityTaskFailedRequest(%+v)", *p)
 }
 
 // Attributes:
 //  - TaskToken
 //  - Details
 //  - Identity
-type RecordActivityTaskHeartbeatRequest struct {
+type RespondActivityTaskCanceledRequest struct {
   // unused fields # 1 to 9
   TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
   // unused fields # 11 to 19
@@ -18952,40 +21207,40 @@ type RecordActivityTaskHeartbeatRequest struct {
   Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
 }
 
-func NewRecordActivityTaskHeartbeatRequest() *RecordActivityTaskHeartbeatRequest {
-  return &RecordActivityTaskHeartbeatRequest{}
+func NewRespondActivityTaskCanceledRequest() *RespondActivityTaskCanceledRequest {
+  return &RespondActivityTaskCanceledRequest{}
 }
 
-var RecordActivityTaskHeartbeatRequest_TaskToken_DEFAULT []byte
+var RespondActivityTaskCanceledRequest_TaskToken_DEFAULT []byte
 
-func (p *RecordActivityTaskHeartbeatRequest) GetTaskToken() []byte {
+func (p *RespondActivityTaskCanceledRequest) GetTaskToken() []byte {
   return p.TaskToken
 }
-var RecordActivityTaskHeartbeatRequest_Details_DEFAULT []byte
+var RespondActivityTaskCanceledRequest_Details_DEFAULT []byte
 
-func (p *RecordActivityTaskHeartbeatRequest) GetDetails() []byte {
+func (p *RespondActivityTaskCanceledRequest) GetDetails() []byte {
   return p.Details
 }
-var RecordActivityTaskHeartbeatRequest_Identity_DEFAULT string
-func (p *RecordActivityTaskHeartbeatRequest) GetIdentity() string {
+var RespondActivityTaskCanceledRequest_Identity_DEFAULT string
+func (p *RespondActivityTaskCanceledRequest) GetIdentity() string {
   if !p.IsSetIdentity() {
-    return RecordActivityTaskHeartbeatRequest_Identity_DEFAULT
+    return RespondActivityTaskCanceledRequest_Identity_DEFAULT
   }
 return *p.Identity
 }
-func (p *RecordActivityTaskHeartbeatRequest) IsSetTaskToken() bool {
+func (p *RespondActivityTaskCanceledRequest) IsSetTaskToken() bool {
   return p.TaskToken != nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) IsSetDetails() bool {
+func (p *RespondActivityTaskCanceledRequest) IsSetDetails() bool {
   return p.Details != nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) IsSetIdentity() bool {
+func (p *RespondActivityTaskCanceledRequest) IsSetIdentity() bool {
   return p.Identity != nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) Read(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskCanceledRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -19025,7 +21280,7 @@ func (p *RecordActivityTaskHeartbeatRequest) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest)  ReadField10(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskCanceledRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -19034,7 +21289,7 @@ func (p *RecordActivityTaskHeartbeatRequest)  ReadField10(iprot thrift.TProtocol
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest)  ReadField20(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskCanceledRequest)  ReadField20(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
@@ -19043,7 +21298,7 @@ func (p *RecordActivityTaskHeartbeatRequest)  ReadField20(iprot thrift.TProtocol
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest)  ReadField30(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskCanceledRequest)  ReadField30(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 30: ", err)
 } else {
@@ -19052,8 +21307,8 @@ func (p *RecordActivityTaskHeartbeatRequest)  ReadField30(iprot thrift.TProtocol
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatRequest"); err != nil {
+func (p *RespondActivityTaskCanceledRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceledRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -19067,7 +21322,7 @@ func (p *RecordActivityTaskHeartbeatRequest) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskCanceledRequest) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetTaskToken() {
     if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
@@ -19079,7 +21334,7 @@ func (p *RecordActivityTaskHeartbeatRequest) writeField10(oprot thrift.TProtocol
   return err
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) writeField20(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskCanceledRequest) writeField20(oprot thrift.TProtocol) (err error) {
   if p.IsSetDetails() {
     if err := oprot.WriteFieldBegin("details", thrift.STRING, 20); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:details: ", p), err) }
@@ -19091,7 +21346,7 @@ func (p *RecordActivityTaskHeartbeatRequest) writeField20(oprot thrift.TProtocol
   return err
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) writeField30(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskCanceledRequest) writeField30(oprot thrift.TProtocol) (err error) {
   if p.IsSetIdentity() {
     if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
@@ -19103,155 +21358,104 @@ func (p *RecordActivityTaskHeartbeatRequest) writeField30(oprot thrift.TProtocol
   return err
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) String() string {
+func (p *RespondActivityTaskCanceledRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RecordActivityTaskHeartbeatRequest(%+v)", *p)
+  return fmt.Sprintf("RespondActivityTaskCanceledRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - CancelRequested
-type RecordActivityTaskHeartbeatResponse struct {
+//  - Domain
+//  - WorkflowID
+//  - RunID
+//  - ActivityID
+//  - Result_
+//  - Identity
+type RespondActivityTaskCompletedByIDRequest struct {
   // unused fields # 1 to 9
-  CancelRequested *bool `thrift:"cancelRequested,10" db:"cancelRequested" json:"cancelRequested,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowID *string `thrift:"workflowID,20" db:"workflowID" json:"workflowID,omitempty"`
+  // unused fields # 21 to 29
+  RunID *string `thrift:"runID,30" db:"runID" json:"runID,omitempty"`
+  // unused fields # 31 to 39
+  ActivityID *string `thrift:"activityID,40" db:"activityID" json:"activityID,omitempty"`
+  // unused fields # 41 to 49
+  Result_ []byte `thrift:"result,50" db:"result" json:"result,omitempty"`
+  // unused fields # 51 to 59
+  Identity *string `thrift:"identity,60" db:"identity" json:"identity,omitempty"`
 }
 
-func NewRecordActivityTaskHeartbeatResponse() *RecordActivityTaskHeartbeatResponse {
-  return &RecordActivityTaskHeartbeatResponse{}
+func NewRespondActivityTaskCompletedByIDRequest() *RespondActivityTaskCompletedByIDRequest {
+  return &RespondActivityTaskCompletedByIDRequest{}
 }
 
-var RecordActivityTaskHeartbeatResponse_CancelRequested_DEFAULT bool
-func (p *RecordActivityTaskHeartbeatResponse) GetCancelRequested() bool {
-  if !p.IsSetCancelRequested() {
-    return RecordActivityTaskHeartbeatResponse_CancelRequested_DEFAULT
+var RespondActivityTaskCompletedByIDRequest_Domain_DEFAULT string
+func (p *RespondActivityTaskCompletedByIDRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return RespondActivityTaskCompletedByIDRequest_Domain_DEFAULT
   }
-return *p.CancelRequested
-}
-func (p *RecordActivityTaskHeartbeatResponse) IsSetCancelRequested() bool {
-  return p.CancelRequested != nil
+return *p.Domain
 }
-
-func (p *RecordActivityTaskHeartbeatResponse) Read(iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-  }
-
-
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 10:
-      if err := p.ReadField10(iprot); err != nil {
-        return err
-      }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
-      }
-    }
-    if err := iprot.ReadFieldEnd(); err != nil {
-      return err
-    }
-  }
-  if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+var RespondActivityTaskCompletedByIDRequest_WorkflowID_DEFAULT string
+func (p *RespondActivityTaskCompletedByIDRequest) GetWorkflowID() string {
+  if !p.IsSetWorkflowID() {
+    return RespondActivityTaskCompletedByIDRequest_WorkflowID_DEFAULT
   }
-  return nil
-}
-
-func (p *RecordActivityTaskHeartbeatResponse)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
-} else {
-  p.CancelRequested = &v
-}
-  return nil
+return *p.WorkflowID
 }
-
-func (p *RecordActivityTaskHeartbeatResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatResponse"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField10(oprot); err != nil { return err }
+var RespondActivityTaskCompletedByIDRequest_RunID_DEFAULT string
+func (p *RespondActivityTaskCompletedByIDRequest) GetRunID() string {
+  if !p.IsSetRunID() {
+    return RespondActivityTaskCompletedByIDRequest_RunID_DEFAULT
   }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
+return *p.RunID
 }
-
-func (p *RecordActivityTaskHeartbeatResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetCancelRequested() {
-    if err := oprot.WriteFieldBegin("cancelRequested", thrift.BOOL, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:cancelRequested: ", p), err) }
-    if err := oprot.WriteBool(bool(*p.CancelRequested)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.cancelRequested (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:cancelRequested: ", p), err) }
+var RespondActivityTaskCompletedByIDRequest_ActivityID_DEFAULT string
+func (p *RespondActivityTaskCompletedByIDRequest) GetActivityID() string {
+  if !p.IsSetActivityID() {
+    return RespondActivityTaskCompletedByIDRequest_ActivityID_DEFAULT
   }
-  return err
+return *p.ActivityID
 }
+var RespondActivityTaskCompletedByIDRequest_Result__DEFAULT []byte
 
-func (p *RecordActivityTaskHeartbeatResponse) String() string {
-  if p == nil {
-    return "<nil>"
+func (p *RespondActivityTaskCompletedByIDRequest) GetResult_() []byte {
+  return p.Result_
+}
+var RespondActivityTaskCompletedByIDRequest_Identity_DEFAULT string
+func (p *RespondActivityTaskCompletedByIDRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return RespondActivityTaskCompletedByIDRequest_Identity_DEFAULT
   }
-  return fmt.Sprintf("RecordActivityTaskHeartbeatResponse(%+v)", *p)
+return *p.Identity
 }
-
-// Attributes:
-//  - TaskToken
-//  - Result_
-//  - Identity
-type RespondActivityTaskCompletedRequest struct {
-  // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
-  // unused fields # 11 to 19
-  Result_ []byte `thrift:"result,20" db:"result" json:"result,omitempty"`
-  // unused fields # 21 to 29
-  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+func (p *RespondActivityTaskCompletedByIDRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func NewRespondActivityTaskCompletedRequest() *RespondActivityTaskCompletedRequest {
-  return &RespondActivityTaskCompletedRequest{}
+func (p *RespondActivityTaskCompletedByIDRequest) IsSetWorkflowID() bool {
+  return p.WorkflowID != nil
 }
 
-var RespondActivityTaskCompletedRequest_TaskToken_DEFAULT []byte
-
-func (p *RespondActivityTaskCompletedRequest) GetTaskToken() []byte {
-  return p.TaskToken
+func (p *RespondActivityTaskCompletedByIDRequest) IsSetRunID() bool {
+  return p.RunID != nil
 }
-var RespondActivityTaskCompletedRequest_Result__DEFAULT []byte
 
-func (p *RespondActivityTaskCompletedRequest) GetResult_() []byte {
-  return p.Result_
-}
-var RespondActivityTaskCompletedRequest_Identity_DEFAULT string
-func (p *RespondActivityTaskCompletedRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return RespondActivityTaskCompletedRequest_Identity_DEFAULT
-  }
-return *p.Identity
-}
-func (p *RespondActivityTaskCompletedRequest) IsSetTaskToken() bool {
-  return p.TaskToken != nil
+func (p *RespondActivityTaskCompletedByIDRequest) IsSetActivityID() bool {
+  return p.ActivityID != nil
 }
 
-func (p *RespondActivityTaskCompletedRequest) IsSetResult_() bool {
+func (p *RespondActivityTaskCompletedByIDRequest) IsSetResult_() bool {
   return p.Result_ != nil
 }
 
-func (p *RespondActivityTaskCompletedRequest) IsSetIdentity() bool {
+func (p *RespondActivityTaskCompletedByIDRequest) IsSetIdentity() bool {
   return p.Identity != nil
 }
 
-func (p *RespondActivityTaskCompletedRequest) Read(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskCompletedByIDRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -19276,6 +21480,18 @@ func (p *RespondActivityTaskCompletedRequest) Read(iprot thrift.TProtocol) error
       if err := p.ReadField30(iprot); err != nil {
         return err
       }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -19291,152 +21507,262 @@ func (p *RespondActivityTaskCompletedRequest) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RespondActivityTaskCompletedRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *RespondActivityTaskCompletedByIDRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.TaskToken = v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *RespondActivityTaskCompletedRequest)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *RespondActivityTaskCompletedByIDRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.Result_ = v
+  p.WorkflowID = &v
 }
   return nil
 }
 
-func (p *RespondActivityTaskCompletedRequest)  ReadField30(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskCompletedByIDRequest)  ReadField30(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.RunID = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCompletedByIDRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.ActivityID = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCompletedByIDRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Result_ = v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCompletedByIDRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
 } else {
   p.Identity = &v
 }
-  return nil
+  return nil
+}
+
+func (p *RespondActivityTaskCompletedByIDRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompletedByIDRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RespondActivityTaskCompletedByIDRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskCompletedByIDRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowID() {
+    if err := oprot.WriteFieldBegin("workflowID", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowID: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowID (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowID: ", p), err) }
+  }
+  return err
 }
 
-func (p *RespondActivityTaskCompletedRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompletedRequest"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField10(oprot); err != nil { return err }
-    if err := p.writeField20(oprot); err != nil { return err }
-    if err := p.writeField30(oprot); err != nil { return err }
+func (p *RespondActivityTaskCompletedByIDRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRunID() {
+    if err := oprot.WriteFieldBegin("runID", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:runID: ", p), err) }
+    if err := oprot.WriteString(string(*p.RunID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.runID (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:runID: ", p), err) }
   }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
+  return err
 }
 
-func (p *RespondActivityTaskCompletedRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskToken() {
-    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
-    if err := oprot.WriteBinary(p.TaskToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+func (p *RespondActivityTaskCompletedByIDRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityID() {
+    if err := oprot.WriteFieldBegin("activityID", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:activityID: ", p), err) }
+    if err := oprot.WriteString(string(*p.ActivityID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.activityID (40) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:activityID: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskCompletedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskCompletedByIDRequest) writeField50(oprot thrift.TProtocol) (err error) {
   if p.IsSetResult_() {
-    if err := oprot.WriteFieldBegin("result", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:result: ", p), err) }
+    if err := oprot.WriteFieldBegin("result", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:result: ", p), err) }
     if err := oprot.WriteBinary(p.Result_); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.result (20) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.result (50) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:result: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:result: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskCompletedRequest) writeField30(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskCompletedByIDRequest) writeField60(oprot thrift.TProtocol) (err error) {
   if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:identity: ", p), err) }
     if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.identity (60) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:identity: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskCompletedRequest) String() string {
+func (p *RespondActivityTaskCompletedByIDRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RespondActivityTaskCompletedRequest(%+v)", *p)
+  return fmt.Sprintf("RespondActivityTaskCompletedByIDRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - TaskToken
+//  - Domain
+//  - WorkflowID
+//  - RunID
+//  - ActivityID
 //  - Reason
 //  - Details
 //  - Identity
-type RespondActivityTaskFailedRequest struct {
+type RespondActivityTaskFailedByIDRequest struct {
   // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  Reason *string `thrift:"reason,20" db:"reason" json:"reason,omitempty"`
+  WorkflowID *string `thrift:"workflowID,20" db:"workflowID" json:"workflowID,omitempty"`
   // unused fields # 21 to 29
-  Details []byte `thrift:"details,30" db:"details" json:"details,omitempty"`
+  RunID *string `thrift:"runID,30" db:"runID" json:"runID,omitempty"`
   // unused fields # 31 to 39
-  Identity *string `thrift:"identity,40" db:"identity" json:"identity,omitempty"`
+  ActivityID *string `thrift:"activityID,40" db:"activityID" json:"activityID,omitempty"`
+  // unused fields # 41 to 49
+  Reason *string `thrift:"reason,50" db:"reason" json:"reason,omitempty"`
+  // unused fields # 51 to 59
+  Details []byte `thrift:"details,60" db:"details" json:"details,omitempty"`
+  // unused fields # 61 to 69
+  Identity *string `thrift:"identity,70" db:"identity" json:"identity,omitempty"`
 }
 
-func NewRespondActivityTaskFailedRequest() *RespondActivityTaskFailedRequest {
-  return &RespondActivityTaskFailedRequest{}
+func NewRespondActivityTaskFailedByIDRequest() *RespondActivityTaskFailedByIDRequest {
+  return &RespondActivityTaskFailedByIDRequest{}
 }
 
-var RespondActivityTaskFailedRequest_TaskToken_DEFAULT []byte
-
-func (p *RespondActivityTaskFailedRequest) GetTaskToken() []byte {
-  return p.TaskToken
+var RespondActivityTaskFailedByIDRequest_Domain_DEFAULT string
+func (p *RespondActivityTaskFailedByIDRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return RespondActivityTaskFailedByIDRequest_Domain_DEFAULT
+  }
+return *p.Domain
 }
-var RespondActivityTaskFailedRequest_Reason_DEFAULT string
-func (p *RespondActivityTaskFailedRequest) GetReason() string {
+var RespondActivityTaskFailedByIDRequest_WorkflowID_DEFAULT string
+func (p *RespondActivityTaskFailedByIDRequest) GetWorkflowID() string {
+  if !p.IsSetWorkflowID() {
+    return RespondActivityTaskFailedByIDRequest_WorkflowID_DEFAULT
+  }
+return *p.WorkflowID
+}
+var RespondActivityTaskFailedByIDRequest_RunID_DEFAULT string
+func (p *RespondActivityTaskFailedByIDRequest) GetRunID() string {
+  if !p.IsSetRunID() {
+    return RespondActivityTaskFailedByIDRequest_RunID_DEFAULT
+  }
+return *p.RunID
+}
+var RespondActivityTaskFailedByIDRequest_ActivityID_DEFAULT string
+func (p *RespondActivityTaskFailedByIDRequest) GetActivityID() string {
+  if !p.IsSetActivityID() {
+    return RespondActivityTaskFailedByIDRequest_ActivityID_DEFAULT
+  }
+return *p.ActivityID
+}
+var RespondActivityTaskFailedByIDRequest_Reason_DEFAULT string
+func (p *RespondActivityTaskFailedByIDRequest) GetReason() string {
   if !p.IsSetReason() {
-    return RespondActivityTaskFailedRequest_Reason_DEFAULT
+    return RespondActivityTaskFailedByIDRequest_Reason_DEFAULT
   }
 return *p.Reason
 }
-var RespondActivityTaskFailedRequest_Details_DEFAULT []byte
+var RespondActivityTaskFailedByIDRequest_Details_DEFAULT []byte
 
-func (p *RespondActivityTaskFailedRequest) GetDetails() []byte {
+func (p *RespondActivityTaskFailedByIDRequest) GetDetails() []byte {
   return p.Details
 }
-var RespondActivityTaskFailedRequest_Identity_DEFAULT string
-func (p *RespondActivityTaskFailedRequest) GetIdentity() string {
+var RespondActivityTaskFailedByIDRequest_Identity_DEFAULT string
+func (p *RespondActivityTaskFailedByIDRequest) GetIdentity() string {
   if !p.IsSetIdentity() {
-    return RespondActivityTaskFailedRequest_Identity_DEFAULT
+    return RespondActivityTaskFailedByIDRequest_Identity_DEFAULT
   }
 return *p.Identity
 }
-func (p *RespondActivityTaskFailedRequest) IsSetTaskToken() bool {
-  return p.TaskToken != nil
+func (p *RespondActivityTaskFailedByIDRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *RespondActivityTaskFailedRequest) IsSetReason() bool {
+func (p *RespondActivityTaskFailedByIDRequest) IsSetWorkflowID() bool {
+  return p.WorkflowID != nil
+}
+
+func (p *RespondActivityTaskFailedByIDRequest) IsSetRunID() bool {
+  return p.RunID != nil
+}
+
+func (p *RespondActivityTaskFailedByIDRequest) IsSetActivityID() bool {
+  return p.ActivityID != nil
+}
+
+func (p *RespondActivityTaskFailedByIDRequest) IsSetReason() bool {
   return p.Reason != nil
 }
 
-func (p *RespondActivityTaskFailedRequest) IsSetDetails() bool {
+func (p *RespondActivityTaskFailedByIDRequest) IsSetDetails() bool {
   return p.Details != nil
 }
 
-func (p *RespondActivityTaskFailedRequest) IsSetIdentity() bool {
+func (p *RespondActivityTaskFailedByIDRequest) IsSetIdentity() bool {
   return p.Identity != nil
 }
 
-func (p *RespondActivityTaskFailedRequest) Read(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskFailedByIDRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -19465,6 +21791,18 @@ func (p *RespondActivityTaskFailedRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField40(iprot); err != nil {
         return err
       }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -19480,50 +21818,80 @@ func (p *RespondActivityTaskFailedRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *RespondActivityTaskFailedRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *RespondActivityTaskFailedByIDRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.TaskToken = v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *RespondActivityTaskFailedRequest)  ReadField20(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskFailedByIDRequest)  ReadField20(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.WorkflowID = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskFailedByIDRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.RunID = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskFailedByIDRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.ActivityID = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskFailedByIDRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
 } else {
   p.Reason = &v
 }
   return nil
 }
 
-func (p *RespondActivityTaskFailedRequest)  ReadField30(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskFailedByIDRequest)  ReadField60(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
+  return thrift.PrependError("error reading field 60: ", err)
 } else {
   p.Details = v
 }
   return nil
 }
 
-func (p *RespondActivityTaskFailedRequest)  ReadField40(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskFailedByIDRequest)  ReadField70(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
+  return thrift.PrependError("error reading field 70: ", err)
 } else {
   p.Identity = &v
 }
   return nil
 }
 
-func (p *RespondActivityTaskFailedRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailedRequest"); err != nil {
+func (p *RespondActivityTaskFailedByIDRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailedByIDRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -19532,108 +21900,188 @@ func (p *RespondActivityTaskFailedRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *RespondActivityTaskFailedRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskToken() {
-    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
-    if err := oprot.WriteBinary(p.TaskToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+func (p *RespondActivityTaskFailedByIDRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskFailedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskFailedByIDRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowID() {
+    if err := oprot.WriteFieldBegin("workflowID", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowID: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowID (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowID: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskFailedByIDRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRunID() {
+    if err := oprot.WriteFieldBegin("runID", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:runID: ", p), err) }
+    if err := oprot.WriteString(string(*p.RunID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.runID (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:runID: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskFailedByIDRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityID() {
+    if err := oprot.WriteFieldBegin("activityID", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:activityID: ", p), err) }
+    if err := oprot.WriteString(string(*p.ActivityID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.activityID (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:activityID: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskFailedByIDRequest) writeField50(oprot thrift.TProtocol) (err error) {
   if p.IsSetReason() {
-    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:reason: ", p), err) }
+    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:reason: ", p), err) }
     if err := oprot.WriteString(string(*p.Reason)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.reason (20) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.reason (50) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:reason: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:reason: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskFailedRequest) writeField30(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskFailedByIDRequest) writeField60(oprot thrift.TProtocol) (err error) {
   if p.IsSetDetails() {
-    if err := oprot.WriteFieldBegin("details", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:details: ", p), err) }
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:details: ", p), err) }
     if err := oprot.WriteBinary(p.Details); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.details (30) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.details (60) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:details: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:details: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskFailedRequest) writeField40(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskFailedByIDRequest) writeField70(oprot thrift.TProtocol) (err error) {
   if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:identity: ", p), err) }
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:identity: ", p), err) }
     if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (40) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.identity (70) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:identity: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskFailedRequest) String() string {
+func (p *RespondActivityTaskFailedByIDRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RespondActivityTaskFailedRequest(%+v)", *p)
+  return fmt.Sprintf("RespondActivityTaskFailedByIDRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - TaskToken
+//  - Domain
+//  - WorkflowID
+//  - RunID
+//  - ActivityID
 //  - Details
 //  - Identity
-type RespondActivityTaskCanceledRequest struct {
+type RespondActivityTaskCanceledByIDRequest struct {
   // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  Details []byte `thrift:"details,20" db:"details" json:"details,omitempty"`
+  WorkflowID *string `thrift:"workflowID,20" db:"workflowID" json:"workflowID,omitempty"`
   // unused fields # 21 to 29
-  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+  RunID *string `thrift:"runID,30" db:"runID" json:"runID,omitempty"`
+  // unused fields # 31 to 39
+  ActivityID *string `thrift:"activityID,40" db:"activityID" json:"activityID,omitempty"`
+  // unused fields # 41 to 49
+  Details []byte `thrift:"details,50" db:"details" json:"details,omitempty"`
+  // unused fields # 51 to 59
+  Identity *string `thrift:"identity,60" db:"identity" json:"identity,omitempty"`
 }
 
-func NewRespondActivityTaskCanceledRequest() *RespondActivityTaskCanceledRequest {
-  return &RespondActivityTaskCanceledRequest{}
+func NewRespondActivityTaskCanceledByIDRequest() *RespondActivityTaskCanceledByIDRequest {
+  return &RespondActivityTaskCanceledByIDRequest{}
 }
 
-var RespondActivityTaskCanceledRequest_TaskToken_DEFAULT []byte
-
-func (p *RespondActivityTaskCanceledRequest) GetTaskToken() []byte {
-  return p.TaskToken
+var RespondActivityTaskCanceledByIDRequest_Domain_DEFAULT string
+func (p *RespondActivityTaskCanceledByIDRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return RespondActivityTaskCanceledByIDRequest_Domain_DEFAULT
+  }
+return *p.Domain
 }
-var RespondActivityTaskCanceledRequest_Details_DEFAULT []byte
+var RespondActivityTaskCanceledByIDRequest_WorkflowID_DEFAULT string
+func (p *RespondActivityTaskCanceledByIDRequest) GetWorkflowID() string {
+  if !p.IsSetWorkflowID() {
+    return RespondActivityTaskCanceledByIDRequest_WorkflowID_DEFAULT
+  }
+return *p.WorkflowID
+}
+var RespondActivityTaskCanceledByIDRequest_RunID_DEFAULT string
+func (p *RespondActivityTaskCanceledByIDRequest) GetRunID() string {
+  if !p.IsSetRunID() {
+    return RespondActivityTaskCanceledByIDRequest_RunID_DEFAULT
+  }
+return *p.RunID
+}
+var RespondActivityTaskCanceledByIDRequest_ActivityID_DEFAULT string
+func (p *RespondActivityTaskCanceledByIDRequest) GetActivityID() string {
+  if !p.IsSetActivityID() {
+    return RespondActivityTaskCanceledByIDRequest_ActivityID_DEFAULT
+  }
+return *p.ActivityID
+}
+var RespondActivityTaskCanceledByIDRequest_Details_DEFAULT []byte
 
-func (p *RespondActivityTaskCanceledRequest) GetDetails() []byte {
+func (p *RespondActivityTaskCanceledByIDRequest) GetDetails() []byte {
   return p.Details
 }
-var RespondActivityTaskCanceledRequest_Identity_DEFAULT string
-func (p *RespondActivityTaskCanceledRequest) GetIdentity() string {
+var RespondActivityTaskCanceledByIDRequest_Identity_DEFAULT string
+func (p *RespondActivityTaskCanceledByIDRequest) GetIdentity() string {
   if !p.IsSetIdentity() {
-    return RespondActivityTaskCanceledRequest_Identity_DEFAULT
+    return RespondActivityTaskCanceledByIDRequest_Identity_DEFAULT
   }
 return *p.Identity
 }
-func (p *RespondActivityTaskCanceledRequest) IsSetTaskToken() bool {
-  return p.TaskToken != nil
+func (p *RespondActivityTaskCanceledByIDRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *RespondActivityTaskCanceledRequest) IsSetDetails() bool {
+func (p *RespondActivityTaskCanceledByIDRequest) IsSetWorkflowID() bool {
+  return p.WorkflowID != nil
+}
+
+func (p *RespondActivityTaskCanceledByIDRequest) IsSetRunID() bool {
+  return p.RunID != nil
+}
+
+func (p *RespondActivityTaskCanceledByIDRequest) IsSetActivityID() bool {
+  return p.ActivityID != nil
+}
+
+func (p *RespondActivityTaskCanceledByIDRequest) IsSetDetails() bool {
   return p.Details != nil
 }
 
-func (p *RespondActivityTaskCanceledRequest) IsSetIdentity() bool {
+func (p *RespondActivityTaskCanceledByIDRequest) IsSetIdentity() bool {
   return p.Identity != nil
 }
 
-func (p *RespondActivityTaskCanceledRequest) Read(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskCanceledByIDRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -19658,6 +22106,18 @@ func (p *RespondActivityTaskCanceledRequest) Read(iprot thrift.TProtocol) error
       if err := p.ReadField30(iprot); err != nil {
         return err
       }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -19673,40 +22133,70 @@ func (p *RespondActivityTaskCanceledRequest) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RespondActivityTaskCanceledRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
+func (p *RespondActivityTaskCanceledByIDRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCanceledByIDRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.WorkflowID = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCanceledByIDRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.RunID = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCanceledByIDRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
 } else {
-  p.TaskToken = v
+  p.ActivityID = &v
 }
   return nil
 }
 
-func (p *RespondActivityTaskCanceledRequest)  ReadField20(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskCanceledByIDRequest)  ReadField50(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 20: ", err)
+  return thrift.PrependError("error reading field 50: ", err)
 } else {
   p.Details = v
 }
   return nil
 }
 
-func (p *RespondActivityTaskCanceledRequest)  ReadField30(iprot thrift.TProtocol) error {
+func (p *RespondActivityTaskCanceledByIDRequest)  ReadField60(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
+  return thrift.PrependError("error reading field 60: ", err)
 } else {
   p.Identity = &v
 }
   return nil
 }
 
-func (p *RespondActivityTaskCanceledRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceledRequest"); err != nil {
+func (p *RespondActivityTaskCanceledByIDRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceledByIDRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -19715,47 +22205,83 @@ func (p *RespondActivityTaskCanceledRequest) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RespondActivityTaskCanceledRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskToken() {
-    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
-    if err := oprot.WriteBinary(p.TaskToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+func (p *RespondActivityTaskCanceledByIDRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskCanceledRequest) writeField20(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskCanceledByIDRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowID() {
+    if err := oprot.WriteFieldBegin("workflowID", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowID: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowID (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowID: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskCanceledByIDRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRunID() {
+    if err := oprot.WriteFieldBegin("runID", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:runID: ", p), err) }
+    if err := oprot.WriteString(string(*p.RunID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.runID (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:runID: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskCanceledByIDRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityID() {
+    if err := oprot.WriteFieldBegin("activityID", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:activityID: ", p), err) }
+    if err := oprot.WriteString(string(*p.ActivityID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.activityID (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:activityID: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskCanceledByIDRequest) writeField50(oprot thrift.TProtocol) (err error) {
   if p.IsSetDetails() {
-    if err := oprot.WriteFieldBegin("details", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:details: ", p), err) }
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:details: ", p), err) }
     if err := oprot.WriteBinary(p.Details); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.details (20) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.details (50) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:details: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:details: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskCanceledRequest) writeField30(oprot thrift.TProtocol) (err error) {
+func (p *RespondActivityTaskCanceledByIDRequest) writeField60(oprot thrift.TProtocol) (err error) {
   if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:identity: ", p), err) }
     if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.identity (60) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:identity: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskCanceledRequest) String() string {
+func (p *RespondActivityTaskCanceledByIDRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RespondActivityTaskCanceledRequest(%+v)", *p)
+  return fmt.Sprintf("RespondActivityTaskCanceledByIDRequest(%+v)", *p)
 }
 
 // Attributes:
@@ -20164,63 +22690,238 @@ func (p *GetWorkflowExecutionHistoryRequest) writeField30(oprot thrift.TProtocol
     if err := oprot.WriteFieldEnd(); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field end error 30:maximumPageSize: ", p), err) }
   }
-  return err
+  return err
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:nextPageToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("GetWorkflowExecutionHistoryRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - History
+//  - NextPageToken
+type GetWorkflowExecutionHistoryResponse struct {
+  // unused fields # 1 to 9
+  History *History `thrift:"history,10" db:"history" json:"history,omitempty"`
+  // unused fields # 11 to 19
+  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
+}
+
+func NewGetWorkflowExecutionHistoryResponse() *GetWorkflowExecutionHistoryResponse {
+  return &GetWorkflowExecutionHistoryResponse{}
+}
+
+var GetWorkflowExecutionHistoryResponse_History_DEFAULT *History
+func (p *GetWorkflowExecutionHistoryResponse) GetHistory() *History {
+  if !p.IsSetHistory() {
+    return GetWorkflowExecutionHistoryResponse_History_DEFAULT
+  }
+return p.History
+}
+var GetWorkflowExecutionHistoryResponse_NextPageToken_DEFAULT []byte
+
+func (p *GetWorkflowExecutionHistoryResponse) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+func (p *GetWorkflowExecutionHistoryResponse) IsSetHistory() bool {
+  return p.History != nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse)  ReadField10(iprot thrift.TProtocol) error {
+  p.History = &History{}
+  if err := p.History.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.History), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.NextPageToken = v
+}
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistoryResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetHistory() {
+    if err := oprot.WriteFieldBegin("history", thrift.STRUCT, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:history: ", p), err) }
+    if err := p.History.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.History), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:history: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("GetWorkflowExecutionHistoryResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - WorkflowExecution
+//  - SignalName
+//  - Input
+//  - Identity
+type SignalWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  // unused fields # 21 to 29
+  SignalName *string `thrift:"signalName,30" db:"signalName" json:"signalName,omitempty"`
+  // unused fields # 31 to 39
+  Input []byte `thrift:"input,40" db:"input" json:"input,omitempty"`
+  // unused fields # 41 to 49
+  Identity *string `thrift:"identity,50" db:"identity" json:"identity,omitempty"`
+}
+
+func NewSignalWorkflowExecutionRequest() *SignalWorkflowExecutionRequest {
+  return &SignalWorkflowExecutionRequest{}
+}
+
+var SignalWorkflowExecutionRequest_Domain_DEFAULT string
+func (p *SignalWorkflowExecutionRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return SignalWorkflowExecutionRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var SignalWorkflowExecutionRequest_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *SignalWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return SignalWorkflowExecutionRequest_WorkflowExecution_DEFAULT
+  }
+return p.WorkflowExecution
 }
-
-func (p *GetWorkflowExecutionHistoryRequest) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetNextPageToken() {
-    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:nextPageToken: ", p), err) }
-    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (40) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:nextPageToken: ", p), err) }
+var SignalWorkflowExecutionRequest_SignalName_DEFAULT string
+func (p *SignalWorkflowExecutionRequest) GetSignalName() string {
+  if !p.IsSetSignalName() {
+    return SignalWorkflowExecutionRequest_SignalName_DEFAULT
   }
-  return err
+return *p.SignalName
 }
+var SignalWorkflowExecutionRequest_Input_DEFAULT []byte
 
-func (p *GetWorkflowExecutionHistoryRequest) String() string {
-  if p == nil {
-    return "<nil>"
+func (p *SignalWorkflowExecutionRequest) GetInput() []byte {
+  return p.Input
+}
+var SignalWorkflowExecutionRequest_Identity_DEFAULT string
+func (p *SignalWorkflowExecutionRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return SignalWorkflowExecutionRequest_Identity_DEFAULT
   }
-  return fmt.Sprintf("GetWorkflowExecutionHistoryRequest(%+v)", *p)
+return *p.Identity
 }
-
-// Attributes:
-//  - History
-//  - NextPageToken
-type GetWorkflowExecutionHistoryResponse struct {
-  // unused fields # 1 to 9
-  History *History `thrift:"history,10" db:"history" json:"history,omitempty"`
-  // unused fields # 11 to 19
-  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
+func (p *SignalWorkflowExecutionRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func NewGetWorkflowExecutionHistoryResponse() *GetWorkflowExecutionHistoryResponse {
-  return &GetWorkflowExecutionHistoryResponse{}
+func (p *SignalWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
 }
 
-var GetWorkflowExecutionHistoryResponse_History_DEFAULT *History
-func (p *GetWorkflowExecutionHistoryResponse) GetHistory() *History {
-  if !p.IsSetHistory() {
-    return GetWorkflowExecutionHistoryResponse_History_DEFAULT
-  }
-return p.History
+func (p *SignalWorkflowExecutionRequest) IsSetSignalName() bool {
+  return p.SignalName != nil
 }
-var GetWorkflowExecutionHistoryResponse_NextPageToken_DEFAULT []byte
 
-func (p *GetWorkflowExecutionHistoryResponse) GetNextPageToken() []byte {
-  return p.NextPageToken
-}
-func (p *GetWorkflowExecutionHistoryResponse) IsSetHistory() bool {
-  return p.History != nil
+func (p *SignalWorkflowExecutionRequest) IsSetInput() bool {
+  return p.Input != nil
 }
 
-func (p *GetWorkflowExecutionHistoryResponse) IsSetNextPageToken() bool {
-  return p.NextPageToken != nil
+func (p *SignalWorkflowExecutionRequest) IsSetIdentity() bool {
+  return p.Identity != nil
 }
 
-func (p *GetWorkflowExecutionHistoryResponse) Read(iprot thrift.TProtocol) error {
+func (p *SignalWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -20241,6 +22942,18 @@ func (p *GetWorkflowExecutionHistoryResponse) Read(iprot thrift.TProtocol) error
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -20256,29 +22969,59 @@ func (p *GetWorkflowExecutionHistoryResponse) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryResponse)  ReadField10(iprot thrift.TProtocol) error {
-  p.History = &History{}
-  if err := p.History.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.History), err)
+func (p *SignalWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *SignalWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
   }
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryResponse)  ReadField20(iprot thrift.TProtocol) error {
+func (p *SignalWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.SignalName = &v
+}
+  return nil
+}
+
+func (p *SignalWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 20: ", err)
+  return thrift.PrependError("error reading field 40: ", err)
 } else {
-  p.NextPageToken = v
+  p.Input = v
 }
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistoryResponse"); err != nil {
+func (p *SignalWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *SignalWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecutionRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -20287,115 +23030,233 @@ func (p *GetWorkflowExecutionHistoryResponse) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetHistory() {
-    if err := oprot.WriteFieldBegin("history", thrift.STRUCT, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:history: ", p), err) }
-    if err := p.History.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.History), err)
+func (p *SignalWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:history: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
   }
   return err
 }
 
-func (p *GetWorkflowExecutionHistoryResponse) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetNextPageToken() {
-    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
-    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
+func (p *SignalWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalName() {
+    if err := oprot.WriteFieldBegin("signalName", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:signalName: ", p), err) }
+    if err := oprot.WriteString(string(*p.SignalName)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.signalName (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:signalName: ", p), err) }
   }
   return err
 }
 
-func (p *GetWorkflowExecutionHistoryResponse) String() string {
+func (p *SignalWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInput() {
+    if err := oprot.WriteFieldBegin("input", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:input: ", p), err) }
+    if err := oprot.WriteBinary(p.Input); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.input (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:input: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWorkflowExecutionRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("GetWorkflowExecutionHistoryResponse(%+v)", *p)
+  return fmt.Sprintf("SignalWorkflowExecutionRequest(%+v)", *p)
 }
 
 // Attributes:
 //  - Domain
-//  - WorkflowExecution
-//  - SignalName
+//  - WorkflowId
+//  - WorkflowType
+//  - TaskList
 //  - Input
+//  - ExecutionStartToCloseTimeoutSeconds
+//  - TaskStartToCloseTimeoutSeconds
 //  - Identity
-type SignalWorkflowExecutionRequest struct {
+//  - RequestId
+//  - SignalName
+//  - SignalInput
+type SignalWithStartWorkflowExecutionRequest struct {
   // unused fields # 1 to 9
   Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  WorkflowId *string `thrift:"workflowId,20" db:"workflowId" json:"workflowId,omitempty"`
   // unused fields # 21 to 29
-  SignalName *string `thrift:"signalName,30" db:"signalName" json:"signalName,omitempty"`
+  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
   // unused fields # 31 to 39
-  Input []byte `thrift:"input,40" db:"input" json:"input,omitempty"`
+  TaskList *TaskList `thrift:"taskList,40" db:"taskList" json:"taskList,omitempty"`
   // unused fields # 41 to 49
-  Identity *string `thrift:"identity,50" db:"identity" json:"identity,omitempty"`
+  Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
+  // unused fields # 51 to 59
+  ExecutionStartToCloseTimeoutSeconds *int32 `thrift:"executionStartToCloseTimeoutSeconds,60" db:"executionStartToCloseTimeoutSeconds" json:"executionStartToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 61 to 69
+  TaskStartToCloseTimeoutSeconds *int32 `thrift:"taskStartToCloseTimeoutSeconds,70" db:"taskStartToCloseTimeoutSeconds" json:"taskStartToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 71 to 79
+  Identity *string `thrift:"identity,80" db:"identity" json:"identity,omitempty"`
+  // unused fields # 81 to 89
+  RequestId *string `thrift:"requestId,90" db:"requestId" json:"requestId,omitempty"`
+  // unused fields # 91 to 99
+  SignalName *string `thrift:"signalName,100" db:"signalName" json:"signalName,omitempty"`
+  // unused fields # 101 to 109
+  SignalInput []byte `thrift:"signalInput,110" db:"signalInput" json:"signalInput,omitempty"`
 }
 
-func NewSignalWorkflowExecutionRequest() *SignalWorkflowExecutionRequest {
-  return &SignalWorkflowExecutionRequest{}
+func NewSignalWithStartWorkflowExecutionRequest() *SignalWithStartWorkflowExecutionRequest {
+  return &SignalWithStartWorkflowExecutionRequest{}
 }
 
-var SignalWorkflowExecutionRequest_Domain_DEFAULT string
-func (p *SignalWorkflowExecutionRequest) GetDomain() string {
+var SignalWithStartWorkflowExecutionRequest_Domain_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetDomain() string {
   if !p.IsSetDomain() {
-    return SignalWorkflowExecutionRequest_Domain_DEFAULT
+    return SignalWithStartWorkflowExecutionRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var SignalWithStartWorkflowExecutionRequest_WorkflowId_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetWorkflowId() string {
+  if !p.IsSetWorkflowId() {
+    return SignalWithStartWorkflowExecutionRequest_WorkflowId_DEFAULT
+  }
+return *p.WorkflowId
+}
+var SignalWithStartWorkflowExecutionRequest_WorkflowType_DEFAULT *WorkflowType
+func (p *SignalWithStartWorkflowExecutionRequest) GetWorkflowType() *WorkflowType {
+  if !p.IsSetWorkflowType() {
+    return SignalWithStartWorkflowExecutionRequest_WorkflowType_DEFAULT
+  }
+return p.WorkflowType
+}
+var SignalWithStartWorkflowExecutionRequest_TaskList_DEFAULT *TaskList
+func (p *SignalWithStartWorkflowExecutionRequest) GetTaskList() *TaskList {
+  if !p.IsSetTaskList() {
+    return SignalWithStartWorkflowExecutionRequest_TaskList_DEFAULT
+  }
+return p.TaskList
+}
+var SignalWithStartWorkflowExecutionRequest_Input_DEFAULT []byte
+
+func (p *SignalWithStartWorkflowExecutionRequest) GetInput() []byte {
+  return p.Input
+}
+var SignalWithStartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT int32
+func (p *SignalWithStartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetExecutionStartToCloseTimeoutSeconds() {
+    return SignalWithStartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.ExecutionStartToCloseTimeoutSeconds
+}
+var SignalWithStartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT int32
+func (p *SignalWithStartWorkflowExecutionRequest) GetTaskStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetTaskStartToCloseTimeoutSeconds() {
+    return SignalWithStartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.TaskStartToCloseTimeoutSeconds
+}
+var SignalWithStartWorkflowExecutionRequest_Identity_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return SignalWithStartWorkflowExecutionRequest_Identity_DEFAULT
   }
-return *p.Domain
+return *p.Identity
 }
-var SignalWorkflowExecutionRequest_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *SignalWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
-  if !p.IsSetWorkflowExecution() {
-    return SignalWorkflowExecutionRequest_WorkflowExecution_DEFAULT
+var SignalWithStartWorkflowExecutionRequest_RequestId_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetRequestId() string {
+  if !p.IsSetRequestId() {
+    return SignalWithStartWorkflowExecutionRequest_RequestId_DEFAULT
   }
-return p.WorkflowExecution
+return *p.RequestId
 }
-var SignalWorkflowExecutionRequest_SignalName_DEFAULT string
-func (p *SignalWorkflowExecutionRequest) GetSignalName() string {
+var SignalWithStartWorkflowExecutionRequest_SignalName_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetSignalName() string {
   if !p.IsSetSignalName() {
-    return SignalWorkflowExecutionRequest_SignalName_DEFAULT
+    return SignalWithStartWorkflowExecutionRequest_SignalName_DEFAULT
   }
 return *p.SignalName
 }
-var SignalWorkflowExecutionRequest_Input_DEFAULT []byte
+var SignalWithStartWorkflowExecutionRequest_SignalInput_DEFAULT []byte
 
-func (p *SignalWorkflowExecutionRequest) GetInput() []byte {
-  return p.Input
-}
-var SignalWorkflowExecutionRequest_Identity_DEFAULT string
-func (p *SignalWorkflowExecutionRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return SignalWorkflowExecutionRequest_Identity_DEFAULT
-  }
-return *p.Identity
+func (p *SignalWithStartWorkflowExecutionRequest) GetSignalInput() []byte {
+  return p.SignalInput
 }
-func (p *SignalWorkflowExecutionRequest) IsSetDomain() bool {
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetDomain() bool {
   return p.Domain != nil
 }
 
-func (p *SignalWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
-  return p.WorkflowExecution != nil
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetWorkflowId() bool {
+  return p.WorkflowId != nil
 }
 
-func (p *SignalWorkflowExecutionRequest) IsSetSignalName() bool {
-  return p.SignalName != nil
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
 }
 
-func (p *SignalWorkflowExecutionRequest) IsSetInput() bool {
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetInput() bool {
   return p.Input != nil
 }
 
-func (p *SignalWorkflowExecutionRequest) IsSetIdentity() bool {
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetExecutionStartToCloseTimeoutSeconds() bool {
+  return p.ExecutionStartToCloseTimeoutSeconds != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetTaskStartToCloseTimeoutSeconds() bool {
+  return p.TaskStartToCloseTimeoutSeconds != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetIdentity() bool {
   return p.Identity != nil
 }
 
-func (p *SignalWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetRequestId() bool {
+  return p.RequestId != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetSignalName() bool {
+  return p.SignalName != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetSignalInput() bool {
+  return p.SignalInput != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -20428,6 +23289,30 @@ func (p *SignalWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField50(iprot); err != nil {
         return err
       }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
+    case 100:
+      if err := p.ReadField100(iprot); err != nil {
+        return err
+      }
+    case 110:
+      if err := p.ReadField110(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -20443,7 +23328,7 @@ func (p *SignalWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -20452,43 +23337,96 @@ func (p *SignalWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
-  p.WorkflowExecution = &WorkflowExecution{}
-  if err := p.WorkflowExecution.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
-  }
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.WorkflowId = &v
+}
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
-} else {
-  p.SignalName = &v
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowType = &WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+  }
+  return nil
 }
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.TaskList = &TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
+  }
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
+  return thrift.PrependError("error reading field 50: ", err)
 } else {
   p.Input = v
 }
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.ExecutionStartToCloseTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.TaskStartToCloseTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField80(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
+  return thrift.PrependError("error reading field 80: ", err)
 } else {
   p.Identity = &v
 }
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecutionRequest"); err != nil {
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField90(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 90: ", err)
+} else {
+  p.RequestId = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField100(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 100: ", err)
+} else {
+  p.SignalName = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField110(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 110: ", err)
+} else {
+  p.SignalInput = v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecutionRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -20496,6 +23434,12 @@ func (p *SignalWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
+    if err := p.writeField100(oprot); err != nil { return err }
+    if err := p.writeField110(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -20504,7 +23448,7 @@ func (p *SignalWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *SignalWithStartWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetDomain() {
     if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
@@ -20516,60 +23460,133 @@ func (p *SignalWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (e
   return err
 }
 
-func (p *SignalWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecution() {
-    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
-    if err := p.WorkflowExecution.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
+func (p *SignalWithStartWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowId() {
+    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowId: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowId (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowId: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
   }
   return err
 }
 
-func (p *SignalWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSignalName() {
-    if err := oprot.WriteFieldBegin("signalName", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:signalName: ", p), err) }
-    if err := oprot.WriteString(string(*p.SignalName)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.signalName (30) field write error: ", p), err) }
+func (p *SignalWithStartWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:signalName: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskList: ", p), err) }
   }
   return err
 }
 
-func (p *SignalWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
+func (p *SignalWithStartWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
   if p.IsSetInput() {
-    if err := oprot.WriteFieldBegin("input", thrift.STRING, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:input: ", p), err) }
+    if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
     if err := oprot.WriteBinary(p.Input); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.input (40) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.input (50) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:input: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:input: ", p), err) }
   }
   return err
 }
 
-func (p *SignalWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
+func (p *SignalWithStartWorkflowExecutionRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutionStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("executionStartToCloseTimeoutSeconds", thrift.I32, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ExecutionStartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.executionStartToCloseTimeoutSeconds (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("taskStartToCloseTimeoutSeconds", thrift.I32, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.TaskStartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskStartToCloseTimeoutSeconds (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField80(oprot thrift.TProtocol) (err error) {
   if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:identity: ", p), err) }
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:identity: ", p), err) }
     if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (50) field write error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T.identity (80) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:identity: ", p), err) }
   }
   return err
 }
 
-func (p *SignalWorkflowExecutionRequest) String() string {
+func (p *SignalWithStartWorkflowExecutionRequest) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestId() {
+    if err := oprot.WriteFieldBegin("requestId", thrift.STRING, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:requestId: ", p), err) }
+    if err := oprot.WriteString(string(*p.RequestId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.requestId (90) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:requestId: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField100(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalName() {
+    if err := oprot.WriteFieldBegin("signalName", thrift.STRING, 100); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:signalName: ", p), err) }
+    if err := oprot.WriteString(string(*p.SignalName)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.signalName (100) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:signalName: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField110(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalInput() {
+    if err := oprot.WriteFieldBegin("signalInput", thrift.STRING, 110); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:signalInput: ", p), err) }
+    if err := oprot.WriteBinary(p.SignalInput); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.signalInput (110) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:signalInput: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("SignalWorkflowExecutionRequest(%+v)", *p)
+  return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest(%+v)", *p)
 }
 
 // Attributes:
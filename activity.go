@@ -42,12 +42,19 @@ type (
 		WorkflowExecution WorkflowExecution
 		ActivityID        string
 		ActivityType      ActivityType
+		Attempt           int32 // Attempt starts from 1, and is increased on every retry by the RetryPolicy.
 	}
 
 	// RegisterActivityOptions consists of options for registering an activity
 	RegisterActivityOptions struct {
+		// Name - The activity type name, or the prefix of the activity type names of the methods when a struct is
+		// registered.
 		Name string
 	}
+
+	// DynamicActivityFunc is the signature of the activity registered through RegisterDynamicActivity. It is called
+	// with the type of the activity task and its encoded arguments, and returns the result of the activity.
+	DynamicActivityFunc func(ctx context.Context, activityType string, args EncodedValues) (interface{}, error)
 )
 
 // RegisterActivity - register a activity function with the framework.
@@ -60,6 +67,13 @@ type (
 //	func sampleActivity(arg1 bool) (result int, err error)
 //	func sampleActivity(arg1 bool) (err error)
 // Serialization of all primitive types, structures is supported ... except channels, functions, variadic, unsafe pointer.
+// A struct, or a pointer to a struct, can be registered too. Each of its exported methods with a valid activity
+// signature is registered as "<StructName>.<MethodName>", and the other methods are skipped. This lets activities share
+// dependencies like DB handles through the struct fields. The method values can be passed to ExecuteActivity:
+//	activities := &MyActivities{db: db}
+//	cadence.RegisterActivity(activities)
+//	...
+//	cadence.ExecuteActivity(ctx, activities.Query, "select").Get(ctx, &result)
 // This method calls panic if activityFunc doesn't comply with the expected format.
 func RegisterActivity(activityFunc interface{}) {
 	RegisterActivityWithOptions(activityFunc, RegisterActivityOptions{})
@@ -70,6 +84,8 @@ func RegisterActivity(activityFunc interface{}) {
 // external name is required. This can be used as
 // client.RegisterActivity(barActivity, RegisterActivityOptions{})
 // client.RegisterActivity(barActivity, RegisterActivityOptions{Name: "barExternal"})
+// client.RegisterActivity(&MyActivities{}, RegisterActivityOptions{Name: "prefix"}) registers "prefix.<MethodName>"
+// A struct type can only be registered under one prefix, as its method values resolve to the registered names.
 // A activity takes a context and input and returns a (result, error) or just error.
 // Examples:
 //	func sampleActivity(ctx context.Context, input []byte) (result []byte, err error)
@@ -88,6 +104,24 @@ func RegisterActivityWithOptions(activityFunc interface{}, opts RegisterActivity
 	}
 }
 
+// RegisterDynamicActivity registers the activity that executes the activity tasks of the types that are not registered.
+// It lets a worker serve activity types that are not known in advance, e.g. to proxy them to other systems by name:
+//	cadence.RegisterDynamicActivity(func(ctx context.Context, activityType string, args cadence.EncodedValues) (interface{}, error) {
+//		var input string
+//		if err := args.Get(&input); err != nil {
+//			return nil, err
+//		}
+//		return gateway.Call(ctx, activityType, input)
+//	})
+// This method calls panic if a dynamic activity is already registered.
+func RegisterDynamicActivity(activityFunc DynamicActivityFunc) {
+	thImpl := getHostEnvironment()
+	err := thImpl.RegisterDynamicActivity(activityFunc)
+	if err != nil {
+		panic(err)
+	}
+}
+
 // GetActivityInfo returns information about currently executing activity.
 func GetActivityInfo(ctx context.Context) ActivityInfo {
 	env := getActivityEnv(ctx)
@@ -96,6 +130,7 @@ func GetActivityInfo(ctx context.Context) ActivityInfo {
 		ActivityType:      env.activityType,
 		TaskToken:         env.taskToken,
 		WorkflowExecution: env.workflowExecution,
+		Attempt:           env.attempt,
 	}
 }
 
@@ -120,16 +155,24 @@ func GetActivityMetricsScope(ctx context.Context) tally.Scope {
 // details - the details that you provided here can be seen in the worflow when it receives TimeoutError, you
 //	can check error TimeOutType()/Details().
 func RecordActivityHeartbeat(ctx context.Context, details ...interface{}) {
+	getActivityInterceptor(ctx).RecordActivityHeartbeat(ctx, details...)
+}
+
+func sendActivityHeartbeat(ctx context.Context, details ...interface{}) {
 	var data []byte
 	var err error
 	// We would like to be a able to pass in "nil" as part of details(that is no progress to report to)
 	if len(details) != 1 || details[0] != nil {
-		data, err = getHostEnvironment().encodeArgs(details)
+		data, err = encodeArgs(getDataConverterFromActivityCtx(ctx), details)
 		if err != nil {
 			panic(err)
 		}
 	}
 	env := getActivityEnv(ctx)
+	if env.serviceInvoker == nil {
+		// Local activities don't heartbeat.
+		return
+	}
 	err = env.serviceInvoker.Heartbeat(data)
 	if err != nil {
 		log := GetActivityLogger(ctx)
@@ -153,6 +196,17 @@ func WithActivityTask(
 	invoker ServiceInvoker,
 	logger *zap.Logger,
 	scope tally.Scope,
+) context.Context {
+	return newActivityContext(ctx, task, invoker, logger, scope, getDefaultDataConverter())
+}
+
+func newActivityContext(
+	ctx context.Context,
+	task *shared.PollForActivityTaskResponse,
+	invoker ServiceInvoker,
+	logger *zap.Logger,
+	scope tally.Scope,
+	dataConverter DataConverter,
 ) context.Context {
 	// TODO: Add activity start to close timeout to activity task and use it as the deadline
 	return context.WithValue(ctx, activityEnvContextKey, &activityEnvironment{
@@ -160,11 +214,13 @@ func WithActivityTask(
 		serviceInvoker: invoker,
 		activityType:   ActivityType{Name: *task.ActivityType.Name},
 		activityID:     *task.ActivityId,
+		attempt:        getActivityAttempt(*task.ActivityId),
 		workflowExecution: WorkflowExecution{
 			RunID: *task.WorkflowExecution.RunId,
 			ID:    *task.WorkflowExecution.WorkflowId},
-		logger:       logger,
-		metricsScope: scope,
+		logger:        logger,
+		metricsScope:  scope,
+		dataConverter: dataConverter,
 	})
 }
 
@@ -200,8 +256,59 @@ type ActivityOptions struct {
 
 	// ActivityID - Business level activity ID, this is not needed for most of the cases if you have
 	// to specify this then talk to cadence team. This is something will be done in future.
+	// It cannot contain "#attempt-", which is reserved for the ActivityIDs of retries.
 	// Optional: default empty string
 	ActivityID string
+
+	// RetryPolicy - Specifies how to retry the activity if it fails. The retries are scheduled by the workflow with
+	// durable timers. Every retry is a new activity, with an ActivityID derived from the one of the first attempt:
+	// ActivityID + "#attempt-" + attempt. CompleteActivityByID and RecordActivityHeartbeatByID must be given the
+	// ActivityID of the current attempt, as returned by GetActivityInfo.
+	// Optional: Default nil, means the activity is not retried.
+	RetryPolicy *RetryPolicy
+}
+
+// RetryPolicy defines how an activity is retried when it fails. The backoff interval before each retry is computed
+// as InitialInterval * BackoffCoefficient^(attempt - 1), capped by MaximumInterval. Retries stop once
+// MaximumAttempts or ExpirationInterval is reached, or when the error is one of NonRetriableErrorReasons.
+type RetryPolicy struct {
+	// InitialInterval - Backoff interval before the first retry.
+	// Mandatory: No default.
+	InitialInterval time.Duration
+
+	// BackoffCoefficient - Coefficient used to compute the next backoff interval from the previous one.
+	// Optional: The default value is 2.0, it can't be less than 1.
+	BackoffCoefficient float64
+
+	// MaximumInterval - Maximum backoff interval between retries.
+	// Optional: Default zero, means the interval is not capped.
+	MaximumInterval time.Duration
+
+	// ExpirationInterval - Maximum time to keep retrying, measured from the first attempt.
+	// Optional: Default zero, means only the activity timeouts limit the retries.
+	ExpirationInterval time.Duration
+
+	// MaximumAttempts - Maximum number of attempts, including the first one.
+	// Optional: Default zero, means the number of attempts is not limited.
+	MaximumAttempts int32
+
+	// NonRetriableErrorReasons - Reasons of the CustomErrors that are not retried.
+	// Optional: Default empty, means all errors are retried.
+	NonRetriableErrorReasons []string
+}
+
+// LocalActivityOptions stores all local activity-specific parameters that will
+// be stored inside of a context.
+type LocalActivityOptions struct {
+	// ScheduleToCloseTimeout - The end to end time out for the local activity, including retries.
+	// It should be shorter than the decision task timeout of the workflow, as the local activity
+	// is executed while the decision task is being processed.
+	// Mandatory: No default.
+	ScheduleToCloseTimeout time.Duration
+
+	// RetryPolicy - Specifies how to retry the local activity if it fails.
+	// Optional: Default nil, means the local activity is not retried.
+	RetryPolicy *RetryPolicy
 }
 
 // WithActivityOptions adds all options to the context.
@@ -216,9 +323,15 @@ func WithActivityOptions(ctx Context, options ActivityOptions) Context {
 	eap.HeartbeatTimeoutSeconds = int32(options.HeartbeatTimeout.Seconds())
 	eap.WaitForCancellation = options.WaitForCancellation
 	eap.ActivityID = common.StringPtr(options.ActivityID)
+	eap.RetryPolicy = options.RetryPolicy
 	return ctx1
 }
 
+// WithLocalActivityOptions adds local activity options to the context.
+func WithLocalActivityOptions(ctx Context, options LocalActivityOptions) Context {
+	return WithValue(ctx, localActivityOptionsContextKey, &options)
+}
+
 // WithTaskList adds a task list to the context.
 func WithTaskList(ctx Context, name string) Context {
 	ctx1 := setActivityParametersIfNotExist(ctx)
@@ -260,3 +373,10 @@ func WithWaitForCancellation(ctx Context, wait bool) Context {
 	getActivityOptions(ctx1).WaitForCancellation = wait
 	return ctx1
 }
+
+// WithRetryPolicy adds the retry policy of the activity to the context.
+func WithRetryPolicy(ctx Context, retryPolicy RetryPolicy) Context {
+	ctx1 := setActivityParametersIfNotExist(ctx)
+	getActivityOptions(ctx1).RetryPolicy = &retryPolicy
+	return ctx1
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import "context"
+
+type (
+	// ActivityInterceptorFactory creates the ActivityInterceptor of each activity task, see
+	// WorkerOptions.ActivityInterceptorChainFactories.
+	ActivityInterceptorFactory interface {
+		// NewInterceptor creates the interceptor of the activity task described by info. The interceptor calls next
+		// to continue the chain, the last one in the chain executes the activity.
+		NewInterceptor(info *ActivityInfo, next ActivityInterceptor) ActivityInterceptor
+	}
+
+	// ActivityInterceptor intercepts the execution of the activity tasks by the activity worker, and the heartbeats
+	// recorded by the activities. It can apply cross-cutting behavior like authorization, quotas or auditing: see
+	// the arguments, the result and the error of the activity, add values to the context passed to the activity, or
+	// fail the activity task without calling the next interceptor. Embed ActivityInterceptorBase to only implement
+	// the methods to intercept.
+	ActivityInterceptor interface {
+		// ExecuteActivity is called with the context and the decoded arguments of the activity task, GetActivityInfo
+		// returns the ActivityInfo from the context. It returns the result and the error of the activity. The
+		// arguments of the dynamic activity are a single EncodedValues, and its result an EncodedValue.
+		ExecuteActivity(ctx context.Context, activityType string, args ...interface{}) (interface{}, error)
+		// RecordActivityHeartbeat is called by RecordActivityHeartbeat.
+		RecordActivityHeartbeat(ctx context.Context, details ...interface{})
+	}
+
+	// ActivityInterceptorBase is an ActivityInterceptor that passes all the calls to Next.
+	ActivityInterceptorBase struct {
+		Next ActivityInterceptor
+	}
+)
+
+var _ ActivityInterceptor = (*ActivityInterceptorBase)(nil)
+
+// ExecuteActivity calls Next.ExecuteActivity.
+func (b *ActivityInterceptorBase) ExecuteActivity(ctx context.Context, activityType string, args ...interface{}) (interface{}, error) {
+	return b.Next.ExecuteActivity(ctx, activityType, args...)
+}
+
+// RecordActivityHeartbeat calls Next.RecordActivityHeartbeat.
+func (b *ActivityInterceptorBase) RecordActivityHeartbeat(ctx context.Context, details ...interface{}) {
+	b.Next.RecordActivityHeartbeat(ctx, details...)
+}
@@ -138,7 +138,7 @@ func TestActivityHeartbeat_SuppressContinousInvokes(t *testing.T) {
 	service3.On("RecordActivityTaskHeartbeat", mock.Anything, mock.Anything).
 		Return(&s.RecordActivityTaskHeartbeatResponse{}, nil).Run(func(arg mock.Arguments) {
 		request := arg.Get(1).(*s.RecordActivityTaskHeartbeatRequest)
-		ev := EncodedValues(request.GetDetails())
+		ev := newEncodedValues(request.GetDetails(), nil)
 		var progress string
 		err := ev.Get(&progress)
 		if err != nil {
@@ -180,3 +180,126 @@ func TestActivityHeartbeat_SuppressContinousInvokes(t *testing.T) {
 	invoker4.Close()
 	service4.AssertExpectations(t)
 }
+
+func TestActivityAttempt(t *testing.T) {
+	require.Equal(t, int32(1), getActivityAttempt("activity-id"))
+	require.Equal(t, "activity-id#attempt-2", getActivityAttemptID("activity-id", 2))
+	require.Equal(t, int32(2), getActivityAttempt(getActivityAttemptID("activity-id", 2)))
+	require.Equal(t, int32(12), getActivityAttempt(getActivityAttemptID("id#attempt-3", 12)))
+	require.Equal(t, int32(1), getActivityAttempt("activity-id#attempt-x"))
+}
+
+func TestRetryPolicyComputeNextDelay(t *testing.T) {
+	policy := &RetryPolicy{
+		InitialInterval:          time.Second,
+		BackoffCoefficient:       2,
+		MaximumInterval:          3 * time.Second,
+		MaximumAttempts:          4,
+		NonRetriableErrorReasons: []string{"bad-request"},
+	}
+	err := NewCustomError("retry-me")
+
+	delay, retry := policy.computeNextDelay(1, 0, err)
+	require.True(t, retry)
+	require.True(t, delay > 0 && delay <= time.Second, "delay %v", delay)
+	delay, retry = policy.computeNextDelay(3, time.Minute, err)
+	require.True(t, retry)
+	require.True(t, delay > 2*time.Second && delay <= 3*time.Second, "delay %v", delay)
+
+	_, retry = policy.computeNextDelay(4, time.Minute, err)
+	require.False(t, retry)
+	_, retry = policy.computeNextDelay(1, 0, NewCustomError("bad-request"))
+	require.False(t, retry)
+	_, retry = policy.computeNextDelay(1, 0, NewCanceledError())
+	require.False(t, retry)
+	_, retry = policy.computeNextDelay(1, 0, NewTimeoutError(s.TimeoutType_START_TO_CLOSE))
+	require.True(t, retry)
+	_, retry = (*RetryPolicy)(nil).computeNextDelay(1, 0, err)
+	require.False(t, retry)
+}
+
+// testRawActivity records the encoded input it is executed with, and returns a fixed encoded output.
+type testRawActivity struct {
+	input  []byte
+	output []byte
+}
+
+func (a *testRawActivity) Execute(ctx context.Context, input []byte) ([]byte, error) {
+	a.input = input
+	return a.output, nil
+}
+
+func (a *testRawActivity) ActivityType() ActivityType {
+	return ActivityType{Name: "raw"}
+}
+
+func (a *testRawActivity) GetFunction() interface{} {
+	return func(ctx context.Context, v *testRawValue) (*testRawValue, error) { return v, nil }
+}
+
+type testRawValue struct {
+	Known string
+}
+
+type testRawActivityInterceptor struct {
+	ActivityInterceptorBase
+	replaceArg bool // replaces the argument with a new value
+	enrich     bool // changes the argument and the result in place
+}
+
+func (i *testRawActivityInterceptor) NewInterceptor(info *ActivityInfo, next ActivityInterceptor) ActivityInterceptor {
+	return &testRawActivityInterceptor{
+		ActivityInterceptorBase: ActivityInterceptorBase{Next: next},
+		replaceArg:              i.replaceArg,
+		enrich:                  i.enrich,
+	}
+}
+
+func (i *testRawActivityInterceptor) ExecuteActivity(ctx context.Context, activityType string, args ...interface{}) (interface{}, error) {
+	if i.replaceArg {
+		args[0] = &testRawValue{Known: "replaced"}
+	}
+	if i.enrich {
+		args[0].(*testRawValue).Known += " enriched"
+	}
+	result, err := i.Next.ExecuteActivity(ctx, activityType, args...)
+	if i.enrich && err == nil {
+		result.(*testRawValue).Known += " audited"
+	}
+	return result, err
+}
+
+func TestActivityInterceptorKeepsEncodingOfUnchangedValues(t *testing.T) {
+	dc := getDefaultDataConverter()
+	input := []byte(`{"Known":"input","Unknown":1}` + "\n")
+	ctx := context.WithValue(context.Background(), activityEnvContextKey, &activityEnvironment{})
+
+	// The arguments and the result passed through by the interceptor keep the fields their type doesn't have.
+	a := &testRawActivity{output: []byte(`{"Known":"output","Unknown":2}` + "\n")}
+	output, err := executeActivityWithInterceptors(ctx, a, input,
+		[]ActivityInterceptorFactory{&testRawActivityInterceptor{}}, dc)
+	require.NoError(t, err)
+	require.Equal(t, input, a.input)
+	require.Equal(t, a.output, output)
+
+	// A replaced argument is encoded again.
+	a = &testRawActivity{output: a.output}
+	_, err = executeActivityWithInterceptors(ctx, a, input,
+		[]ActivityInterceptorFactory{&testRawActivityInterceptor{replaceArg: true}}, dc)
+	require.NoError(t, err)
+	var arg testRawValue
+	require.NoError(t, decodeArg(dc, a.input, &arg))
+	require.Equal(t, "replaced", arg.Known)
+	require.NotContains(t, string(a.input), "Unknown")
+
+	// The argument and the result changed in place through their pointers are encoded again.
+	a = &testRawActivity{output: a.output}
+	output, err = executeActivityWithInterceptors(ctx, a, input,
+		[]ActivityInterceptorFactory{&testRawActivityInterceptor{enrich: true}}, dc)
+	require.NoError(t, err)
+	require.NoError(t, decodeArg(dc, a.input, &arg))
+	require.Equal(t, "input enriched", arg.Known)
+	var result testRawValue
+	require.NoError(t, decodeArg(dc, output, &result))
+	require.Equal(t, "output audited", result.Known)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/uber-go/tally"
 	m "go.uber.org/cadence/.gen/go/cadence"
 	s "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/common/backoff"
 	"go.uber.org/cadence/common/metrics"
 )
 
@@ -44,11 +45,18 @@ type (
 		//     StartWorkflow(ctx, options, "workflowTypeName", input)
 		//     or
 		//     StartWorkflow(ctx, options, workflowExecuteFn, arg1, arg2, arg3)
+		// The returned WorkflowRun can be used to wait for the result of the started execution.
 		// The errors it can return:
 		//	- EntityNotExistsError
 		//	- BadRequestError
 		//	- WorkflowExecutionAlreadyStartedError
-		StartWorkflow(ctx context.Context, options StartWorkflowOptions, workflow interface{}, args ...interface{}) (*WorkflowExecution, error)
+		StartWorkflow(ctx context.Context, options StartWorkflowOptions, workflow interface{}, args ...interface{}) (WorkflowRun, error)
+
+		// GetWorkflow retrieves a workflow execution and return a WorkflowRun instance
+		// - workflow ID of the workflow.
+		// - runID can be default(empty string). if empty string then it will pick the last running execution of that workflow ID.
+		// No service call is made here, errors are reported by WorkflowRun.Get().
+		GetWorkflow(ctx context.Context, workflowID string, runID string) WorkflowRun
 
 		// SignalWorkflow sends a signals to a workflow in execution
 		// - workflow ID of the workflow.
@@ -59,6 +67,19 @@ type (
 		//	- InternalServiceError
 		SignalWorkflow(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) error
 
+		// SignalWithStartWorkflow sends a signal to a running workflow.
+		// If the workflow is not running or not found, it starts the workflow and then sends the signal in transaction.
+		// - workflowID, signalName, signalArg are same as SignalWorkflow's parameters
+		// - options, workflow, workflowArgs are same as StartWorkflow's parameters
+		// Note: options.ID is used only when workflowID is empty.
+		// The returned WorkflowRun refers to the execution that received the signal.
+		// The errors it can return:
+		//	- EntityNotExistsError
+		//	- InternalServiceError
+		//	- BadRequestError
+		SignalWithStartWorkflow(ctx context.Context, workflowID string, signalName string, signalArg interface{},
+			options StartWorkflowOptions, workflow interface{}, workflowArgs ...interface{}) (WorkflowRun, error)
+
 		// CancelWorkflow cancels a workflow in execution
 		// - workflow ID of the workflow.
 		// - runID can be default(empty string). if empty string then it will pick the running execution of that workflow ID.
@@ -87,6 +108,28 @@ type (
 		//	- InternalServiceError
 		GetWorkflowHistory(ctx context.Context, workflowID string, runID string) (*s.History, error)
 
+		// GetWorkflowHistoryIterator gets an iterator over the history events of a particular workflow.
+		// Unlike GetWorkflowHistory, pages of history are only fetched from the server when the iterator needs them.
+		// - workflow ID of the workflow.
+		// - runID can be default(empty string). if empty string then it will pick the running execution of that workflow ID.
+		// - options control the page size and whether the iterator waits for new events of an open workflow.
+		// The errors returned by the iterator's Next() can be:
+		//	- EntityNotExistsError
+		//	- BadRequestError
+		//	- InternalServiceError
+		GetWorkflowHistoryIterator(ctx context.Context, workflowID string, runID string, options HistoryIteratorOptions) HistoryEventIterator
+
+		// DescribeWorkflow gets a description of a workflow execution: what it is waiting on and how it was closed.
+		// The description is built on the client side by scanning the history of the execution, as the service has no
+		// describe API. Only what is in the history is reported: the heartbeat details of pending activities are not.
+		// - workflow ID of the workflow.
+		// - runID can be default(empty string). if empty string then it will pick the running execution of that workflow ID.
+		// The errors it can return:
+		//	- EntityNotExistsError
+		//	- BadRequestError
+		//	- InternalServiceError
+		DescribeWorkflow(ctx context.Context, workflowID string, runID string) (*WorkflowDescription, error)
+
 		// GetWorkflowStackTrace gets a stack trace of all goroutines of a particular workflow.
 		// atDecisionTaskCompletedEventID is the eventID of the CompleteDecisionTask event at which stack trace should be taken.
 		// It allows to look at the past states of a workflow.
@@ -119,6 +162,29 @@ type (
 		//	- InternalServiceError
 		RecordActivityHeartbeat(ctx context.Context, taskToken []byte, details ...interface{}) error
 
+		// CompleteActivityByID reports activity completed.
+		// Similar to CompleteActivity, but may save user from keeping taskToken info.
+		// activity Execute method can return cadence.ErrActivityResultPending to
+		// indicate the activity is not completed when it's Execute method returns. In that case, this CompleteActivityByID() method
+		// should be called when that activity is completed with the actual result and error. If err is nil, activity task
+		// completed event will be reported; if err is CanceledError, activity task cancelled event will be reported; otherwise,
+		// activity task failed event will be reported.
+		// An activity implementation should use activityID provided in ActivityOption to use for completion.
+		// When the activity has a RetryPolicy, retries have their own activityID: use the one from GetActivityInfo.
+		// domain name, workflowID, activityID are required, runID is optional.
+		// An empty domain uses the domain of the client.
+		// The activity can fail with below errors ErrorWithDetails, TimeoutError, CanceledError.
+		CompleteActivityByID(ctx context.Context, domain, workflowID, runID, activityID string, result interface{}, err error) error
+
+		// RecordActivityHeartbeatByID records heartbeat for an activity.
+		// Similar to RecordActivityHeartbeat, but identifies the activity by domain, workflowID, runID and activityID
+		// instead of the task token. As for CompleteActivityByID, retries of an activity have their own activityID.
+		// details - is the progress you want to record along with heart beat for this activity.
+		// The errors it can return:
+		//	- EntityNotExistsError
+		//	- InternalServiceError
+		RecordActivityHeartbeatByID(ctx context.Context, domain, workflowID, runID, activityID string, details ...interface{}) error
+
 		// ListClosedWorkflow gets closed workflow executions based on request filters
 		// The errors it can return:
 		//  - BadRequestError
@@ -133,6 +199,24 @@ type (
 		//  - EntityNotExistError
 		ListOpenWorkflow(ctx context.Context, request *s.ListOpenWorkflowExecutionsRequest) (*s.ListOpenWorkflowExecutionsResponse, error)
 
+		// ListWorkflows gets workflow executions matching the given options, open executions first and then closed ones.
+		// Pages of results are fetched from the server when the iterator needs them.
+		// The errors returned by the iterator's Next() can be:
+		//  - BadRequestError
+		//  - InternalServiceError
+		//  - EntityNotExistError
+		ListWorkflows(ctx context.Context, options ListWorkflowOptions) WorkflowExecutionInfoIterator
+
+		// BatchOperation signals, cancels or terminates all open workflow executions matching the request filter.
+		// Executions closed before the operation reaches them are reported as skipped. Errors of individual
+		// operations are reported in the returned BatchResult, the returned error is only set when listing
+		// the executions fails.
+		// The errors it can return:
+		//  - BadRequestError
+		//  - InternalServiceError
+		//  - EntityNotExistError
+		BatchOperation(ctx context.Context, request BatchRequest) (*BatchResult, error)
+
 		// QueryWorkflow queries a given workflow execution and returns the query result synchronously. Parameter workflowID
 		// and queryType are required, other parameters are optional. The workflowID and runID (optional) identify the
 		// target workflow execution that this query will be send to. If runID is not specified (empty string), server will
@@ -154,10 +238,224 @@ type (
 		QueryWorkflow(ctx context.Context, workflowID string, runID string, queryType string, args ...interface{}) (EncodedValue, error)
 	}
 
+	// WorkflowRun represents a started and non null workflow execution.
+	WorkflowRun interface {
+		// GetID return workflow ID, which will be same as StartWorkflowOptions.ID if provided.
+		GetID() string
+
+		// GetRunID return the first started workflow run ID (please see below)
+		GetRunID() string
+
+		// Get will fill the workflow execution result to valuePtr,
+		// if workflow execution is a success, or return corresponding
+		// error. This is a blocking API.
+		// If the workflow is continued as new, Get follows the chain and returns the result of the last run.
+		// The errors it can return:
+		//	- CustomError: the workflow failed
+		//	- TimeoutError: the workflow timed out
+		//	- CanceledError: the workflow was canceled
+		//	- TerminatedError: the workflow was terminated
+		//	- EntityNotExistsError
+		//	- BadRequestError
+		//	- InternalServiceError
+		Get(ctx context.Context, valuePtr interface{}) error
+	}
+
+	// HistoryEventIterator represents the interface for history event iterator
+	HistoryEventIterator interface {
+		// HasNext return whether this iterator has next value. When WaitForNewEvent is set it blocks
+		// until a new event is available or the workflow is closed.
+		HasNext() bool
+		// Next returns the next history events and error
+		// The errors it can return:
+		//	- EntityNotExistsError
+		//	- BadRequestError
+		//	- InternalServiceError
+		Next() (*s.HistoryEvent, error)
+	}
+
+	// HistoryIteratorOptions configuration parameters for iterating over the history of a workflow execution.
+	HistoryIteratorOptions struct {
+		// MaximumPageSize - The maximum number of events returned by the server in one page.
+		// Optional: defaulted to the server side page size.
+		MaximumPageSize int32
+
+		// WaitForNewEvent - Whether the iterator keeps polling for new events until the workflow is closed,
+		// instead of stopping at the end of the currently recorded history.
+		// Optional: default false
+		WaitForNewEvent bool
+	}
+
+	// WorkflowExecutionStatus is the status of a workflow execution.
+	WorkflowExecutionStatus int32
+
+	// WorkflowExecutionInfo contains information about a workflow execution returned by ListWorkflows.
+	WorkflowExecutionInfo struct {
+		Execution     WorkflowExecution
+		Type          WorkflowType
+		StartTime     time.Time
+		CloseTime     time.Time // zero if the execution is open
+		Status        WorkflowExecutionStatus
+		HistoryLength int64
+	}
+
+	// WorkflowExecutionInfoIterator represents the interface for iterating over workflow executions.
+	WorkflowExecutionInfoIterator interface {
+		// HasNext return whether this iterator has next value
+		HasNext() bool
+		// Next returns the next workflow execution and error
+		Next() (*WorkflowExecutionInfo, error)
+	}
+
+	// ListWorkflowOptions configuration parameters for listing workflow executions.
+	ListWorkflowOptions struct {
+		// EarliestStartTime - Only executions started at or after this time are returned.
+		// Optional: default no lower bound.
+		EarliestStartTime time.Time
+
+		// LatestStartTime - Only executions started at or before this time are returned.
+		// Optional: defaulted to the time of the call.
+		LatestStartTime time.Time
+
+		// WorkflowType - Only executions of this workflow type are returned.
+		// Optional: default all workflow types.
+		WorkflowType string
+
+		// WorkflowID - Only executions with this workflow ID are returned.
+		// Optional: default all workflow IDs.
+		WorkflowID string
+
+		// Status - Only executions in this status are returned. Use WorkflowExecutionStatusClosed to get
+		// closed executions regardless of how they were closed.
+		// Optional: default WorkflowExecutionStatusAny.
+		Status WorkflowExecutionStatus
+
+		// MaximumPageSize - The maximum number of executions returned by the server in one page.
+		// Optional: defaulted to the server side page size.
+		MaximumPageSize int32
+	}
+
+	// WorkflowDescription describes the current state of a workflow execution, as returned by DescribeWorkflow.
+	WorkflowDescription struct {
+		Execution     WorkflowExecution
+		Type          WorkflowType
+		TaskList      string
+		StartTime     time.Time
+		CloseTime     time.Time // zero if the execution is open
+		Status        WorkflowExecutionStatus
+		HistoryLength int64
+
+		// CancelRequested is true if a cancellation of the execution was requested.
+		CancelRequested bool
+		// SignalCount is the number of signals received by the execution.
+		SignalCount int
+
+		PendingActivities     []PendingActivityInfo
+		PendingTimers         []PendingTimerInfo
+		PendingChildWorkflows []PendingChildWorkflowInfo
+		// PendingCancelRequests are the external workflows the execution requested to cancel, and for which
+		// the request was not delivered yet.
+		PendingCancelRequests []WorkflowExecution
+	}
+
+	// PendingActivityInfo describes an activity that is scheduled or running.
+	// It has no heartbeat details: they are not recorded in the history while the activity runs, and the service
+	// doesn't expose them through any other API yet. They are only available through the TimeoutError if the
+	// activity times out.
+	PendingActivityInfo struct {
+		ActivityID      string
+		ActivityType    ActivityType
+		ScheduledTime   time.Time
+		StartedTime     time.Time // zero if the activity is not started yet
+		CancelRequested bool
+	}
+
+	// PendingTimerInfo describes a timer that is not fired or canceled yet.
+	PendingTimerInfo struct {
+		TimerID     string
+		StartedTime time.Time
+		FireTime    time.Time
+	}
+
+	// PendingChildWorkflowInfo describes a child workflow that is initiated or running.
+	PendingChildWorkflowInfo struct {
+		Domain        string
+		Execution     WorkflowExecution // RunID is empty if the child is not started yet
+		Type          WorkflowType
+		InitiatedTime time.Time
+	}
+
+	// BatchOperationType is the operation applied to each execution by Client.BatchOperation.
+	BatchOperationType int32
+
+	// BatchRequest configuration parameters for Client.BatchOperation.
+	BatchRequest struct {
+		// WorkflowType - Only open executions of this workflow type are targeted.
+		// Mandatory: No default.
+		WorkflowType string
+
+		// EarliestStartTime and LatestStartTime - Only executions started in this time window are targeted.
+		// Optional: default no lower bound and the time of the call as upper bound.
+		EarliestStartTime time.Time
+		LatestStartTime   time.Time
+
+		// Operation - The operation applied to each execution.
+		// Mandatory: No default.
+		Operation BatchOperationType
+
+		// SignalName and SignalArg - The signal sent with BatchOperationSignal.
+		SignalName string
+		SignalArg  interface{}
+
+		// Reason and Details - The reason and details used with BatchOperationTerminate.
+		Reason  string
+		Details []byte
+
+		// MaxConcurrency - The number of operations in flight at the same time.
+		// Optional: defaulted to 10.
+		MaxConcurrency int
+
+		// MaxOperationsPerSecond - The rate limit of the operations.
+		// Optional: default no limit.
+		MaxOperationsPerSecond float64
+
+		// DryRun - Only list the targeted executions in BatchResult.Targets without applying the operation.
+		// Optional: default false
+		DryRun bool
+	}
+
+	// BatchResult is the progress report of Client.BatchOperation.
+	BatchResult struct {
+		Targets   []WorkflowExecution
+		Succeeded []WorkflowExecution
+		Failed    []BatchFailure
+		Skipped   []WorkflowExecution
+	}
+
+	// BatchFailure is an execution on which the batch operation failed.
+	BatchFailure struct {
+		Execution WorkflowExecution
+		Err       error
+	}
+
 	// ClientOptions are optional parameters for Client creation.
 	ClientOptions struct {
 		MetricsScope tally.Scope
 		Identity     string
+
+		// RetryPolicy - The policy used to retry service calls that fail with a transient error, like
+		// ServiceBusyError or InternalServiceError. BadRequestError and EntityNotExistsError are never retried.
+		// SignalWorkflow and TerminateWorkflow are not idempotent, so they are only retried on ServiceBusyError: a
+		// retry after a timeout or a transport error could apply them twice.
+		// Every retry is reported as a request-retry counter on the scope of the operation under MetricsScope.
+		// Optional: default is exponential backoff starting at 1ms, capped at 4s, for up to 60s in total.
+		RetryPolicy backoff.RetryPolicy
+
+		// DataConverter - The converter used to serialize workflow arguments, signals, queries and activity
+		// results and heartbeat details sent by this client, and to deserialize workflow results, query results and
+		// error details. It must match the DataConverter of the workers that run the workflows.
+		// Optional: default is JSON encoding, or thrift encoding for thrift types.
+		DataConverter DataConverter
 	}
 
 	// StartWorkflowOptions configuration parameters for starting a workflow execution.
@@ -182,6 +480,18 @@ type (
 		// The resolution is seconds.
 		// Optional: defaulted to 20 secs.
 		DecisionTaskStartToCloseTimeout time.Duration
+
+		// CronSchedule - Runs the workflow on a cron schedule, like "*/15 * * * *" for every 15 minutes. The five
+		// standard cron fields and descriptors like @daily are supported, and fire times are computed in UTC.
+		// The schedule is run by the workers, without server support: the first run starts right away, and once
+		// a run returns the workflow continues as new with the same arguments, the next run waiting with a durable
+		// timer until the next fire time. The next run reads the result and the error of the previous one through
+		// GetLastCompletionResult. The schedule stops when a run is canceled, while a run that continues as new by
+		// itself starts the next one right away. ExecutionStartToCloseTimeout applies to each run from its fire
+		// time, the wait is not charged to it. The schedule is carried in the input of the runs, so their Input in
+		// the history is not the bare encoded arguments.
+		// Optional: Default empty, means the workflow runs once.
+		CronSchedule string
 	}
 
 	// DomainClient is the client for managing operations on the domain.
@@ -214,6 +524,36 @@ type (
 	}
 )
 
+const (
+	// WorkflowExecutionStatusAny matches executions in any status, it is only used as ListWorkflowOptions.Status.
+	WorkflowExecutionStatusAny WorkflowExecutionStatus = 0
+	// WorkflowExecutionStatusOpen is the status of a running execution.
+	WorkflowExecutionStatusOpen WorkflowExecutionStatus = 1
+	// WorkflowExecutionStatusClosed matches closed executions, it is only used as ListWorkflowOptions.Status.
+	WorkflowExecutionStatusClosed WorkflowExecutionStatus = 2
+	// WorkflowExecutionStatusCompleted is the status of an execution that completed successfully.
+	WorkflowExecutionStatusCompleted WorkflowExecutionStatus = 3
+	// WorkflowExecutionStatusFailed is the status of an execution that failed.
+	WorkflowExecutionStatusFailed WorkflowExecutionStatus = 4
+	// WorkflowExecutionStatusCanceled is the status of an execution that was canceled.
+	WorkflowExecutionStatusCanceled WorkflowExecutionStatus = 5
+	// WorkflowExecutionStatusTerminated is the status of an execution that was terminated.
+	WorkflowExecutionStatusTerminated WorkflowExecutionStatus = 6
+	// WorkflowExecutionStatusContinuedAsNew is the status of an execution that continued as a new run.
+	WorkflowExecutionStatusContinuedAsNew WorkflowExecutionStatus = 7
+	// WorkflowExecutionStatusTimedOut is the status of an execution that timed out.
+	WorkflowExecutionStatusTimedOut WorkflowExecutionStatus = 8
+)
+
+const (
+	// BatchOperationSignal sends a signal to each execution.
+	BatchOperationSignal BatchOperationType = 0
+	// BatchOperationCancel requests cancellation of each execution.
+	BatchOperationCancel BatchOperationType = 1
+	// BatchOperationTerminate terminates each execution.
+	BatchOperationTerminate BatchOperationType = 2
+)
+
 // NewClient creates an instance of a workflow client
 func NewClient(service m.TChanWorkflowService, domain string, options *ClientOptions) Client {
 	var identity string
@@ -232,6 +572,8 @@ func NewClient(service m.TChanWorkflowService, domain string, options *ClientOpt
 		domain:          domain,
 		metricsScope:    metricScope,
 		identity:        identity,
+		retryPolicy:     getRetryPolicy(options),
+		dataConverter:   getDataConverter(options),
 	}
 }
 
@@ -252,5 +594,20 @@ func NewDomainClient(service m.TChanWorkflowService, options *ClientOptions) Dom
 		workflowService: metrics.NewWorkflowServiceWrapper(service, metricScope),
 		metricsScope:    metricScope,
 		identity:        identity,
+		retryPolicy:     getRetryPolicy(options),
+	}
+}
+
+func getRetryPolicy(options *ClientOptions) backoff.RetryPolicy {
+	if options == nil || options.RetryPolicy == nil {
+		return serviceOperationRetryPolicy
+	}
+	return options.RetryPolicy
+}
+
+func getDataConverter(options *ClientOptions) DataConverter {
+	if options == nil || options.DataConverter == nil {
+		return getDefaultDataConverter()
 	}
+	return options.DataConverter
 }
@@ -99,12 +99,12 @@ Retry_Loop:
 			return nil
 		}
 
-		if next = r.NextBackOff(); next == done {
+		// Check if the error is retryable
+		if isRetryable != nil && !isRetryable(err) {
 			return err
 		}
 
-		// Check if the error is retryable
-		if isRetryable != nil && !isRetryable(err) {
+		if next = r.NextBackOff(); next == done {
 			return err
 		}
 
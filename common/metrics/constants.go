@@ -35,6 +35,10 @@ const (
 	WorkflowGetHistoryLatency        = CadenceMetricsPrefix + "workflow-get-history-latency"
 	DecisionTimeoutCounter           = CadenceMetricsPrefix + "decision-timeout"
 
+	WorkflowBatchOperationSucceededCounter = CadenceMetricsPrefix + "workflow-batch-operation-succeeded"
+	WorkflowBatchOperationFailedCounter    = CadenceMetricsPrefix + "workflow-batch-operation-failed"
+	WorkflowBatchOperationSkippedCounter   = CadenceMetricsPrefix + "workflow-batch-operation-skipped"
+
 	DecisionPollCounter                = CadenceMetricsPrefix + "decision-poll-total"
 	DecisionPollFailedCounter          = CadenceMetricsPrefix + "decision-poll-failed"
 	DecisionPollTransientFailedCounter = CadenceMetricsPrefix + "decision-poll-transient-failed"
@@ -71,6 +75,7 @@ const (
 	PollerStartCounter = CadenceMetricsPrefix + "poller-start"
 
 	CadenceRequest        = CadenceMetricsPrefix + "request"
+	CadenceRequestRetry   = CadenceMetricsPrefix + "request-retry"
 	CadenceError          = CadenceMetricsPrefix + "error"
 	CadenceLatency        = CadenceMetricsPrefix + "latency"
 	CadenceInvalidRequest = CadenceMetricsPrefix + "invalid-request"
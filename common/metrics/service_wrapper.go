@@ -28,6 +28,7 @@ import (
 	"github.com/uber/tchannel-go/thrift"
 	m "go.uber.org/cadence/.gen/go/cadence"
 	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/common/backoff"
 )
 
 type (
@@ -42,29 +43,39 @@ type (
 		scope     tally.Scope
 		startTime time.Time
 	}
+
+	retryPolicyMetricsWrapper struct {
+		policy backoff.RetryPolicy
+		scope  tally.Scope
+	}
 )
 
 const (
-	scopeNameDeprecateDomain                = CadenceMetricsPrefix + "DeprecateDomain"
-	scopeNameDescribeDomain                 = CadenceMetricsPrefix + "DescribeDomain"
-	scopeNameGetWorkflowExecutionHistory    = CadenceMetricsPrefix + "GetWorkflowExecutionHistory"
-	scopeNameListClosedWorkflowExecutions   = CadenceMetricsPrefix + "ListClosedWorkflowExecutions"
-	scopeNameListOpenWorkflowExecutions     = CadenceMetricsPrefix + "ListOpenWorkflowExecutions"
-	scopeNamePollForActivityTask            = CadenceMetricsPrefix + "PollForActivityTask"
-	scopeNamePollForDecisionTask            = CadenceMetricsPrefix + "PollForDecisionTask"
-	scopeNameRecordActivityTaskHeartbeat    = CadenceMetricsPrefix + "RecordActivityTaskHeartbeat"
-	scopeNameRegisterDomain                 = CadenceMetricsPrefix + "RegisterDomain"
-	scopeNameRequestCancelWorkflowExecution = CadenceMetricsPrefix + "RequestCancelWorkflowExecution"
-	scopeNameRespondActivityTaskCanceled    = CadenceMetricsPrefix + "RespondActivityTaskCanceled"
-	scopeNameRespondActivityTaskCompleted   = CadenceMetricsPrefix + "RespondActivityTaskCompleted"
-	scopeNameRespondActivityTaskFailed      = CadenceMetricsPrefix + "RespondActivityTaskFailed"
-	scopeNameRespondDecisionTaskCompleted   = CadenceMetricsPrefix + "RespondDecisionTaskCompleted"
-	scopeNameSignalWorkflowExecution        = CadenceMetricsPrefix + "SignalWorkflowExecution"
-	scopeNameStartWorkflowExecution         = CadenceMetricsPrefix + "StartWorkflowExecution"
-	scopeNameTerminateWorkflowExecution     = CadenceMetricsPrefix + "TerminateWorkflowExecution"
-	scopeNameUpdateDomain                   = CadenceMetricsPrefix + "UpdateDomain"
-	scopeNameQueryWorkflow                  = CadenceMetricsPrefix + "QueryWorkflow"
-	scopeNameRespondQueryTaskCompleted      = CadenceMetricsPrefix + "RespondQueryTaskCompleted"
+	scopeNameDeprecateDomain                  = CadenceMetricsPrefix + "DeprecateDomain"
+	scopeNameDescribeDomain                   = CadenceMetricsPrefix + "DescribeDomain"
+	scopeNameGetWorkflowExecutionHistory      = CadenceMetricsPrefix + "GetWorkflowExecutionHistory"
+	scopeNameListClosedWorkflowExecutions     = CadenceMetricsPrefix + "ListClosedWorkflowExecutions"
+	scopeNameListOpenWorkflowExecutions       = CadenceMetricsPrefix + "ListOpenWorkflowExecutions"
+	scopeNamePollForActivityTask              = CadenceMetricsPrefix + "PollForActivityTask"
+	scopeNamePollForDecisionTask              = CadenceMetricsPrefix + "PollForDecisionTask"
+	scopeNameRecordActivityTaskHeartbeat      = CadenceMetricsPrefix + "RecordActivityTaskHeartbeat"
+	scopeNameRecordActivityTaskHeartbeatByID  = CadenceMetricsPrefix + "RecordActivityTaskHeartbeatByID"
+	scopeNameRegisterDomain                   = CadenceMetricsPrefix + "RegisterDomain"
+	scopeNameRequestCancelWorkflowExecution   = CadenceMetricsPrefix + "RequestCancelWorkflowExecution"
+	scopeNameRespondActivityTaskCanceled      = CadenceMetricsPrefix + "RespondActivityTaskCanceled"
+	scopeNameRespondActivityTaskCanceledByID  = CadenceMetricsPrefix + "RespondActivityTaskCanceledByID"
+	scopeNameRespondActivityTaskCompleted     = CadenceMetricsPrefix + "RespondActivityTaskCompleted"
+	scopeNameRespondActivityTaskCompletedByID = CadenceMetricsPrefix + "RespondActivityTaskCompletedByID"
+	scopeNameRespondActivityTaskFailed        = CadenceMetricsPrefix + "RespondActivityTaskFailed"
+	scopeNameRespondActivityTaskFailedByID    = CadenceMetricsPrefix + "RespondActivityTaskFailedByID"
+	scopeNameRespondDecisionTaskCompleted     = CadenceMetricsPrefix + "RespondDecisionTaskCompleted"
+	scopeNameSignalWorkflowExecution          = CadenceMetricsPrefix + "SignalWorkflowExecution"
+	scopeNameStartWorkflowExecution           = CadenceMetricsPrefix + "StartWorkflowExecution"
+	scopeNameSignalWithStartWorkflowExecution = CadenceMetricsPrefix + "SignalWithStartWorkflowExecution"
+	scopeNameTerminateWorkflowExecution       = CadenceMetricsPrefix + "TerminateWorkflowExecution"
+	scopeNameUpdateDomain                     = CadenceMetricsPrefix + "UpdateDomain"
+	scopeNameQueryWorkflow                    = CadenceMetricsPrefix + "QueryWorkflow"
+	scopeNameRespondQueryTaskCompleted        = CadenceMetricsPrefix + "RespondQueryTaskCompleted"
 )
 
 // NewWorkflowServiceWrapper creates a new wrapper to WorkflowService that will emit metrics for each service call.
@@ -72,6 +83,21 @@ func NewWorkflowServiceWrapper(service m.TChanWorkflowService, scope tally.Scope
 	return &workflowServiceMetricsWrapper{service: service, scope: scope, childScopes: make(map[string]tally.Scope)}
 }
 
+// NewRetryPolicyWrapper creates a new wrapper to RetryPolicy that will emit a retry metric each time the wrapped policy
+// allows another attempt of a service call. operation is the name of the WorkflowService method called, the metric is
+// emitted to the scope NewWorkflowServiceWrapper uses for that method when given the same scope.
+func NewRetryPolicyWrapper(policy backoff.RetryPolicy, scope tally.Scope, operation string) backoff.RetryPolicy {
+	return &retryPolicyMetricsWrapper{policy: policy, scope: scope.SubScope(CadenceMetricsPrefix + operation)}
+}
+
+func (r *retryPolicyMetricsWrapper) ComputeNextDelay(elapsedTime time.Duration, numAttempts int) time.Duration {
+	next := r.policy.ComputeNextDelay(elapsedTime, numAttempts)
+	if next >= 0 {
+		r.scope.Counter(CadenceRequestRetry).Inc(1)
+	}
+	return next
+}
+
 func (w *workflowServiceMetricsWrapper) getScope(scopeName string) tally.Scope {
 	w.mutex.Lock()
 	scope, ok := w.childScopes[scopeName]
@@ -164,6 +190,13 @@ func (w *workflowServiceMetricsWrapper) RecordActivityTaskHeartbeat(ctx thrift.C
 	return result, err
 }
 
+func (w *workflowServiceMetricsWrapper) RecordActivityTaskHeartbeatByID(ctx thrift.Context, request *shared.RecordActivityTaskHeartbeatByIDRequest) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+	scope := w.getOperationScope(scopeNameRecordActivityTaskHeartbeatByID)
+	result, err := w.service.RecordActivityTaskHeartbeatByID(ctx, request)
+	scope.handleError(err)
+	return result, err
+}
+
 func (w *workflowServiceMetricsWrapper) RegisterDomain(ctx thrift.Context, request *shared.RegisterDomainRequest) error {
 	scope := w.getOperationScope(scopeNameRegisterDomain)
 	err := w.service.RegisterDomain(ctx, request)
@@ -185,6 +218,13 @@ func (w *workflowServiceMetricsWrapper) RespondActivityTaskCanceled(ctx thrift.C
 	return err
 }
 
+func (w *workflowServiceMetricsWrapper) RespondActivityTaskCanceledByID(ctx thrift.Context, request *shared.RespondActivityTaskCanceledByIDRequest) error {
+	scope := w.getOperationScope(scopeNameRespondActivityTaskCanceledByID)
+	err := w.service.RespondActivityTaskCanceledByID(ctx, request)
+	scope.handleError(err)
+	return err
+}
+
 func (w *workflowServiceMetricsWrapper) RespondActivityTaskCompleted(ctx thrift.Context, request *shared.RespondActivityTaskCompletedRequest) error {
 	scope := w.getOperationScope(scopeNameRespondActivityTaskCompleted)
 	err := w.service.RespondActivityTaskCompleted(ctx, request)
@@ -192,6 +232,13 @@ func (w *workflowServiceMetricsWrapper) RespondActivityTaskCompleted(ctx thrift.
 	return err
 }
 
+func (w *workflowServiceMetricsWrapper) RespondActivityTaskCompletedByID(ctx thrift.Context, request *shared.RespondActivityTaskCompletedByIDRequest) error {
+	scope := w.getOperationScope(scopeNameRespondActivityTaskCompletedByID)
+	err := w.service.RespondActivityTaskCompletedByID(ctx, request)
+	scope.handleError(err)
+	return err
+}
+
 func (w *workflowServiceMetricsWrapper) RespondActivityTaskFailed(ctx thrift.Context, request *shared.RespondActivityTaskFailedRequest) error {
 	scope := w.getOperationScope(scopeNameRespondActivityTaskFailed)
 	err := w.service.RespondActivityTaskFailed(ctx, request)
@@ -199,6 +246,13 @@ func (w *workflowServiceMetricsWrapper) RespondActivityTaskFailed(ctx thrift.Con
 	return err
 }
 
+func (w *workflowServiceMetricsWrapper) RespondActivityTaskFailedByID(ctx thrift.Context, request *shared.RespondActivityTaskFailedByIDRequest) error {
+	scope := w.getOperationScope(scopeNameRespondActivityTaskFailedByID)
+	err := w.service.RespondActivityTaskFailedByID(ctx, request)
+	scope.handleError(err)
+	return err
+}
+
 func (w *workflowServiceMetricsWrapper) RespondDecisionTaskCompleted(ctx thrift.Context, request *shared.RespondDecisionTaskCompletedRequest) error {
 	scope := w.getOperationScope(scopeNameRespondDecisionTaskCompleted)
 	err := w.service.RespondDecisionTaskCompleted(ctx, request)
@@ -220,6 +274,13 @@ func (w *workflowServiceMetricsWrapper) StartWorkflowExecution(ctx thrift.Contex
 	return result, err
 }
 
+func (w *workflowServiceMetricsWrapper) SignalWithStartWorkflowExecution(ctx thrift.Context, request *shared.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	scope := w.getOperationScope(scopeNameSignalWithStartWorkflowExecution)
+	result, err := w.service.SignalWithStartWorkflowExecution(ctx, request)
+	scope.handleError(err)
+	return result, err
+}
+
 func (w *workflowServiceMetricsWrapper) TerminateWorkflowExecution(ctx thrift.Context, request *shared.TerminateWorkflowExecutionRequest) error {
 	scope := w.getOperationScope(scopeNameTerminateWorkflowExecution)
 	err := w.service.TerminateWorkflowExecution(ctx, request)
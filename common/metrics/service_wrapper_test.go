@@ -31,6 +31,7 @@ import (
 	"github.com/uber/tchannel-go/thrift"
 	m "go.uber.org/cadence/.gen/go/cadence"
 	s "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/common/backoff"
 	"go.uber.org/cadence/mocks"
 )
 
@@ -53,14 +54,19 @@ func Test_Wrapper(t *testing.T) {
 		{"PollForActivityTask", []interface{}{ctx, &s.PollForActivityTaskRequest{}}, []interface{}{&s.PollForActivityTaskResponse{}, nil}, []string{CadenceRequest}},
 		{"PollForDecisionTask", []interface{}{ctx, &s.PollForDecisionTaskRequest{}}, []interface{}{&s.PollForDecisionTaskResponse{}, nil}, []string{CadenceRequest}},
 		{"RecordActivityTaskHeartbeat", []interface{}{ctx, &s.RecordActivityTaskHeartbeatRequest{}}, []interface{}{&s.RecordActivityTaskHeartbeatResponse{}, nil}, []string{CadenceRequest}},
+		{"RecordActivityTaskHeartbeatByID", []interface{}{ctx, &s.RecordActivityTaskHeartbeatByIDRequest{}}, []interface{}{&s.RecordActivityTaskHeartbeatResponse{}, nil}, []string{CadenceRequest}},
 		{"RegisterDomain", []interface{}{ctx, &s.RegisterDomainRequest{}}, []interface{}{nil}, []string{CadenceRequest}},
 		{"RequestCancelWorkflowExecution", []interface{}{ctx, &s.RequestCancelWorkflowExecutionRequest{}}, []interface{}{nil}, []string{CadenceRequest}},
 		{"RespondActivityTaskCanceled", []interface{}{ctx, &s.RespondActivityTaskCanceledRequest{}}, []interface{}{nil}, []string{CadenceRequest}},
+		{"RespondActivityTaskCanceledByID", []interface{}{ctx, &s.RespondActivityTaskCanceledByIDRequest{}}, []interface{}{nil}, []string{CadenceRequest}},
 		{"RespondActivityTaskCompleted", []interface{}{ctx, &s.RespondActivityTaskCompletedRequest{}}, []interface{}{nil}, []string{CadenceRequest}},
+		{"RespondActivityTaskCompletedByID", []interface{}{ctx, &s.RespondActivityTaskCompletedByIDRequest{}}, []interface{}{nil}, []string{CadenceRequest}},
 		{"RespondActivityTaskFailed", []interface{}{ctx, &s.RespondActivityTaskFailedRequest{}}, []interface{}{nil}, []string{CadenceRequest}},
+		{"RespondActivityTaskFailedByID", []interface{}{ctx, &s.RespondActivityTaskFailedByIDRequest{}}, []interface{}{nil}, []string{CadenceRequest}},
 		{"RespondDecisionTaskCompleted", []interface{}{ctx, &s.RespondDecisionTaskCompletedRequest{}}, []interface{}{nil}, []string{CadenceRequest}},
 		{"SignalWorkflowExecution", []interface{}{ctx, &s.SignalWorkflowExecutionRequest{}}, []interface{}{nil}, []string{CadenceRequest}},
 		{"StartWorkflowExecution", []interface{}{ctx, &s.StartWorkflowExecutionRequest{}}, []interface{}{&s.StartWorkflowExecutionResponse{}, nil}, []string{CadenceRequest}},
+		{"SignalWithStartWorkflowExecution", []interface{}{ctx, &s.SignalWithStartWorkflowExecutionRequest{}}, []interface{}{&s.StartWorkflowExecutionResponse{}, nil}, []string{CadenceRequest}},
 		{"TerminateWorkflowExecution", []interface{}{ctx, &s.TerminateWorkflowExecutionRequest{}}, []interface{}{nil}, []string{CadenceRequest}},
 		{"UpdateDomain", []interface{}{ctx, &s.UpdateDomainRequest{}}, []interface{}{&s.UpdateDomainResponse{}, nil}, []string{CadenceRequest}},
 		// one case of invalid request
@@ -114,3 +120,23 @@ func newService() (mockService *mocks.TChanWorkflowService,
 	wrapperService = NewWorkflowServiceWrapper(mockService, scope)
 	return
 }
+
+func Test_RetryPolicyWrapper(t *testing.T) {
+	isReplay := false
+	scope, closer, reporter := newMetricsScope(&isReplay)
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(2)
+	wrapper := NewRetryPolicyWrapper(policy, scope, "SignalWorkflowExecution")
+
+	require.True(t, wrapper.ComputeNextDelay(0, 0) >= 0)
+	require.True(t, wrapper.ComputeNextDelay(0, 1) >= 0)
+	require.True(t, wrapper.ComputeNextDelay(0, 2) < 0)
+	closer.Close()
+
+	var retries int64
+	for _, counter := range reporter.counts {
+		require.Equal(t, CadenceMetricsPrefix+"SignalWorkflowExecution."+CadenceRequestRetry, counter.name)
+		retries += counter.value
+	}
+	require.Equal(t, int64(2), retries)
+}
@@ -22,6 +22,7 @@ package cadence
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	"go.uber.org/cadence/.gen/go/shared"
@@ -228,9 +229,8 @@ func parentCancelCtx(parent Context) (*cancelCtx, bool) {
 		switch c := parent.(type) {
 		case *cancelCtx:
 			return c, true
-		// TODO: Uncomment once timer story is implemented
-		//case *timerCtx:
-		//	return c.cancelCtx, true
+		case *timerCtx:
+			return c.cancelCtx, true
 		case *valueCtx:
 			parent = c.Context
 		default:
@@ -302,7 +302,6 @@ func (c *cancelCtx) cancel(removeFromParent bool, err error) {
 	}
 }
 
-// Commented out until workflow time API is exposed.
 // WithDeadline returns a copy of the parent context with the deadline adjusted
 // to be no later than d.  If the parent's deadline is already earlier than d,
 // WithDeadline(parent, d) is semantically equivalent to parent.  The returned
@@ -310,74 +309,109 @@ func (c *cancelCtx) cancel(removeFromParent bool, err error) {
 // cancel function is called, or when the parent context's Done channel is
 // closed, whichever happens first.
 //
+// The deadline is measured against the workflow time returned by Now and is backed
+// by a durable timer, so it survives worker restarts and is replayed deterministically.
+// When the deadline expires the context's Err returns ErrDeadlineExceeded. Activities
+// and child workflows scheduled under the context have their timeouts capped to the
+// deadline and are canceled when it expires.
+//
 // Canceling this context releases resources associated with it, so code should
 // call cancel as soon as the operations running in this Context complete.
-//func WithDeadline(parent Context, deadline time.Time) (Context, CancelFunc) {
-//	if cur, ok := parent.Deadline(); ok && cur.Before(deadline) {
-//		// The current deadline is already sooner than the new one.
-//		return WithCancel(parent)
-//	}
-//	c := &timerCtx{
-//		cancelCtx: newCancelCtx(parent),
-//		deadline:  deadline,
-//	}
-//	propagateCancel(parent, c)
-//	d := deadline.Sub(time.Now())
-//	if d <= 0 {
-//		c.cancel(true, DeadlineExceeded) // deadline has already passed
-//		return c, func() { c.cancel(true, Canceled) }
-//	}
-//	if c.err == nil {
-//		c.timer = time.AfterFunc(d, func() {
-//			c.cancel(true, DeadlineExceeded)
-//		})
-//	}
-//	return c, func() { c.cancel(true, Canceled) }
-//}
-//
-//// A timerCtx carries a timer and a deadline.  It embeds a cancelCtx to
-//// implement Done and Err.  It implements cancel by stopping its timer then
-//// delegating to cancelCtx.cancel.
-//type timerCtx struct {
-//	*cancelCtx
-//	timer *time.Timer // Under cancelCtx.mu.
-//
-//	deadline time.Time
-//}
-//
-//func (c *timerCtx) Deadline() (deadline time.Time, ok bool) {
-//	return c.deadline, true
-//}
-//
-//func (c *timerCtx) String() string {
-//	return fmt.Sprintf("%v.WithDeadline(%s [%s])", c.cancelCtx.Context, c.deadline, c.deadline.Sub(time.Now()))
-//}
-//
-//func (c *timerCtx) cancel(removeFromParent bool, err error) {
-//	c.cancelCtx.cancel(false, err)
-//	if removeFromParent {
-//		// Remove this timerCtx from its parent cancelCtx's children.
-//		removeChild(c.cancelCtx.Context, c)
-//	}
-//	if c.timer != nil {
-//		c.timer.Stop()
-//		c.timer = nil
-//	}
-//}
-//
-// WithTimeout returns WithDeadline(parent, time.Now().Add(timeout)).
+func WithDeadline(parent Context, deadline time.Time) (Context, CancelFunc) {
+	if cur, ok := parent.Deadline(); ok && cur.Before(deadline) {
+		// The current deadline is already sooner than the new one.
+		return WithCancel(parent)
+	}
+	c := &timerCtx{
+		cancelCtx: newCancelCtx(parent),
+		deadline:  deadline,
+	}
+	propagateCancel(parent, c)
+	d := deadline.Sub(Now(parent))
+	if d <= 0 {
+		c.cancel(true, ErrDeadlineExceeded) // deadline has already passed
+		return c, func() { c.cancel(true, ErrCanceled) }
+	}
+	if c.err == nil {
+		// Timers have a resolution of a second, round up so that the deadline doesn't fire early.
+		d = time.Duration(math.Ceil(d.Seconds())) * time.Second
+		c.timer = getWorkflowEnvironment(parent).NewTimer(d, func(r []byte, e error) {
+			if e != nil {
+				return // timer was canceled
+			}
+			c.timer = nil
+			c.cancel(true, ErrDeadlineExceeded)
+		})
+	}
+	return c, func() { c.cancel(true, ErrCanceled) }
+}
+
+// A timerCtx carries a timer and a deadline.  It embeds a cancelCtx to
+// implement Done and Err.  It implements cancel by canceling its timer then
+// delegating to cancelCtx.cancel.
+type timerCtx struct {
+	*cancelCtx
+	timer *timerInfo // nil once fired or canceled.
+
+	deadline time.Time
+}
+
+func (c *timerCtx) Deadline() (deadline time.Time, ok bool) {
+	return c.deadline, true
+}
+
+func (c *timerCtx) String() string {
+	return fmt.Sprintf("%v.WithDeadline(%s)", c.cancelCtx.Context, c.deadline)
+}
+
+func (c *timerCtx) cancel(removeFromParent bool, err error) {
+	c.cancelCtx.cancel(false, err)
+	if removeFromParent {
+		// Remove this timerCtx from its parent cancelCtx's children.
+		removeChild(c.cancelCtx.Context, c)
+	}
+	if c.timer != nil {
+		getWorkflowEnvironment(c.cancelCtx.Context).RequestCancelTimer(c.timer.timerID)
+		c.timer = nil
+	}
+}
+
+// WithTimeout returns WithDeadline(parent, Now(parent).Add(timeout)).
 //
 // Canceling this context releases resources associated with it, so code should
 // call cancel as soon as the operations running in this Context complete:
 //
-// 	func slowOperationWithTimeout(ctx context.Context) (Result, error) {
-// 		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+// 	func slowOperationWithTimeout(ctx cadence.Context) (Result, error) {
+// 		ctx, cancel := cadence.WithTimeout(ctx, 10*time.Minute)
 // 		defer cancel()  // releases resources if slowOperation completes before timeout elapses
 // 		return slowOperation(ctx)
 // 	}
-//func WithTimeout(parent Context, timeout time.Duration) (Context, CancelFunc) {
-//	return WithDeadline(parent, time.Now().Add(timeout))
-//}
+func WithTimeout(parent Context, timeout time.Duration) (Context, CancelFunc) {
+	return WithDeadline(parent, Now(parent).Add(timeout))
+}
+
+// deadlineExceededError converts the cancellation error of an operation that was canceled
+// because the deadline of ctx expired into ErrDeadlineExceeded.
+func deadlineExceededError(ctx Context, err error) error {
+	if _, ok := err.(*CanceledError); ok && ctx.Err() == ErrDeadlineExceeded {
+		return ErrDeadlineExceeded
+	}
+	return err
+}
+
+// remainingTimeoutSeconds returns the number of seconds, rounded up and at least one, left
+// until the deadline of ctx. ok is false when ctx has no deadline.
+func remainingTimeoutSeconds(ctx Context) (seconds int32, ok bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, false
+	}
+	seconds = int32(math.Ceil(deadline.Sub(Now(ctx)).Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds, true
+}
 
 // WithValue returns a copy of parent in which the value associated with key is
 // val.
@@ -0,0 +1,34 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+// DataConverter is used by the framework to serialize/deserialize the payloads that are sent over the wire: workflow
+// and activity input and results, signals, queries, heartbeat details, SideEffect values and CustomError details.
+// The same DataConverter has to be configured on the Client (through ClientOptions) and on the Worker (through
+// WorkerOptions) that handle a given workflow, otherwise they won't be able to read each other's payloads.
+// When no DataConverter is provided the default one is used, which encodes values as JSON, or as thrift if all the
+// values are thrift types, and passes a single []byte value through unchanged.
+type DataConverter interface {
+	// ToData encodes a list of values into a single payload.
+	ToData(values ...interface{}) ([]byte, error)
+	// FromData decodes a payload produced by ToData into the values pointed to by valuePtrs.
+	FromData(data []byte, valuePtrs ...interface{}) error
+}
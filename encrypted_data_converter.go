@@ -0,0 +1,225 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+type (
+	// KeyProvider supplies the keys used by the DataConverter returned from NewEncryptedDataConverter.
+	// Every encrypted payload records the ID of the key it was encrypted with, so keys can be rotated by changing
+	// the current key as long as the previous keys remain available through GetKey to decrypt the existing histories.
+	// Keys have to be 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256.
+	KeyProvider interface {
+		// GetCurrentKey returns the ID and the value of the key used to encrypt new payloads.
+		GetCurrentKey() (keyID string, key []byte, err error)
+		// GetKey returns the value of the key with the given ID.
+		GetKey(keyID string) ([]byte, error)
+	}
+
+	// encryptedDataConverter encrypts the payloads produced by the wrapped DataConverter with AES-GCM.
+	encryptedDataConverter struct {
+		keyProvider   KeyProvider
+		dataConverter DataConverter
+	}
+
+	// fileKeyProvider is a KeyProvider that reads hex encoded keys from the files of a local directory.
+	fileKeyProvider struct {
+		dir          string
+		currentKeyID string
+
+		sync.Mutex
+		keys map[string][]byte
+	}
+)
+
+const (
+	// encryptedPayloadVersion is the first byte of every encrypted payload. It identifies the layout of the header
+	// that follows it: the length of the key ID as a single byte, the key ID and the AES-GCM nonce.
+	encryptedPayloadVersion byte = 1
+
+	maxKeyIDLength = 255
+)
+
+// Assert that structs do indeed implement the interfaces
+var _ DataConverter = (*encryptedDataConverter)(nil)
+var _ KeyProvider = (*fileKeyProvider)(nil)
+
+// NewEncryptedDataConverter returns a DataConverter that encrypts the payloads produced by dataConverter with
+// AES-GCM, using the keys supplied by keyProvider. When dataConverter is nil the default encoding is used.
+// Empty payloads are left as is, so the presence of optional values like heartbeat details is still visible.
+// The returned DataConverter has to be set on both the ClientOptions and the WorkerOptions.
+func NewEncryptedDataConverter(keyProvider KeyProvider, dataConverter DataConverter) DataConverter {
+	if keyProvider == nil {
+		panic("keyProvider is required")
+	}
+	if dataConverter == nil {
+		dataConverter = getDefaultDataConverter()
+	}
+	return &encryptedDataConverter{keyProvider: keyProvider, dataConverter: dataConverter}
+}
+
+// NewFileKeyProvider returns a KeyProvider that reads the keys from the files of dir. Each file is named after the ID
+// of the key it contains and holds the hex encoded value of the key. currentKeyID is the ID of the key used to
+// encrypt new payloads. The keys are read on first use and cached.
+// It is intended for tests and local development; production deployments should use a key management service.
+func NewFileKeyProvider(dir string, currentKeyID string) (KeyProvider, error) {
+	if err := validateKeyID(currentKeyID); err != nil {
+		return nil, err
+	}
+	p := &fileKeyProvider{dir: dir, currentKeyID: currentKeyID, keys: make(map[string][]byte)}
+	if _, err := p.GetKey(currentKeyID); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ToData implements the DataConverter interface.
+func (dc *encryptedDataConverter) ToData(values ...interface{}) ([]byte, error) {
+	plaintext, err := dc.dataConverter.ToData(values...)
+	if err != nil || len(plaintext) == 0 {
+		return plaintext, err
+	}
+
+	keyID, key, err := dc.keyProvider.GetCurrentKey()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateKeyID(keyID); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, 2+len(keyID)+aead.NonceSize())
+	header = append(header, encryptedPayloadVersion, byte(len(keyID)))
+	header = append(header, keyID...)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	header = append(header, nonce...)
+
+	// The header is authenticated along with the payload, so the key ID can't be tampered with.
+	return aead.Seal(header, nonce, plaintext, header), nil
+}
+
+// FromData implements the DataConverter interface.
+func (dc *encryptedDataConverter) FromData(data []byte, valuePtrs ...interface{}) error {
+	if len(data) == 0 {
+		return dc.dataConverter.FromData(data, valuePtrs...)
+	}
+
+	if data[0] != encryptedPayloadVersion {
+		return fmt.Errorf("unsupported encrypted payload version: %d", data[0])
+	}
+	if len(data) < 2 || len(data) < 2+int(data[1]) {
+		return errors.New("encrypted payload is truncated")
+	}
+	keyIDEnd := 2 + int(data[1])
+	keyID := string(data[2:keyIDEnd])
+
+	key, err := dc.keyProvider.GetKey(keyID)
+	if err != nil {
+		return err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	if len(data) < keyIDEnd+aead.NonceSize() {
+		return errors.New("encrypted payload is truncated")
+	}
+	headerEnd := keyIDEnd + aead.NonceSize()
+	plaintext, err := aead.Open(nil, data[keyIDEnd:headerEnd], data[headerEnd:], data[:headerEnd])
+	if err != nil {
+		return fmt.Errorf("unable to decrypt payload with key %q: %v", keyID, err)
+	}
+	return dc.dataConverter.FromData(plaintext, valuePtrs...)
+}
+
+// GetCurrentKey implements the KeyProvider interface.
+func (p *fileKeyProvider) GetCurrentKey() (string, []byte, error) {
+	key, err := p.GetKey(p.currentKeyID)
+	return p.currentKeyID, key, err
+}
+
+// GetKey implements the KeyProvider interface.
+func (p *fileKeyProvider) GetKey(keyID string) ([]byte, error) {
+	// The key ID of a payload being decrypted comes from the payload itself, make sure it can't escape dir.
+	if err := validateKeyID(keyID); err != nil {
+		return nil, err
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if key, ok := p.keys[keyID]; ok {
+		return key, nil
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(p.dir, keyID))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key %q: %v", keyID, err)
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("key %q is not hex encoded: %v", keyID, err)
+	}
+	if _, err := aes.NewCipher(key); err != nil {
+		return nil, fmt.Errorf("invalid key %q: %v", keyID, err)
+	}
+	p.keys[keyID] = key
+	return key, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func validateKeyID(keyID string) error {
+	if keyID == "" {
+		return errors.New("key ID is empty")
+	}
+	if len(keyID) > maxKeyIDLength {
+		return fmt.Errorf("key ID is longer than %d bytes", maxKeyIDLength)
+	}
+	if keyID == "." || keyID == ".." || strings.ContainsAny(keyID, `/\`) {
+		return fmt.Errorf("invalid key ID: %q", keyID)
+	}
+	return nil
+}
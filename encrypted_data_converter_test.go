@@ -0,0 +1,225 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/cadence/mocks"
+)
+
+type testKeyProvider struct {
+	currentKeyID string
+	keys         map[string][]byte
+}
+
+func (p *testKeyProvider) GetCurrentKey() (string, []byte, error) {
+	key, err := p.GetKey(p.currentKeyID)
+	return p.currentKeyID, key, err
+}
+
+func (p *testKeyProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, NewCustomError("unknown key", keyID)
+	}
+	return key, nil
+}
+
+func newTestKeyProvider() *testKeyProvider {
+	return &testKeyProvider{
+		currentKeyID: "key1",
+		keys: map[string][]byte{
+			"key1": bytes.Repeat([]byte{1}, 32),
+			"key2": bytes.Repeat([]byte{2}, 16),
+		},
+	}
+}
+
+func TestEncryptedDataConverter_RoundTrip(t *testing.T) {
+	dc := NewEncryptedDataConverter(newTestKeyProvider(), nil)
+
+	data, err := dc.ToData("pii-value", 42)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(data, []byte("pii-value")))
+
+	var s string
+	var i int
+	require.NoError(t, dc.FromData(data, &s, &i))
+	require.Equal(t, "pii-value", s)
+	require.Equal(t, 42, i)
+
+	// The same value encrypts to different payloads.
+	other, err := dc.ToData("pii-value", 42)
+	require.NoError(t, err)
+	require.NotEqual(t, data, other)
+
+	// Encoded values decode through the converter.
+	result, err := encodeArg(dc, "result")
+	require.NoError(t, err)
+	require.NoError(t, newEncodedValue(result, dc).Get(&s))
+	require.Equal(t, "result", s)
+
+	// Empty payloads are not encrypted.
+	data, err = dc.ToData()
+	require.NoError(t, err)
+	require.Empty(t, data)
+	require.False(t, newEncodedValues(data, dc).HasValues())
+}
+
+func TestEncryptedDataConverter_KeyRotation(t *testing.T) {
+	keyProvider := newTestKeyProvider()
+	dc := NewEncryptedDataConverter(keyProvider, nil)
+
+	oldData, err := dc.ToData("old")
+	require.NoError(t, err)
+
+	keyProvider.currentKeyID = "key2"
+	newData, err := dc.ToData("new")
+	require.NoError(t, err)
+
+	var s string
+	require.NoError(t, dc.FromData(oldData, &s))
+	require.Equal(t, "old", s)
+	require.NoError(t, dc.FromData(newData, &s))
+	require.Equal(t, "new", s)
+
+	// Payloads encrypted with a key that is no longer available can't be decoded.
+	delete(keyProvider.keys, "key1")
+	require.Error(t, dc.FromData(oldData, &s))
+}
+
+func TestEncryptedDataConverter_InvalidPayload(t *testing.T) {
+	dc := NewEncryptedDataConverter(newTestKeyProvider(), nil)
+	data, err := dc.ToData("value")
+	require.NoError(t, err)
+
+	var s string
+	tampered := append([]byte(nil), data...)
+	tampered[len(tampered)-1] ^= 1
+	require.Error(t, dc.FromData(tampered, &s))
+
+	// The key ID is authenticated as well.
+	tampered = append([]byte(nil), data...)
+	tampered[len("key")+2] = '2'
+	require.Error(t, dc.FromData(tampered, &s))
+
+	require.Error(t, dc.FromData(data[:len(data)/2], &s))
+	require.Error(t, dc.FromData([]byte(`"value"`), &s))
+}
+
+func TestEncryptedDataConverter_SerializeFnArgs(t *testing.T) {
+	dc := NewEncryptedDataConverter(newTestKeyProvider(), nil)
+	data, err := SerializeFnArgsWithDataConverter(dc, "arg1", 2)
+	require.NoError(t, err)
+	var s string
+	var i int
+	require.NoError(t, dc.FromData(data, &s, &i))
+	require.Equal(t, "arg1", s)
+	require.Equal(t, 2, i)
+
+	result, err := dc.ToData("result")
+	require.NoError(t, err)
+	require.NoError(t, DeserializeFnResultsWithDataConverter(dc, result, &s))
+	require.Equal(t, "result", s)
+	require.Error(t, getDefaultDataConverter().FromData(result, &s))
+
+	// The DataConverter of a worker doesn't leak into the functions using the default one.
+	NewActivityTaskWorker(nil, new(mocks.TChanWorkflowService), "testDomain", "testTaskList", WorkerOptions{DataConverter: dc})
+	data, err = SerializeFnArgs("arg1")
+	require.NoError(t, err)
+	require.NoError(t, getDefaultDataConverter().FromData(data, &s))
+	require.Equal(t, "arg1", s)
+}
+
+func TestFileKeyProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cadence-keys")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeKey := func(keyID string, key []byte) {
+		err := ioutil.WriteFile(filepath.Join(dir, keyID), []byte(hex.EncodeToString(key)+"\n"), 0600)
+		require.NoError(t, err)
+	}
+	writeKey("key1", bytes.Repeat([]byte{1}, 32))
+	writeKey("short", []byte{1, 2, 3})
+
+	_, err = NewFileKeyProvider(dir, "missing")
+	require.Error(t, err)
+	_, err = NewFileKeyProvider(dir, "short")
+	require.Error(t, err)
+
+	keyProvider, err := NewFileKeyProvider(dir, "key1")
+	require.NoError(t, err)
+	oldData, err := NewEncryptedDataConverter(keyProvider, nil).ToData("old")
+	require.NoError(t, err)
+
+	writeKey("key2", bytes.Repeat([]byte{2}, 32))
+	keyProvider, err = NewFileKeyProvider(dir, "key2")
+	require.NoError(t, err)
+	dc := NewEncryptedDataConverter(keyProvider, nil)
+	keyID, _, err := keyProvider.GetCurrentKey()
+	require.NoError(t, err)
+	require.Equal(t, "key2", keyID)
+
+	var s string
+	require.NoError(t, dc.FromData(oldData, &s))
+	require.Equal(t, "old", s)
+
+	_, err = keyProvider.GetKey("../key1")
+	require.Error(t, err)
+}
+
+func TestEncryptedDataConverter_Workflow(t *testing.T) {
+	activityFn := func(ctx context.Context, name string) (string, error) {
+		return "hello " + name, nil
+	}
+	RegisterActivity(activityFn)
+	workflowFn := func(ctx Context, name string) (string, error) {
+		ctx = WithActivityOptions(ctx, ActivityOptions{
+			ScheduleToStartTimeout: time.Minute,
+			StartToCloseTimeout:    time.Minute,
+		})
+		var greeting string
+		err := ExecuteActivity(ctx, activityFn, name).Get(ctx, &greeting)
+		return greeting, err
+	}
+	RegisterWorkflow(workflowFn)
+
+	dc := NewEncryptedDataConverter(newTestKeyProvider(), nil)
+	env := (&WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.SetWorkerOptions(WorkerOptions{DataConverter: dc})
+	env.ExecuteWorkflow(workflowFn, "world")
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result string
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, "hello world", result)
+}
@@ -26,6 +26,7 @@ import (
 	"strings"
 
 	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/common"
 )
 
 /*
@@ -34,7 +35,7 @@ Below are the possible cases that activity could fail:
 	If activity implementation returns *CustomError by using NewCustomError() API, workflow code would receive *CustomError.
 	The err would contain a Reason and Details. The reason is what activity specified to NewCustomError(), which workflow
 	code could check to determine what kind of error it was and take actions based on the reason. The details is encoded
-	[]byte which workflow code could extract strong typed data. Workflow code needs to know what the types of the encoded
+	with the DataConverter and workflow code could extract strong typed data. Workflow code needs to know what the types of the encoded
 	details are before extracting them.
 2) *GenericError:
 	If activity implementation returns errors other than from NewCustomError() API, workflow code would receive *GenericError.
@@ -87,7 +88,7 @@ type (
 	// CustomError returned from workflow and activity implementations with reason and optional details.
 	CustomError struct {
 		reason  string
-		details []byte
+		details EncodedValues
 	}
 
 	// GenericError returned from workflow/workflow when the implementations return errors other than from NewCustomError() API.
@@ -98,12 +99,16 @@ type (
 	// TimeoutError returned when activity or child workflow timed out.
 	TimeoutError struct {
 		timeoutType shared.TimeoutType
-		details     []byte
+		details     EncodedValues
 	}
 
 	// CanceledError returned when operation was canceled.
 	CanceledError struct {
-		details []byte
+		details EncodedValues
+	}
+
+	// TerminatedError returned when workflow was terminated.
+	TerminatedError struct {
 	}
 
 	// PanicError contains information about panicked workflow/activity.
@@ -133,17 +138,16 @@ const (
 // that could report the activity completed event to cadence server via Client.CompleteActivity() API.
 var ErrActivityResultPending = errors.New("not error: do not autocomplete, using Client.CompleteActivity() to complete")
 
+// ErrNoData is returned by GetLastCompletionResult when there is no result of a previous run.
+var ErrNoData = errors.New("no data available")
+
 // NewCustomError create new instance of *CustomError with reason and optional details.
 func NewCustomError(reason string, details ...interface{}) *CustomError {
 	if strings.HasPrefix(reason, "cadenceInternal:") {
 		panic("'cadenceInternal:' is reserved prefix, please use different reason")
 	}
 
-	data, err := getHostEnvironment().encodeArgs(details)
-	if err != nil {
-		panic(err)
-	}
-	return &CustomError{reason: reason, details: data}
+	return &CustomError{reason: reason, details: errorDetailsValues(details)}
 }
 
 // NewTimeoutError creates TimeoutError instance.
@@ -151,27 +155,29 @@ func NewCustomError(reason string, details ...interface{}) *CustomError {
 // WARNING: This function is public only to support unit testing of workflows.
 // It shouldn't be used by application level code.
 func NewTimeoutError(timeoutType shared.TimeoutType) *TimeoutError {
-	return &TimeoutError{timeoutType: timeoutType}
+	return &TimeoutError{timeoutType: timeoutType, details: errorDetailsValues(nil)}
 }
 
 // NewHeartbeatTimeoutError creates TimeoutError instance
 // WARNING: This function is public only to support unit testing of workflows.
 // It shouldn't be used by application level code.
 func NewHeartbeatTimeoutError(details ...interface{}) *TimeoutError {
-	data, err := getHostEnvironment().encodeArgs(details)
-	if err != nil {
-		panic(err)
-	}
-	return &TimeoutError{timeoutType: shared.TimeoutType_HEARTBEAT, details: data}
+	return &TimeoutError{timeoutType: shared.TimeoutType_HEARTBEAT, details: errorDetailsValues(details)}
 }
 
 // NewCanceledError creates CanceledError instance
 func NewCanceledError(details ...interface{}) *CanceledError {
-	data, err := getHostEnvironment().encodeArgs(details)
-	if err != nil {
-		panic(err)
-	}
-	return &CanceledError{details: data}
+	return &CanceledError{details: errorDetailsValues(details)}
+}
+
+// newHeartbeatTimeoutErrorWithDetails creates heartbeat TimeoutError instance from details received from the server.
+func newHeartbeatTimeoutErrorWithDetails(details []byte, dc DataConverter) *TimeoutError {
+	return &TimeoutError{timeoutType: shared.TimeoutType_HEARTBEAT, details: newEncodedValues(details, dc)}
+}
+
+// newCanceledErrorWithDetails creates CanceledError instance from details received from the server.
+func newCanceledErrorWithDetails(details []byte, dc DataConverter) *CanceledError {
+	return &CanceledError{details: newEncodedValues(details, dc)}
 }
 
 // NewContinueAsNewError creates ContinueAsNewError instance
@@ -187,28 +193,53 @@ func NewCanceledError(details ...interface{}) *CanceledError {
 //  args - arguments for the new workflow.
 //
 func NewContinueAsNewError(ctx Context, wfn interface{}, args ...interface{}) *ContinueAsNewError {
+	return NewContinueAsNewErrorWithOptions(ctx, ContinueAsNewOptions{}, wfn, args...)
+}
+
+// NewContinueAsNewErrorWithOptions creates ContinueAsNewError instance like NewContinueAsNewError, with the non zero
+// fields of options overriding the options of the new execution.
+//  options - task list, timeouts and workflow type of the new execution.
+//  wfn - workflow function. for new execution it can be different from the currently running.
+//  args - arguments for the new workflow.
+//
+func NewContinueAsNewErrorWithOptions(ctx Context, options ContinueAsNewOptions, wfn interface{}, args ...interface{}) *ContinueAsNewError {
 	// Validate type and its arguments.
-	workflowType, input, err := getValidatedWorkerFunction(wfn, args)
+	registry, dc := getRegistryFromWorkflowContext(ctx), getDataConverterFromWorkflowContext(ctx)
+	workflowType, input, err := getValidatedWorkerFunction(wfn, args, registry, dc)
 	if err != nil {
 		panic(err)
 	}
-	options := getWorkflowEnvOptions(ctx)
-	if options == nil {
+	envOptions := getWorkflowEnvOptions(ctx)
+	if envOptions == nil {
 		panic("context is missing required options for continue as new")
 	}
-	if options.taskListName == nil || *options.taskListName == "" {
+	// Copy the options so the ones of the current execution are left untouched.
+	newOptions := *envOptions
+	if options.TaskList != "" {
+		newOptions.taskListName = common.StringPtr(options.TaskList)
+	}
+	if options.ExecutionStartToCloseTimeout != 0 {
+		newOptions.executionStartToCloseTimeoutSeconds = common.Int32Ptr(int32(options.ExecutionStartToCloseTimeout.Seconds()))
+	}
+	if options.TaskStartToCloseTimeout != 0 {
+		newOptions.taskStartToCloseTimeoutSeconds = common.Int32Ptr(int32(options.TaskStartToCloseTimeout.Seconds()))
+	}
+	if options.WorkflowType != "" {
+		workflowType = &WorkflowType{Name: options.WorkflowType}
+	}
+	if newOptions.taskListName == nil || *newOptions.taskListName == "" {
 		panic("invalid task list provided")
 	}
-	if options.executionStartToCloseTimeoutSeconds == nil || *options.executionStartToCloseTimeoutSeconds <= 0 {
+	if newOptions.executionStartToCloseTimeoutSeconds == nil || *newOptions.executionStartToCloseTimeoutSeconds <= 0 {
 		panic("invalid executionStartToCloseTimeoutSeconds provided")
 	}
-	if options.taskStartToCloseTimeoutSeconds == nil || *options.taskStartToCloseTimeoutSeconds <= 0 {
+	if newOptions.taskStartToCloseTimeoutSeconds == nil || *newOptions.taskStartToCloseTimeoutSeconds <= 0 {
 		panic("invalid taskStartToCloseTimeoutSeconds provided")
 	}
 
-	options.workflowType = workflowType
-	options.input = input
-	return &ContinueAsNewError{wfn: wfn, args: args, options: options}
+	newOptions.workflowType = workflowType
+	newOptions.input = input
+	return &ContinueAsNewError{wfn: wfn, args: args, options: &newOptions}
 }
 
 // Error from error interface
@@ -221,9 +252,14 @@ func (e *CustomError) Reason() string {
 	return e.reason
 }
 
+// HasDetails return if this error has strong typed detail data.
+func (e *CustomError) HasDetails() bool {
+	return e.details != nil && e.details.HasValues()
+}
+
 // Details extracts strong typed detail data of this custom error
 func (e *CustomError) Details(d ...interface{}) {
-	if err := getHostEnvironment().decode(e.details, d); err != nil {
+	if err := e.details.Get(d...); err != nil {
 		panic(err)
 	}
 }
@@ -243,9 +279,14 @@ func (e *TimeoutError) TimeoutType() shared.TimeoutType {
 	return e.timeoutType
 }
 
+// HasDetails return if this error has strong typed detail data.
+func (e *TimeoutError) HasDetails() bool {
+	return e.details != nil && e.details.HasValues()
+}
+
 // Details extracts strong typed detail data of this error
 func (e *TimeoutError) Details(d ...interface{}) {
-	if err := getHostEnvironment().decode(e.details, d); err != nil {
+	if err := e.details.Get(d...); err != nil {
 		panic(err)
 	}
 }
@@ -255,13 +296,23 @@ func (e *CanceledError) Error() string {
 	return "CanceledError"
 }
 
+// HasDetails return if this error has strong typed detail data.
+func (e *CanceledError) HasDetails() bool {
+	return e.details != nil && e.details.HasValues()
+}
+
 // Details extracts strong typed detail data of this error.
 func (e *CanceledError) Details(d ...interface{}) {
-	if err := getHostEnvironment().decode(e.details, d); err != nil {
+	if err := e.details.Get(d...); err != nil {
 		panic(err)
 	}
 }
 
+// Error from error interface
+func (e *TerminatedError) Error() string {
+	return "Terminated"
+}
+
 func newPanicError(value interface{}, stackTrace string) *PanicError {
 	return &PanicError{value: fmt.Sprintf("%v", value), stackTrace: stackTrace}
 }
@@ -54,7 +54,7 @@ func Test_ActivityError(t *testing.T) {
 		_, err := env.ExecuteActivity(errorActivityFn, i)
 		require.Error(t, err)
 		printError(err)
-		require.Equal(t, errs[i][1], err)
+		requireErrorEqual(t, errs[i][1], err)
 	}
 }
 
@@ -86,11 +86,51 @@ func Test_WorkflowError(t *testing.T) {
 		err := wfEnv.GetWorkflowError()
 		require.Error(t, err)
 		printError(err)
-		require.Equal(t, errs[i][1], err)
+		requireErrorEqual(t, errs[i][1], err)
 	}
 
 }
 
+func Test_ErrorDetailsDecoding(t *testing.T) {
+	type detailStruct struct {
+		Name string
+	}
+	err := NewCustomError(customErrReasonA, 5, detailStruct{Name: "details"})
+	require.True(t, err.HasDetails())
+	var count int64
+	var detail *detailStruct
+	err.Details(&count, &detail)
+	require.Equal(t, int64(5), count)
+	require.Equal(t, &detailStruct{Name: "details"}, detail)
+}
+
+// requireErrorEqual compares the errors by type, reason and details, the details of the received error are encoded.
+func requireErrorEqual(t *testing.T, expected, actual error) {
+	require.IsType(t, expected, actual)
+	require.Equal(t, expected.Error(), actual.Error())
+	var expectedDetails, actualDetails interface {
+		HasDetails() bool
+		Details(d ...interface{})
+	}
+	switch expected := expected.(type) {
+	case *CustomError:
+		require.Equal(t, expected.Reason(), actual.(*CustomError).Reason())
+		expectedDetails, actualDetails = expected, actual.(*CustomError)
+	case *CanceledError:
+		expectedDetails, actualDetails = expected, actual.(*CanceledError)
+	default:
+		require.Equal(t, expected, actual)
+		return
+	}
+	require.Equal(t, expectedDetails.HasDetails(), actualDetails.HasDetails())
+	if expectedDetails.HasDetails() {
+		var expectedValue, actualValue string
+		expectedDetails.Details(&expectedValue)
+		actualDetails.Details(&actualValue)
+		require.Equal(t, expectedValue, actualValue)
+	}
+}
+
 func printError(err error) {
 	switch err := err.(type) {
 	case *CanceledError:
@@ -160,6 +160,20 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
     )
 
+  /**
+  * RecordActivityTaskHeartbeatByID is called by application worker while it is processing an ActivityTask.  If worker fails
+  * to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
+  * 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeatByID' will
+  * fail with 'EntityNotExistsError' in such situations.  Instead of using 'taskToken' like in RecordActivityTaskHeartbeat,
+  * use Domain, WorkflowID, RunID and ActivityID
+  **/
+  shared.RecordActivityTaskHeartbeatResponse RecordActivityTaskHeartbeatByID(1: shared.RecordActivityTaskHeartbeatByIDRequest heartbeatRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
   /**
   * RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
   * result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
@@ -174,6 +188,20 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
     )
 
+  /**
+  * RespondActivityTaskCompletedByID is called by application worker when it is done processing an ActivityTask.
+  * It will result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
+  * created for the workflow so new decisions could be made.  Similar to RespondActivityTaskCompleted but use Domain,
+  * WorkflowID, RunID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'
+  * if these IDs are not valid anymore due to activity timeout.
+  **/
+  void  RespondActivityTaskCompletedByID(1: shared.RespondActivityTaskCompletedByIDRequest completeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
   /**
   * RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
   * result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
@@ -188,6 +216,20 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
     )
 
+  /**
+  * RespondActivityTaskFailedByID is called by application worker when it is done processing an ActivityTask.
+  * It will result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
+  * created for the workflow instance so new decisions could be made.  Similar to RespondActivityTaskFailed but use
+  * Domain, WorkflowID, RunID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'
+  * if these IDs are not valid anymore due to activity timeout.
+  **/
+  void  RespondActivityTaskFailedByID(1: shared.RespondActivityTaskFailedByIDRequest failRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
   /**
   * RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
   * result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
@@ -202,6 +244,20 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
     )
 
+  /**
+  * RespondActivityTaskCanceledByID is called by application worker when it is successfully canceled an ActivityTask.
+  * It will result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
+  * created for the workflow instance so new decisions could be made.  Similar to RespondActivityTaskCanceled but use
+  * Domain, WorkflowID, RunID and ActivityID instead of 'taskToken' for completion. It fails with 'EntityNotExistsError'
+  * if these IDs are not valid anymore due to activity timeout.
+  **/
+  void RespondActivityTaskCanceledByID(1: shared.RespondActivityTaskCanceledByIDRequest canceledRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
   /**
   * RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
   * It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
@@ -229,6 +285,21 @@ service WorkflowService {
       4: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * SignalWithStartWorkflowExecution is used to ensure sending signal to a workflow.
+  * If the workflow is running, this results in WorkflowExecutionSignaled event being recorded in the history
+  * and a decision task being created for the execution.
+  * If the workflow is not running or not found, this results in WorkflowExecutionStarted and WorkflowExecutionSignaled
+  * events being recorded in history, and a decision task being created for the execution
+  **/
+  shared.StartWorkflowExecutionResponse SignalWithStartWorkflowExecution(1: shared.SignalWithStartWorkflowExecutionRequest signalWithStartRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
   * in the history and immediately terminating the execution instance.
@@ -79,6 +79,7 @@ enum DecisionType {
   RecordMarker,
   ContinueAsNewWorkflowExecution,
   StartChildWorkflowExecution,
+  SignalExternalWorkflowExecution,
 }
 
 enum EventType {
@@ -120,6 +121,9 @@ enum EventType {
   ChildWorkflowExecutionCanceled,
   ChildWorkflowExecutionTimedOut,
   ChildWorkflowExecutionTerminated,
+  SignalExternalWorkflowExecutionInitiated,
+  SignalExternalWorkflowExecutionFailed,
+  ExternalWorkflowExecutionSignaled,
 }
 
 enum DecisionTaskFailedCause {
@@ -135,12 +139,17 @@ enum DecisionTaskFailedCause {
   BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
   BAD_CONTINUE_AS_NEW_ATTRIBUTES,
   START_TIMER_DUPLICATE_ID,
+  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
 }
 
 enum CancelExternalWorkflowExecutionFailedCause {
   UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,
 }
 
+enum SignalExternalWorkflowExecutionFailedCause {
+  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,
+}
+
 enum ChildWorkflowExecutionFailedCause {
   WORKFLOW_ALREADY_RUNNING,
 }
@@ -236,6 +245,14 @@ struct RequestCancelExternalWorkflowExecutionDecisionAttributes {
   40: optional binary control
 }
 
+struct SignalExternalWorkflowExecutionDecisionAttributes {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+  30: optional string signalName
+  40: optional binary input
+  50: optional binary control
+}
+
 struct RecordMarkerDecisionAttributes {
   10: optional string markerName
   20: optional binary details
@@ -274,6 +291,7 @@ struct Decision {
   80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes
   90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes
   100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes
+  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes
 }
 
 struct WorkflowExecutionStartedEventAttributes {
@@ -283,6 +301,7 @@ struct WorkflowExecutionStartedEventAttributes {
   40: optional i32 executionStartToCloseTimeoutSeconds
   50: optional i32 taskStartToCloseTimeoutSeconds
   60: optional string identity
+  70: optional string continuedExecutionRunId
 }
 
 struct WorkflowExecutionCompletedEventAttributes {
@@ -478,6 +497,31 @@ struct ExternalWorkflowExecutionCancelRequestedEventAttributes {
   30: optional WorkflowExecution workflowExecution
 }
 
+struct SignalExternalWorkflowExecutionInitiatedEventAttributes {
+  10: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  20: optional string domain
+  30: optional WorkflowExecution workflowExecution
+  40: optional string signalName
+  50: optional binary input
+  60: optional binary control
+}
+
+struct SignalExternalWorkflowExecutionFailedEventAttributes {
+  10: optional SignalExternalWorkflowExecutionFailedCause cause
+  20: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  30: optional string domain
+  40: optional WorkflowExecution workflowExecution
+  50: optional i64 (js.type = "Long") initiatedEventId
+  60: optional binary control
+}
+
+struct ExternalWorkflowExecutionSignaledEventAttributes {
+  10: optional i64 (js.type = "Long") initiatedEventId
+  20: optional string domain
+  30: optional WorkflowExecution workflowExecution
+  40: optional binary control
+}
+
 struct StartChildWorkflowExecutionInitiatedEventAttributes {
   10:  optional string domain
   20:  optional string workflowId
@@ -595,6 +639,9 @@ struct HistoryEvent {
   390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes
   400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes
   410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes
+  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes
+  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
+  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
 }
 
 struct History {
@@ -733,6 +780,15 @@ struct RecordActivityTaskHeartbeatResponse {
   10: optional bool cancelRequested
 }
 
+struct RecordActivityTaskHeartbeatByIDRequest {
+  10: optional string domain
+  20: optional string workflowID
+  30: optional string runID
+  40: optional string activityID
+  50: optional binary details
+  60: optional string identity
+}
+
 struct RespondActivityTaskCompletedRequest {
   10: optional binary taskToken
   20: optional binary result
@@ -752,6 +808,34 @@ struct RespondActivityTaskCanceledRequest {
   30: optional string identity
 }
 
+struct RespondActivityTaskCompletedByIDRequest {
+  10: optional string domain
+  20: optional string workflowID
+  30: optional string runID
+  40: optional string activityID
+  50: optional binary result
+  60: optional string identity
+}
+
+struct RespondActivityTaskFailedByIDRequest {
+  10: optional string domain
+  20: optional string workflowID
+  30: optional string runID
+  40: optional string activityID
+  50: optional string reason
+  60: optional binary details
+  70: optional string identity
+}
+
+struct RespondActivityTaskCanceledByIDRequest {
+  10: optional string domain
+  20: optional string workflowID
+  30: optional string runID
+  40: optional string activityID
+  50: optional binary details
+  60: optional string identity
+}
+
 struct RequestCancelWorkflowExecutionRequest {
   10: optional string domain
   20: optional WorkflowExecution workflowExecution
@@ -779,6 +863,20 @@ struct SignalWorkflowExecutionRequest {
   50: optional string identity
 }
 
+struct SignalWithStartWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional string workflowId
+  30: optional WorkflowType workflowType
+  40: optional TaskList taskList
+  50: optional binary input
+  60: optional i32 executionStartToCloseTimeoutSeconds
+  70: optional i32 taskStartToCloseTimeoutSeconds
+  80: optional string identity
+  90: optional string requestId
+  100: optional string signalName
+  110: optional binary signalInput
+}
+
 struct TerminateWorkflowExecutionRequest {
   10: optional string domain
   20: optional WorkflowExecution workflowExecution
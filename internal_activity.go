@@ -26,9 +26,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/common"
+	"go.uber.org/cadence/common/backoff"
 	"go.uber.org/zap"
 )
 
@@ -56,6 +63,16 @@ type (
 		HeartbeatTimeoutSeconds       int32
 		WaitForCancellation           bool
 		OriginalTaskListName          string
+		RetryPolicy                   *RetryPolicy
+	}
+
+	// executeLocalActivityParameters configuration parameters for executing a local activity
+	executeLocalActivityParameters struct {
+		ActivityType           ActivityType
+		ActivityFn             interface{} // nil when the local activity is referred to by name
+		Input                  []byte
+		ScheduleToCloseTimeout time.Duration
+		RetryPolicy            *RetryPolicy
 	}
 
 	// asyncActivityClient for requesting activity execution
@@ -71,19 +88,38 @@ type (
 		RequestCancelActivity(activityID string)
 	}
 
+	// localActivityClient for requesting local activity execution
+	localActivityClient interface {
+		// ExecuteLocalActivity schedules a local activity with a callback handler. The local activity is executed by
+		// the worker once the workflow code is blocked, and the callback is invoked with its result before the workflow
+		// code is resumed.
+		ExecuteLocalActivity(parameters executeLocalActivityParameters, callback resultHandler) *activityInfo
+
+		// RequestCancelLocalActivity cancels a local activity that hasn't been executed yet, and invokes its callback
+		// handler with CanceledError. Otherwise it is a no-operation.
+		RequestCancelLocalActivity(activityID string)
+	}
+
 	activityEnvironment struct {
 		taskToken         []byte
 		workflowExecution WorkflowExecution
 		activityID        string
 		activityType      ActivityType
+		attempt           int32
 		serviceInvoker    ServiceInvoker
 		logger            *zap.Logger
 		metricsScope      tally.Scope
+		dataConverter     DataConverter
 	}
 )
 
 const activityEnvContextKey = "activityEnv"
 const activityOptionsContextKey = "activityOptions"
+const localActivityOptionsContextKey = "localActivityOptions"
+
+// activityAttemptSeparator separates the ActivityID of the first attempt of an activity from the attempt number, in
+// the ActivityID of its retries.
+const activityAttemptSeparator = "#attempt-"
 
 func getActivityEnv(ctx context.Context) *activityEnvironment {
 	env := ctx.Value(activityEnvContextKey)
@@ -93,6 +129,16 @@ func getActivityEnv(ctx context.Context) *activityEnvironment {
 	return env.(*activityEnvironment)
 }
 
+// getDataConverterFromActivityCtx returns the DataConverter of the activity, or the default one if the context
+// was not set up with one.
+func getDataConverterFromActivityCtx(ctx context.Context) DataConverter {
+	env, ok := ctx.Value(activityEnvContextKey).(*activityEnvironment)
+	if !ok || env.dataConverter == nil {
+		return getDefaultDataConverter()
+	}
+	return env.dataConverter
+}
+
 func getActivityOptions(ctx Context) *executeActivityParameters {
 	eap := ctx.Value(activityOptionsContextKey)
 	if eap == nil {
@@ -127,10 +173,302 @@ func getValidatedActivityOptions(ctx Context) (*executeActivityParameters, error
 	if p.HeartbeatTimeoutSeconds < 0 {
 		return nil, errors.New("invalid negative HeartbeatTimeoutSeconds")
 	}
+	if p.ActivityID != nil && strings.Contains(*p.ActivityID, activityAttemptSeparator) {
+		return nil, fmt.Errorf("ActivityID cannot contain %q", activityAttemptSeparator)
+	}
+	if err := validateRetryPolicy(p.RetryPolicy); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
 
+func getValidatedLocalActivityOptions(ctx Context) (*LocalActivityOptions, error) {
+	p, ok := ctx.Value(localActivityOptionsContextKey).(*LocalActivityOptions)
+	if !ok {
+		return nil, errLocalActivityParamsBadRequest
+	}
+	if p.ScheduleToCloseTimeout <= 0 {
+		return nil, errors.New("missing or negative ScheduleToCloseTimeout")
+	}
+	if err := validateRetryPolicy(p.RetryPolicy); err != nil {
+		return nil, err
+	}
 	return p, nil
 }
 
+func validateRetryPolicy(p *RetryPolicy) error {
+	if p == nil {
+		return nil
+	}
+	if p.InitialInterval <= 0 {
+		return errors.New("missing or negative InitialInterval on retry policy")
+	}
+	if p.BackoffCoefficient != 0 && p.BackoffCoefficient < 1 {
+		return errors.New("BackoffCoefficient on retry policy cannot be less than 1")
+	}
+	if p.MaximumInterval < 0 {
+		return errors.New("negative MaximumInterval on retry policy")
+	}
+	if p.ExpirationInterval < 0 {
+		return errors.New("negative ExpirationInterval on retry policy")
+	}
+	if p.MaximumAttempts < 0 {
+		return errors.New("negative MaximumAttempts on retry policy")
+	}
+	return nil
+}
+
+// computeNextDelay returns the backoff interval before retrying the given failed attempt (starting with 1) that
+// happened elapsed after the first attempt started, or false if the failure should not be retried.
+// Timeouts of an attempt are retried, for activities and local activities alike; the overall timeout is enforced
+// by the callers.
+func (p *RetryPolicy) computeNextDelay(attempt int32, elapsed time.Duration, err error) (time.Duration, bool) {
+	if p == nil {
+		return 0, false
+	}
+	if p.MaximumAttempts > 0 && attempt >= p.MaximumAttempts {
+		return 0, false
+	}
+	switch err := err.(type) {
+	case *CanceledError:
+		return 0, false
+	case *CustomError:
+		for _, reason := range p.NonRetriableErrorReasons {
+			if reason == err.Reason() {
+				return 0, false
+			}
+		}
+	}
+
+	policy := backoff.NewExponentialRetryPolicy(p.InitialInterval)
+	if p.BackoffCoefficient != 0 {
+		policy.SetBackoffCoefficient(p.BackoffCoefficient)
+	}
+	policy.SetMaximumInterval(p.MaximumInterval)
+	policy.SetExpirationInterval(p.ExpirationInterval)
+	policy.SetMaximumAttempts(0) // attempts are limited above
+	delay := policy.ComputeNextDelay(elapsed, int(attempt-1))
+	if delay < 0 {
+		return 0, false
+	}
+	return delay, true
+}
+
+// getActivityAttemptID returns the ActivityID of the given retry attempt of an activity.
+func getActivityAttemptID(activityID string, attempt int32) string {
+	return fmt.Sprintf("%v%v%d", activityID, activityAttemptSeparator, attempt)
+}
+
+// getActivityAttempt returns the retry attempt of an activity from its ActivityID.
+func getActivityAttempt(activityID string) int32 {
+	i := strings.LastIndex(activityID, activityAttemptSeparator)
+	if i < 0 {
+		return 1
+	}
+	attempt, err := strconv.ParseInt(activityID[i+len(activityAttemptSeparator):], 10, 32)
+	if err != nil || attempt < 2 {
+		return 1
+	}
+	return int32(attempt)
+}
+
+// newLocalActivityContext returns the context a local activity is executed with.
+func newLocalActivityContext(
+	ctx context.Context,
+	activityID string,
+	activityType ActivityType,
+	workflowExecution WorkflowExecution,
+	logger *zap.Logger,
+	scope tally.Scope,
+	dataConverter DataConverter,
+) context.Context {
+	return context.WithValue(ctx, activityEnvContextKey, &activityEnvironment{
+		workflowExecution: workflowExecution,
+		activityID:        activityID,
+		activityType:      activityType,
+		logger:            logger,
+		metricsScope:      scope,
+		dataConverter:     dataConverter,
+	})
+}
+
+// executeLocalActivity runs a local activity in the worker process, retrying it according to its retry policy,
+// until it succeeds, it fails with an error that is not retried or its ScheduleToCloseTimeout expires.
+func executeLocalActivity(ctx context.Context, a activity, params executeLocalActivityParameters) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, params.ScheduleToCloseTimeout)
+	defer cancel()
+
+	startTime := time.Now()
+	for attempt := int32(1); ; attempt++ {
+		env := *getActivityEnv(ctx)
+		env.attempt = attempt
+		result, err := executeLocalActivityAttempt(context.WithValue(ctx, activityEnvContextKey, &env), a, params.Input)
+		if err == nil {
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			return nil, NewTimeoutError(shared.TimeoutType_SCHEDULE_TO_CLOSE)
+		}
+		delay, retry := params.RetryPolicy.computeNextDelay(attempt, time.Now().Sub(startTime), err)
+		if !retry {
+			return nil, err
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, NewTimeoutError(shared.TimeoutType_SCHEDULE_TO_CLOSE)
+		}
+	}
+}
+
+func executeLocalActivityAttempt(ctx context.Context, a activity, input []byte) ([]byte, error) {
+	type activityResult struct {
+		result []byte
+		err    error
+	}
+	resultCh := make(chan activityResult, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				topLine := fmt.Sprintf("local activity for %s [panic]:", a.ActivityType().Name)
+				st := getStackTraceRaw(topLine, 7, 0)
+				resultCh <- activityResult{err: newPanicError(p, st)}
+			}
+		}()
+		result, err := a.Execute(ctx, input)
+		resultCh <- activityResult{result: result, err: err}
+	}()
+
+	select {
+	case r := <-resultCh:
+		return r.result, r.err
+	case <-ctx.Done():
+		// The local activity doesn't honor its context, abandon it.
+		return nil, NewTimeoutError(shared.TimeoutType_SCHEDULE_TO_CLOSE)
+	}
+}
+
+// activityRetryState tracks the attempts of an activity that is executed with a retry policy.
+type activityRetryState struct {
+	ctx        Context
+	env        workflowEnvironment
+	parameters executeActivityParameters
+	callback   resultHandler
+	startTime  time.Time
+	activityID string // ActivityID of the first attempt
+	attempt    int32
+
+	pendingActivity *activityInfo
+	pendingTimer    *timerInfo
+}
+
+// executeActivityWithRetryPolicy executes an activity, and schedules it again after the backoff interval computed by
+// its retry policy every time it fails. The callback is invoked with the result of the last attempt.
+func executeActivityWithRetryPolicy(ctx Context, parameters executeActivityParameters, callback resultHandler) {
+	state := &activityRetryState{
+		ctx:        ctx,
+		env:        getWorkflowEnvironment(ctx),
+		parameters: parameters,
+		callback:   callback,
+		startTime:  Now(ctx),
+	}
+	state.scheduleAttempt()
+	Go(ctx, func(ctx Context) {
+		if ctx.Done() == nil {
+			return // not cancellable.
+		}
+		if ctx.Done().Receive(ctx, nil); ctx.Err() == ErrCanceled || ctx.Err() == ErrDeadlineExceeded {
+			state.cancel()
+		}
+	})
+}
+
+func (s *activityRetryState) scheduleAttempt() {
+	s.attempt++
+	parameters := s.parameters
+	if s.attempt > 1 {
+		parameters.ActivityID = common.StringPtr(getActivityAttemptID(s.activityID, s.attempt))
+	}
+	s.pendingActivity = s.env.ExecuteActivity(limitActivityTimeoutsToDeadline(s.ctx, parameters), s.handleAttemptResult)
+	if s.attempt == 1 {
+		s.activityID = s.pendingActivity.activityID
+	}
+}
+
+func (s *activityRetryState) handleAttemptResult(result []byte, err error) {
+	s.pendingActivity = nil
+	if err == nil || s.ctx.Err() != nil {
+		s.callback(result, err)
+		return
+	}
+
+	delay, retry := computeRetryBackoff(s.env, s.parameters.RetryPolicy, s.attempt, s.startTime, err)
+	if !retry {
+		s.callback(nil, err)
+		return
+	}
+	s.pendingTimer = s.env.NewTimer(delay, func(r []byte, timerErr error) {
+		s.pendingTimer = nil
+		if timerErr != nil {
+			s.callback(nil, timerErr)
+			return
+		}
+		s.scheduleAttempt()
+	})
+}
+
+func (s *activityRetryState) cancel() {
+	if s.pendingActivity != nil {
+		s.env.RequestCancelActivity(s.pendingActivity.activityID)
+	}
+	if s.pendingTimer != nil {
+		s.env.RequestCancelTimer(s.pendingTimer.timerID)
+	}
+}
+
+// computeRetryBackoff returns the backoff interval before retrying the given failed attempt, or false if the retry
+// policy gives up. The interval is jittered, so it is recorded as a side effect to be the same when replayed. It is
+// rounded up to whole seconds, the resolution of timers.
+func computeRetryBackoff(env workflowEnvironment, policy *RetryPolicy, attempt int32, startTime time.Time, failure error) (time.Duration, bool) {
+	elapsed := env.Now().Sub(startTime)
+	delay := time.Duration(-1)
+	env.SideEffect(func() ([]byte, error) {
+		next, retry := policy.computeNextDelay(attempt, elapsed, failure)
+		if !retry {
+			next = -1
+		}
+		return getDefaultDataConverter().ToData(next)
+	}, func(data []byte, err error) {
+		if err == nil {
+			err = getDefaultDataConverter().FromData(data, &delay)
+		}
+		if err != nil {
+			delay = -1
+		}
+	})
+	if delay < 0 {
+		return 0, false
+	}
+	return time.Duration(math.Ceil(delay.Seconds())) * time.Second, true
+}
+
+// limitActivityTimeoutsToDeadline caps the timeouts of an activity to the deadline of ctx, if any.
+func limitActivityTimeoutsToDeadline(ctx Context, p executeActivityParameters) executeActivityParameters {
+	if remaining, ok := remainingTimeoutSeconds(ctx); ok {
+		if p.ScheduleToCloseTimeoutSeconds > remaining {
+			p.ScheduleToCloseTimeoutSeconds = remaining
+		}
+		if p.ScheduleToStartTimeoutSeconds > remaining {
+			p.ScheduleToStartTimeoutSeconds = remaining
+		}
+		if p.StartToCloseTimeoutSeconds > remaining {
+			p.StartToCloseTimeoutSeconds = remaining
+		}
+	}
+	return p
+}
+
 func validateFunctionArgs(f interface{}, args []interface{}, isWorkflow bool) error {
 	fType := reflect.TypeOf(f)
 	if fType.Kind() != reflect.Func {
@@ -197,32 +535,46 @@ func validateFunctionResults(f interface{}, result interface{}) ([]byte, error)
 	return data, nil
 }
 
-func getValidatedActivityFunction(f interface{}, args []interface{}) (*ActivityType, []byte, error) {
-	fnName := ""
+func getValidatedActivityFunction(
+	f interface{},
+	args []interface{},
+	registry *hostEnvImpl,
+	dc DataConverter,
+) (*ActivityType, []byte, error) {
+	fnName, err := getValidatedActivityType(f, args, registry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input, err := encodeArgs(dc, args)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &ActivityType{Name: fnName}, input, nil
+}
+
+// getValidatedActivityType validates the arguments of the activity function, and returns the activity type name it is
+// registered under.
+func getValidatedActivityType(f interface{}, args []interface{}, registry *hostEnvImpl) (string, error) {
 	fType := reflect.TypeOf(f)
 	switch fType.Kind() {
 	case reflect.String:
-		fnName = reflect.ValueOf(f).String()
+		return reflect.ValueOf(f).String(), nil
 
 	case reflect.Func:
 		if err := validateFunctionArgs(f, args, false); err != nil {
-			return nil, nil, err
+			return "", err
 		}
-		fnName = getFunctionName(f)
-		if alias, ok := getHostEnvironment().getActivityAlias(fnName); ok {
+		fnName := getFunctionName(f)
+		if alias, ok := registry.getActivityAlias(fnName); ok {
 			fnName = alias
 		}
+		return fnName, nil
 
 	default:
-		return nil, nil, fmt.Errorf(
+		return "", fmt.Errorf(
 			"Invalid type 'f' parameter provided, it can be either activity function or name of the activity: %v", f)
 	}
-
-	input, err := getHostEnvironment().encodeArgs(args)
-	if err != nil {
-		return nil, nil, err
-	}
-	return &ActivityType{Name: fnName}, input, nil
 }
 
 func isActivityContext(inType reflect.Type) bool {
@@ -230,7 +582,7 @@ func isActivityContext(inType reflect.Type) bool {
 	return inType.Implements(contextElem)
 }
 
-func validateFunctionAndGetResults(f interface{}, values []reflect.Value) ([]byte, error) {
+func validateFunctionAndGetResults(f interface{}, values []reflect.Value, dc DataConverter) ([]byte, error) {
 	fnName := getFunctionName(f)
 	resultSize := len(values)
 
@@ -247,7 +599,7 @@ func validateFunctionAndGetResults(f interface{}, values []reflect.Value) ([]byt
 	if resultSize > 1 {
 		retValue := values[0]
 		if retValue.Kind() != reflect.Ptr || !retValue.IsNil() {
-			result, err = getHostEnvironment().encodeArg(retValue.Interface())
+			result, err = encodeArg(dc, retValue.Interface())
 			if err != nil {
 				return nil, err
 			}
@@ -268,7 +620,7 @@ func validateFunctionAndGetResults(f interface{}, values []reflect.Value) ([]byt
 	return result, errInterface
 }
 
-func deSerializeFnResultFromFnType(fnType reflect.Type, result []byte, to interface{}) error {
+func deSerializeFnResultFromFnType(fnType reflect.Type, result []byte, to interface{}, dc DataConverter) error {
 	if fnType.Kind() != reflect.Func {
 		return fmt.Errorf("expecting only function type but got type: %v", fnType)
 	}
@@ -280,7 +632,7 @@ func deSerializeFnResultFromFnType(fnType reflect.Type, result []byte, to interf
 		if result == nil {
 			return nil
 		}
-		err := getHostEnvironment().decodeArg(result, to)
+		err := decodeArg(dc, result, to)
 		if err != nil {
 			return err
 		}
@@ -288,24 +640,30 @@ func deSerializeFnResultFromFnType(fnType reflect.Type, result []byte, to interf
 	return nil
 }
 
-func deSerializeFunctionResult(f interface{}, result []byte, to interface{}) error {
+func deSerializeFunctionResult(
+	f interface{},
+	result []byte,
+	to interface{},
+	registry *hostEnvImpl,
+	dc DataConverter,
+) error {
 	fType := reflect.TypeOf(f)
 
 	switch fType.Kind() {
 	case reflect.Func:
 		// We already validated that it either have (result, error) (or) just error.
-		return deSerializeFnResultFromFnType(fType, result, to)
+		return deSerializeFnResultFromFnType(fType, result, to, dc)
 
 	case reflect.String:
 		// If we know about this function through registration then we will try to return corresponding result type.
 		fnName := reflect.ValueOf(f).String()
-		if fnRegistered, ok := getHostEnvironment().getActivityFn(fnName); ok {
-			return deSerializeFnResultFromFnType(reflect.TypeOf(fnRegistered), result, to)
+		if fnRegistered, ok := registry.getActivityFn(fnName); ok {
+			return deSerializeFnResultFromFnType(reflect.TypeOf(fnRegistered), result, to, dc)
 		}
 	}
 
 	// For everything we return result.
-	return getHostEnvironment().decodeArg(result, to)
+	return decodeArg(dc, result, to)
 }
 
 func setActivityParametersIfNotExist(ctx Context) Context {
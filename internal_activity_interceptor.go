@@ -0,0 +1,163 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"context"
+	"reflect"
+)
+
+const activityInterceptorContextKey = "activityInterceptor"
+
+// activityEnvironmentInterceptor is the last ActivityInterceptor of the chain, it executes the activity and records
+// the heartbeats. The arguments and the result that the interceptors pass through unchanged keep their original
+// encoding, which avoids encoding them again and losing what the decoding dropped, like unknown fields. They are
+// compared with a separate decoding of the original encoding, so that changes made in place are detected too.
+type activityEnvironmentInterceptor struct {
+	activity      activity
+	dataConverter DataConverter
+
+	input  []byte        // encoded arguments of the activity task
+	args   []interface{} // arguments decoded from input, not shared with the interceptors
+	output []byte        // encoded result of the last execution
+	result interface{}   // result decoded from output, not shared with the interceptors
+}
+
+var _ ActivityInterceptor = (*activityEnvironmentInterceptor)(nil)
+
+func (a *activityEnvironmentInterceptor) ExecuteActivity(ctx context.Context, activityType string, args ...interface{}) (interface{}, error) {
+	input := a.input
+	if !reflect.DeepEqual(args, a.args) {
+		var err error
+		if input, err = encodeActivityArgs(a.dataConverter, args); err != nil {
+			return nil, err
+		}
+	}
+	output, err := a.activity.Execute(ctx, input)
+	result, decodeErr := decodeActivityResult(a.dataConverter, a.activity.GetFunction(), output)
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+	a.output = output
+	if a.result, decodeErr = decodeActivityResult(a.dataConverter, a.activity.GetFunction(), output); decodeErr != nil {
+		return nil, decodeErr
+	}
+	return result, err
+}
+
+func (a *activityEnvironmentInterceptor) RecordActivityHeartbeat(ctx context.Context, details ...interface{}) {
+	sendActivityHeartbeat(ctx, details...)
+}
+
+// executeActivityWithInterceptors executes the activity through the interceptors created by the factories. The first
+// factory creates the outermost interceptor, so the interceptors are called in the order of the factories.
+func executeActivityWithInterceptors(
+	ctx context.Context,
+	a activity,
+	input []byte,
+	factories []ActivityInterceptorFactory,
+	dc DataConverter,
+) ([]byte, error) {
+	if len(factories) == 0 {
+		return a.Execute(ctx, input)
+	}
+	args, err := decodeActivityArgs(dc, a.GetFunction(), input)
+	if err != nil {
+		return nil, err
+	}
+	env := &activityEnvironmentInterceptor{activity: a, dataConverter: dc, input: input}
+	if env.args, err = decodeActivityArgs(dc, a.GetFunction(), input); err != nil {
+		return nil, err
+	}
+	info := GetActivityInfo(ctx)
+	var interceptor ActivityInterceptor = env
+	for i := len(factories) - 1; i >= 0; i-- {
+		interceptor = factories[i].NewInterceptor(&info, interceptor)
+	}
+	ctx = context.WithValue(ctx, activityInterceptorContextKey, interceptor)
+	result, err := interceptor.ExecuteActivity(ctx, info.ActivityType.Name, args...)
+	if reflect.DeepEqual(result, env.result) {
+		return env.output, err
+	}
+	output, encodeErr := encodeActivityResult(dc, result)
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+	return output, err
+}
+
+// getActivityInterceptor returns the first interceptor of the chain of the activity, the one that records the
+// heartbeats if the activity is not executed through interceptors.
+func getActivityInterceptor(ctx context.Context) ActivityInterceptor {
+	if interceptor, ok := ctx.Value(activityInterceptorContextKey).(ActivityInterceptor); ok {
+		return interceptor
+	}
+	return &activityEnvironmentInterceptor{}
+}
+
+func decodeActivityArgs(dc DataConverter, fn interface{}, input []byte) ([]interface{}, error) {
+	if _, ok := fn.(DynamicActivityFunc); ok {
+		return []interface{}{newEncodedValues(input, dc)}, nil
+	}
+	values, err := decodeArgs(dc, reflect.TypeOf(fn), input)
+	if err != nil {
+		return nil, err
+	}
+	var args []interface{}
+	for _, v := range values {
+		args = append(args, v.Interface())
+	}
+	return args, nil
+}
+
+func encodeActivityArgs(dc DataConverter, args []interface{}) ([]byte, error) {
+	if len(args) == 1 {
+		if values, ok := args[0].(*encodedValues); ok {
+			return values.values, nil
+		}
+	}
+	return encodeArgs(dc, args)
+}
+
+func decodeActivityResult(dc DataConverter, fn interface{}, output []byte) (interface{}, error) {
+	if output == nil {
+		return nil, nil
+	}
+	fnType := reflect.TypeOf(fn)
+	if _, ok := fn.(DynamicActivityFunc); ok || fnType.NumOut() < 2 {
+		return newEncodedValue(output, dc), nil
+	}
+	result := reflect.New(fnType.Out(0))
+	if err := decodeArg(dc, output, result.Interface()); err != nil {
+		return nil, err
+	}
+	return result.Elem().Interface(), nil
+}
+
+func encodeActivityResult(dc DataConverter, result interface{}) ([]byte, error) {
+	if result == nil {
+		return nil, nil
+	}
+	if value, ok := result.(*encodedValue); ok {
+		return value.value, nil
+	}
+	return encodeArg(dc, result)
+}
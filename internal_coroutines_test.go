@@ -561,6 +561,213 @@ func TestDispatchClose(t *testing.T) {
 	require.EqualValues(t, expected, history)
 }
 
+func TestAwait(t *testing.T) {
+	var history []string
+	var c Channel
+	d := newDispatcher(background, func(ctx Context) {
+		c = NewChannel(ctx)
+		counter := 0
+		Go(ctx, func(ctx Context) {
+			for c.Receive(ctx, nil) {
+				counter++
+				history = append(history, fmt.Sprintf("child-%v", counter))
+			}
+		})
+		history = append(history, "root-before-await")
+		err := Await(ctx, func() bool { return counter == 2 })
+		assert.NoError(t, err)
+		history = append(history, "root-after-await")
+	})
+	d.ExecuteUntilAllBlocked()
+	require.False(t, d.IsDone())
+	require.Contains(t, d.StackTrace(), "coroutine 1 [blocked on Await]:")
+
+	c.SendAsync(nil)
+	d.ExecuteUntilAllBlocked()
+	require.False(t, d.IsDone())
+	c.SendAsync(nil)
+	d.ExecuteUntilAllBlocked()
+
+	expected := []string{
+		"root-before-await",
+		"child-1",
+		"child-2",
+		"root-after-await",
+	}
+	require.EqualValues(t, expected, history)
+}
+
+func TestAwaitCancellation(t *testing.T) {
+	var err error
+	var cancel CancelFunc
+	d := newDispatcher(background, func(ctx Context) {
+		ctx, cancel = WithCancel(ctx)
+		err = Await(ctx, func() bool { return false })
+	})
+	d.ExecuteUntilAllBlocked()
+	require.False(t, d.IsDone())
+	cancel()
+	d.ExecuteUntilAllBlocked()
+	require.True(t, d.IsDone())
+	require.Equal(t, ErrCanceled, err)
+}
+
+func TestMutex(t *testing.T) {
+	var history []string
+	var c Channel
+	d := newDispatcher(background, func(ctx Context) {
+		c = NewChannel(ctx)
+		m := NewMutex(ctx)
+		for i := 1; i <= 2; i++ {
+			ii := i
+			Go(ctx, func(ctx Context) {
+				assert.NoError(t, m.Lock(ctx))
+				history = append(history, fmt.Sprintf("child-%v-locked", ii))
+				c.Receive(ctx, nil)
+				history = append(history, fmt.Sprintf("child-%v-unlocked", ii))
+				m.Unlock()
+			})
+		}
+		assert.NoError(t, Await(ctx, func() bool { return len(history) > 0 }))
+		assert.False(t, m.TryLock())
+		assert.NoError(t, m.Lock(ctx))
+		history = append(history, "root-locked")
+		m.Unlock()
+	})
+	d.ExecuteUntilAllBlocked()
+	require.False(t, d.IsDone())
+	require.Contains(t, d.StackTrace(), "coroutine 1 [blocked on mutex-1.Lock]:")
+	require.Contains(t, d.StackTrace(), "coroutine 3 [blocked on mutex-1.Lock]:")
+
+	c.SendAsync(nil)
+	d.ExecuteUntilAllBlocked()
+	require.False(t, d.IsDone())
+	c.SendAsync(nil)
+	d.ExecuteUntilAllBlocked()
+	require.True(t, d.IsDone(), d.StackTrace())
+
+	expected := []string{
+		"child-1-locked",
+		"child-1-unlocked",
+		"child-2-locked",
+		"child-2-unlocked",
+		"root-locked",
+	}
+	require.EqualValues(t, expected, history)
+}
+
+func TestMutexCancellation(t *testing.T) {
+	var err error
+	var cancel CancelFunc
+	var m Mutex
+	d := newDispatcher(background, func(ctx Context) {
+		m = NewMutex(ctx)
+		require.True(t, m.TryLock())
+		ctx, cancel = WithCancel(ctx)
+		err = m.Lock(ctx)
+	})
+	d.ExecuteUntilAllBlocked()
+	require.False(t, d.IsDone())
+	cancel()
+	d.ExecuteUntilAllBlocked()
+	require.True(t, d.IsDone(), d.StackTrace())
+	require.Equal(t, ErrCanceled, err)
+
+	// The canceled waiter doesn't get the lock once it is released.
+	m.Unlock()
+	require.True(t, m.TryLock())
+}
+
+func TestSemaphore(t *testing.T) {
+	var history []string
+	d := newDispatcher(background, func(ctx Context) {
+		sem := NewSemaphore(ctx, 3)
+		require.True(t, sem.TryAcquire(2))
+		acquired := sem.AcquireFuture(ctx, 2)
+		Go(ctx, func(ctx Context) {
+			assert.NoError(t, sem.Acquire(ctx, 2))
+			history = append(history, "child-1-acquired")
+			sem.Release(2)
+		})
+		Go(ctx, func(ctx Context) {
+			// Waits behind the first child even when a permit is available.
+			assert.NoError(t, sem.Acquire(ctx, 1))
+			history = append(history, "child-2-acquired")
+			sem.Release(1)
+		})
+		c := NewChannel(ctx)
+		Go(ctx, func(ctx Context) {
+			c.Send(ctx, nil)
+		})
+		NewSelector(ctx).
+			AddFuture(acquired, func(f Future) {
+				history = append(history, "root-future-ready")
+			}).
+			AddReceive(c, func(c Channel, more bool) {
+				c.Receive(ctx, nil)
+				history = append(history, "root-received")
+			}).
+			Select(ctx)
+		sem.Release(2)
+		require.True(t, acquired.IsReady())
+		assert.NoError(t, acquired.Get(ctx, nil))
+		sem.Release(2)
+		assert.NoError(t, sem.Acquire(ctx, 3))
+		history = append(history, "root-acquired")
+	})
+	d.ExecuteUntilAllBlocked()
+	require.True(t, d.IsDone(), d.StackTrace())
+
+	expected := []string{
+		"root-received",
+		"child-1-acquired",
+		"child-2-acquired",
+		"root-acquired",
+	}
+	require.EqualValues(t, expected, history)
+}
+
+func TestWaitGroup(t *testing.T) {
+	var history []string
+	d := newDispatcher(background, func(ctx Context) {
+		wg := NewWaitGroup(ctx)
+		c := NewChannel(ctx)
+		for i := 1; i <= 2; i++ {
+			ii := i
+			wg.Add(1)
+			Go(ctx, func(ctx Context) {
+				defer wg.Done()
+				c.Receive(ctx, nil)
+				history = append(history, fmt.Sprintf("child-%v", ii))
+			})
+		}
+		s := NewSelector(ctx)
+		s.AddFuture(wg.WaitFuture(ctx), func(f Future) {
+			assert.NoError(t, f.Get(ctx, nil))
+			history = append(history, "wait-future-ready")
+		})
+		s.AddSend(c, nil, func() {
+			history = append(history, "sent")
+		})
+		s.Select(ctx)
+		c.Send(ctx, nil)
+		assert.NoError(t, wg.Wait(ctx))
+		history = append(history, "root-done")
+		s.Select(ctx)
+	})
+	d.ExecuteUntilAllBlocked()
+	require.True(t, d.IsDone(), d.StackTrace())
+
+	expected := []string{
+		"child-1",
+		"sent",
+		"child-2",
+		"root-done",
+		"wait-future-ready",
+	}
+	require.EqualValues(t, expected, history)
+}
+
 func TestPanic(t *testing.T) {
 	var history []string
 	d := newDispatcher(background, func(ctx Context) {
@@ -0,0 +1,323 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+// All code in this file is private to the package.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// cronSchedule is a parsed cron expression made of the five standard fields: minute, hour, day of month, month
+	// and day of week. Each field accepts *, values, ranges (1-5), lists (1,15) and steps (*/15, 0-30/10); the month
+	// and day of week fields also accept three letter names (jan, mon). The descriptors @yearly, @annually,
+	// @monthly, @weekly, @daily, @midnight and @hourly are accepted in place of the fields.
+	// Schedules are evaluated in UTC, so all the workers agree on the fire times of a workflow.
+	cronSchedule struct {
+		minute, hour, dayOfMonth, month, dayOfWeek cronField
+		// When both day fields are restricted, a day matches if either of them matches, like in crontab.
+		dayOfMonthStar, dayOfWeekStar bool
+	}
+
+	// cronField is the set of the values allowed by a field of a cron expression.
+	cronField uint64
+
+	// cronWorkflowInput is the input of a run of a cron workflow. The schedule is run by the workers: the input of the
+	// workflow is wrapped with the state of the schedule, which is carried from run to run through continue as new.
+	// Neither the schedule nor the outcome of the previous run are sent to the server in dedicated fields.
+	cronWorkflowInput struct {
+		Schedule             string
+		RunTimeoutSeconds    int32   // ExecutionStartToCloseTimeout of the run, not counting the wait for FireTime
+		FireTime             int64   // UnixNano time the run starts at, zero to start right away
+		LastCompletionResult []byte  // result of the previous run
+		LastFailureReason    *string // error of the previous run, if it failed
+		LastFailureDetails   []byte
+		Input                []byte // input of the workflow
+	}
+)
+
+var (
+	// cronWorkflowInputPrefix tells the input of a run of a cron workflow apart from the input of other executions.
+	cronWorkflowInputPrefix = []byte("\x00cadence-cron\x00")
+
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+
+	cronMonthNames = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+
+	cronDayOfWeekNames = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+)
+
+// parseCronSchedule parses a cron expression, see cronSchedule for the accepted syntax.
+func parseCronSchedule(spec string) (*cronSchedule, error) {
+	expression := strings.TrimSpace(spec)
+	if strings.HasPrefix(expression, "@") {
+		fields, ok := cronDescriptors[strings.ToLower(expression)]
+		if !ok {
+			return nil, fmt.Errorf("invalid cron schedule %q: unknown descriptor", spec)
+		}
+		expression = fields
+	}
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron schedule %q: expected 5 fields, got %v", spec, len(fields))
+	}
+
+	var err error
+	schedule := &cronSchedule{
+		dayOfMonthStar: isCronStar(fields[2]),
+		dayOfWeekStar:  isCronStar(fields[4]),
+	}
+	if schedule.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: minute: %v", spec, err)
+	}
+	if schedule.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: hour: %v", spec, err)
+	}
+	if schedule.dayOfMonth, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: day of month: %v", spec, err)
+	}
+	if schedule.month, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: month: %v", spec, err)
+	}
+	// 7 is accepted as Sunday too.
+	if schedule.dayOfWeek, err = parseCronField(fields[4], 0, 7, cronDayOfWeekNames); err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: day of week: %v", spec, err)
+	}
+	if schedule.dayOfWeek.has(7) {
+		schedule.dayOfWeek |= 1
+	}
+	return schedule, nil
+}
+
+func isCronStar(field string) bool {
+	return field == "*" || field == "?"
+}
+
+func parseCronField(field string, min, max int, names map[string]int) (cronField, error) {
+	var result cronField
+	for _, part := range strings.Split(field, ",") {
+		rangeAndStep := strings.SplitN(part, "/", 2)
+		start, end, step := min, max, 1
+		if !isCronStar(rangeAndStep[0]) {
+			bounds := strings.SplitN(rangeAndStep[0], "-", 2)
+			var err error
+			if start, err = parseCronValue(bounds[0], names); err != nil {
+				return 0, err
+			}
+			switch {
+			case len(bounds) == 2:
+				if end, err = parseCronValue(bounds[1], names); err != nil {
+					return 0, err
+				}
+			case len(rangeAndStep) == 1:
+				// A single value, while "5/10" stands for "5-max/10".
+				end = start
+			}
+		}
+		if len(rangeAndStep) == 2 {
+			var err error
+			if step, err = strconv.Atoi(rangeAndStep[1]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", rangeAndStep[1])
+			}
+		}
+		if start < min || end > max || start > end {
+			return 0, fmt.Errorf("%q is out of range [%v-%v]", part, min, max)
+		}
+		for i := start; i <= end; i += step {
+			result |= 1 << uint(i)
+		}
+	}
+	return result, nil
+}
+
+func parseCronValue(value string, names map[string]int) (int, error) {
+	if n, ok := names[strings.ToLower(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	return n, nil
+}
+
+func (f cronField) has(value int) bool {
+	return f&(1<<uint(value)) != 0
+}
+
+// next returns the first fire time of the schedule strictly after t. It returns the zero time when the schedule does
+// not fire within the next five years, as for "0 0 30 2 *".
+func (c *cronSchedule) next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !c.month.has(int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !c.hour.has(t.Hour()):
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case !c.minute.has(t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (c *cronSchedule) matchDay(t time.Time) bool {
+	dayOfMonth := c.dayOfMonth.has(t.Day())
+	dayOfWeek := c.dayOfWeek.has(int(t.Weekday()))
+	if c.dayOfMonthStar || c.dayOfWeekStar {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// wrapCronWorkflowInput wraps the input of the first run of a workflow started with a cron schedule. The input is
+// returned as is when there is no schedule.
+func wrapCronWorkflowInput(schedule string, runTimeoutSeconds int32, input []byte) ([]byte, error) {
+	if schedule == "" {
+		return input, nil
+	}
+	return encodeCronWorkflowInput(&cronWorkflowInput{
+		Schedule:          schedule,
+		RunTimeoutSeconds: runTimeoutSeconds,
+		Input:             input,
+	})
+}
+
+func encodeCronWorkflowInput(cron *cronWorkflowInput) ([]byte, error) {
+	data, err := json.Marshal(cron)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, cronWorkflowInputPrefix...), data...), nil
+}
+
+// decodeCronWorkflowInput returns the cron input wrapped in the input of a workflow execution, nil if the
+// execution is not a run of a cron workflow.
+func decodeCronWorkflowInput(input []byte) (*cronWorkflowInput, error) {
+	if !bytes.HasPrefix(input, cronWorkflowInputPrefix) {
+		return nil, nil
+	}
+	cron := &cronWorkflowInput{}
+	if err := json.Unmarshal(input[len(cronWorkflowInputPrefix):], cron); err != nil {
+		return nil, fmt.Errorf("invalid cron workflow input: %v", err)
+	}
+	return cron, nil
+}
+
+// setCronWorkflowInfo sets the schedule and the outcome of the previous run of a cron workflow on its WorkflowInfo.
+func setCronWorkflowInfo(info *WorkflowInfo, cron *cronWorkflowInput, dc DataConverter) {
+	info.CronSchedule = cron.Schedule
+	info.lastCompletionResult = cron.LastCompletionResult
+	if cron.LastFailureReason != nil {
+		info.lastFailure = constructError(*cron.LastFailureReason, cron.LastFailureDetails, dc)
+	}
+}
+
+// executeCronWorkflowRun executes a run of a cron workflow. It waits with a durable timer until the fire time of the
+// run, executes the workflow, then continues it as new with the run of the next fire time and the same input. The
+// result and the error of the run are passed to the next one, which reads them through GetLastCompletionResult.
+// A canceled run ends the schedule, while a run continued as new by the workflow code keeps it and starts right away.
+func executeCronWorkflowRun(ctx Context, cron *cronWorkflowInput, w workflow) ([]byte, error) {
+	if cron.FireTime != 0 {
+		if wait := time.Unix(0, cron.FireTime).Sub(Now(ctx)); wait > 0 {
+			if err := Sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result, err := w.Execute(ctx, cron.Input)
+	switch err := err.(type) {
+	case *CanceledError:
+		return result, err
+	case *ContinueAsNewError:
+		next := *cron
+		next.RunTimeoutSeconds = *err.options.executionStartToCloseTimeoutSeconds
+		next.FireTime = 0
+		next.Input = err.options.input
+		input, encodeErr := encodeCronWorkflowInput(&next)
+		if encodeErr != nil {
+			return nil, encodeErr
+		}
+		err.options.input = input
+		return result, err
+	}
+
+	info := GetWorkflowInfo(ctx)
+	schedule, parseErr := parseCronSchedule(cron.Schedule)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	now := Now(ctx)
+	fireTime := schedule.next(now)
+	if fireTime.IsZero() {
+		return nil, fmt.Errorf("cron schedule %q does not fire after %v", cron.Schedule, now)
+	}
+	next := cronWorkflowInput{
+		Schedule:             cron.Schedule,
+		RunTimeoutSeconds:    cron.RunTimeoutSeconds,
+		FireTime:             fireTime.UnixNano(),
+		LastCompletionResult: result,
+		Input:                cron.Input,
+	}
+	if err != nil {
+		reason, details := getErrorDetails(err, getDataConverterFromWorkflowContext(ctx))
+		next.LastFailureReason, next.LastFailureDetails = &reason, details
+	}
+	input, encodeErr := encodeCronWorkflowInput(&next)
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+
+	options := *getWorkflowEnvOptions(ctx)
+	options.workflowType = &WorkflowType{Name: info.WorkflowType.Name}
+	options.input = input
+	// The next run waits for its fire time, which is not charged to the ExecutionStartToCloseTimeout of the run.
+	timeout := cron.RunTimeoutSeconds + int32(math.Ceil(fireTime.Sub(now).Seconds()))
+	options.executionStartToCloseTimeoutSeconds = &timeout
+	return nil, &ContinueAsNewError{wfn: info.WorkflowType.Name, options: &options}
+}
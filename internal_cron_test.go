@@ -0,0 +1,87 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	// Wednesday
+	now := time.Date(2017, time.November, 15, 10, 17, 30, 0, time.UTC)
+	tests := []struct {
+		schedule string
+		next     time.Time
+	}{
+		{"* * * * *", time.Date(2017, time.November, 15, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2017, time.November, 15, 10, 30, 0, 0, time.UTC)},
+		{"0,20-25 * * * *", time.Date(2017, time.November, 15, 10, 20, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2017, time.November, 15, 10, 25, 0, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2017, time.November, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", time.Date(2017, time.November, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 7", time.Date(2017, time.November, 19, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan *", time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either of them matches.
+		{"0 0 20 * mon", time.Date(2017, time.November, 20, 0, 0, 0, 0, time.UTC)},
+		{"0 0 17 * 1", time.Date(2017, time.November, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2017, time.November, 15, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2017, time.November, 16, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2017, time.November, 19, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2017, time.December, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, test := range tests {
+		schedule, err := parseCronSchedule(test.schedule)
+		require.NoError(t, err, test.schedule)
+		require.Equal(t, test.next, schedule.next(now), test.schedule)
+	}
+
+	// Fire times are computed in UTC whatever the location of the time passed in.
+	schedule, err := parseCronSchedule("0 9 * * *")
+	require.NoError(t, err)
+	local := now.In(time.FixedZone("UTC-8", -8*3600))
+	require.Equal(t, time.Date(2017, time.November, 16, 9, 0, 0, 0, time.UTC), schedule.next(local))
+}
+
+func TestCronScheduleInvalid(t *testing.T) {
+	for _, schedule := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"* * * foo *",
+		"@every",
+	} {
+		_, err := parseCronSchedule(schedule)
+		require.Error(t, err, schedule)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"errors"
+	"reflect"
+)
+
+type (
+	// defaultDataConverter uses the encodings of the host environment.
+	defaultDataConverter struct{}
+
+	// encodedValue is the EncodedValue implementation backed by a payload and the DataConverter to decode it.
+	encodedValue struct {
+		value         []byte
+		dataConverter DataConverter
+	}
+
+	// encodedValues is the EncodedValues implementation backed by a payload and the DataConverter to decode it.
+	encodedValues struct {
+		values        []byte
+		dataConverter DataConverter
+	}
+
+	// errorDetailsValues holds the not yet encoded details of an error created by the application. They are encoded
+	// with the worker's DataConverter when the error is reported to the server.
+	errorDetailsValues []interface{}
+)
+
+var defaultConverter DataConverter = &defaultDataConverter{}
+
+// Assert that structs do indeed implement the interfaces
+var _ DataConverter = (*defaultDataConverter)(nil)
+var _ EncodedValue = (*encodedValue)(nil)
+var _ EncodedValues = (*encodedValues)(nil)
+var _ EncodedValues = errorDetailsValues(nil)
+
+func getDefaultDataConverter() DataConverter {
+	return defaultConverter
+}
+
+// ToData implements the DataConverter interface.
+func (dc *defaultDataConverter) ToData(values ...interface{}) ([]byte, error) {
+	return getHostEnvironment().encode(values)
+}
+
+// FromData implements the DataConverter interface.
+func (dc *defaultDataConverter) FromData(data []byte, valuePtrs ...interface{}) error {
+	return getHostEnvironment().decode(data, valuePtrs)
+}
+
+func newEncodedValue(value []byte, dc DataConverter) EncodedValue {
+	if dc == nil {
+		dc = getDefaultDataConverter()
+	}
+	return &encodedValue{value: value, dataConverter: dc}
+}
+
+// Get extract data from encoded data to desired value type. valuePtr is pointer to the actual value type.
+func (b *encodedValue) Get(valuePtr interface{}) error {
+	return decodeArg(b.dataConverter, b.value, valuePtr)
+}
+
+// HasValue returns whether there is a value encoded.
+func (b *encodedValue) HasValue() bool {
+	return len(b.value) > 0
+}
+
+func newEncodedValues(values []byte, dc DataConverter) EncodedValues {
+	if dc == nil {
+		dc = getDefaultDataConverter()
+	}
+	return &encodedValues{values: values, dataConverter: dc}
+}
+
+// Get extract data from encoded data to desired value type. valuePtr is pointer to the actual value type.
+func (b *encodedValues) Get(valuePtr ...interface{}) error {
+	return b.dataConverter.FromData(b.values, valuePtr...)
+}
+
+// HasValues returns whether there are values encoded.
+func (b *encodedValues) HasValues() bool {
+	return len(b.values) > 0
+}
+
+// Get extract the detail values to desired value types. The values are round tripped through the default
+// DataConverter, like the details of the errors received from the server, so an int detail can be extracted to an
+// int64 and a struct detail to a pointer to the struct.
+func (b errorDetailsValues) Get(valuePtr ...interface{}) error {
+	dc := getDefaultDataConverter()
+	data, err := encodeArgs(dc, b)
+	if err != nil {
+		return err
+	}
+	return dc.FromData(data, valuePtr...)
+}
+
+// HasValues returns whether there are detail values.
+func (b errorDetailsValues) HasValues() bool {
+	return len(b) > 0
+}
+
+// getErrorDetailsData encodes the details of an error with the given DataConverter.
+func getErrorDetailsData(details EncodedValues, dc DataConverter) ([]byte, error) {
+	switch details := details.(type) {
+	case errorDetailsValues:
+		return encodeArgs(dc, details)
+	case *encodedValues:
+		return details.values, nil
+	default:
+		return nil, nil
+	}
+}
+
+// encode multiple arguments(arguments to a function).
+func encodeArgs(dc DataConverter, args []interface{}) ([]byte, error) {
+	return dc.ToData(args...)
+}
+
+// decode multiple arguments(arguments to a function).
+func decodeArgs(dc DataConverter, fnType reflect.Type, data []byte) (result []reflect.Value, err error) {
+	var r []interface{}
+argsLoop:
+	for i := 0; i < fnType.NumIn(); i++ {
+		argT := fnType.In(i)
+		if i == 0 && (isActivityContext(argT) || isWorkflowContext(argT)) {
+			continue argsLoop
+		}
+		arg := reflect.New(argT).Interface()
+		r = append(r, arg)
+	}
+	err = dc.FromData(data, r...)
+	if err != nil {
+		return
+	}
+	for i := 0; i < len(r); i++ {
+		result = append(result, reflect.ValueOf(r[i]).Elem())
+	}
+	return
+}
+
+// encode single value(like return parameter).
+func encodeArg(dc DataConverter, arg interface{}) ([]byte, error) {
+	return dc.ToData(arg)
+}
+
+// decode single value(like return parameter).
+func decodeArg(dc DataConverter, data []byte, to interface{}) error {
+	return dc.FromData(data, to)
+}
+
+func decodeAndAssignValue(dc DataConverter, from interface{}, toValuePtr interface{}) error {
+	if toValuePtr == nil {
+		return nil
+	}
+	if rf := reflect.ValueOf(toValuePtr); rf.Type().Kind() != reflect.Ptr {
+		return errors.New("value parameter provided is not a pointer")
+	}
+	if data, ok := from.([]byte); ok {
+		if err := decodeArg(dc, data, toValuePtr); err != nil {
+			return err
+		}
+	} else if fv := reflect.ValueOf(from); fv.IsValid() {
+		reflect.ValueOf(toValuePtr).Elem().Set(fv)
+	}
+	return nil
+}
@@ -92,6 +92,11 @@ type (
 		*naiveDecisionStateMachine
 	}
 
+	// only possible state transition is: CREATED->SENT->INITIATED->COMPLETED
+	signalExternalWorkflowDecisionStateMachine struct {
+		*naiveDecisionStateMachine
+	}
+
 	// only possible state transition is: CREATED->SENT->COMPLETED
 	markerDecisionStateMachine struct {
 		*naiveDecisionStateMachine
@@ -124,6 +129,7 @@ const (
 	decisionTypeExternalWorkflow decisionType = 2
 	decisionTypeMarker           decisionType = 3
 	decisionTypeTimer            decisionType = 4
+	decisionTypeSignal           decisionType = 5
 )
 
 const (
@@ -139,8 +145,10 @@ const (
 )
 
 const (
-	sideEffectMarkerName = "SideEffect"
-	versionMarkerName    = "Version"
+	sideEffectMarkerName        = "SideEffect"
+	versionMarkerName           = "Version"
+	localActivityMarkerName     = "LocalActivity"
+	mutableSideEffectMarkerName = "MutableSideEffect"
 )
 
 func (d decisionState) String() string {
@@ -182,6 +190,8 @@ func (d decisionType) String() string {
 		return "Marker"
 	case decisionTypeTimer:
 		return "Timer"
+	case decisionTypeSignal:
+		return "Signal"
 	default:
 		return "Unknown"
 	}
@@ -251,6 +261,14 @@ func newCancelExternalWorkflowStateMachine(attributes *s.RequestCancelExternalWo
 	}
 }
 
+func newSignalExternalWorkflowStateMachine(attributes *s.SignalExternalWorkflowExecutionDecisionAttributes, signalID string) *signalExternalWorkflowDecisionStateMachine {
+	d := createNewDecision(s.DecisionType_SignalExternalWorkflowExecution)
+	d.SignalExternalWorkflowExecutionDecisionAttributes = attributes
+	return &signalExternalWorkflowDecisionStateMachine{
+		naiveDecisionStateMachine: newNaiveDecisionStateMachine(decisionTypeSignal, signalID, d),
+	}
+}
+
 func (d *decisionStateMachineBase) getState() decisionState {
 	return d.state
 }
@@ -585,6 +603,24 @@ func (d *cancelExternalWorkflowDecisionStateMachine) handleCompletionEvent() {
 	}
 }
 
+func (d *signalExternalWorkflowDecisionStateMachine) handleInitiatedEvent() {
+	switch d.state {
+	case decisionStateDecisionSent:
+		d.moveState(decisionStateInitiated, eventInitiated)
+	default:
+		d.failStateTransition(eventInitiated)
+	}
+}
+
+func (d *signalExternalWorkflowDecisionStateMachine) handleCompletionEvent() {
+	switch d.state {
+	case decisionStateInitiated:
+		d.moveState(decisionStateCompleted, eventCompletion)
+	default:
+		d.failStateTransition(eventCompletion)
+	}
+}
+
 func (d *markerDecisionStateMachine) handleCompletionEvent() {
 	// Marker decision transit from SENT to COMPLETED on EventType_MarkerRecorded event
 	switch d.state {
@@ -709,6 +745,28 @@ func (h *decisionsHelper) recordSideEffectMarker(sideEffectID int32, data []byte
 	return decision
 }
 
+func (h *decisionsHelper) recordMutableSideEffectMarker(id string, call int32, data []byte) decisionStateMachine {
+	markerID := fmt.Sprintf("%v_%v_%v", mutableSideEffectMarkerName, id, call)
+	attributes := &s.RecordMarkerDecisionAttributes{
+		MarkerName: common.StringPtr(mutableSideEffectMarkerName),
+		Details:    data,
+	}
+	decision := newMarkerDecisionStateMachine(markerID, attributes)
+	h.addDecision(decision)
+	return decision
+}
+
+func (h *decisionsHelper) recordLocalActivityMarker(activityID string, data []byte) decisionStateMachine {
+	markerID := fmt.Sprintf("%v_%v", localActivityMarkerName, activityID)
+	attributes := &s.RecordMarkerDecisionAttributes{
+		MarkerName: common.StringPtr(localActivityMarkerName),
+		Details:    data,
+	}
+	decision := newMarkerDecisionStateMachine(markerID, attributes)
+	h.addDecision(decision)
+	return decision
+}
+
 func (h *decisionsHelper) handleSideEffectMarkerRecorded(sideEffectID int32) decisionStateMachine {
 	markerID := fmt.Sprintf("%v_%v", sideEffectMarkerName, sideEffectID)
 	decision := h.getDecision(makeDecisionID(decisionTypeMarker, markerID))
@@ -717,6 +775,8 @@ func (h *decisionsHelper) handleSideEffectMarkerRecorded(sideEffectID int32) dec
 }
 
 func (h *decisionsHelper) startChildWorkflowExecution(attributes *s.StartChildWorkflowExecutionDecisionAttributes) decisionStateMachine {
+	// A child workflow retried by its RetryPolicy reuses the workflow ID of the previous attempt, which is closed, so
+	// the decision of the previous attempt is replaced.
 	decision := newChildWorkflowDecisionStateMachine(attributes)
 	h.addDecision(decision)
 	return decision
@@ -788,6 +848,35 @@ func (h *decisionsHelper) handleRequestCancelExternalWorkflowExecutionFailed(wor
 	}
 }
 
+func (h *decisionsHelper) signalExternalWorkflowExecution(domain, workflowID, runID, signalName string, input []byte, signalID string) decisionStateMachine {
+	attributes := &s.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain: common.StringPtr(domain),
+		Execution: &s.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+		SignalName: common.StringPtr(signalName),
+		Input:      input,
+		Control:    []byte(signalID),
+	}
+	decision := newSignalExternalWorkflowStateMachine(attributes, signalID)
+	h.addDecision(decision)
+	return decision
+}
+
+func (h *decisionsHelper) handleSignalExternalWorkflowExecutionInitiated(signalID string) {
+	decision := h.getDecision(makeDecisionID(decisionTypeSignal, signalID))
+	decision.handleInitiatedEvent()
+}
+
+// handleSignalExternalWorkflowExecutionClosed handles both the signaled and the failed events, either of them is the end
+// of the decision state.
+func (h *decisionsHelper) handleSignalExternalWorkflowExecutionClosed(signalID string) decisionStateMachine {
+	decision := h.getDecision(makeDecisionID(decisionTypeSignal, signalID))
+	decision.handleCompletionEvent()
+	return decision
+}
+
 func (h *decisionsHelper) startTimer(attributes *s.StartTimerDecisionAttributes) decisionStateMachine {
 	decision := newTimerDecisionStateMachine(attributes)
 	h.addDecision(decision)
@@ -298,6 +298,37 @@ func Test_ChildWorkflowStateMachine_Basic(t *testing.T) {
 	require.Equal(t, 0, len(h.getDecisions(true)))
 }
 
+func Test_ChildWorkflowStateMachine_Retry(t *testing.T) {
+	workflowID := "test-child-workflow-1"
+	attributes := &s.StartChildWorkflowExecutionDecisionAttributes{
+		WorkflowId: common.StringPtr(workflowID),
+	}
+	h := newDecisionsHelper()
+
+	// first attempt fails
+	d1 := h.startChildWorkflowExecution(attributes)
+	h.getDecisions(true)
+	h.handleStartChildWorkflowExecutionInitiated(workflowID)
+	h.handleChildWorkflowExecutionStarted(workflowID)
+	h.handleChildWorkflowExecutionClosed(workflowID)
+	require.Equal(t, decisionStateCompleted, d1.getState())
+
+	// retry with the same workflow ID
+	d2 := h.startChildWorkflowExecution(attributes)
+	require.Equal(t, decisionStateCreated, d2.getState())
+	decisions := h.getDecisions(true)
+	require.Equal(t, 1, len(decisions))
+	require.Equal(t, s.DecisionType_StartChildWorkflowExecution, decisions[0].GetDecisionType())
+
+	// events of the second attempt are handled by its decision
+	h.handleStartChildWorkflowExecutionInitiated(workflowID)
+	h.handleChildWorkflowExecutionStarted(workflowID)
+	require.Equal(t, decisionStateStarted, d2.getState())
+	h.handleChildWorkflowExecutionClosed(workflowID)
+	require.Equal(t, decisionStateCompleted, d2.getState())
+	require.Equal(t, decisionStateCompleted, d1.getState())
+}
+
 func Test_ChildWorkflowStateMachine_CancelSucced(t *testing.T) {
 	domain, workflowID, runID := "test-domain", "test-child-workflow-1", "test-child-run-id"
 	attributes := &s.StartChildWorkflowExecutionDecisionAttributes{
@@ -532,6 +563,42 @@ func Test_CancelExternalWorkflowStateMachine_Failed(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func Test_SignalExternalWorkflowStateMachine(t *testing.T) {
+	domain, workflowID, runID, signalName := "test-domain", "test-workflow-id", "test-run-id", "test-signal"
+	signalID := "1"
+	h := newDecisionsHelper()
+
+	// signal external workflow
+	decision := h.signalExternalWorkflowExecution(domain, workflowID, runID, signalName, []byte("test-input"), signalID)
+	require.False(t, decision.isDone())
+	d := h.getDecision(makeDecisionID(decisionTypeSignal, signalID))
+	require.Equal(t, decisionStateCreated, d.getState())
+
+	// send decisions
+	decisions := h.getDecisions(true)
+	require.Equal(t, 1, len(decisions))
+	require.Equal(t, s.DecisionType_SignalExternalWorkflowExecution, decisions[0].GetDecisionType())
+	attributes := decisions[0].GetSignalExternalWorkflowExecutionDecisionAttributes()
+	require.Equal(t, workflowID, attributes.GetExecution().GetWorkflowId())
+	require.Equal(t, signalName, attributes.GetSignalName())
+	require.Equal(t, []byte(signalID), attributes.GetControl())
+
+	// signal initiated
+	h.handleSignalExternalWorkflowExecutionInitiated(signalID)
+	require.Equal(t, decisionStateInitiated, d.getState())
+
+	// signaled
+	h.handleSignalExternalWorkflowExecutionClosed(signalID)
+	require.Equal(t, decisionStateCompleted, d.getState())
+	require.True(t, d.isDone())
+
+	// mark the signal failed now will make it invalid state transition
+	err := runAndCatchPanic(func() {
+		h.handleSignalExternalWorkflowExecutionClosed(signalID)
+	})
+	require.NotNil(t, err)
+}
+
 func runAndCatchPanic(f func()) (err *PanicError) {
 	// panic handler
 	defer func() {
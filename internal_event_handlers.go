@@ -23,8 +23,12 @@ package cadence
 // All code in this file is private to the package.
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"sync"
 	"time"
 
 	"github.com/uber-go/tally"
@@ -68,13 +72,41 @@ type (
 		handled             bool
 	}
 
+	scheduledSignal struct {
+		callback resultHandler
+		handled  bool
+	}
+
+	// localActivityTask is a local activity waiting to be executed once the workflow code is blocked.
+	localActivityTask struct {
+		activityID string
+		params     executeLocalActivityParameters
+		callback   resultHandler
+	}
+
+	// localActivityMarkerData is the outcome of a local activity, as recorded in its marker.
+	localActivityMarkerData struct {
+		ActivityID   string
+		ActivityType string
+		Result       []byte
+		ErrReason    string
+		ErrDetails   []byte
+		TimeoutType  *m.TimeoutType
+	}
+
 	// workflowEnvironmentImpl an implementation of workflowEnvironment represents a environment for workflow execution.
 	workflowEnvironmentImpl struct {
 		workflowInfo *WorkflowInfo
 
-		decisionsHelper  *decisionsHelper
-		sideEffectResult map[int32][]byte
-		changeVersions   map[string]Version
+		decisionsHelper         *decisionsHelper
+		sideEffectResult        map[int32][]byte
+		mutableSideEffect       map[string][]byte
+		mutableSideEffectCalls  map[string]int32
+		mutableSideEffectMarker map[string]map[int32][]byte
+		changeVersions          map[string]Version
+		localActivityResult     map[string]*localActivityMarkerData
+		pendingLocalActivities  []*localActivityTask
+		isWorkflowCompleted     bool
 
 		counterID         int32     // To generate sequence IDs for activity/timer etc.
 		currentReplayTime time.Time // Indicates current replay time of the decision.
@@ -88,8 +120,11 @@ type (
 		isReplay              bool // flag to indicate if workflow is in replay mode
 		enableLoggingInReplay bool // flag to indicate if workflow should enable logging in replay mode
 
-		metricsScope tally.Scope
-		hostEnv      *hostEnvImpl
+		metricsScope  tally.Scope
+		hostEnv       *hostEnvImpl
+		dataConverter DataConverter
+
+		workflowInterceptors []WorkflowInterceptorFactory
 	}
 
 	// wrapper around zapcore.Core that will be aware of replay
@@ -125,15 +160,23 @@ func newWorkflowExecutionEventHandler(
 	enableLoggingInReplay bool,
 	scope tally.Scope,
 	hostEnv *hostEnvImpl,
+	dataConverter DataConverter,
+	workflowInterceptors []WorkflowInterceptorFactory,
 ) workflowExecutionEventHandler {
 	context := &workflowEnvironmentImpl{
-		workflowInfo:          workflowInfo,
-		decisionsHelper:       newDecisionsHelper(),
-		sideEffectResult:      make(map[int32][]byte),
-		changeVersions:        make(map[string]Version),
-		completeHandler:       completeHandler,
-		enableLoggingInReplay: enableLoggingInReplay,
-		hostEnv:               hostEnv,
+		workflowInfo:            workflowInfo,
+		decisionsHelper:         newDecisionsHelper(),
+		sideEffectResult:        make(map[int32][]byte),
+		mutableSideEffect:       make(map[string][]byte),
+		mutableSideEffectCalls:  make(map[string]int32),
+		mutableSideEffectMarker: make(map[string]map[int32][]byte),
+		changeVersions:          make(map[string]Version),
+		localActivityResult:     make(map[string]*localActivityMarkerData),
+		completeHandler:         completeHandler,
+		enableLoggingInReplay:   enableLoggingInReplay,
+		hostEnv:                 hostEnv,
+		dataConverter:           dataConverter,
+		workflowInterceptors:    workflowInterceptors,
 	}
 	context.logger = logger.With(
 		zapcore.Field{Key: tagWorkflowType, Type: zapcore.StringType, String: workflowInfo.WorkflowType.Name},
@@ -172,11 +215,32 @@ func (s *scheduledChildWorkflow) handle(result []byte, err error) {
 	s.resultCallback(result, err)
 }
 
+func (s *scheduledSignal) handle(result []byte, err error) {
+	if s.handled {
+		panic(fmt.Sprintf("signal already handled %v", s))
+	}
+	s.handled = true
+	s.callback(result, err)
+}
+
 func (wc *workflowEnvironmentImpl) WorkflowInfo() *WorkflowInfo {
 	return wc.workflowInfo
 }
 
+func (wc *workflowEnvironmentImpl) GetDataConverter() DataConverter {
+	return wc.dataConverter
+}
+
+func (wc *workflowEnvironmentImpl) GetRegistry() *hostEnvImpl {
+	return wc.hostEnv
+}
+
+func (wc *workflowEnvironmentImpl) GetWorkflowInterceptorFactories() []WorkflowInterceptorFactory {
+	return wc.workflowInterceptors
+}
+
 func (wc *workflowEnvironmentImpl) Complete(result []byte, err error) {
+	wc.isWorkflowCompleted = true
 	wc.completeHandler(result, err)
 }
 
@@ -203,6 +267,17 @@ func (wc *workflowEnvironmentImpl) RequestCancelWorkflow(domainName, workflowID,
 	return nil
 }
 
+func (wc *workflowEnvironmentImpl) SignalExternalWorkflow(
+	domainName, workflowID, runID, signalName string, input []byte, callback resultHandler) {
+	signalID := wc.GenerateSequenceID()
+	decision := wc.decisionsHelper.signalExternalWorkflowExecution(domainName, workflowID, runID, signalName, input, signalID)
+	decision.setData(&scheduledSignal{callback: callback})
+	wc.logger.Debug("SignalExternalWorkflow",
+		zap.String(tagWorkflowID, workflowID),
+		zap.String(tagRunID, runID),
+		zap.String(tagSignalName, signalName))
+}
+
 func (wc *workflowEnvironmentImpl) RegisterCancelHandler(handler func()) {
 	wc.cancelHandler = handler
 }
@@ -313,6 +388,113 @@ func (wc *workflowEnvironmentImpl) RequestCancelActivity(activityID string) {
 	wc.logger.Debug("RequestCancelActivity", zap.String(tagActivityID, activityID))
 }
 
+func (wc *workflowEnvironmentImpl) ExecuteLocalActivity(parameters executeLocalActivityParameters, callback resultHandler) *activityInfo {
+	activityID := wc.GenerateSequenceID()
+	wc.pendingLocalActivities = append(wc.pendingLocalActivities, &localActivityTask{
+		activityID: activityID,
+		params:     parameters,
+		callback:   callback,
+	})
+
+	wc.logger.Debug("ExecuteLocalActivity",
+		zap.String(tagActivityID, activityID),
+		zap.String(tagActivityType, parameters.ActivityType.Name))
+
+	return &activityInfo{activityID: activityID}
+}
+
+func (wc *workflowEnvironmentImpl) RequestCancelLocalActivity(activityID string) {
+	for i, task := range wc.pendingLocalActivities {
+		if task.activityID == activityID {
+			wc.pendingLocalActivities = append(wc.pendingLocalActivities[:i], wc.pendingLocalActivities[i+1:]...)
+			task.callback(nil, ErrCanceled)
+			wc.logger.Debug("RequestCancelLocalActivity", zap.String(tagActivityID, activityID))
+			return
+		}
+	}
+}
+
+// executePendingLocalActivities executes the local activities scheduled since the workflow code was last resumed,
+// records their markers and invokes their callbacks in the order they were scheduled. When replaying, the outcome
+// recorded in the markers is used instead of executing them again.
+func (wc *workflowEnvironmentImpl) executePendingLocalActivities() {
+	tasks := wc.pendingLocalActivities
+	wc.pendingLocalActivities = nil
+
+	outcomes := make([]*localActivityMarkerData, len(tasks))
+	if wc.isReplay {
+		for i, task := range tasks {
+			outcome, ok := wc.localActivityResult[task.activityID]
+			if !ok {
+				panic(fmt.Sprintf("No recorded result found for local activity with activityID=%v, activityType=%v",
+					task.activityID, task.params.ActivityType.Name))
+			}
+			outcomes[i] = outcome
+		}
+	} else {
+		var wg sync.WaitGroup
+		for i, task := range tasks {
+			wg.Add(1)
+			go func(i int, task *localActivityTask) {
+				defer wg.Done()
+				outcomes[i] = wc.executeLocalActivity(task)
+			}(i, task)
+		}
+		wg.Wait()
+	}
+
+	for i, task := range tasks {
+		outcome := outcomes[i]
+		details, err := wc.hostEnv.encodeArg(outcome)
+		if err != nil {
+			panic(err)
+		}
+		wc.decisionsHelper.recordLocalActivityMarker(task.activityID, details)
+
+		// The outcome is always read back from the marker data, so the workflow sees the same result when replayed.
+		switch {
+		case outcome.TimeoutType != nil:
+			task.callback(nil, NewTimeoutError(*outcome.TimeoutType))
+		case outcome.ErrReason != "":
+			task.callback(nil, constructError(outcome.ErrReason, outcome.ErrDetails, wc.dataConverter))
+		default:
+			task.callback(outcome.Result, nil)
+		}
+	}
+}
+
+func (wc *workflowEnvironmentImpl) executeLocalActivity(task *localActivityTask) *localActivityMarkerData {
+	outcome := &localActivityMarkerData{
+		ActivityID:   task.activityID,
+		ActivityType: task.params.ActivityType.Name,
+	}
+
+	var a activity
+	if task.params.ActivityFn != nil {
+		a = &activityExecutor{name: task.params.ActivityType.Name, fn: task.params.ActivityFn}
+	} else if registered, ok := wc.hostEnv.getActivity(task.params.ActivityType.Name); ok {
+		a = registered
+	} else {
+		outcome.ErrReason, outcome.ErrDetails = getErrorDetails(
+			fmt.Errorf("unable to find activityType=%v", task.params.ActivityType.Name), wc.dataConverter)
+		return outcome
+	}
+
+	ctx := newLocalActivityContext(context.Background(), task.activityID, task.params.ActivityType,
+		wc.workflowInfo.WorkflowExecution, wc.logger, wc.metricsScope, wc.dataConverter)
+	result, err := executeLocalActivity(ctx, a, task.params)
+	switch err := err.(type) {
+	case nil:
+		outcome.Result = result
+	case *TimeoutError:
+		timeoutType := err.TimeoutType()
+		outcome.TimeoutType = &timeoutType
+	default:
+		outcome.ErrReason, outcome.ErrDetails = getErrorDetails(err, wc.dataConverter)
+	}
+	return outcome
+}
+
 func (wc *workflowEnvironmentImpl) SetCurrentReplayTime(replayTime time.Time) {
 	wc.currentReplayTime = replayTime
 }
@@ -428,6 +610,71 @@ func (wc *workflowEnvironmentImpl) SideEffect(f func() ([]byte, error), callback
 	wc.logger.Debug("SideEffect Marker added", zap.Int32(tagSideEffectID, sideEffectID))
 }
 
+func (wc *workflowEnvironmentImpl) MutableSideEffect(id string, f func() interface{}, equals func(a, b interface{}) bool) EncodedValue {
+	// Markers are recorded with the number of the call that changed the value, so that each call replays the value
+	// it returned originally, even when several markers with the same id are loaded at once.
+	wc.mutableSideEffectCalls[id]++
+	call := wc.mutableSideEffectCalls[id]
+	if data, ok := wc.mutableSideEffectMarker[id][call]; ok {
+		wc.mutableSideEffect[id] = data
+		return newEncodedValue(data, wc.dataConverter)
+	}
+
+	if recorded, ok := wc.mutableSideEffect[id]; ok {
+		if wc.isReplay {
+			// The value didn't change on this call.
+			return newEncodedValue(recorded, wc.dataConverter)
+		}
+		changed, data := isMutableSideEffectValueChanged(wc.dataConverter, recorded, f(), equals)
+		if !changed {
+			return newEncodedValue(recorded, wc.dataConverter)
+		}
+		return wc.recordMutableSideEffect(id, call, data)
+	}
+
+	if wc.isReplay {
+		panic(fmt.Sprintf("No recorded value found for mutable side effect with id=%v", id))
+	}
+	data, err := encodeArg(wc.dataConverter, f())
+	if err != nil {
+		panic(err)
+	}
+	return wc.recordMutableSideEffect(id, call, data)
+}
+
+func (wc *workflowEnvironmentImpl) recordMutableSideEffect(id string, call int32, data []byte) EncodedValue {
+	details, err := wc.hostEnv.encodeArgs([]interface{}{id, call, data})
+	if err != nil {
+		panic(err)
+	}
+	wc.decisionsHelper.recordMutableSideEffectMarker(id, call, details)
+	wc.mutableSideEffect[id] = data
+	wc.logger.Debug("MutableSideEffect Marker added", zap.String(tagSideEffectID, id))
+	return newEncodedValue(data, wc.dataConverter)
+}
+
+// isMutableSideEffectValueChanged compares the new value of a mutable side effect with the recorded one, decoded into a
+// value of the same type, and returns the encoded new value.
+func isMutableSideEffectValueChanged(
+	dc DataConverter,
+	recorded []byte,
+	newValue interface{},
+	equals func(a, b interface{}) bool,
+) (bool, []byte) {
+	data, err := encodeArg(dc, newValue)
+	if err != nil {
+		panic(err)
+	}
+	if equals == nil || newValue == nil {
+		return !bytes.Equal(recorded, data), data
+	}
+	oldValue := reflect.New(reflect.TypeOf(newValue))
+	if err := decodeArg(dc, recorded, oldValue.Interface()); err != nil {
+		return true, data
+	}
+	return !equals(newValue, oldValue.Elem().Interface()), data
+}
+
 func (weh *workflowExecutionEventHandlerImpl) ProcessEvent(
 	event *m.HistoryEvent,
 	isReplay bool,
@@ -457,7 +704,7 @@ func (weh *workflowExecutionEventHandlerImpl) ProcessEvent(
 	case m.EventType_DecisionTaskScheduled:
 		// No Operation
 	case m.EventType_DecisionTaskStarted:
-		weh.workflowDefinition.OnDecisionTaskStarted()
+		weh.executeDecisionTask()
 
 	case m.EventType_DecisionTaskTimedOut:
 		// No Operation
@@ -522,6 +769,16 @@ func (weh *workflowExecutionEventHandlerImpl) ProcessEvent(
 		weh.decisionsHelper.handleExternalWorkflowExecutionCancelRequested(
 			event.GetExternalWorkflowExecutionCancelRequestedEventAttributes().GetWorkflowExecution().GetWorkflowId())
 
+	case m.EventType_SignalExternalWorkflowExecutionInitiated:
+		weh.decisionsHelper.handleSignalExternalWorkflowExecutionInitiated(
+			string(event.GetSignalExternalWorkflowExecutionInitiatedEventAttributes().GetControl()))
+
+	case m.EventType_SignalExternalWorkflowExecutionFailed:
+		weh.handleSignalExternalWorkflowExecutionFailed(event)
+
+	case m.EventType_ExternalWorkflowExecutionSignaled:
+		weh.handleExternalWorkflowExecutionSignaled(event)
+
 	case m.EventType_WorkflowExecutionContinuedAsNew:
 		// No Operation.
 
@@ -571,15 +828,30 @@ func (weh *workflowExecutionEventHandlerImpl) ProcessEvent(
 	// decision started. So always call OnDecisionTaskStarted on the last event.
 	// Don't call for EventType_DecisionTaskStarted as it was already called when handling it.
 	if isLast && event.GetEventType() != m.EventType_DecisionTaskStarted {
-		weh.workflowDefinition.OnDecisionTaskStarted()
+		weh.executeDecisionTask()
 	}
 
 	return weh.decisionsHelper.getDecisions(true), nil
 }
 
+// executeDecisionTask runs the workflow code until it is blocked. The local activities scheduled by the workflow code
+// are then executed and the workflow code is resumed with their results, until it doesn't wait on any local activity.
+func (weh *workflowExecutionEventHandlerImpl) executeDecisionTask() {
+	weh.workflowDefinition.OnDecisionTaskStarted()
+	for len(weh.pendingLocalActivities) > 0 {
+		if weh.isWorkflowCompleted {
+			// Nobody is waiting for the results anymore.
+			weh.pendingLocalActivities = nil
+			return
+		}
+		weh.executePendingLocalActivities()
+		weh.workflowDefinition.OnDecisionTaskStarted()
+	}
+}
+
 func (weh *workflowExecutionEventHandlerImpl) ProcessQuery(queryType string, queryArgs []byte) ([]byte, error) {
 	if queryType == QueryTypeStackTrace {
-		return getHostEnvironment().encodeArg(weh.StackTrace())
+		return encodeArg(weh.GetDataConverter(), weh.StackTrace())
 	}
 	return weh.queryHandler(queryType, queryArgs)
 }
@@ -629,7 +901,7 @@ func (weh *workflowExecutionEventHandlerImpl) handleActivityTaskFailed(event *m.
 	}
 
 	attributes := event.GetActivityTaskFailedEventAttributes()
-	err := constructError(*attributes.Reason, attributes.Details)
+	err := constructError(*attributes.Reason, attributes.Details, weh.GetDataConverter())
 	activity.handle(nil, err)
 	return nil
 }
@@ -646,7 +918,7 @@ func (weh *workflowExecutionEventHandlerImpl) handleActivityTaskTimedOut(event *
 	var err error
 	tt := attributes.GetTimeoutType()
 	if tt == m.TimeoutType_HEARTBEAT {
-		err = NewHeartbeatTimeoutError(attributes.GetDetails())
+		err = newHeartbeatTimeoutErrorWithDetails(attributes.GetDetails(), weh.GetDataConverter())
 	} else {
 		err = NewTimeoutError(attributes.GetTimeoutType())
 	}
@@ -664,7 +936,7 @@ func (weh *workflowExecutionEventHandlerImpl) handleActivityTaskCanceled(event *
 
 	if decision.isDone() || !activity.waitForCancelRequest {
 		// Clear this so we don't have a recursive call that while executing might call the cancel one.
-		err := NewCanceledError(event.GetActivityTaskCanceledEventAttributes().GetDetails())
+		err := newCanceledErrorWithDetails(event.GetActivityTaskCanceledEventAttributes().GetDetails(), weh.GetDataConverter())
 		activity.handle(nil, err)
 	}
 
@@ -690,7 +962,8 @@ func (weh *workflowExecutionEventHandlerImpl) handleMarkerRecorded(
 	eventID int64,
 	attributes *m.MarkerRecordedEventAttributes,
 ) error {
-	encodedValues := EncodedValues(attributes.GetDetails())
+	// markers are framework internal and always use the default encoding.
+	encodedValues := newEncodedValues(attributes.GetDetails(), getDefaultDataConverter())
 	switch attributes.GetMarkerName() {
 	case sideEffectMarkerName:
 		var sideEffectID int32
@@ -698,6 +971,25 @@ func (weh *workflowExecutionEventHandlerImpl) handleMarkerRecorded(
 		encodedValues.Get(&sideEffectID, &result)
 		weh.sideEffectResult[sideEffectID] = result
 		return nil
+	case mutableSideEffectMarkerName:
+		var id string
+		var call int32
+		var result []byte
+		if err := encodedValues.Get(&id, &call, &result); err != nil {
+			return err
+		}
+		if _, ok := weh.mutableSideEffectMarker[id]; !ok {
+			weh.mutableSideEffectMarker[id] = make(map[int32][]byte)
+		}
+		weh.mutableSideEffectMarker[id][call] = result
+		return nil
+	case localActivityMarkerName:
+		var outcome localActivityMarkerData
+		if err := encodedValues.Get(&outcome); err != nil {
+			return err
+		}
+		weh.localActivityResult[outcome.ActivityID] = &outcome
+		return nil
 	case versionMarkerName:
 		var changeID string
 		var version Version
@@ -715,6 +1007,20 @@ func (weh *workflowExecutionEventHandlerImpl) handleWorkflowExecutionSignaled(
 	weh.signalHandler(attributes.GetSignalName(), attributes.GetInput())
 }
 
+func (weh *workflowExecutionEventHandlerImpl) handleExternalWorkflowExecutionSignaled(event *m.HistoryEvent) {
+	attributes := event.GetExternalWorkflowExecutionSignaledEventAttributes()
+	decision := weh.decisionsHelper.handleSignalExternalWorkflowExecutionClosed(string(attributes.GetControl()))
+	signal := decision.getData().(*scheduledSignal)
+	signal.handle(nil, nil)
+}
+
+func (weh *workflowExecutionEventHandlerImpl) handleSignalExternalWorkflowExecutionFailed(event *m.HistoryEvent) {
+	attributes := event.GetSignalExternalWorkflowExecutionFailedEventAttributes()
+	decision := weh.decisionsHelper.handleSignalExternalWorkflowExecutionClosed(string(attributes.GetControl()))
+	signal := decision.getData().(*scheduledSignal)
+	signal.handle(nil, fmt.Errorf("SignalExternalWorkflowFailed: %v", attributes.GetCause()))
+}
+
 func (weh *workflowExecutionEventHandlerImpl) handleStartChildWorkflowExecutionFailed(event *m.HistoryEvent) error {
 	attributes := event.GetStartChildWorkflowExecutionFailedEventAttributes()
 	childWorkflowID := attributes.GetWorkflowId()
@@ -772,7 +1078,7 @@ func (weh *workflowExecutionEventHandlerImpl) handleChildWorkflowExecutionFailed
 		return nil
 	}
 
-	err := constructError(attributes.GetReason(), attributes.GetDetails())
+	err := constructError(attributes.GetReason(), attributes.GetDetails(), weh.GetDataConverter())
 	childWorkflow.handle(nil, err)
 
 	return nil
@@ -786,7 +1092,7 @@ func (weh *workflowExecutionEventHandlerImpl) handleChildWorkflowExecutionCancel
 	if childWorkflow.handled {
 		return nil
 	}
-	err := NewCanceledError(attributes.GetDetails())
+	err := newCanceledErrorWithDetails(attributes.GetDetails(), weh.GetDataConverter())
 	childWorkflow.handle(nil, err)
 	return nil
 }
@@ -813,7 +1119,7 @@ func (weh *workflowExecutionEventHandlerImpl) handleChildWorkflowExecutionTermin
 	if childWorkflow.handled {
 		return nil
 	}
-	err := errors.New("terminated")
+	err := &TerminatedError{}
 	childWorkflow.handle(nil, err)
 
 	return nil
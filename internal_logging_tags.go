@@ -39,4 +39,5 @@ const (
 	tagChangeID        = "ChangeID"
 	tagVersion         = "Version"
 	tagChildWorkflowID = "ChildWorkflowID"
+	tagSignalName      = "SignalName"
 )
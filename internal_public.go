@@ -77,6 +77,7 @@ func NewWorkflowTaskWorker(
 		Identity:                  wOptions.Identity,
 		MetricsScope:              wOptions.MetricsScope,
 		Logger:                    wOptions.Logger,
+		DataConverter:             wOptions.DataConverter,
 	}
 
 	processTestTags(&wOptions, &workerParams)
@@ -103,6 +104,7 @@ func NewActivityTaskWorker(
 		Logger:                          wOptions.Logger,
 		EnableLoggingInReplay:           wOptions.EnableLoggingInReplay,
 		UserContext:                     wOptions.BackgroundActivityContext,
+		DataConverter:                   wOptions.DataConverter,
 	}
 
 	processTestTags(&wOptions, &workerParams)
@@ -150,16 +152,28 @@ func AddActivityRegistrationInterceptor(
 	getHostEnvironment().AddActivityRegistrationInterceptor(i)
 }
 
-// SerializeFnArgs serializes an activity function arguments.
+// SerializeFnArgs serializes an activity function arguments with the default DataConverter.
 func SerializeFnArgs(args ...interface{}) ([]byte, error) {
-	return getHostEnvironment().encodeArgs(args)
+	return SerializeFnArgsWithDataConverter(getDefaultDataConverter(), args...)
 }
 
-// DeserializeFnResults de-serializes a function results.
+// SerializeFnArgsWithDataConverter serializes an activity function arguments with the given DataConverter, which
+// should be the one of the WorkerOptions of the workers executing the function.
+func SerializeFnArgsWithDataConverter(dc DataConverter, args ...interface{}) ([]byte, error) {
+	return encodeArgs(dc, args)
+}
+
+// DeserializeFnResults de-serializes a function results with the default DataConverter.
 // The input result doesn't include the error. The cadence server has result, error.
 // This is to de-serialize the result.
 func DeserializeFnResults(result []byte, to interface{}) error {
-	return getHostEnvironment().decodeArg(result, to)
+	return DeserializeFnResultsWithDataConverter(getDefaultDataConverter(), result, to)
+}
+
+// DeserializeFnResultsWithDataConverter de-serializes a function results with the given DataConverter, see
+// SerializeFnArgsWithDataConverter.
+func DeserializeFnResultsWithDataConverter(dc DataConverter, result []byte, to interface{}) error {
+	return decodeArg(dc, result, to)
 }
 
 // EnableVerboseLogging enable or disable verbose logging. This is for internal use only.
@@ -83,6 +83,8 @@ type (
 		identity              string
 		enableLoggingInReplay bool
 		hostEnv               *hostEnvImpl
+		dataConverter         DataConverter
+		workflowInterceptors  []WorkflowInterceptorFactory
 	}
 
 	activityProvider func(name string) activity
@@ -96,6 +98,9 @@ type (
 		userContext      context.Context
 		hostEnv          *hostEnvImpl
 		activityProvider activityProvider
+		dataConverter    DataConverter
+
+		activityInterceptors []ActivityInterceptorFactory
 	}
 
 	// history wrapper method to help information about events.
@@ -168,7 +173,8 @@ func isDecisionEvent(eventType s.EventType) bool {
 		s.EventType_TimerCanceled,
 		s.EventType_MarkerRecorded,
 		s.EventType_StartChildWorkflowExecutionInitiated,
-		s.EventType_RequestCancelExternalWorkflowExecutionInitiated:
+		s.EventType_RequestCancelExternalWorkflowExecutionInitiated,
+		s.EventType_SignalExternalWorkflowExecutionInitiated:
 		return true
 	default:
 		return false
@@ -315,6 +321,8 @@ func newWorkflowTaskHandler(
 		identity:              params.Identity,
 		enableLoggingInReplay: params.EnableLoggingInReplay,
 		hostEnv:               hostEnv,
+		dataConverter:         params.DataConverter,
+		workflowInterceptors:  params.WorkflowInterceptorChainFactories,
 	}
 }
 
@@ -362,7 +370,8 @@ func (wth *workflowTaskHandlerImpl) ProcessWorkflowTask(
 		},
 		ExecutionStartToCloseTimeoutSeconds: attributes.GetExecutionStartToCloseTimeoutSeconds(),
 		TaskStartToCloseTimeoutSeconds:      attributes.GetTaskStartToCloseTimeoutSeconds(),
-		Domain: wth.domain,
+		Domain:                              wth.domain,
+		ContinuedExecutionRunID:             attributes.GetContinuedExecutionRunId(),
 	}
 
 	isWorkflowCompleted := false
@@ -382,6 +391,8 @@ func (wth *workflowTaskHandlerImpl) ProcessWorkflowTask(
 		wth.enableLoggingInReplay,
 		wth.metricsScope,
 		wth.hostEnv,
+		wth.dataConverter,
+		wth.workflowInterceptors,
 	)
 	defer eventHandler.Close()
 	reorderedHistory := newHistory(&workflowTask{task: task, getHistoryPageFunc: getHistoryPage}, eventHandler.(*workflowExecutionEventHandlerImpl))
@@ -545,6 +556,23 @@ func isVersionMarkerEvent(e *s.HistoryEvent) bool {
 	return false
 }
 
+// Mutable side effect markers are only recorded when the value changes, and never when replaying.
+func isMutableSideEffectMarkerDecision(d *s.Decision) bool {
+	if d.GetDecisionType() == s.DecisionType_RecordMarker &&
+		d.RecordMarkerDecisionAttributes.GetMarkerName() == mutableSideEffectMarkerName {
+		return true
+	}
+	return false
+}
+
+func isMutableSideEffectMarkerEvent(e *s.HistoryEvent) bool {
+	if e.GetEventType() == s.EventType_MarkerRecorded &&
+		e.MarkerRecordedEventAttributes.GetMarkerName() == mutableSideEffectMarkerName {
+		return true
+	}
+	return false
+}
+
 func matchReplayWithHistory(replayDecisions []*s.Decision, historyEvents []*s.HistoryEvent) error {
 	di := 0
 	hi := 0
@@ -556,7 +584,7 @@ matchLoop:
 		if hi < hSize {
 			e = historyEvents[hi]
 		}
-		if isVersionMarkerEvent(e) {
+		if isVersionMarkerEvent(e) || isMutableSideEffectMarkerEvent(e) {
 			hi++
 			continue matchLoop
 		}
@@ -565,7 +593,7 @@ matchLoop:
 		if di < dSize {
 			d = replayDecisions[di]
 		}
-		if isVersionMarkerDecision(d) {
+		if isVersionMarkerDecision(d) || isMutableSideEffectMarkerDecision(d) {
 			di++
 			continue matchLoop
 		}
@@ -703,6 +731,23 @@ func isDecisionMatchEvent(d *s.Decision, e *s.HistoryEvent, strictMode bool) boo
 
 		return true
 
+	case s.DecisionType_SignalExternalWorkflowExecution:
+		if e.GetEventType() != s.EventType_SignalExternalWorkflowExecutionInitiated {
+			return false
+		}
+		eventAttributes := e.GetSignalExternalWorkflowExecutionInitiatedEventAttributes()
+		decisionAttributes := d.GetSignalExternalWorkflowExecutionDecisionAttributes()
+		if eventAttributes.GetDomain() != decisionAttributes.GetDomain() ||
+			eventAttributes.GetSignalName() != decisionAttributes.GetSignalName() ||
+			eventAttributes.GetWorkflowExecution().GetWorkflowId() != decisionAttributes.GetExecution().GetWorkflowId() ||
+			eventAttributes.GetWorkflowExecution().GetRunId() != decisionAttributes.GetExecution().GetRunId() ||
+			bytes.Compare(eventAttributes.GetControl(), decisionAttributes.GetControl()) != 0 ||
+			(strictMode && bytes.Compare(eventAttributes.GetInput(), decisionAttributes.GetInput()) != 0) {
+			return false
+		}
+
+		return true
+
 	case s.DecisionType_CancelWorkflowExecution:
 		if e.GetEventType() != s.EventType_WorkflowExecutionCanceled {
 			return false
@@ -748,11 +793,12 @@ func (wth *workflowTaskHandlerImpl) completeWorkflow(
 	startAttributes *s.WorkflowExecutionStartedEventAttributes,
 ) *s.Decision {
 	var decision *s.Decision
-	if canceledErr, ok := err.(*CanceledError); ok {
+	if _, ok := err.(*CanceledError); ok {
 		// Workflow cancelled
+		_, details := getErrorDetails(err, wth.dataConverter)
 		decision = createNewDecision(s.DecisionType_CancelWorkflowExecution)
 		decision.CancelWorkflowExecutionDecisionAttributes = &s.CancelWorkflowExecutionDecisionAttributes{
-			Details: canceledErr.details,
+			Details: details,
 		}
 	} else if contErr, ok := err.(*ContinueAsNewError); ok {
 		// Continue as new error.
@@ -767,7 +813,7 @@ func (wth *workflowTaskHandlerImpl) completeWorkflow(
 	} else if err != nil {
 		// Workflow failures
 		decision = createNewDecision(s.DecisionType_FailWorkflowExecution)
-		reason, details := getErrorDetails(err)
+		reason, details := getErrorDetails(err, wth.dataConverter)
 		decision.FailWorkflowExecutionDecisionAttributes = &s.FailWorkflowExecutionDecisionAttributes{
 			Reason:  common.StringPtr(reason),
 			Details: details,
@@ -821,6 +867,7 @@ func newActivityTaskHandlerWithCustomProvider(
 	env *hostEnvImpl,
 	activityProvider activityProvider,
 ) ActivityTaskHandler {
+	ensureRequiredParams(&params)
 	return &activityTaskHandlerImpl{
 		taskListName:     params.TaskList,
 		identity:         params.Identity,
@@ -830,6 +877,9 @@ func newActivityTaskHandlerWithCustomProvider(
 		userContext:      params.UserContext,
 		hostEnv:          env,
 		activityProvider: activityProvider,
+		dataConverter:    params.DataConverter,
+
+		activityInterceptors: params.ActivityInterceptorChainFactories,
 	}
 }
 
@@ -968,7 +1018,7 @@ func (ath *activityTaskHandlerImpl) Execute(t *s.PollForActivityTaskResponse) (r
 	canCtx, cancel := context.WithCancel(rootCtx)
 	invoker := newServiceInvoker(t.TaskToken, ath.identity, ath.service, cancel, t.GetHeartbeatTimeoutSeconds())
 	defer invoker.Close()
-	ctx := WithActivityTask(canCtx, t, invoker, ath.logger, ath.metricsScope)
+	ctx := newActivityContext(canCtx, t, invoker, ath.logger, ath.metricsScope, ath.dataConverter)
 	activityType := *t.GetActivityType()
 	activityImplementation := ath.getActivity(activityType.GetName())
 	if activityImplementation == nil {
@@ -986,7 +1036,7 @@ func (ath *activityTaskHandlerImpl) Execute(t *s.PollForActivityTaskResponse) (r
 				zap.String("PanicStack", st))
 			ath.metricsScope.Counter(metrics.ActivityTaskPanicCounter).Inc(1)
 			panicErr := newPanicError(p, st)
-			result, err = convertActivityResultToRespondRequest(ath.identity, t.TaskToken, nil, panicErr), nil
+			result, err = convertActivityResultToRespondRequest(ath.identity, t.TaskToken, nil, panicErr, ath.dataConverter), nil
 		}
 	}()
 
@@ -1001,14 +1051,15 @@ func (ath *activityTaskHandlerImpl) Execute(t *s.PollForActivityTaskResponse) (r
 	}
 	ctx, dlCancelFunc := context.WithDeadline(ctx, deadline)
 
-	output, err := activityImplementation.Execute(ctx, t.GetInput())
+	output, err := executeActivityWithInterceptors(
+		ctx, activityImplementation, t.GetInput(), ath.activityInterceptors, ath.dataConverter)
 
 	dlCancelFunc()
 	if <-ctx.Done(); ctx.Err() == context.DeadlineExceeded {
 		return nil, ctx.Err()
 	}
 
-	return convertActivityResultToRespondRequest(ath.identity, t.TaskToken, output, err), nil
+	return convertActivityResultToRespondRequest(ath.identity, t.TaskToken, output, err, ath.dataConverter), nil
 }
 
 func (ath *activityTaskHandlerImpl) getActivity(name string) activity {
@@ -1019,6 +1070,9 @@ func (ath *activityTaskHandlerImpl) getActivity(name string) activity {
 	if a, ok := ath.hostEnv.getActivity(name); ok {
 		return a
 	}
+	if dynamicActivity := ath.hostEnv.getDynamicActivity(); dynamicActivity != nil {
+		return &dynamicActivityExecutor{name: name, fn: dynamicActivity}
+	}
 
 	return nil
 }
@@ -1059,6 +1113,39 @@ func recordActivityHeartbeat(
 	return heartbeatErr
 }
 
+func recordActivityHeartbeatByID(
+	ctx context.Context,
+	service m.TChanWorkflowService,
+	identity, domain, workflowID, runID, activityID string,
+	details []byte,
+	retryPolicy backoff.RetryPolicy,
+) error {
+	request := &s.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:     common.StringPtr(domain),
+		WorkflowID: common.StringPtr(workflowID),
+		RunID:      getRunID(runID),
+		ActivityID: common.StringPtr(activityID),
+		Details:    details,
+		Identity:   common.StringPtr(identity)}
+
+	var heartbeatResponse *s.RecordActivityTaskHeartbeatResponse
+	heartbeatErr := backoff.Retry(ctx,
+		func() error {
+			tchCtx, cancel := newTChannelContext(ctx)
+			defer cancel()
+
+			var err error
+			heartbeatResponse, err = service.RecordActivityTaskHeartbeatByID(tchCtx, request)
+			return err
+		}, retryPolicy, isServiceTransientError)
+
+	if heartbeatErr == nil && heartbeatResponse != nil && heartbeatResponse.GetCancelRequested() {
+		return NewCanceledError()
+	}
+
+	return heartbeatErr
+}
+
 // This enables verbose logging in the client library.
 // check Cadence.EnableVerboseLogging()
 func traceLog(fn func()) {
@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"go.uber.org/atomic"
 	s "go.uber.org/cadence/.gen/go/shared"
 	"go.uber.org/cadence/common"
 	"go.uber.org/cadence/common/util"
@@ -64,6 +65,134 @@ func init() {
 		greeterActivityFunc,
 		RegisterActivityOptions{Name: "Greeter_Activity"},
 	)
+	RegisterWorkflowWithOptions(
+		localActivityWorkflowFunc,
+		RegisterWorkflowOptions{Name: "LocalActivity_Workflow"},
+	)
+	RegisterWorkflowWithOptions(
+		mutableSideEffectWorkflowFunc,
+		RegisterWorkflowOptions{Name: "MutableSideEffect_Workflow"},
+	)
+	RegisterWorkflowWithOptions(
+		mutableSideEffectTwiceWorkflowFunc,
+		RegisterWorkflowOptions{Name: "MutableSideEffectTwice_Workflow"},
+	)
+	RegisterWorkflowWithOptions(
+		signalExternalWorkflowFunc,
+		RegisterWorkflowOptions{Name: "SignalExternal_Workflow"},
+	)
+	RegisterWorkflowWithOptions(
+		deadlineWorkflowFunc,
+		RegisterWorkflowOptions{Name: "Deadline_Workflow"},
+	)
+	RegisterWorkflowWithOptions(
+		signalChildWorkflowFunc,
+		RegisterWorkflowOptions{Name: "SignalChild_Workflow"},
+	)
+	RegisterWorkflowWithOptions(
+		continueAsNewWorkflowFunc,
+		RegisterWorkflowOptions{Name: "ContinueAsNew_Workflow"},
+	)
+	RegisterWorkflowWithOptions(
+		cronWorkflowFunc,
+		RegisterWorkflowOptions{Name: "Cron_Workflow"},
+	)
+}
+
+var localActivityExecutionCount atomic.Int32
+
+func localActivityWorkflowFunc(ctx Context) (string, error) {
+	ctx = WithLocalActivityOptions(ctx, LocalActivityOptions{ScheduleToCloseTimeout: time.Second})
+	var result string
+	err := ExecuteLocalActivity(ctx, func(ctx context.Context, name string) (string, error) {
+		localActivityExecutionCount.Inc()
+		return "Hello " + name, nil
+	}, "local").Get(ctx, &result)
+	if err != nil {
+		return "", err
+	}
+	GetSignalChannel(ctx, "local-activity-signal").Receive(ctx, nil)
+	return result, nil
+}
+
+var mutableSideEffectValue atomic.Int32
+
+func mutableSideEffectWorkflowFunc(ctx Context) ([]int32, error) {
+	var values []int32
+	for i := 0; i < 2; i++ {
+		var value int32
+		err := MutableSideEffect(ctx, "config", func(ctx Context) interface{} {
+			return mutableSideEffectValue.Load()
+		}, func(a, b interface{}) bool {
+			return a.(int32) == b.(int32)
+		}).Get(&value)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		GetSignalChannel(ctx, "mutable-side-effect-signal").Receive(ctx, nil)
+	}
+	return values, nil
+}
+
+func mutableSideEffectTwiceWorkflowFunc(ctx Context) ([]int32, error) {
+	var values []int32
+	for i := int32(1); i <= 2; i++ {
+		value := i
+		var result int32
+		err := MutableSideEffect(ctx, "config", func(ctx Context) interface{} {
+			return value
+		}, nil).Get(&result)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, result)
+	}
+	GetSignalChannel(ctx, "mutable-side-effect-signal").Receive(ctx, nil)
+	return values, nil
+}
+
+func signalExternalWorkflowFunc(ctx Context) error {
+	return SignalExternalWorkflow(ctx, testDomain, "target-workflow-id", "", "test-signal", "payload").Get(ctx, nil)
+}
+
+func deadlineWorkflowFunc(ctx Context) error {
+	ctx1, cancel1 := WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel1()
+	ctx2, cancel2 := WithTimeout(ctx1, 1900*time.Millisecond)
+	defer cancel2()
+	ctx3, cancel3 := WithDeadline(ctx, Now(ctx).Add(1900*time.Millisecond))
+	defer cancel3()
+	NewSelector(ctx).AddReceive(ctx2.Done(), func(c Channel, more bool) {}).
+		AddReceive(ctx3.Done(), func(c Channel, more bool) {}).Select(ctx)
+	return nil
+}
+
+func signalChildWorkflowFunc(ctx Context) (string, error) {
+	ctx = WithChildWorkflowOptions(ctx, ChildWorkflowOptions{
+		WorkflowID:                   "child-workflow-id",
+		ExecutionStartToCloseTimeout: time.Minute,
+		TaskStartToCloseTimeout:      time.Minute,
+	})
+	child := ExecuteChildWorkflow(ctx, "HelloWorld_Workflow", "child")
+	result := "timer"
+	NewSelector(ctx).AddFuture(child.SignalChildWorkflow(ctx, "ping", "hello"), func(f Future) {
+		result = "signaled"
+	}).AddFuture(NewTimer(ctx, time.Minute), func(f Future) {}).Select(ctx)
+	return result, nil
+}
+
+func continueAsNewWorkflowFunc(ctx Context, input string) error {
+	options := ContinueAsNewOptions{TaskList: "tl2", WorkflowType: "ContinueAsNew_Workflow"}
+	return NewContinueAsNewErrorWithOptions(ctx, options, continueAsNewWorkflowFunc, GetWorkflowInfo(ctx).ContinuedExecutionRunID)
+}
+
+func cronWorkflowFunc(ctx Context) (int, error) {
+	var count int
+	if err := GetLastCompletionResult(ctx, &count); err != nil && err != ErrNoData {
+		return 0, err
+	}
+	return count + 1, nil
 }
 
 // Test suite.
@@ -185,6 +314,39 @@ func (t *TaskHandlersTestSuite) TestWorkflowTask_WorkflowExecutionStarted() {
 	t.NotNil(response.GetDecisions()[0].GetScheduleActivityTaskDecisionAttributes())
 }
 
+func (t *TaskHandlersTestSuite) TestWorkflowTask_ContinueAsNewWithOptions() {
+	taskList := "tl1"
+	testEvents := []*s.HistoryEvent{
+		createTestEventWorkflowExecutionStarted(1, &s.WorkflowExecutionStartedEventAttributes{
+			TaskList:                            &s.TaskList{Name: &taskList},
+			Input:                               []byte(`""`),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+			ContinuedExecutionRunId:             common.StringPtr("previous-run-id"),
+		}),
+	}
+	task := createWorkflowTask(testEvents, 0, "ContinueAsNew_Workflow")
+	params := workerExecutionParameters{
+		TaskList: taskList,
+		Identity: "test-id-1",
+		Logger:   t.logger,
+	}
+	taskHandler := newWorkflowTaskHandler(testDomain, params, nil, getHostEnvironment())
+	request, _, err := taskHandler.ProcessWorkflowTask(task, nil, false)
+	t.NoError(err)
+	response := request.(*s.RespondDecisionTaskCompletedRequest)
+	t.Equal(1, len(response.GetDecisions()))
+	t.Equal(s.DecisionType_ContinueAsNewWorkflowExecution, response.GetDecisions()[0].GetDecisionType())
+	attributes := response.GetDecisions()[0].GetContinueAsNewWorkflowExecutionDecisionAttributes()
+	t.Equal("ContinueAsNew_Workflow", attributes.GetWorkflowType().GetName())
+	t.Equal("tl2", attributes.GetTaskList().GetName())
+	t.EqualValues(60, attributes.GetExecutionStartToCloseTimeoutSeconds())
+	t.EqualValues(10, attributes.GetTaskStartToCloseTimeoutSeconds())
+	var input string
+	t.NoError(getHostEnvironment().decodeArg(attributes.GetInput(), &input))
+	t.Equal("previous-run-id", input)
+}
+
 func (t *TaskHandlersTestSuite) TestWorkflowTask_ActivityTaskScheduled() {
 	// Schedule an activity and see if we complete workflow.
 	taskList := "tl1"
@@ -226,6 +388,76 @@ func (t *TaskHandlersTestSuite) TestWorkflowTask_ActivityTaskScheduled() {
 	t.NotNil(response.GetDecisions()[0].GetCompleteWorkflowExecutionDecisionAttributes())
 }
 
+type testTaskListInterceptorFactory struct {
+	taskList string
+	calls    []string
+}
+
+type testTaskListInterceptor struct {
+	WorkflowInterceptorBase
+	factory *testTaskListInterceptorFactory
+}
+
+func (f *testTaskListInterceptorFactory) NewInterceptor(info *WorkflowInfo, next WorkflowInterceptor) WorkflowInterceptor {
+	f.calls = append(f.calls, "NewInterceptor:"+info.WorkflowType.Name)
+	return &testTaskListInterceptor{WorkflowInterceptorBase: WorkflowInterceptorBase{Next: next}, factory: f}
+}
+
+func (i *testTaskListInterceptor) ExecuteActivity(ctx Context, activityType string, args ...interface{}) Future {
+	i.factory.calls = append(i.factory.calls, "ExecuteActivity:"+activityType)
+	return i.Next.ExecuteActivity(WithTaskList(ctx, i.factory.taskList), activityType, args...)
+}
+
+func (i *testTaskListInterceptor) SetQueryHandler(ctx Context, queryType string, handler interface{}) error {
+	i.factory.calls = append(i.factory.calls, "SetQueryHandler:"+queryType)
+	return i.Next.SetQueryHandler(ctx, queryType, handler)
+}
+
+func (t *TaskHandlersTestSuite) TestWorkflowTask_WorkflowInterceptor() {
+	taskList := "tl1"
+	testEvents := []*s.HistoryEvent{
+		createTestEventWorkflowExecutionStarted(1, &s.WorkflowExecutionStartedEventAttributes{TaskList: &s.TaskList{Name: &taskList}}),
+		createTestEventActivityTaskScheduled(2, &s.ActivityTaskScheduledEventAttributes{
+			ActivityId:   common.StringPtr("0"),
+			ActivityType: &s.ActivityType{Name: common.StringPtr("Greeter_Activity")},
+			TaskList:     &s.TaskList{Name: common.StringPtr("intercepted")},
+		}),
+		createTestEventActivityTaskStarted(3, &s.ActivityTaskStartedEventAttributes{}),
+		createTestEventActivityTaskCompleted(4, &s.ActivityTaskCompletedEventAttributes{ScheduledEventId: common.Int64Ptr(2)}),
+		createTestEventDecisionTaskStarted(5),
+	}
+	factory := &testTaskListInterceptorFactory{taskList: "intercepted"}
+	params := workerExecutionParameters{
+		TaskList:                          taskList,
+		Identity:                          "test-id-1",
+		Logger:                            t.logger,
+		WorkflowInterceptorChainFactories: []WorkflowInterceptorFactory{factory},
+	}
+	taskHandler := newWorkflowTaskHandler(testDomain, params, nil, getHostEnvironment())
+	task := createWorkflowTask(testEvents[0:1], 0, "HelloWorld_Workflow")
+	request, _, err := taskHandler.ProcessWorkflowTask(task, nil, false)
+	t.NoError(err)
+	response := request.(*s.RespondDecisionTaskCompletedRequest)
+	t.Equal(1, len(response.GetDecisions()))
+	t.Equal(s.DecisionType_ScheduleActivityTask, response.GetDecisions()[0].GetDecisionType())
+	t.Equal("intercepted", response.GetDecisions()[0].GetScheduleActivityTaskDecisionAttributes().GetTaskList().GetName())
+	t.Equal([]string{
+		"NewInterceptor:HelloWorld_Workflow", "SetQueryHandler:test-query", "ExecuteActivity:Greeter_Activity",
+	}, factory.calls)
+
+	// The interceptors are called again on replay, and make the same decisions.
+	factory.calls = nil
+	task = createWorkflowTask(testEvents, 2, "HelloWorld_Workflow")
+	request, _, err = taskHandler.ProcessWorkflowTask(task, nil, false)
+	t.NoError(err)
+	response = request.(*s.RespondDecisionTaskCompletedRequest)
+	t.Equal(1, len(response.GetDecisions()))
+	t.Equal(s.DecisionType_CompleteWorkflowExecution, response.GetDecisions()[0].GetDecisionType())
+	t.Equal([]string{
+		"NewInterceptor:HelloWorld_Workflow", "SetQueryHandler:test-query", "ExecuteActivity:Greeter_Activity",
+	}, factory.calls)
+}
+
 func (t *TaskHandlersTestSuite) TestWorkflowTask_QueryWorkflow() {
 	// Schedule an activity and see if we complete workflow.
 	taskList := "tl1"
@@ -290,7 +522,7 @@ func (t *TaskHandlersTestSuite) verifyQueryResult(response interface{}, expected
 	t.True(ok)
 	t.Nil(queryResp.ErrorMessage)
 	t.NotNil(queryResp.QueryResult_)
-	encodedValue := EncodedValue(queryResp.QueryResult_)
+	encodedValue := newEncodedValue(queryResp.QueryResult_, nil)
 	var queryResult string
 	err := encodedValue.Get(&queryResult)
 	t.NoError(err)
@@ -354,6 +586,375 @@ func (t *TaskHandlersTestSuite) TestWorkflowTask_CancelActivityBeforeSent() {
 	t.NotNil(response.GetDecisions()[0].GetCompleteWorkflowExecutionDecisionAttributes())
 }
 
+func (t *TaskHandlersTestSuite) TestWorkflowTask_LocalActivity() {
+	taskList := "tl1"
+	testEvents := []*s.HistoryEvent{
+		createTestEventWorkflowExecutionStarted(1, &s.WorkflowExecutionStartedEventAttributes{TaskList: &s.TaskList{Name: &taskList}}),
+		createTestEventDecisionTaskScheduled(2, &s.DecisionTaskScheduledEventAttributes{}),
+		createTestEventDecisionTaskStarted(3),
+	}
+	task := createWorkflowTask(testEvents, 0, "LocalActivity_Workflow")
+	params := workerExecutionParameters{
+		TaskList: taskList,
+		Identity: "test-id-1",
+		Logger:   t.logger,
+	}
+	localActivityExecutionCount.Store(0)
+	taskHandler := newWorkflowTaskHandler(testDomain, params, nil, getHostEnvironment())
+	request, _, err := taskHandler.ProcessWorkflowTask(task, nil, false)
+	response := request.(*s.RespondDecisionTaskCompletedRequest)
+	t.NoError(err)
+	t.NotNil(response)
+	// The local activity is executed while processing the decision task, and its result is recorded as a marker.
+	t.Equal(int32(1), localActivityExecutionCount.Load())
+	t.Equal(1, len(response.GetDecisions()))
+	t.Equal(s.DecisionType_RecordMarker, response.GetDecisions()[0].GetDecisionType())
+	markerAttributes := response.GetDecisions()[0].GetRecordMarkerDecisionAttributes()
+	t.Equal(localActivityMarkerName, markerAttributes.GetMarkerName())
+
+	// Replaying the history returns the result recorded in the marker, without executing the local activity again.
+	testEvents = append(testEvents,
+		createTestEventDecisionTaskCompleted(4, &s.DecisionTaskCompletedEventAttributes{ScheduledEventId: common.Int64Ptr(2)}),
+		&s.HistoryEvent{
+			EventId:   common.Int64Ptr(5),
+			EventType: common.EventTypePtr(s.EventType_MarkerRecorded),
+			MarkerRecordedEventAttributes: &s.MarkerRecordedEventAttributes{
+				MarkerName:                   markerAttributes.MarkerName,
+				Details:                      markerAttributes.Details,
+				DecisionTaskCompletedEventId: common.Int64Ptr(4),
+			},
+		},
+		createTestEventWorkflowExecutionSignaled(6, "local-activity-signal"),
+		createTestEventDecisionTaskScheduled(7, &s.DecisionTaskScheduledEventAttributes{}),
+		createTestEventDecisionTaskStarted(8),
+	)
+	task = createWorkflowTask(testEvents, 3, "LocalActivity_Workflow")
+	request, _, err = taskHandler.ProcessWorkflowTask(task, nil, false)
+	response = request.(*s.RespondDecisionTaskCompletedRequest)
+	t.NoError(err)
+	t.NotNil(response)
+	t.Equal(int32(1), localActivityExecutionCount.Load())
+	t.Equal(1, len(response.GetDecisions()))
+	t.Equal(s.DecisionType_CompleteWorkflowExecution, response.GetDecisions()[0].GetDecisionType())
+	var result string
+	err = getDefaultDataConverter().FromData(response.GetDecisions()[0].GetCompleteWorkflowExecutionDecisionAttributes().Result_, &result)
+	t.NoError(err)
+	t.Equal("Hello local", result)
+}
+
+func (t *TaskHandlersTestSuite) TestWorkflowTask_MutableSideEffect() {
+	taskList := "tl1"
+	testEvents := []*s.HistoryEvent{
+		createTestEventWorkflowExecutionStarted(1, &s.WorkflowExecutionStartedEventAttributes{TaskList: &s.TaskList{Name: &taskList}}),
+		createTestEventDecisionTaskScheduled(2, &s.DecisionTaskScheduledEventAttributes{}),
+		createTestEventDecisionTaskStarted(3),
+	}
+	params := workerExecutionParameters{
+		TaskList: taskList,
+		Identity: "test-id-1",
+		Logger:   t.logger,
+	}
+	taskHandler := newWorkflowTaskHandler(testDomain, params, nil, getHostEnvironment())
+	processTask := func(previousStartedEventID int64) []*s.Decision {
+		task := createWorkflowTask(testEvents, previousStartedEventID, "MutableSideEffect_Workflow")
+		request, _, err := taskHandler.ProcessWorkflowTask(task, nil, false)
+		t.NoError(err)
+		response := request.(*s.RespondDecisionTaskCompletedRequest)
+		t.NotNil(response)
+		return response.GetDecisions()
+	}
+	appendDecisionTask := func(marker *s.RecordMarkerDecisionAttributes) {
+		nextEventID := int64(len(testEvents)) + 1
+		testEvents = append(testEvents,
+			createTestEventDecisionTaskCompleted(nextEventID, &s.DecisionTaskCompletedEventAttributes{}))
+		nextEventID++
+		if marker != nil {
+			testEvents = append(testEvents, &s.HistoryEvent{
+				EventId:   common.Int64Ptr(nextEventID),
+				EventType: common.EventTypePtr(s.EventType_MarkerRecorded),
+				MarkerRecordedEventAttributes: &s.MarkerRecordedEventAttributes{
+					MarkerName: marker.MarkerName,
+					Details:    marker.Details,
+				},
+			})
+			nextEventID++
+		}
+		testEvents = append(testEvents,
+			createTestEventWorkflowExecutionSignaled(nextEventID, "mutable-side-effect-signal"),
+			createTestEventDecisionTaskScheduled(nextEventID+1, &s.DecisionTaskScheduledEventAttributes{}),
+			createTestEventDecisionTaskStarted(nextEventID+2),
+		)
+	}
+
+	// The first value is recorded.
+	mutableSideEffectValue.Store(1)
+	decisions := processTask(0)
+	t.Equal(1, len(decisions))
+	t.Equal(s.DecisionType_RecordMarker, decisions[0].GetDecisionType())
+	t.Equal(mutableSideEffectMarkerName, decisions[0].GetRecordMarkerDecisionAttributes().GetMarkerName())
+
+	// The recorded value is replayed, and the changed value is recorded.
+	appendDecisionTask(decisions[0].GetRecordMarkerDecisionAttributes())
+	mutableSideEffectValue.Store(2)
+	decisions = processTask(3)
+	t.Equal(1, len(decisions))
+	t.Equal(s.DecisionType_RecordMarker, decisions[0].GetDecisionType())
+
+	// Replaying returns the recorded values without calling the function.
+	appendDecisionTask(decisions[0].GetRecordMarkerDecisionAttributes())
+	mutableSideEffectValue.Store(3)
+	decisions = processTask(8)
+	t.Equal(1, len(decisions))
+	t.Equal(s.DecisionType_CompleteWorkflowExecution, decisions[0].GetDecisionType())
+	var result []int32
+	err := getDefaultDataConverter().FromData(decisions[0].GetCompleteWorkflowExecutionDecisionAttributes().Result_, &result)
+	t.NoError(err)
+	t.Equal([]int32{1, 2}, result)
+}
+
+func (t *TaskHandlersTestSuite) TestWorkflowTask_MutableSideEffectChangedTwiceInDecision() {
+	taskList := "tl1"
+	testEvents := []*s.HistoryEvent{
+		createTestEventWorkflowExecutionStarted(1, &s.WorkflowExecutionStartedEventAttributes{TaskList: &s.TaskList{Name: &taskList}}),
+		createTestEventDecisionTaskScheduled(2, &s.DecisionTaskScheduledEventAttributes{}),
+		createTestEventDecisionTaskStarted(3),
+	}
+	params := workerExecutionParameters{
+		TaskList: taskList,
+		Identity: "test-id-1",
+		Logger:   t.logger,
+	}
+	taskHandler := newWorkflowTaskHandler(testDomain, params, nil, getHostEnvironment())
+
+	task := createWorkflowTask(testEvents, 0, "MutableSideEffectTwice_Workflow")
+	request, _, err := taskHandler.ProcessWorkflowTask(task, nil, false)
+	t.NoError(err)
+	decisions := request.(*s.RespondDecisionTaskCompletedRequest).GetDecisions()
+	t.Equal(2, len(decisions))
+
+	// Both markers of the decision are loaded before it is replayed, each call must still get its own value.
+	testEvents = append(testEvents, createTestEventDecisionTaskCompleted(4, &s.DecisionTaskCompletedEventAttributes{}))
+	for i, d := range decisions {
+		t.Equal(s.DecisionType_RecordMarker, d.GetDecisionType())
+		testEvents = append(testEvents, &s.HistoryEvent{
+			EventId:   common.Int64Ptr(int64(5 + i)),
+			EventType: common.EventTypePtr(s.EventType_MarkerRecorded),
+			MarkerRecordedEventAttributes: &s.MarkerRecordedEventAttributes{
+				MarkerName: d.GetRecordMarkerDecisionAttributes().MarkerName,
+				Details:    d.GetRecordMarkerDecisionAttributes().Details,
+			},
+		})
+	}
+	testEvents = append(testEvents,
+		createTestEventWorkflowExecutionSignaled(7, "mutable-side-effect-signal"),
+		createTestEventDecisionTaskScheduled(8, &s.DecisionTaskScheduledEventAttributes{}),
+		createTestEventDecisionTaskStarted(9),
+	)
+	task = createWorkflowTask(testEvents, 3, "MutableSideEffectTwice_Workflow")
+	request, _, err = taskHandler.ProcessWorkflowTask(task, nil, false)
+	t.NoError(err)
+	decisions = request.(*s.RespondDecisionTaskCompletedRequest).GetDecisions()
+	t.Equal(1, len(decisions))
+	t.Equal(s.DecisionType_CompleteWorkflowExecution, decisions[0].GetDecisionType())
+	var result []int32
+	err = getDefaultDataConverter().FromData(decisions[0].GetCompleteWorkflowExecutionDecisionAttributes().Result_, &result)
+	t.NoError(err)
+	t.Equal([]int32{1, 2}, result)
+}
+
+func (t *TaskHandlersTestSuite) TestWorkflowTask_DeadlineRoundedUpToSeconds() {
+	taskList := "tl1"
+	testEvents := []*s.HistoryEvent{
+		createTestEventWorkflowExecutionStarted(1, &s.WorkflowExecutionStartedEventAttributes{TaskList: &s.TaskList{Name: &taskList}}),
+		createTestEventDecisionTaskScheduled(2, &s.DecisionTaskScheduledEventAttributes{}),
+		createTestEventDecisionTaskStarted(3),
+	}
+	params := workerExecutionParameters{
+		TaskList: taskList,
+		Identity: "test-id-1",
+		Logger:   t.logger,
+	}
+	taskHandler := newWorkflowTaskHandler(testDomain, params, nil, getHostEnvironment())
+
+	task := createWorkflowTask(testEvents, 0, "Deadline_Workflow")
+	request, _, err := taskHandler.ProcessWorkflowTask(task, nil, false)
+	t.NoError(err)
+	response := request.(*s.RespondDecisionTaskCompletedRequest)
+	// The sub-second timeout fires after a second, and the fractional deadline after two. The nested timeout
+	// doesn't extend the sooner deadline of its parent, so it starts no timer.
+	t.Equal(2, len(response.Decisions))
+	for i, seconds := range []int64{1, 2} {
+		t.Equal(s.DecisionType_StartTimer, response.Decisions[i].GetDecisionType())
+		t.Equal(seconds, response.Decisions[i].GetStartTimerDecisionAttributes().GetStartToFireTimeoutSeconds())
+	}
+}
+
+func (t *TaskHandlersTestSuite) TestWorkflowTask_SignalChildWorkflowBeforeStart() {
+	taskList := "tl1"
+	testEvents := []*s.HistoryEvent{
+		createTestEventWorkflowExecutionStarted(1, &s.WorkflowExecutionStartedEventAttributes{TaskList: &s.TaskList{Name: &taskList}}),
+		createTestEventDecisionTaskScheduled(2, &s.DecisionTaskScheduledEventAttributes{}),
+		createTestEventDecisionTaskStarted(3),
+	}
+	params := workerExecutionParameters{
+		TaskList: taskList,
+		Identity: "test-id-1",
+		Logger:   t.logger,
+	}
+	taskHandler := newWorkflowTaskHandler(testDomain, params, nil, getHostEnvironment())
+
+	// The workflow selects on the signal future while the child is not started yet, so the timer is started too.
+	task := createWorkflowTask(testEvents, 0, "SignalChild_Workflow")
+	request, _, err := taskHandler.ProcessWorkflowTask(task, nil, false)
+	t.NoError(err)
+	response := request.(*s.RespondDecisionTaskCompletedRequest)
+	t.Equal(2, len(response.Decisions))
+	t.Equal(s.DecisionType_StartChildWorkflowExecution, response.Decisions[0].GetDecisionType())
+	t.Equal(s.DecisionType_StartTimer, response.Decisions[1].GetDecisionType())
+}
+
+func (t *TaskHandlersTestSuite) TestWorkflowTask_SignalExternalWorkflow() {
+	taskList := "tl1"
+	testEvents := []*s.HistoryEvent{
+		createTestEventWorkflowExecutionStarted(1, &s.WorkflowExecutionStartedEventAttributes{TaskList: &s.TaskList{Name: &taskList}}),
+		createTestEventDecisionTaskScheduled(2, &s.DecisionTaskScheduledEventAttributes{}),
+		createTestEventDecisionTaskStarted(3),
+	}
+	params := workerExecutionParameters{
+		TaskList: taskList,
+		Identity: "test-id-1",
+		Logger:   t.logger,
+	}
+	taskHandler := newWorkflowTaskHandler(testDomain, params, nil, getHostEnvironment())
+
+	task := createWorkflowTask(testEvents, 0, "SignalExternal_Workflow")
+	request, _, err := taskHandler.ProcessWorkflowTask(task, nil, false)
+	t.NoError(err)
+	response := request.(*s.RespondDecisionTaskCompletedRequest)
+	t.Equal(1, len(response.Decisions))
+	t.Equal(s.DecisionType_SignalExternalWorkflowExecution, response.Decisions[0].GetDecisionType())
+	attributes := response.Decisions[0].GetSignalExternalWorkflowExecutionDecisionAttributes()
+	t.Equal(testDomain, attributes.GetDomain())
+	t.Equal("target-workflow-id", attributes.GetExecution().GetWorkflowId())
+	t.Equal("test-signal", attributes.GetSignalName())
+
+	// The signal is replayed and the workflow completes once it is delivered.
+	testEvents = append(testEvents,
+		createTestEventDecisionTaskCompleted(4, &s.DecisionTaskCompletedEventAttributes{}),
+		&s.HistoryEvent{
+			EventId:   common.Int64Ptr(5),
+			EventType: common.EventTypePtr(s.EventType_SignalExternalWorkflowExecutionInitiated),
+			SignalExternalWorkflowExecutionInitiatedEventAttributes: &s.SignalExternalWorkflowExecutionInitiatedEventAttributes{
+				Domain:            attributes.Domain,
+				WorkflowExecution: attributes.Execution,
+				SignalName:        attributes.SignalName,
+				Input:             attributes.Input,
+				Control:           attributes.Control,
+			},
+		},
+		&s.HistoryEvent{
+			EventId:   common.Int64Ptr(6),
+			EventType: common.EventTypePtr(s.EventType_ExternalWorkflowExecutionSignaled),
+			ExternalWorkflowExecutionSignaledEventAttributes: &s.ExternalWorkflowExecutionSignaledEventAttributes{
+				InitiatedEventId:  common.Int64Ptr(5),
+				Domain:            attributes.Domain,
+				WorkflowExecution: attributes.Execution,
+				Control:           attributes.Control,
+			},
+		},
+		createTestEventDecisionTaskScheduled(7, &s.DecisionTaskScheduledEventAttributes{}),
+		createTestEventDecisionTaskStarted(8),
+	)
+	task = createWorkflowTask(testEvents, 3, "SignalExternal_Workflow")
+	request, _, err = taskHandler.ProcessWorkflowTask(task, nil, false)
+	t.NoError(err)
+	response = request.(*s.RespondDecisionTaskCompletedRequest)
+	t.Equal(1, len(response.Decisions))
+	t.Equal(s.DecisionType_CompleteWorkflowExecution, response.Decisions[0].GetDecisionType())
+}
+
+func (t *TaskHandlersTestSuite) TestWorkflowTask_CronSchedule() {
+	taskList := "tl1"
+	lastResult, err := getHostEnvironment().encodeArg(2)
+	t.NoError(err)
+	// The run fires one hour after the Unix epoch, where the history starts, and has one hour to complete.
+	input, err := encodeCronWorkflowInput(&cronWorkflowInput{
+		Schedule:             "@hourly",
+		RunTimeoutSeconds:    3600,
+		FireTime:             time.Hour.Nanoseconds(),
+		LastCompletionResult: lastResult,
+	})
+	t.NoError(err)
+	testEvents := []*s.HistoryEvent{
+		createTestEventWorkflowExecutionStarted(1, &s.WorkflowExecutionStartedEventAttributes{
+			TaskList:                            &s.TaskList{Name: &taskList},
+			Input:                               input,
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(7200),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		}),
+		createTestEventDecisionTaskScheduled(2, &s.DecisionTaskScheduledEventAttributes{}),
+		createTestEventDecisionTaskStarted(3),
+	}
+	params := workerExecutionParameters{
+		TaskList: taskList,
+		Identity: "test-id-1",
+		Logger:   t.logger,
+	}
+	taskHandler := newWorkflowTaskHandler(testDomain, params, nil, getHostEnvironment())
+
+	// The run waits for its fire time.
+	task := createWorkflowTask(testEvents, 0, "Cron_Workflow")
+	request, _, err := taskHandler.ProcessWorkflowTask(task, nil, false)
+	t.NoError(err)
+	response := request.(*s.RespondDecisionTaskCompletedRequest)
+	t.Equal(1, len(response.Decisions))
+	t.Equal(s.DecisionType_StartTimer, response.Decisions[0].GetDecisionType())
+	timerAttributes := response.Decisions[0].GetStartTimerDecisionAttributes()
+	t.EqualValues(3600, timerAttributes.GetStartToFireTimeoutSeconds())
+
+	// Once the timer fires, the run completes and the workflow continues as new with its result.
+	testEvents = append(testEvents,
+		createTestEventDecisionTaskCompleted(4, &s.DecisionTaskCompletedEventAttributes{}),
+		&s.HistoryEvent{
+			EventId:   common.Int64Ptr(5),
+			EventType: common.EventTypePtr(s.EventType_TimerStarted),
+			TimerStartedEventAttributes: &s.TimerStartedEventAttributes{
+				TimerId:                   timerAttributes.TimerId,
+				StartToFireTimeoutSeconds: timerAttributes.StartToFireTimeoutSeconds,
+			},
+		},
+		&s.HistoryEvent{
+			EventId:   common.Int64Ptr(6),
+			EventType: common.EventTypePtr(s.EventType_TimerFired),
+			TimerFiredEventAttributes: &s.TimerFiredEventAttributes{
+				TimerId:        timerAttributes.TimerId,
+				StartedEventId: common.Int64Ptr(5),
+			},
+		},
+		createTestEventDecisionTaskScheduled(7, &s.DecisionTaskScheduledEventAttributes{}),
+		createTestEventDecisionTaskStarted(8),
+	)
+	task = createWorkflowTask(testEvents, 3, "Cron_Workflow")
+	request, _, err = taskHandler.ProcessWorkflowTask(task, nil, false)
+	t.NoError(err)
+	response = request.(*s.RespondDecisionTaskCompletedRequest)
+	t.Equal(1, len(response.Decisions))
+	t.Equal(s.DecisionType_ContinueAsNewWorkflowExecution, response.Decisions[0].GetDecisionType())
+	attributes := response.Decisions[0].GetContinueAsNewWorkflowExecutionDecisionAttributes()
+	t.Equal("Cron_Workflow", attributes.GetWorkflowType().GetName())
+	// The next run waits one hour for the next fire time, on top of its own timeout.
+	t.EqualValues(7200, attributes.GetExecutionStartToCloseTimeoutSeconds())
+	next, err := decodeCronWorkflowInput(attributes.Input)
+	t.NoError(err)
+	t.Equal("@hourly", next.Schedule)
+	t.EqualValues(3600, next.RunTimeoutSeconds)
+	t.Equal(time.Hour.Nanoseconds(), next.FireTime)
+	t.Nil(next.LastFailureReason)
+	var count int
+	t.NoError(getHostEnvironment().decodeArg(next.LastCompletionResult, &count))
+	t.Equal(3, count)
+}
+
 func (t *TaskHandlersTestSuite) TestWorkflowTask_PressurePoints() {
 	// Schedule a decision activity and see if we complete workflow.
 	taskList := "tl1"
@@ -519,6 +1120,115 @@ func (t *TaskHandlersTestSuite) TestActivityExecutionDeadline() {
 	}
 }
 
+type testAuthActivityInterceptor struct {
+	ActivityInterceptorBase
+	info *ActivityInfo
+}
+
+func (i *testAuthActivityInterceptor) NewInterceptor(info *ActivityInfo, next ActivityInterceptor) ActivityInterceptor {
+	return &testAuthActivityInterceptor{ActivityInterceptorBase: ActivityInterceptorBase{Next: next}, info: info}
+}
+
+func (i *testAuthActivityInterceptor) ExecuteActivity(ctx context.Context, activityType string, args ...interface{}) (interface{}, error) {
+	if args[0] == "denied" {
+		return nil, NewCustomError("unauthorized", i.info.ActivityType.Name)
+	}
+	ctx = context.WithValue(ctx, testContextKey("tenant"), i.info.WorkflowExecution.ID)
+	result, err := i.Next.ExecuteActivity(ctx, activityType, args...)
+	if err != nil {
+		return nil, err
+	}
+	return result.(string) + "!", nil
+}
+
+func (t *TaskHandlersTestSuite) TestActivityExecution_ActivityInterceptor() {
+	hostEnv := newHostEnvironment()
+	t.NoError(hostEnv.RegisterActivityWithOptions(func(ctx context.Context, name string) (string, error) {
+		return "hello " + name + " from " + ctx.Value(testContextKey("tenant")).(string), nil
+	}, RegisterActivityOptions{Name: "greet"}))
+	mockService := &mocks.TChanWorkflowService{}
+	wep := workerExecutionParameters{
+		Logger:                            t.logger,
+		DataConverter:                     getDefaultDataConverter(),
+		ActivityInterceptorChainFactories: []ActivityInterceptorFactory{&testAuthActivityInterceptor{}},
+	}
+	activityHandler := newActivityTaskHandler(mockService, wep, hostEnv)
+	newTask := func(name string) *s.PollForActivityTaskResponse {
+		input, err := encodeArgs(getDefaultDataConverter(), []interface{}{name})
+		t.NoError(err)
+		now := time.Now()
+		return &s.PollForActivityTaskResponse{
+			TaskToken: []byte("token"),
+			WorkflowExecution: &s.WorkflowExecution{
+				WorkflowId: common.StringPtr("tenant-wID"),
+				RunId:      common.StringPtr("rID")},
+			ActivityType:                  &s.ActivityType{Name: common.StringPtr("greet")},
+			ActivityId:                    common.StringPtr(uuid.New()),
+			Input:                         input,
+			ScheduledTimestamp:            common.Int64Ptr(now.UnixNano()),
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(10),
+			StartedTimestamp:              common.Int64Ptr(now.UnixNano()),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(10),
+		}
+	}
+
+	r, err := activityHandler.Execute(newTask("cadence"))
+	t.NoError(err)
+	completed, ok := r.(*s.RespondActivityTaskCompletedRequest)
+	t.True(ok)
+	var result string
+	t.NoError(decodeArg(getDefaultDataConverter(), completed.Result_, &result))
+	t.Equal("hello cadence from tenant-wID!", result)
+
+	// The interceptor fails the activity task without executing the activity.
+	r, err = activityHandler.Execute(newTask("denied"))
+	t.NoError(err)
+	failed, ok := r.(*s.RespondActivityTaskFailedRequest)
+	t.True(ok)
+	t.Equal("unauthorized", failed.GetReason())
+}
+
+func (t *TaskHandlersTestSuite) TestActivityExecution_DynamicActivity() {
+	hostEnv := newHostEnvironment()
+	t.NoError(hostEnv.RegisterDynamicActivity(
+		func(ctx context.Context, activityType string, args EncodedValues) (interface{}, error) {
+			var name string
+			if err := args.Get(&name); err != nil {
+				return nil, err
+			}
+			return activityType + ":" + name, nil
+		}))
+	mockService := &mocks.TChanWorkflowService{}
+	wep := workerExecutionParameters{
+		Logger:        t.logger,
+		DataConverter: getDefaultDataConverter(),
+	}
+	activityHandler := newActivityTaskHandler(mockService, wep, hostEnv)
+	input, err := encodeArgs(getDefaultDataConverter(), []interface{}{"cadence"})
+	t.NoError(err)
+	now := time.Now()
+	pats := &s.PollForActivityTaskResponse{
+		TaskToken: []byte("token"),
+		WorkflowExecution: &s.WorkflowExecution{
+			WorkflowId: common.StringPtr("wID"),
+			RunId:      common.StringPtr("rID")},
+		ActivityType:                  &s.ActivityType{Name: common.StringPtr("unregistered")},
+		ActivityId:                    common.StringPtr(uuid.New()),
+		Input:                         input,
+		ScheduledTimestamp:            common.Int64Ptr(now.UnixNano()),
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(10),
+		StartedTimestamp:              common.Int64Ptr(now.UnixNano()),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(10),
+	}
+	r, err := activityHandler.Execute(pats)
+	t.NoError(err)
+	response, ok := r.(*s.RespondActivityTaskCompletedRequest)
+	t.True(ok)
+	var result string
+	t.NoError(decodeArg(getDefaultDataConverter(), response.Result_, &result))
+	t.Equal("unregistered:cadence", result)
+}
+
 func stackTraceActivity() error {
 	return ErrActivityResultPending
 }
@@ -108,6 +108,14 @@ func isServiceTransientError(err error) bool {
 	return true
 }
 
+// isServiceRejectedError returns whether the server rejected the request without applying it. Only these errors are
+// retried for the requests that are not idempotent, as a retry after a timeout or an internal error could apply them
+// twice.
+func isServiceRejectedError(err error) bool {
+	_, ok := err.(*s.ServiceBusyError)
+	return ok
+}
+
 func isClientSideError(err error) bool {
 	// If an activity execution exceeds deadline.
 	if err == context.DeadlineExceeded {
@@ -377,7 +385,8 @@ func (atp *activityTaskPoller) ProcessTask(task interface{}) error {
 	}
 
 	responseStartTime := time.Now()
-	reportErr := reportActivityComplete(context.Background(), atp.service, request, atp.metricsScope)
+	reportErr := reportActivityComplete(context.Background(), atp.service, request, atp.metricsScope,
+		serviceOperationRetryPolicy)
 	if reportErr != nil {
 		atp.metricsScope.Counter(metrics.ActivityResponseFailedCounter).Inc(1)
 		traceLog(func() {
@@ -391,7 +400,8 @@ func (atp *activityTaskPoller) ProcessTask(task interface{}) error {
 	return nil
 }
 
-func reportActivityComplete(ctx context.Context, service m.TChanWorkflowService, request interface{}, metricsScope tally.Scope) error {
+func reportActivityComplete(ctx context.Context, service m.TChanWorkflowService, request interface{},
+	metricsScope tally.Scope, retryPolicy backoff.RetryPolicy) error {
 	if request == nil {
 		// nothing to report
 		return nil
@@ -405,25 +415,40 @@ func reportActivityComplete(ctx context.Context, service m.TChanWorkflowService,
 		reportErr = backoff.Retry(ctx,
 			func() error {
 				return service.RespondActivityTaskCanceled(tchCtx, request)
-			}, serviceOperationRetryPolicy, isServiceTransientError)
+			}, metrics.NewRetryPolicyWrapper(retryPolicy, metricsScope, "RespondActivityTaskCanceled"), isServiceTransientError)
 	case *s.RespondActivityTaskFailedRequest:
 		reportErr = backoff.Retry(ctx,
 			func() error {
 				return service.RespondActivityTaskFailed(tchCtx, request)
-			}, serviceOperationRetryPolicy, isServiceTransientError)
+			}, metrics.NewRetryPolicyWrapper(retryPolicy, metricsScope, "RespondActivityTaskFailed"), isServiceTransientError)
 	case *s.RespondActivityTaskCompletedRequest:
 		reportErr = backoff.Retry(ctx,
 			func() error {
 				return service.RespondActivityTaskCompleted(tchCtx, request)
-			}, serviceOperationRetryPolicy, isServiceTransientError)
+			}, metrics.NewRetryPolicyWrapper(retryPolicy, metricsScope, "RespondActivityTaskCompleted"), isServiceTransientError)
+	case *s.RespondActivityTaskCanceledByIDRequest:
+		reportErr = backoff.Retry(ctx,
+			func() error {
+				return service.RespondActivityTaskCanceledByID(tchCtx, request)
+			}, metrics.NewRetryPolicyWrapper(retryPolicy, metricsScope, "RespondActivityTaskCanceledByID"), isServiceTransientError)
+	case *s.RespondActivityTaskFailedByIDRequest:
+		reportErr = backoff.Retry(ctx,
+			func() error {
+				return service.RespondActivityTaskFailedByID(tchCtx, request)
+			}, metrics.NewRetryPolicyWrapper(retryPolicy, metricsScope, "RespondActivityTaskFailedByID"), isServiceTransientError)
+	case *s.RespondActivityTaskCompletedByIDRequest:
+		reportErr = backoff.Retry(ctx,
+			func() error {
+				return service.RespondActivityTaskCompletedByID(tchCtx, request)
+			}, metrics.NewRetryPolicyWrapper(retryPolicy, metricsScope, "RespondActivityTaskCompletedByID"), isServiceTransientError)
 	}
 	if reportErr == nil {
 		switch request.(type) {
-		case *s.RespondActivityTaskCanceledRequest:
+		case *s.RespondActivityTaskCanceledRequest, *s.RespondActivityTaskCanceledByIDRequest:
 			metricsScope.Counter(metrics.ActivityTaskCanceledCounter).Inc(1)
-		case *s.RespondActivityTaskFailedRequest:
+		case *s.RespondActivityTaskFailedRequest, *s.RespondActivityTaskFailedByIDRequest:
 			metricsScope.Counter(metrics.ActivityTaskFailedCounter).Inc(1)
-		case *s.RespondActivityTaskCompletedRequest:
+		case *s.RespondActivityTaskCompletedRequest, *s.RespondActivityTaskCompletedByIDRequest:
 			metricsScope.Counter(metrics.ActivityTaskCompletedCounter).Inc(1)
 		}
 	}
@@ -431,7 +456,8 @@ func reportActivityComplete(ctx context.Context, service m.TChanWorkflowService,
 	return reportErr
 }
 
-func convertActivityResultToRespondRequest(identity string, taskToken, result []byte, err error) interface{} {
+func convertActivityResultToRespondRequest(identity string, taskToken, result []byte, err error,
+	dataConverter DataConverter) interface{} {
 	if err == ErrActivityResultPending {
 		// activity result is pending and will be completed asynchronously.
 		// nothing to report at this point
@@ -445,7 +471,7 @@ func convertActivityResultToRespondRequest(identity string, taskToken, result []
 			Identity:  common.StringPtr(identity)}
 	}
 
-	reason, details := getErrorDetails(err)
+	reason, details := getErrorDetails(err, dataConverter)
 	if _, ok := err.(*CanceledError); ok || err == context.Canceled {
 		return &s.RespondActivityTaskCanceledRequest{
 			TaskToken: taskToken,
@@ -459,3 +485,42 @@ func convertActivityResultToRespondRequest(identity string, taskToken, result []
 		Details:   details,
 		Identity:  common.StringPtr(identity)}
 }
+
+func convertActivityResultToRespondRequestByID(identity, domain, workflowID, runID, activityID string,
+	result []byte, err error, dataConverter DataConverter) interface{} {
+	if err == ErrActivityResultPending {
+		// activity result is pending and will be completed asynchronously.
+		// nothing to report at this point
+		return nil
+	}
+
+	if err == nil {
+		return &s.RespondActivityTaskCompletedByIDRequest{
+			Domain:     common.StringPtr(domain),
+			WorkflowID: common.StringPtr(workflowID),
+			RunID:      getRunID(runID),
+			ActivityID: common.StringPtr(activityID),
+			Result_:    result,
+			Identity:   common.StringPtr(identity)}
+	}
+
+	reason, details := getErrorDetails(err, dataConverter)
+	if _, ok := err.(*CanceledError); ok || err == context.Canceled {
+		return &s.RespondActivityTaskCanceledByIDRequest{
+			Domain:     common.StringPtr(domain),
+			WorkflowID: common.StringPtr(workflowID),
+			RunID:      getRunID(runID),
+			ActivityID: common.StringPtr(activityID),
+			Details:    details,
+			Identity:   common.StringPtr(identity)}
+	}
+
+	return &s.RespondActivityTaskFailedByIDRequest{
+		Domain:     common.StringPtr(domain),
+		WorkflowID: common.StringPtr(workflowID),
+		RunID:      getRunID(runID),
+		ActivityID: common.StringPtr(activityID),
+		Reason:     common.StringPtr(reason),
+		Details:    details,
+		Identity:   common.StringPtr(identity)}
+}
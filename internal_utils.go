@@ -118,14 +118,14 @@ func workflowExecutionPtr(t WorkflowExecution) *s.WorkflowExecution {
 }
 
 // getErrorDetails gets reason and details.
-func getErrorDetails(err error) (string, []byte) {
+func getErrorDetails(err error, dc DataConverter) (string, []byte) {
 	switch err := err.(type) {
 	case *CustomError:
-		return err.Reason(), err.details
+		return err.Reason(), mustGetErrorDetailsData(err.details, dc)
 	case *CanceledError:
-		return errReasonCanceled, err.details
+		return errReasonCanceled, mustGetErrorDetailsData(err.details, dc)
 	case *PanicError:
-		data, gobErr := getHostEnvironment().encodeArgs([]interface{}{err.Error(), err.StackTrace()})
+		data, gobErr := encodeArgs(dc, []interface{}{err.Error(), err.StackTrace()})
 		if gobErr != nil {
 			panic(gobErr)
 		}
@@ -136,22 +136,30 @@ func getErrorDetails(err error) (string, []byte) {
 	}
 }
 
+func mustGetErrorDetailsData(details EncodedValues, dc DataConverter) []byte {
+	data, err := getErrorDetailsData(details, dc)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
 // constructError construct error from reason and details sending down from server.
-func constructError(reason string, details []byte) error {
+func constructError(reason string, details []byte, dc DataConverter) error {
 	switch reason {
 	case errReasonPanic:
 		// panic error
 		var msg, st string
-		details := EncodedValues(details)
+		details := newEncodedValues(details, dc)
 		details.Get(&msg, &st)
 		return newPanicError(msg, st)
 	case errReasonGeneric:
 		// errors created other than using NewCustomError() API.
 		return &GenericError{err: string(details)}
 	case errReasonCanceled:
-		return NewCanceledError(details)
+		return newCanceledErrorWithDetails(details, dc)
 	default:
-		return NewCustomError(reason, details)
+		return &CustomError{reason: reason, details: newEncodedValues(details, dc)}
 	}
 }
 
@@ -123,6 +123,15 @@ type (
 
 		// Context to store user provided key/value pairs
 		UserContext context.Context
+
+		// DataConverter to serialize/deserialize the payloads of the workflows and activities
+		DataConverter DataConverter
+
+		// WorkflowInterceptorChainFactories create the interceptors of the workflow executions
+		WorkflowInterceptorChainFactories []WorkflowInterceptorFactory
+
+		// ActivityInterceptorChainFactories create the interceptors of the activity tasks
+		ActivityInterceptorChainFactories []ActivityInterceptorFactory
 	}
 )
 
@@ -133,7 +142,7 @@ func newWorkflowWorker(
 	params workerExecutionParameters,
 	ppMgr pressurePointMgr,
 	hostEnv *hostEnvImpl,
-) Worker {
+) *workflowWorker {
 	return newWorkflowWorkerInternal(service, domain, params, ppMgr, nil, hostEnv)
 }
 
@@ -156,6 +165,9 @@ func ensureRequiredParams(params *workerExecutionParameters) {
 		params.MetricsScope = tally.NoopScope
 		params.Logger.Info("No metrics scope configured for cadence worker. Use NoopScope as default.")
 	}
+	if params.DataConverter == nil {
+		params.DataConverter = getDefaultDataConverter()
+	}
 }
 
 // verifyDomainExist does a DescribeDomain operation on the specified domain with backoff/retry
@@ -197,7 +209,7 @@ func newWorkflowWorkerInternal(
 	ppMgr pressurePointMgr,
 	overrides *workerOverrides,
 	hostEnv *hostEnvImpl,
-) Worker {
+) *workflowWorker {
 	// Get a workflow task handler.
 	ensureRequiredParams(&params)
 	var taskHandler WorkflowTaskHandler
@@ -214,7 +226,7 @@ func newWorkflowTaskWorkerInternal(
 	service m.TChanWorkflowService,
 	domain string,
 	params workerExecutionParameters,
-) Worker {
+) *workflowWorker {
 	ensureRequiredParams(&params)
 	poller := newWorkflowTaskPoller(
 		taskHandler,
@@ -273,7 +285,7 @@ func newActivityWorker(
 	params workerExecutionParameters,
 	overrides *workerOverrides,
 	env *hostEnvImpl,
-) Worker {
+) *activityWorker {
 	ensureRequiredParams(&params)
 	// Get a activity task handler.
 	var taskHandler ActivityTaskHandler
@@ -290,7 +302,7 @@ func newActivityTaskWorker(
 	service m.TChanWorkflowService,
 	domain string,
 	workerParams workerExecutionParameters,
-) (worker Worker) {
+) *activityWorker {
 	ensureRequiredParams(&workerParams)
 
 	poller := newActivityTaskPoller(
@@ -353,9 +365,12 @@ type activityFunc func(ctx context.Context, input []byte) ([]byte, error)
 
 type interceptorFn func(name string, workflow interface{}) (string, interface{})
 
-// hostEnvImpl is the implementation of hostEnv
+// hostEnvImpl is the implementation of hostEnv. It is the registry of the workflow and activity functions: the global
+// one returned by getHostEnvironment, or the one of a worker, which falls back to the global one for what is not
+// registered on the worker.
 type hostEnvImpl struct {
 	sync.Mutex
+	parent                           *hostEnvImpl // registry the lookups fall back to, nil for the global one
 	workflowFuncMap                  map[string]interface{}
 	workflowAliasMap                 map[string]string
 	activityFuncMap                  map[string]activity
@@ -364,6 +379,8 @@ type hostEnvImpl struct {
 	tEncoding                        encoding
 	activityRegistrationInterceptors []interceptorFn
 	workflowRegistrationInterceptors []interceptorFn
+	dynamicWorkflow                  DynamicWorkflowFunc // executes the workflow types that are not registered
+	dynamicActivity                  DynamicActivityFunc // executes the activity types that are not registered
 }
 
 func (th *hostEnvImpl) AddWorkflowRegistrationInterceptor(i interceptorFn) {
@@ -421,15 +438,15 @@ func (th *hostEnvImpl) RegisterWorkflowWithOptions(
 	if len(alias) > 0 {
 		registerName = alias
 	}
-	// Check if already registered
-	if _, ok := th.getWorkflowFn(registerName); ok {
+	// Check if already registered. A worker can register a name that is registered globally, its own one is used.
+	if _, ok := th.getOwnWorkflowFn(registerName); ok {
 		return fmt.Errorf("workflow name \"%v\" is already registered", registerName)
 	}
 	// Register args with encoding.
 	if err := th.registerEncodingTypes(fnType); err != nil {
 		return err
 	}
-	registerName, af = th.invokeInterceptors(registerName, af, th.workflowRegistrationInterceptors)
+	registerName, af = th.invokeInterceptors(registerName, af, workflowRegistrationInterceptors)
 	th.addWorkflowFn(registerName, af)
 	if len(alias) > 0 {
 		th.addWorkflowAlias(fnName, alias)
@@ -445,8 +462,11 @@ func (th *hostEnvImpl) RegisterActivityWithOptions(
 	af interface{},
 	options RegisterActivityOptions,
 ) error {
-	// Validate that it is a function
 	fnType := reflect.TypeOf(af)
+	if isActivityStruct(fnType) {
+		return th.registerActivityStructWithOptions(af, options)
+	}
+	// Validate that it is a function
 	if err := validateFnFormat(fnType, false); err != nil {
 		return err
 	}
@@ -456,15 +476,15 @@ func (th *hostEnvImpl) RegisterActivityWithOptions(
 	if len(alias) > 0 {
 		registerName = alias
 	}
-	// Check if already registered
-	if _, ok := th.getActivityFn(registerName); ok {
+	// Check if already registered. A worker can register a name that is registered globally, its own one is used.
+	if _, ok := th.getOwnActivity(registerName); ok {
 		return fmt.Errorf("activity type \"%v\" is already registered", registerName)
 	}
 	// Register args with encoding.
 	if err := th.registerEncodingTypes(fnType); err != nil {
 		return err
 	}
-	registerName, af = th.invokeInterceptors(registerName, af, th.activityRegistrationInterceptors)
+	registerName, af = th.invokeInterceptors(registerName, af, activityRegistrationInterceptors)
 	th.addActivityFn(registerName, af)
 	if len(alias) > 0 {
 		th.addActivityAlias(fnName, alias)
@@ -472,19 +492,147 @@ func (th *hostEnvImpl) RegisterActivityWithOptions(
 	return nil
 }
 
-func (th *hostEnvImpl) invokeInterceptors(name string, f interface{}, interceptors []interceptorFn) (string, interface{}) {
+// registerActivityStructWithOptions registers the exported methods of a struct, or of a pointer to a struct, that have
+// a valid activity signature, under "<prefix>.<MethodName>". The prefix is options.Name, the name of the struct type by
+// default. The other methods are skipped. The method values, like activities.MethodName, resolve to these names when
+// passed to ExecuteActivity, so a struct type can only be registered under one prefix.
+func (th *hostEnvImpl) registerActivityStructWithOptions(aStruct interface{}, options RegisterActivityOptions) error {
+	structValue := reflect.ValueOf(aStruct)
+	structType := structValue.Type()
+	elemType := structType
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	prefix := options.Name
+	if len(prefix) == 0 {
+		prefix = elemType.Name()
+	}
+
+	type structMethod struct {
+		registerName string
+		fnName       string
+		fn           interface{}
+	}
+	var methods []structMethod
+	for i := 0; i < structType.NumMethod(); i++ {
+		method := structType.Method(i)
+		fnType := structValue.Method(i).Type()
+		if err := validateFnFormat(fnType, false); err != nil {
+			continue
+		}
+		registerName := prefix + "." + method.Name
+		// Check if already registered, before registering any of the methods.
+		if _, ok := th.getOwnActivity(registerName); ok {
+			return fmt.Errorf("activity type \"%v\" is already registered", registerName)
+		}
+		// The name of a method value is "<pkg>.(*<type>).<method>-fm" for a pointer receiver and
+		// "<pkg>.<type>.<method>-fm" for a value receiver.
+		fnName := fmt.Sprintf("%v.(*%v).%v-fm", elemType.PkgPath(), elemType.Name(), method.Name)
+		if _, ok := elemType.MethodByName(method.Name); ok {
+			fnName = fmt.Sprintf("%v.%v.%v-fm", elemType.PkgPath(), elemType.Name(), method.Name)
+		}
+		if alias, ok := th.getOwnActivityAlias(fnName); ok {
+			return fmt.Errorf("activity method %v.%v is already registered as \"%v\"", elemType.Name(), method.Name, alias)
+		}
+		methods = append(methods, structMethod{registerName, fnName, structValue.Method(i).Interface()})
+	}
+	if len(methods) == 0 {
+		return fmt.Errorf("expected %v to have at least one exported method with a valid activity signature", structType)
+	}
+
+	for _, m := range methods {
+		// Register args with encoding.
+		if err := th.registerEncodingTypes(reflect.TypeOf(m.fn)); err != nil {
+			return err
+		}
+		registerName, fn := th.invokeInterceptors(m.registerName, m.fn, activityRegistrationInterceptors)
+		th.addActivityFn(registerName, fn)
+		th.addActivityAlias(m.fnName, m.registerName)
+	}
+	return nil
+}
+
+func (th *hostEnvImpl) RegisterDynamicWorkflow(wf DynamicWorkflowFunc) error {
+	if wf == nil {
+		return errors.New("dynamic workflow function is nil")
+	}
 	th.Lock()
-	var copy []interceptorFn
-	for _, i := range interceptors {
-		copy = append(copy, i)
+	defer th.Unlock()
+	if th.dynamicWorkflow != nil {
+		return errors.New("dynamic workflow is already registered")
+	}
+	th.dynamicWorkflow = wf
+	return nil
+}
+
+func (th *hostEnvImpl) RegisterDynamicActivity(af DynamicActivityFunc) error {
+	if af == nil {
+		return errors.New("dynamic activity function is nil")
 	}
+	th.Lock()
+	defer th.Unlock()
+	if th.dynamicActivity != nil {
+		return errors.New("dynamic activity is already registered")
+	}
+	th.dynamicActivity = af
+	return nil
+}
+
+// getDynamicWorkflow returns the dynamic workflow of the registry, or of the global one, nil if none is registered.
+func (th *hostEnvImpl) getDynamicWorkflow() DynamicWorkflowFunc {
+	th.Lock()
+	wf := th.dynamicWorkflow
+	th.Unlock()
+	if wf == nil && th.parent != nil {
+		return th.parent.getDynamicWorkflow()
+	}
+	return wf
+}
+
+// getDynamicActivity returns the dynamic activity of the registry, or of the global one, nil if none is registered.
+func (th *hostEnvImpl) getDynamicActivity() DynamicActivityFunc {
+	th.Lock()
+	af := th.dynamicActivity
 	th.Unlock()
+	if af == nil && th.parent != nil {
+		return th.parent.getDynamicActivity()
+	}
+	return af
+}
+
+func isActivityStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+func (th *hostEnvImpl) invokeInterceptors(
+	name string,
+	f interface{},
+	interceptors func(th *hostEnvImpl) []interceptorFn,
+) (string, interface{}) {
+	var copy []interceptorFn
+	// The interceptors added globally apply to the registrations on the workers too.
+	for h := th; h != nil; h = h.parent {
+		h.Lock()
+		copy = append(append([]interceptorFn(nil), interceptors(h)...), copy...)
+		h.Unlock()
+	}
 	for _, l := range copy {
 		name, f = l(name, f)
 	}
 	return name, f
 }
 
+func workflowRegistrationInterceptors(th *hostEnvImpl) []interceptorFn {
+	return th.workflowRegistrationInterceptors
+}
+
+func activityRegistrationInterceptors(th *hostEnvImpl) []interceptorFn {
+	return th.activityRegistrationInterceptors
+}
+
 // Get the encoder.
 func (th *hostEnvImpl) Encoder() encoding {
 	return th.encoding
@@ -508,8 +656,11 @@ func (th *hostEnvImpl) addWorkflowAlias(fnName string, alias string) {
 
 func (th *hostEnvImpl) getWorkflowAlias(fnName string) (string, bool) {
 	th.Lock()
-	defer th.Unlock()
 	alias, ok := th.workflowAliasMap[fnName]
+	th.Unlock()
+	if !ok && th.parent != nil {
+		return th.parent.getWorkflowAlias(fnName)
+	}
 	return alias, ok
 }
 
@@ -520,6 +671,14 @@ func (th *hostEnvImpl) addWorkflowFn(fnName string, wf interface{}) {
 }
 
 func (th *hostEnvImpl) getWorkflowFn(fnName string) (interface{}, bool) {
+	fn, ok := th.getOwnWorkflowFn(fnName)
+	if !ok && th.parent != nil {
+		return th.parent.getWorkflowFn(fnName)
+	}
+	return fn, ok
+}
+
+func (th *hostEnvImpl) getOwnWorkflowFn(fnName string) (interface{}, bool) {
 	th.Lock()
 	defer th.Unlock()
 	fn, ok := th.workflowFuncMap[fnName]
@@ -528,18 +687,23 @@ func (th *hostEnvImpl) getWorkflowFn(fnName string) (interface{}, bool) {
 
 func (th *hostEnvImpl) getRegisteredWorkflowTypes() []string {
 	th.Lock()
-	defer th.Unlock()
 	var r []string
 	for t := range th.workflowFuncMap {
 		r = append(r, t)
 	}
+	th.Unlock()
+	if th.parent != nil {
+		for _, t := range th.parent.getRegisteredWorkflowTypes() {
+			if _, ok := th.getOwnWorkflowFn(t); !ok {
+				r = append(r, t)
+			}
+		}
+	}
 	return r
 }
 
 func (th *hostEnvImpl) lenWorkflowFns() int {
-	th.Lock()
-	defer th.Unlock()
-	return len(th.workflowFuncMap)
+	return len(th.getRegisteredWorkflowTypes())
 }
 
 func (th *hostEnvImpl) addActivityAlias(fnName string, alias string) {
@@ -549,6 +713,16 @@ func (th *hostEnvImpl) addActivityAlias(fnName string, alias string) {
 }
 
 func (th *hostEnvImpl) getActivityAlias(fnName string) (string, bool) {
+	th.Lock()
+	alias, ok := th.activityAliasMap[fnName]
+	th.Unlock()
+	if !ok && th.parent != nil {
+		return th.parent.getActivityAlias(fnName)
+	}
+	return alias, ok
+}
+
+func (th *hostEnvImpl) getOwnActivityAlias(fnName string) (string, bool) {
 	th.Lock()
 	defer th.Unlock()
 	alias, ok := th.activityAliasMap[fnName]
@@ -566,6 +740,14 @@ func (th *hostEnvImpl) addActivityFn(fnName string, af interface{}) {
 }
 
 func (th *hostEnvImpl) getActivity(fnName string) (activity, bool) {
+	a, ok := th.getOwnActivity(fnName)
+	if !ok && th.parent != nil {
+		return th.parent.getActivity(fnName)
+	}
+	return a, ok
+}
+
+func (th *hostEnvImpl) getOwnActivity(fnName string) (activity, bool) {
 	th.Lock()
 	defer th.Unlock()
 	a, ok := th.activityFuncMap[fnName]
@@ -580,23 +762,35 @@ func (th *hostEnvImpl) getActivityFn(fnName string) (interface{}, bool) {
 }
 
 func (th *hostEnvImpl) getRegisteredActivities() []activity {
-	activities := make([]activity, 0, len(th.activityFuncMap))
-	for _, a := range th.activityFuncMap {
+	var activities []activity
+	for _, a := range th.getActivityMap() {
 		activities = append(activities, a)
 	}
 	return activities
 }
 
 func (th *hostEnvImpl) getRegisteredActivityTypes() []string {
-	th.Lock()
-	defer th.Unlock()
 	var r []string
-	for t := range th.activityFuncMap {
+	for t := range th.getActivityMap() {
 		r = append(r, t)
 	}
 	return r
 }
 
+// getActivityMap returns a copy of the activities found through the registry, including the global ones.
+func (th *hostEnvImpl) getActivityMap() map[string]activity {
+	activities := make(map[string]activity)
+	if th.parent != nil {
+		activities = th.parent.getActivityMap()
+	}
+	th.Lock()
+	defer th.Unlock()
+	for t, a := range th.activityFuncMap {
+		activities[t] = a
+	}
+	return activities
+}
+
 // register all the types with encoder.
 func (th *hostEnvImpl) registerEncodingTypes(fnType reflect.Type) error {
 	th.Lock()
@@ -684,6 +878,9 @@ func (th *hostEnvImpl) getWorkflowDefinition(wt WorkflowType) (workflowDefinitio
 	}
 	wf, ok := th.getWorkflowFn(lookup)
 	if !ok {
+		if dynamicWorkflow := th.getDynamicWorkflow(); dynamicWorkflow != nil {
+			return newWorkflowDefinition(&dynamicWorkflowExecutor{name: lookup, fn: dynamicWorkflow}), nil
+		}
 		supported := strings.Join(th.getRegisteredWorkflowTypes(), ", ")
 		return nil, fmt.Errorf("Unable to find workflow type: %v. Supported types: [%v]", lookup, supported)
 	}
@@ -786,28 +983,6 @@ func (th *hostEnvImpl) encodeArgs(args []interface{}) ([]byte, error) {
 	return th.encode(args)
 }
 
-// decode multiple arguments(arguments to a function).
-func (th *hostEnvImpl) decodeArgs(fnType reflect.Type, data []byte) (result []reflect.Value, err error) {
-	var r []interface{}
-argsLoop:
-	for i := 0; i < fnType.NumIn(); i++ {
-		argT := fnType.In(i)
-		if i == 0 && (isActivityContext(argT) || isWorkflowContext(argT)) {
-			continue argsLoop
-		}
-		arg := reflect.New(argT).Interface()
-		r = append(r, arg)
-	}
-	err = th.decode(data, r)
-	if err != nil {
-		return
-	}
-	for i := 0; i < len(r); i++ {
-		result = append(result, reflect.ValueOf(r[i]).Elem())
-	}
-	return
-}
-
 // encode single value(like return parameter).
 func (th *hostEnvImpl) encodeArg(arg interface{}) ([]byte, error) {
 	return th.encode([]interface{}{arg})
@@ -818,23 +993,6 @@ func (th *hostEnvImpl) decodeArg(data []byte, to interface{}) error {
 	return th.decode(data, []interface{}{to})
 }
 
-func (th *hostEnvImpl) decodeAndAssignValue(from interface{}, toValuePtr interface{}) error {
-	if toValuePtr == nil {
-		return nil
-	}
-	if rf := reflect.ValueOf(toValuePtr); rf.Type().Kind() != reflect.Ptr {
-		return errors.New("value parameter provided is not a pointer")
-	}
-	if data, ok := from.([]byte); ok {
-		if err := th.decodeArg(data, toValuePtr); err != nil {
-			return err
-		}
-	} else if fv := reflect.ValueOf(from); fv.IsValid() {
-		reflect.ValueOf(toValuePtr).Elem().Set(fv)
-	}
-	return nil
-}
-
 func isTypeByteSlice(inType reflect.Type) bool {
 	r := reflect.TypeOf(([]byte)(nil))
 	return inType == r || inType == reflect.PtrTo(r)
@@ -863,6 +1021,14 @@ func getHostEnvironment() *hostEnvImpl {
 	return thImpl
 }
 
+// newWorkerHostEnvironment creates the registry of a worker, or of a TestWorkflowEnvironment. The functions registered
+// globally are found through it too, unless a function is registered under the same name on it.
+func newWorkerHostEnvironment() *hostEnvImpl {
+	th := newHostEnvironment()
+	th.parent = getHostEnvironment()
+	return th
+}
+
 // Wrapper to execute workflow functions.
 type workflowExecutor struct {
 	name string
@@ -874,24 +1040,20 @@ func (we *workflowExecutor) Execute(ctx Context, input []byte) ([]byte, error) {
 	// Workflow context.
 	args := []reflect.Value{reflect.ValueOf(ctx)}
 
-	if fnType.NumIn() > 1 && isTypeByteSlice(fnType.In(1)) {
-		// 0 - is workflow context.
-		// 1 ... input types.
-		args = append(args, reflect.ValueOf(input))
-	} else {
-		decoded, err := getHostEnvironment().decodeArgs(fnType, input)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"Unable to decode the workflow function input bytes with error: %v, function name: %v",
-				err, we.name)
-		}
-		args = append(args, decoded...)
+	// The DataConverter decides how a single []byte argument is passed, the default one passes it through.
+	dataConverter := getDataConverterFromWorkflowContext(ctx)
+	decoded, err := decodeArgs(dataConverter, fnType, input)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Unable to decode the workflow function input bytes with error: %v, function name: %v",
+			err, we.name)
 	}
+	args = append(args, decoded...)
 
 	// Invoke the workflow with arguments.
 	fnValue := reflect.ValueOf(we.fn)
 	retValues := fnValue.Call(args)
-	return validateFunctionAndGetResults(we.fn, retValues)
+	return validateFunctionAndGetResults(we.fn, retValues, dataConverter)
 }
 
 // Wrapper to execute activity functions.
@@ -917,35 +1079,112 @@ func (ae *activityExecutor) Execute(ctx context.Context, input []byte) ([]byte,
 		args = append(args, reflect.ValueOf(ctx))
 	}
 
-	if fnType.NumIn() == 1 && isTypeByteSlice(fnType.In(0)) {
-		args = append(args, reflect.ValueOf(input))
-	} else {
-		decoded, err := getHostEnvironment().decodeArgs(fnType, input)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"Unable to decode the activity function input bytes with error: %v for function name: %v",
-				err, ae.name)
-		}
-		args = append(args, decoded...)
+	// The DataConverter decides how a single []byte argument is passed, the default one passes it through.
+	dataConverter := getDataConverterFromActivityCtx(ctx)
+	decoded, err := decodeArgs(dataConverter, fnType, input)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Unable to decode the activity function input bytes with error: %v for function name: %v",
+			err, ae.name)
 	}
+	args = append(args, decoded...)
 
 	// Invoke the activity with arguments.
 	fnValue := reflect.ValueOf(ae.fn)
 	retValues := fnValue.Call(args)
-	return validateFunctionAndGetResults(ae.fn, retValues)
+	return validateFunctionAndGetResults(ae.fn, retValues, dataConverter)
+}
+
+// Wrapper to execute the dynamic workflow for a workflow type that is not registered.
+type dynamicWorkflowExecutor struct {
+	name string
+	fn   DynamicWorkflowFunc
+}
+
+func (we *dynamicWorkflowExecutor) Execute(ctx Context, input []byte) ([]byte, error) {
+	dataConverter := getDataConverterFromWorkflowContext(ctx)
+	result, err := we.fn(ctx, we.name, newEncodedValues(input, dataConverter))
+	return encodeDynamicResult(result, err, dataConverter)
+}
+
+// Wrapper to execute the dynamic activity for an activity type that is not registered.
+type dynamicActivityExecutor struct {
+	name string
+	fn   DynamicActivityFunc
+}
+
+func (ae *dynamicActivityExecutor) ActivityType() ActivityType {
+	return ActivityType{Name: ae.name}
+}
+
+func (ae *dynamicActivityExecutor) GetFunction() interface{} {
+	return ae.fn
+}
+
+func (ae *dynamicActivityExecutor) Execute(ctx context.Context, input []byte) ([]byte, error) {
+	dataConverter := getDataConverterFromActivityCtx(ctx)
+	result, err := ae.fn(ctx, ae.name, newEncodedValues(input, dataConverter))
+	return encodeDynamicResult(result, err, dataConverter)
+}
+
+// encodeDynamicResult encodes the result of a dynamic workflow or activity, like validateFunctionAndGetResults does
+// for the registered functions.
+func encodeDynamicResult(result interface{}, err error, dc DataConverter) ([]byte, error) {
+	var data []byte
+	if result != nil {
+		var encodeErr error
+		if data, encodeErr = encodeArg(dc, result); encodeErr != nil {
+			return nil, encodeErr
+		}
+	}
+	return data, err
 }
 
 // aggregatedWorker combines management of both workflowWorker and activityWorker worker lifecycle.
 type aggregatedWorker struct {
-	workflowWorker Worker
-	activityWorker Worker
+	workflowWorker *workflowWorker
+	activityWorker *activityWorker
 	logger         *zap.Logger
 	hostEnv        *hostEnvImpl
 }
 
+var _ WorkerRegistry = (*aggregatedWorker)(nil)
+
+func (aw *aggregatedWorker) RegisterWorkflow(w interface{}) {
+	aw.RegisterWorkflowWithOptions(w, RegisterWorkflowOptions{})
+}
+
+func (aw *aggregatedWorker) RegisterWorkflowWithOptions(w interface{}, options RegisterWorkflowOptions) {
+	if err := aw.hostEnv.RegisterWorkflowWithOptions(w, options); err != nil {
+		panic(err)
+	}
+}
+
+func (aw *aggregatedWorker) RegisterActivity(a interface{}) {
+	aw.RegisterActivityWithOptions(a, RegisterActivityOptions{})
+}
+
+func (aw *aggregatedWorker) RegisterActivityWithOptions(a interface{}, options RegisterActivityOptions) {
+	if err := aw.hostEnv.RegisterActivityWithOptions(a, options); err != nil {
+		panic(err)
+	}
+}
+
+func (aw *aggregatedWorker) RegisterDynamicWorkflow(w DynamicWorkflowFunc) {
+	if err := aw.hostEnv.RegisterDynamicWorkflow(w); err != nil {
+		panic(err)
+	}
+}
+
+func (aw *aggregatedWorker) RegisterDynamicActivity(a DynamicActivityFunc) {
+	if err := aw.hostEnv.RegisterDynamicActivity(a); err != nil {
+		panic(err)
+	}
+}
+
 func (aw *aggregatedWorker) Start() error {
-	if !isInterfaceNil(aw.workflowWorker) {
-		if len(aw.hostEnv.getRegisteredWorkflowTypes()) == 0 {
+	if aw.workflowWorker != nil {
+		if len(aw.hostEnv.getRegisteredWorkflowTypes()) == 0 && aw.hostEnv.getDynamicWorkflow() == nil {
 			aw.logger.Warn(
 				"Starting worker without any workflows. Workflows must be registered before start.",
 			)
@@ -954,8 +1193,8 @@ func (aw *aggregatedWorker) Start() error {
 			return err
 		}
 	}
-	if !isInterfaceNil(aw.activityWorker) {
-		if len(aw.hostEnv.getRegisteredActivities()) == 0 {
+	if aw.activityWorker != nil {
+		if len(aw.hostEnv.getRegisteredActivities()) == 0 && aw.hostEnv.getDynamicActivity() == nil {
 			aw.logger.Warn(
 				"Starting worker without any activities. Activities must be registered before start.",
 			)
@@ -981,10 +1220,10 @@ func (aw *aggregatedWorker) Run() error {
 }
 
 func (aw *aggregatedWorker) Stop() {
-	if !isInterfaceNil(aw.workflowWorker) {
+	if aw.workflowWorker != nil {
 		aw.workflowWorker.Stop()
 	}
-	if !isInterfaceNil(aw.activityWorker) {
+	if aw.activityWorker != nil {
 		aw.activityWorker.Stop()
 	}
 	aw.logger.Info("Stopped Worker")
@@ -1001,15 +1240,18 @@ func newAggregatedWorker(
 ) (worker Worker) {
 	wOptions := fillWorkerOptionsDefaults(options)
 	workerParams := workerExecutionParameters{
-		TaskList:                        taskList,
-		ConcurrentPollRoutineSize:       defaultConcurrentPollRoutineSize,
-		ConcurrentActivityExecutionSize: wOptions.MaxConcurrentActivityExecutionSize,
-		MaxActivityExecutionPerSecond:   wOptions.MaxActivityExecutionPerSecond,
-		Identity:                        wOptions.Identity,
-		MetricsScope:                    wOptions.MetricsScope,
-		Logger:                          wOptions.Logger,
-		EnableLoggingInReplay:           wOptions.EnableLoggingInReplay,
-		UserContext:                     wOptions.BackgroundActivityContext,
+		TaskList:                          taskList,
+		ConcurrentPollRoutineSize:         defaultConcurrentPollRoutineSize,
+		ConcurrentActivityExecutionSize:   wOptions.MaxConcurrentActivityExecutionSize,
+		MaxActivityExecutionPerSecond:     wOptions.MaxActivityExecutionPerSecond,
+		Identity:                          wOptions.Identity,
+		MetricsScope:                      wOptions.MetricsScope,
+		Logger:                            wOptions.Logger,
+		EnableLoggingInReplay:             wOptions.EnableLoggingInReplay,
+		UserContext:                       wOptions.BackgroundActivityContext,
+		DataConverter:                     wOptions.DataConverter,
+		WorkflowInterceptorChainFactories: wOptions.WorkflowInterceptorChainFactories,
+		ActivityInterceptorChainFactories: wOptions.ActivityInterceptorChainFactories,
 	}
 
 	ensureRequiredParams(&workerParams)
@@ -1023,9 +1265,9 @@ func newAggregatedWorker(
 
 	processTestTags(&wOptions, &workerParams)
 
-	hostEnv := getHostEnvironment()
+	hostEnv := newWorkerHostEnvironment()
 	// workflow factory.
-	var workflowWorker Worker
+	var workflowWorker *workflowWorker
 	if !wOptions.DisableWorkflowWorker {
 		testTags := getTestTags(wOptions.BackgroundActivityContext)
 		if testTags != nil && len(testTags) > 0 {
@@ -1048,7 +1290,7 @@ func newAggregatedWorker(
 	}
 
 	// activity types.
-	var activityWorker Worker
+	var activityWorker *activityWorker
 
 	if !wOptions.DisableActivityWorker {
 		activityWorker = newActivityWorker(
@@ -1115,10 +1357,6 @@ func getFunctionName(i interface{}) string {
 	return runtime.FuncForPC(reflect.ValueOf(i).Pointer()).Name()
 }
 
-func isInterfaceNil(i interface{}) bool {
-	return i == nil || reflect.ValueOf(i).IsNil()
-}
-
 // encoding is capable of encoding and decoding objects
 type encoding interface {
 	Register(obj interface{}) error
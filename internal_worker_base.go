@@ -52,18 +52,24 @@ type (
 	// Should only be used within the scope of workflow definition
 	workflowEnvironment interface {
 		asyncActivityClient
+		localActivityClient
 		workflowTimerClient
 		SideEffect(f func() ([]byte, error), callback resultHandler)
+		MutableSideEffect(id string, f func() interface{}, equals func(a, b interface{}) bool) EncodedValue
 		GetVersion(changeID string, minSupported, maxSupported Version) Version
 		WorkflowInfo() *WorkflowInfo
 		Complete(result []byte, err error)
 		RegisterCancelHandler(handler func())
 		RequestCancelWorkflow(domainName, workflowID, runID string) error
+		SignalExternalWorkflow(domainName, workflowID, runID, signalName string, input []byte, callback resultHandler)
 		ExecuteChildWorkflow(options workflowOptions, callback resultHandler, startedHandler func(r WorkflowExecution, e error)) error
 		GetLogger() *zap.Logger
 		GetMetricsScope() tally.Scope
 		RegisterSignalHandler(handler func(name string, input []byte))
 		RegisterQueryHandler(handler func(queryType string, queryArgs []byte) ([]byte, error))
+		GetDataConverter() DataConverter
+		GetRegistry() *hostEnvImpl
+		GetWorkflowInterceptorFactories() []WorkflowInterceptorFactory
 	}
 
 	// WorkflowDefinition wraps the code that can execute a workflow.
@@ -125,6 +125,101 @@ func TestWorkflowRegistrationListener(t *testing.T) {
 	require.Equal(t, strings.Join(expectedWorkflows, ","), strings.Join(registeredWorkflows, ","))
 }
 
+type testActivityStruct struct {
+	greeting string
+}
+
+func (a *testActivityStruct) Greet(ctx context.Context, name string) (string, error) {
+	return a.greeting + " " + name, nil
+}
+
+func (a testActivityStruct) Length(name string) (int, error) {
+	return len(name), nil
+}
+
+func (a *testActivityStruct) Describe() string {
+	return "not an activity: no error returned"
+}
+
+func TestActivityStructRegistration(t *testing.T) {
+	hostEnv := newHostEnvironment()
+	activities := &testActivityStruct{greeting: "Hello"}
+	require.NoError(t, hostEnv.RegisterActivity(activities))
+	registered := hostEnv.getRegisteredActivityTypes()
+	sort.Strings(registered)
+	require.Equal(t, []string{"testActivityStruct.Greet", "testActivityStruct.Length"}, registered)
+
+	// The methods are bound to the registered struct.
+	a, ok := hostEnv.getActivity("testActivityStruct.Greet")
+	require.True(t, ok)
+	input, err := encodeArgs(getDefaultDataConverter(), []interface{}{"cadence"})
+	require.NoError(t, err)
+	result, err := a.Execute(context.Background(), input)
+	require.NoError(t, err)
+	var greeting string
+	require.NoError(t, decodeArg(getDefaultDataConverter(), result, &greeting))
+	require.Equal(t, "Hello cadence", greeting)
+
+	// Method values resolve to the registered names, for pointer and value receivers.
+	activityType, _, err := getValidatedActivityFunction(activities.Greet, []interface{}{"cadence"}, hostEnv, getDefaultDataConverter())
+	require.NoError(t, err)
+	require.Equal(t, "testActivityStruct.Greet", activityType.Name)
+	activityType, _, err = getValidatedActivityFunction(activities.Length, []interface{}{"cadence"}, hostEnv, getDefaultDataConverter())
+	require.NoError(t, err)
+	require.Equal(t, "testActivityStruct.Length", activityType.Name)
+
+	// A struct type cannot be registered under a second prefix, its method values would be ambiguous.
+	require.Error(t, hostEnv.RegisterActivityWithOptions(testActivityStruct{}, RegisterActivityOptions{Name: "prefix"}))
+	_, ok = hostEnv.getActivity("prefix.Length")
+	require.False(t, ok)
+	activityType, _, err = getValidatedActivityFunction(activities.Length, []interface{}{"cadence"}, hostEnv, getDefaultDataConverter())
+	require.NoError(t, err)
+	require.Equal(t, "testActivityStruct.Length", activityType.Name)
+
+	// A prefix replaces the struct name, and a name cannot be registered twice.
+	hostEnv = newHostEnvironment()
+	require.NoError(t, hostEnv.RegisterActivityWithOptions(testActivityStruct{}, RegisterActivityOptions{Name: "prefix"}))
+	_, ok = hostEnv.getActivity("prefix.Length")
+	require.True(t, ok)
+	require.Error(t, hostEnv.RegisterActivityWithOptions(&testActivityStruct{}, RegisterActivityOptions{Name: "prefix"}))
+	require.Error(t, hostEnv.RegisterActivity(&struct{}{}))
+}
+
+func TestDynamicRegistration(t *testing.T) {
+	dynamicWorkflow := func(ctx Context, workflowType string, args EncodedValues) (interface{}, error) {
+		return workflowType, nil
+	}
+	dynamicActivity := func(ctx context.Context, activityType string, args EncodedValues) (interface{}, error) {
+		return activityType, nil
+	}
+	global := newHostEnvironment()
+	require.Error(t, global.RegisterDynamicWorkflow(nil))
+	require.Error(t, global.RegisterDynamicActivity(nil))
+	_, err := global.getWorkflowDefinition(WorkflowType{Name: "unregistered"})
+	require.Error(t, err)
+
+	require.NoError(t, global.RegisterDynamicWorkflow(dynamicWorkflow))
+	require.NoError(t, global.RegisterDynamicActivity(dynamicActivity))
+	require.Error(t, global.RegisterDynamicWorkflow(dynamicWorkflow))
+	require.Error(t, global.RegisterDynamicActivity(dynamicActivity))
+	_, err = global.getWorkflowDefinition(WorkflowType{Name: "unregistered"})
+	require.NoError(t, err)
+
+	// A worker registry falls back to the dynamic workflow and activity registered globally, and can override them.
+	worker := newHostEnvironment()
+	worker.parent = global
+	require.NotNil(t, worker.getDynamicWorkflow())
+	require.NotNil(t, worker.getDynamicActivity())
+	require.NoError(t, worker.RegisterDynamicActivity(dynamicActivity))
+	require.NotNil(t, worker.getDynamicActivity())
+
+	// The registered functions take precedence.
+	require.NoError(t, worker.RegisterActivityWithOptions(testActivityReturnString, RegisterActivityOptions{Name: "registered"}))
+	handler := &activityTaskHandlerImpl{hostEnv: worker}
+	require.IsType(t, &activityExecutor{}, handler.getActivity("registered"))
+	require.IsType(t, &dynamicActivityExecutor{}, handler.getActivity("unregistered"))
+}
+
 func getLogger() *zap.Logger {
 	logger, _ := zap.NewDevelopment()
 	return logger
@@ -247,6 +342,51 @@ func TestNoActivitiesOrWorkflows(t *testing.T) {
 	assert.NoError(t, aw.Start())
 }
 
+func TestWorkerRegistry(t *testing.T) {
+	service := new(mocks.TChanWorkflowService)
+	worker1 := NewWorker(service, "testDomain", "tl1", WorkerOptions{}).(*aggregatedWorker)
+	worker2 := NewWorker(service, "testDomain", "tl2", WorkerOptions{}).(*aggregatedWorker)
+
+	activity1 := func() (string, error) { return "worker1", nil }
+	activity2 := func() (string, error) { return "worker2", nil }
+	worker1.RegisterActivityWithOptions(activity1, RegisterActivityOptions{Name: "workerActivity"})
+	worker2.RegisterActivityWithOptions(activity2, RegisterActivityOptions{Name: "workerActivity"})
+	worker1.RegisterWorkflowWithOptions(testReplayWorkflow, RegisterWorkflowOptions{Name: "workerWorkflow"})
+
+	// Each worker serves its own registrations, which are not visible globally nor from the other worker.
+	a, ok := worker1.hostEnv.getActivity("workerActivity")
+	require.True(t, ok)
+	require.Equal(t, reflect.ValueOf(activity1).Pointer(), reflect.ValueOf(a.GetFunction()).Pointer())
+	a, ok = worker2.hostEnv.getActivity("workerActivity")
+	require.True(t, ok)
+	require.Equal(t, reflect.ValueOf(activity2).Pointer(), reflect.ValueOf(a.GetFunction()).Pointer())
+	_, ok = getHostEnvironment().getActivity("workerActivity")
+	require.False(t, ok)
+	_, ok = worker2.hostEnv.getWorkflowFn("workerWorkflow")
+	require.False(t, ok)
+	alias, ok := worker1.hostEnv.getWorkflowAlias(getFunctionName(testReplayWorkflow))
+	require.True(t, ok)
+	require.Equal(t, "workerWorkflow", alias)
+	require.Contains(t, worker1.hostEnv.getRegisteredWorkflowTypes(), "workerWorkflow")
+	require.NotContains(t, worker2.hostEnv.getRegisteredWorkflowTypes(), "workerWorkflow")
+
+	// The global registrations are served by all the workers.
+	_, ok = worker1.hostEnv.getActivity("testActivity")
+	require.True(t, ok)
+	_, ok = worker2.hostEnv.getWorkflowFn("sampleWorkflowExecute")
+	require.True(t, ok)
+	require.Contains(t, worker2.hostEnv.getRegisteredActivityTypes(), "testActivity")
+
+	// A worker can override a global registration, but not register the same name twice.
+	worker2.RegisterActivityWithOptions(activity2, RegisterActivityOptions{Name: "testActivity"})
+	a, ok = worker2.hostEnv.getActivity("testActivity")
+	require.True(t, ok)
+	require.Equal(t, reflect.ValueOf(activity2).Pointer(), reflect.ValueOf(a.GetFunction()).Pointer())
+	require.Panics(t, func() {
+		worker1.RegisterActivityWithOptions(activity2, RegisterActivityOptions{Name: "workerActivity"})
+	})
+}
+
 func TestWorkerStartFailsWithInvalidDomain(t *testing.T) {
 
 	testCases := []struct {
@@ -673,7 +813,7 @@ func TestActivityErrorWithDetails(t *testing.T) {
 		}}
 	encResult, e := a1.Execute(context.Background(), testEncodeFunctionArgs(a1.fn, 1))
 
-	err := deSerializeFunctionResult(a1.fn, encResult, nil)
+	err := deSerializeFunctionResult(a1.fn, encResult, nil, getHostEnvironment(), getDefaultDataConverter())
 	require.NoError(t, err)
 	require.Error(t, e)
 	errWD := e.(*CustomError)
@@ -688,7 +828,7 @@ func TestActivityErrorWithDetails(t *testing.T) {
 			return NewCustomError("testReason", testErrorDetails{T: "testErrorStack"})
 		}}
 	encResult, e = a2.Execute(context.Background(), testEncodeFunctionArgs(a2.fn, 1))
-	err = deSerializeFunctionResult(a2.fn, encResult, nil)
+	err = deSerializeFunctionResult(a2.fn, encResult, nil, getHostEnvironment(), getDefaultDataConverter())
 	require.NoError(t, err)
 	require.Error(t, e)
 	errWD = e.(*CustomError)
@@ -704,7 +844,7 @@ func TestActivityErrorWithDetails(t *testing.T) {
 		}}
 	encResult, e = a3.Execute(context.Background(), testEncodeFunctionArgs(a3.fn, 1))
 	var result string
-	err = deSerializeFunctionResult(a3.fn, encResult, &result)
+	err = deSerializeFunctionResult(a3.fn, encResult, &result, getHostEnvironment(), getDefaultDataConverter())
 	require.NoError(t, err)
 	require.Equal(t, "testResult", result)
 	require.Error(t, e)
@@ -719,7 +859,7 @@ func TestActivityErrorWithDetails(t *testing.T) {
 			return "testResult4", NewCustomError("testReason", "testMultipleString", testErrorDetails{T: "testErrorStack4"})
 		}}
 	encResult, e = a4.Execute(context.Background(), testEncodeFunctionArgs(a4.fn, 1))
-	err = deSerializeFunctionResult(a3.fn, encResult, &result)
+	err = deSerializeFunctionResult(a3.fn, encResult, &result, getHostEnvironment(), getDefaultDataConverter())
 	require.NoError(t, err)
 	require.Equal(t, "testResult4", result)
 	require.Error(t, e)
@@ -738,7 +878,7 @@ func TestActivityCancelledError(t *testing.T) {
 			return NewCanceledError("testCancelStringDetails")
 		}}
 	encResult, e := a1.Execute(context.Background(), testEncodeFunctionArgs(a1.fn, 1))
-	err := deSerializeFunctionResult(a1.fn, encResult, nil)
+	err := deSerializeFunctionResult(a1.fn, encResult, nil, getHostEnvironment(), getDefaultDataConverter())
 	require.NoError(t, err)
 	require.Error(t, e)
 	errWD := e.(*CanceledError)
@@ -752,7 +892,7 @@ func TestActivityCancelledError(t *testing.T) {
 			return NewCanceledError(testErrorDetails{T: "testCancelErrorStack"})
 		}}
 	encResult, e = a2.Execute(context.Background(), testEncodeFunctionArgs(a2.fn, 1))
-	err = deSerializeFunctionResult(a2.fn, encResult, nil)
+	err = deSerializeFunctionResult(a2.fn, encResult, nil, getHostEnvironment(), getDefaultDataConverter())
 	require.NoError(t, err)
 	require.Error(t, e)
 	errWD = e.(*CanceledError)
@@ -767,7 +907,7 @@ func TestActivityCancelledError(t *testing.T) {
 		}}
 	encResult, e = a3.Execute(context.Background(), testEncodeFunctionArgs(a2.fn, 1))
 	var r string
-	err = deSerializeFunctionResult(a3.fn, encResult, &r)
+	err = deSerializeFunctionResult(a3.fn, encResult, &r, getHostEnvironment(), getDefaultDataConverter())
 	require.NoError(t, err)
 	require.Equal(t, "testResult", r)
 	require.Error(t, e)
@@ -781,7 +921,7 @@ func TestActivityCancelledError(t *testing.T) {
 			return "testResult4", NewCanceledError("testMultipleString", testErrorDetails{T: "testErrorStack4"})
 		}}
 	encResult, e = a4.Execute(context.Background(), testEncodeFunctionArgs(a2.fn, 1))
-	err = deSerializeFunctionResult(a3.fn, encResult, &r)
+	err = deSerializeFunctionResult(a3.fn, encResult, &r, getHostEnvironment(), getDefaultDataConverter())
 	require.NoError(t, err)
 	require.Equal(t, "testResult4", r)
 	require.Error(t, e)
@@ -800,7 +940,7 @@ func TestActivityExecutionVariousTypes(t *testing.T) {
 	encResult, e := a1.Execute(context.Background(), testEncodeFunctionArgs(a1.fn, "test"))
 	require.NoError(t, e)
 	var r *testWorkflowResult
-	err := deSerializeFunctionResult(a1.fn, encResult, &r)
+	err := deSerializeFunctionResult(a1.fn, encResult, &r, getHostEnvironment(), getDefaultDataConverter())
 	require.NoError(t, err)
 	require.Equal(t, 1, r.V)
 
@@ -810,7 +950,7 @@ func TestActivityExecutionVariousTypes(t *testing.T) {
 		}}
 	encResult, e = a2.Execute(context.Background(), testEncodeFunctionArgs(a2.fn, r))
 	require.NoError(t, e)
-	err = deSerializeFunctionResult(a2.fn, encResult, &r)
+	err = deSerializeFunctionResult(a2.fn, encResult, &r, getHostEnvironment(), getDefaultDataConverter())
 	require.NoError(t, err)
 	require.Equal(t, 2, r.V)
 }
@@ -107,6 +107,7 @@ type (
 		blockedReceives []receiveCallback   // receives waiting when no messages are available.
 		closed          bool                // true if channel is closed.
 		recValue        *interface{}        // Used only while receiving value, this is used as pre-fetch buffer value from the channel.
+		dataConverter   DataConverter       // Used to decode the encoded values (like signals) sent to the channel.
 	}
 
 	// Single case statement of the Select
@@ -146,6 +147,7 @@ type (
 		sequence         int
 		channelSequence  int // used to name channels
 		selectorSequence int // used to name channels
+		syncSequence     int // used to name mutexes, semaphores and wait groups
 		coroutines       []*coroutineState
 		executing        bool       // currently running ExecuteUntilAllBlocked. Used to avoid recursive calls to it.
 		mutex            sync.Mutex // used to synchronize executing
@@ -162,6 +164,8 @@ type (
 		workflowID                          string
 		childPolicy                         ChildWorkflowPolicy
 		waitForCancellation                 bool
+		retryPolicy                         *RetryPolicy
+		cronSchedule                        string
 		signalChannels                      map[string]Channel
 		queryHandlers                       map[string]func([]byte) ([]byte, error)
 	}
@@ -173,8 +177,10 @@ type (
 	}
 
 	childWorkflowFutureImpl struct {
-		*decodeFutureImpl             // for child workflow result
-		executionFuture   *futureImpl // for child workflow execution future
+		*decodeFutureImpl                          // for child workflow result
+		executionFuture   *futureImpl              // for child workflow execution future
+		domain            string                   // domain of the child workflow, used to signal it
+		retryState        *childWorkflowRetryState // attempts of the child workflow, if it has a retry policy
 	}
 
 	asyncFuture interface {
@@ -194,9 +200,35 @@ type (
 		Set(value interface{}, err error)
 	}
 
+	// Implements Semaphore interface
+	semaphoreImpl struct {
+		name    string
+		size    int
+		used    int                // permits currently acquired
+		waiters []*semaphoreWaiter // acquires waiting for permits in FIFO order
+	}
+
+	semaphoreWaiter struct {
+		n      int
+		future *futureImpl
+	}
+
+	// Implements Mutex interface as a semaphore with a single permit
+	mutexImpl struct {
+		semaphore *semaphoreImpl
+	}
+
+	// Implements WaitGroup interface
+	waitGroupImpl struct {
+		name    string
+		counter int
+		waiters []*futureImpl // waits for the counter to drop to zero
+	}
+
 	queryHandler struct {
-		fn        interface{}
-		queryType string
+		fn            interface{}
+		queryType     string
+		dataConverter DataConverter
 	}
 )
 
@@ -205,12 +237,16 @@ const (
 	workflowResultContextKey      = "workflowResult"
 	coroutinesContextKey          = "coroutines"
 	workflowEnvOptionsContextKey  = "wfEnvOptions"
+	workflowInterceptorContextKey = "workflowInterceptor"
 )
 
 // Assert that structs do indeed implement the interfaces
 var _ Channel = (*channelImpl)(nil)
 var _ Selector = (*selectorImpl)(nil)
 var _ dispatcher = (*dispatcherImpl)(nil)
+var _ Mutex = (*mutexImpl)(nil)
+var _ Semaphore = (*semaphoreImpl)(nil)
+var _ WaitGroup = (*waitGroupImpl)(nil)
 
 var stackBuf [100000]byte
 
@@ -231,6 +267,32 @@ func getWorkflowEnvironment(ctx Context) workflowEnvironment {
 	return wc.(workflowEnvironment)
 }
 
+// getRegistryFromWorkflowContext returns the registry of the worker executing the workflow, used to resolve the
+// names of the functions passed to ExecuteActivity and ExecuteChildWorkflow.
+func getRegistryFromWorkflowContext(ctx Context) *hostEnvImpl {
+	if ctx == nil {
+		return getHostEnvironment()
+	}
+	env, ok := ctx.Value(workflowEnvironmentContextKey).(workflowEnvironment)
+	if !ok || env.GetRegistry() == nil {
+		return getHostEnvironment()
+	}
+	return env.GetRegistry()
+}
+
+// getDataConverterFromWorkflowContext returns the DataConverter of the workflow, or the default one if the context
+// is not a workflow context.
+func getDataConverterFromWorkflowContext(ctx Context) DataConverter {
+	if ctx == nil {
+		return getDefaultDataConverter()
+	}
+	env, ok := ctx.Value(workflowEnvironmentContextKey).(workflowEnvironment)
+	if !ok || env.GetDataConverter() == nil {
+		return getDefaultDataConverter()
+	}
+	return env.GetDataConverter()
+}
+
 func (f *futureImpl) Get(ctx Context, value interface{}) error {
 	more := f.channel.Receive(ctx, nil)
 	if more {
@@ -329,19 +391,44 @@ func (f *futureImpl) GetValueAndError() (interface{}, error) {
 }
 
 func (f childWorkflowFutureImpl) GetChildWorkflowExecution() Future {
+	if f.retryState != nil {
+		return f.retryState.executionFuture
+	}
 	return f.executionFuture
 }
 
+func (f childWorkflowFutureImpl) SignalChildWorkflow(ctx Context, signalName string, arg interface{}) Future {
+	future, settable := NewFuture(ctx)
+	executionFuture := f.GetChildWorkflowExecution()
+	Go(ctx, func(ctx Context) {
+		var childExec WorkflowExecution
+		if err := executionFuture.Get(ctx, &childExec); err != nil {
+			settable.Set(nil, err)
+			return
+		}
+		settable.Chain(SignalExternalWorkflow(ctx, f.domain, childExec.ID, childExec.RunID, signalName, arg))
+	})
+	return future
+}
+
 func (d *syncWorkflowDefinition) Execute(env workflowEnvironment, input []byte) {
 	d.rootCtx = WithValue(background, workflowEnvironmentContextKey, env)
 	var resultPtr *workflowResult
 	d.rootCtx = WithValue(d.rootCtx, workflowResultContextKey, &resultPtr)
+	d.rootCtx = WithValue(d.rootCtx, workflowInterceptorContextKey, newWorkflowInterceptorChain(env))
 
 	// Set default values for the workflow execution.
 	wInfo := env.WorkflowInfo()
+	executionTimeoutSeconds := wInfo.ExecutionStartToCloseTimeoutSeconds
+	// The input of a run of a cron workflow is wrapped with the state of the schedule.
+	cron, cronErr := decodeCronWorkflowInput(input)
+	if cron != nil {
+		setCronWorkflowInfo(wInfo, cron, env.GetDataConverter())
+		executionTimeoutSeconds = cron.RunTimeoutSeconds
+	}
 	d.rootCtx = WithWorkflowDomain(d.rootCtx, wInfo.Domain)
 	d.rootCtx = WithWorkflowTaskList(d.rootCtx, wInfo.TaskListName)
-	d.rootCtx = WithExecutionStartToCloseTimeout(d.rootCtx, time.Duration(wInfo.ExecutionStartToCloseTimeoutSeconds)*time.Second)
+	d.rootCtx = WithExecutionStartToCloseTimeout(d.rootCtx, time.Duration(executionTimeoutSeconds)*time.Second)
 	d.rootCtx = WithWorkflowTaskStartToCloseTimeout(d.rootCtx, time.Duration(wInfo.TaskStartToCloseTimeoutSeconds)*time.Second)
 	d.rootCtx = WithTaskList(d.rootCtx, wInfo.TaskListName)
 	activityOptions := getActivityOptions(d.rootCtx)
@@ -357,7 +444,14 @@ func (d *syncWorkflowDefinition) Execute(env workflowEnvironment, input []byte)
 		state := getState(d.rootCtx)
 		state.yield("yield before executing to setup state")
 
-		r.workflowResult, r.error = d.workflow.Execute(d.rootCtx, input)
+		switch {
+		case cronErr != nil:
+			r.error = cronErr
+		case cron != nil:
+			r.workflowResult, r.error = executeCronWorkflowRun(d.rootCtx, cron, d.workflow)
+		default:
+			r.workflowResult, r.error = d.workflow.Execute(d.rootCtx, input)
+		}
 		rpp := getWorkflowResultPointerPointer(ctx)
 		*rpp = r
 	})
@@ -604,7 +698,11 @@ func (c *channelImpl) Close() {
 
 // Takes a value and assigns that 'to' value.
 func (c *channelImpl) assignValue(from interface{}, to interface{}) {
-	err := getHostEnvironment().decodeAndAssignValue(from, to)
+	dc := c.dataConverter
+	if dc == nil {
+		dc = getDefaultDataConverter()
+	}
+	err := decodeAndAssignValue(dc, from, to)
 	if err != nil {
 		panic(err)
 	}
@@ -936,38 +1034,220 @@ func (s *selectorImpl) Select(ctx Context) {
 	}
 }
 
+// newReadyFuture returns a future that is already set to the given error.
+func newReadyFuture(err error) *futureImpl {
+	f := &futureImpl{channel: &channelImpl{}}
+	f.Set(nil, err)
+	return f
+}
+
+// failOnCancel fails the future with ctx.Err() when ctx is canceled before the future becomes ready. onCancel is called
+// first to withdraw the pending wait.
+func failOnCancel(ctx Context, future *futureImpl, onCancel func()) {
+	if ctx.Done() == nil {
+		return // not cancellable.
+	}
+	Go(ctx, func(ctx Context) {
+		NewSelector(ctx).
+			AddReceive(ctx.Done(), func(c Channel, more bool) {}).
+			AddFuture(future, func(f Future) {}).
+			Select(ctx)
+		if !future.IsReady() {
+			onCancel()
+			future.Set(nil, ctx.Err())
+		}
+	})
+}
+
+// waitFuture blocks until the future is ready and returns its error. Unlike Future.Get it reports the blocking
+// operation in the coroutine stack trace.
+func waitFuture(ctx Context, future *futureImpl, status string) error {
+	if !future.IsReady() {
+		state := getState(ctx)
+		defer state.unblocked()
+		for !future.IsReady() {
+			state.yield(status)
+		}
+	}
+	return future.err
+}
+
+func newSemaphore(ctx Context, kind string, size int) *semaphoreImpl {
+	state := getState(ctx)
+	state.dispatcher.syncSequence++
+	return &semaphoreImpl{name: fmt.Sprintf("%s-%v", kind, state.dispatcher.syncSequence), size: size}
+}
+
+func (s *semaphoreImpl) Acquire(ctx Context, n int) error {
+	return waitFuture(ctx, s.acquireFuture(ctx, n), fmt.Sprintf("blocked on %s.Acquire", s.name))
+}
+
+func (s *semaphoreImpl) AcquireFuture(ctx Context, n int) Future {
+	return s.acquireFuture(ctx, n)
+}
+
+func (s *semaphoreImpl) acquireFuture(ctx Context, n int) *futureImpl {
+	if n <= 0 || n > s.size {
+		panic(fmt.Sprintf("%s: cannot acquire %v permits out of %v", s.name, n, s.size))
+	}
+	if err := ctx.Err(); err != nil {
+		return newReadyFuture(err)
+	}
+	if s.TryAcquire(n) {
+		return newReadyFuture(nil)
+	}
+	waiter := &semaphoreWaiter{n: n, future: &futureImpl{channel: &channelImpl{name: s.name}}}
+	s.waiters = append(s.waiters, waiter)
+	failOnCancel(ctx, waiter.future, func() {
+		for i, w := range s.waiters {
+			if w == waiter {
+				s.waiters = append(s.waiters[:i], s.waiters[i+1:]...)
+				break
+			}
+		}
+		// The withdrawn waiter might have been blocking smaller acquires behind it.
+		s.notifyWaiters()
+	})
+	return waiter.future
+}
+
+func (s *semaphoreImpl) TryAcquire(n int) bool {
+	// Do not overtake the blocked acquires.
+	if len(s.waiters) > 0 || s.used+n > s.size {
+		return false
+	}
+	s.used += n
+	return true
+}
+
+func (s *semaphoreImpl) Release(n int) {
+	if n <= 0 || n > s.used {
+		panic(fmt.Sprintf("%s: cannot release %v permits, %v acquired", s.name, n, s.used))
+	}
+	s.used -= n
+	s.notifyWaiters()
+}
+
+func (s *semaphoreImpl) notifyWaiters() {
+	for len(s.waiters) > 0 && s.used+s.waiters[0].n <= s.size {
+		waiter := s.waiters[0]
+		s.waiters = s.waiters[1:]
+		s.used += waiter.n
+		waiter.future.Set(nil, nil)
+	}
+}
+
+func (m *mutexImpl) Lock(ctx Context) error {
+	return waitFuture(ctx, m.semaphore.acquireFuture(ctx, 1), fmt.Sprintf("blocked on %s.Lock", m.semaphore.name))
+}
+
+func (m *mutexImpl) LockFuture(ctx Context) Future {
+	return m.semaphore.acquireFuture(ctx, 1)
+}
+
+func (m *mutexImpl) TryLock() bool {
+	return m.semaphore.TryAcquire(1)
+}
+
+func (m *mutexImpl) Unlock() {
+	if m.semaphore.used == 0 {
+		panic(fmt.Sprintf("%s: unlock of unlocked mutex", m.semaphore.name))
+	}
+	m.semaphore.Release(1)
+}
+
+func (wg *waitGroupImpl) Add(delta int) {
+	wg.counter += delta
+	if wg.counter < 0 {
+		panic(fmt.Sprintf("%s: negative counter", wg.name))
+	}
+	if wg.counter == 0 {
+		waiters := wg.waiters
+		wg.waiters = nil
+		for _, f := range waiters {
+			f.Set(nil, nil)
+		}
+	}
+}
+
+func (wg *waitGroupImpl) Done() {
+	wg.Add(-1)
+}
+
+func (wg *waitGroupImpl) Wait(ctx Context) error {
+	return waitFuture(ctx, wg.waitFuture(ctx), fmt.Sprintf("blocked on %s.Wait", wg.name))
+}
+
+func (wg *waitGroupImpl) WaitFuture(ctx Context) Future {
+	return wg.waitFuture(ctx)
+}
+
+func (wg *waitGroupImpl) waitFuture(ctx Context) *futureImpl {
+	if err := ctx.Err(); err != nil {
+		return newReadyFuture(err)
+	}
+	if wg.counter == 0 {
+		return newReadyFuture(nil)
+	}
+	future := &futureImpl{channel: &channelImpl{name: wg.name}}
+	wg.waiters = append(wg.waiters, future)
+	failOnCancel(ctx, future, func() {
+		for i, f := range wg.waiters {
+			if f == future {
+				wg.waiters = append(wg.waiters[:i], wg.waiters[i+1:]...)
+				break
+			}
+		}
+	})
+	return future
+}
+
 // NewWorkflowDefinition creates a  WorkflowDefinition from a Workflow
 func newWorkflowDefinition(workflow workflow) workflowDefinition {
 	return &syncWorkflowDefinition{workflow: workflow}
 }
 
-func getValidatedWorkerFunction(workflowFunc interface{}, args []interface{}) (*WorkflowType, []byte, error) {
-	fnName := ""
+func getValidatedWorkerFunction(
+	workflowFunc interface{},
+	args []interface{},
+	registry *hostEnvImpl,
+	dc DataConverter,
+) (*WorkflowType, []byte, error) {
+	fnName, err := getValidatedWorkflowType(workflowFunc, args, registry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input, err := encodeArgs(dc, args)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &WorkflowType{Name: fnName}, input, nil
+}
+
+// getValidatedWorkflowType validates the arguments of the workflow function, and returns the workflow type name it is
+// registered under.
+func getValidatedWorkflowType(workflowFunc interface{}, args []interface{}, registry *hostEnvImpl) (string, error) {
 	fType := reflect.TypeOf(workflowFunc)
 	switch fType.Kind() {
 	case reflect.String:
-		fnName = reflect.ValueOf(workflowFunc).String()
+		return reflect.ValueOf(workflowFunc).String(), nil
 
 	case reflect.Func:
 		if err := validateFunctionArgs(workflowFunc, args, true); err != nil {
-			return nil, nil, err
+			return "", err
 		}
-		fnName = getFunctionName(workflowFunc)
-		if alias, ok := getHostEnvironment().getWorkflowAlias(fnName); ok {
+		fnName := getFunctionName(workflowFunc)
+		if alias, ok := registry.getWorkflowAlias(fnName); ok {
 			fnName = alias
 		}
+		return fnName, nil
 
 	default:
-		return nil, nil, fmt.Errorf(
+		return "", fmt.Errorf(
 			"Invalid type 'workflowFunc' parameter provided, it can be either worker function or name of the worker type: %v",
 			workflowFunc)
 	}
-
-	input, err := getHostEnvironment().encodeArgs(args)
-	if err != nil {
-		return nil, nil, err
-	}
-	return &WorkflowType{Name: fnName}, input, nil
 }
 
 func getValidatedWorkflowOptions(ctx Context) (*workflowOptions, error) {
@@ -994,10 +1274,114 @@ func getValidatedWorkflowOptions(ctx Context) (*workflowOptions, error) {
 	if p.executionStartToCloseTimeoutSeconds == nil || *p.executionStartToCloseTimeoutSeconds <= 0 {
 		return nil, errors.New("missing or invalid ExecutionStartToCloseTimeout")
 	}
+	if err := validateRetryPolicy(p.retryPolicy); err != nil {
+		return nil, err
+	}
+	if p.cronSchedule != "" {
+		if _, err := parseCronSchedule(p.cronSchedule); err != nil {
+			return nil, err
+		}
+	}
 
 	return p, nil
 }
 
+// limitWorkflowTimeoutToDeadline caps the execution timeout of a child workflow to the deadline of ctx, if any.
+func limitWorkflowTimeoutToDeadline(ctx Context, options workflowOptions) workflowOptions {
+	if remaining, ok := remainingTimeoutSeconds(ctx); ok && *options.executionStartToCloseTimeoutSeconds > remaining {
+		options.executionStartToCloseTimeoutSeconds = common.Int32Ptr(remaining)
+	}
+	return options
+}
+
+// childWorkflowRetryState tracks the attempts of a child workflow that is executed with a retry policy.
+type childWorkflowRetryState struct {
+	ctx       Context
+	env       workflowEnvironment
+	options   workflowOptions
+	callback  resultHandler
+	startTime time.Time
+	attempt   int32
+
+	executionFuture Future             // execution future of the latest attempt
+	execution       *WorkflowExecution // execution of the running attempt, once started
+	pendingTimer    *timerInfo
+}
+
+// executeChildWorkflowWithRetryPolicy executes a child workflow, and starts it again with the same workflow ID after the
+// backoff interval computed by its retry policy every time it fails. The callback is invoked with the result of the last
+// attempt. Every attempt gets its own execution future, the returned state holds the one of the latest attempt.
+func executeChildWorkflowWithRetryPolicy(ctx Context, options workflowOptions, callback resultHandler) *childWorkflowRetryState {
+	state := &childWorkflowRetryState{
+		ctx:       ctx,
+		env:       getWorkflowEnvironment(ctx),
+		options:   options,
+		callback:  callback,
+		startTime: Now(ctx),
+	}
+	state.startAttempt()
+	Go(ctx, func(ctx Context) {
+		if ctx.Done() == nil {
+			return // not cancellable.
+		}
+		if ctx.Done().Receive(ctx, nil); ctx.Err() == ErrCanceled || ctx.Err() == ErrDeadlineExceeded {
+			state.cancel()
+		}
+	})
+	return state
+}
+
+func (s *childWorkflowRetryState) startAttempt() {
+	s.attempt++
+	// Attempts are started from callbacks, outside of the coroutines, so the future can't come from NewFuture.
+	executionSettable := &futureImpl{
+		channel: NewNamedChannel(s.ctx, fmt.Sprintf("child-workflow-execution-%v", s.attempt)).(*channelImpl),
+	}
+	s.executionFuture = executionSettable
+	s.env.ExecuteChildWorkflow(limitWorkflowTimeoutToDeadline(s.ctx, s.options), s.handleAttemptResult,
+		func(r WorkflowExecution, e error) {
+			if e == nil {
+				// Later attempts reuse the workflow ID, which is generated by the first one if not specified.
+				s.options.workflowID = r.ID
+				s.execution = &r
+			}
+			executionSettable.Set(r, e)
+		})
+}
+
+func (s *childWorkflowRetryState) handleAttemptResult(result []byte, err error) {
+	started := s.execution != nil
+	s.execution = nil
+	if err == nil || s.ctx.Err() != nil || !started {
+		// A child workflow that failed to start, for example because its workflow ID is in use, is not retried.
+		s.callback(result, err)
+		return
+	}
+
+	delay, retry := computeRetryBackoff(s.env, s.options.retryPolicy, s.attempt, s.startTime, err)
+	if !retry {
+		s.callback(nil, err)
+		return
+	}
+	s.pendingTimer = s.env.NewTimer(delay, func(r []byte, timerErr error) {
+		s.pendingTimer = nil
+		if timerErr != nil {
+			s.callback(nil, timerErr)
+			return
+		}
+		s.startAttempt()
+	})
+}
+
+func (s *childWorkflowRetryState) cancel() {
+	if s.execution != nil {
+		s.env.RequestCancelWorkflow(*s.options.domain, s.execution.ID, s.execution.RunID)
+	}
+	if s.pendingTimer != nil {
+		s.env.RequestCancelTimer(s.pendingTimer.timerID)
+	}
+}
+
 func getWorkflowEnvOptions(ctx Context) *workflowOptions {
 	options := ctx.Value(workflowEnvOptionsContextKey)
 	if options != nil {
@@ -1056,7 +1440,8 @@ func (d *decodeFutureImpl) Get(ctx Context, value interface{}) error {
 		return errors.New("value parameter is not a pointer")
 	}
 
-	err := deSerializeFunctionResult(d.fn, d.futureImpl.value.([]byte), value)
+	registry, dc := getRegistryFromWorkflowContext(ctx), getDataConverterFromWorkflowContext(ctx)
+	err := deSerializeFunctionResult(d.fn, d.futureImpl.value.([]byte), value, registry, dc)
 	if err != nil {
 		return err
 	}
@@ -1088,7 +1473,7 @@ func newDecodeFuture(ctx Context, fn interface{}) (Future, Settable) {
 
 // setQueryHandler sets query handler for given queryType.
 func setQueryHandler(ctx Context, queryType string, handler interface{}) error {
-	qh := &queryHandler{fn: handler, queryType: queryType}
+	qh := &queryHandler{fn: handler, queryType: queryType, dataConverter: getDataConverterFromWorkflowContext(ctx)}
 	err := qh.validateHandlerFn()
 	if err != nil {
 		return err
@@ -1142,15 +1527,12 @@ func (h *queryHandler) execute(input []byte) (result []byte, err error) {
 	fnType := reflect.TypeOf(h.fn)
 	args := []reflect.Value{}
 
-	if fnType.NumIn() == 1 && isTypeByteSlice(fnType.In(0)) {
-		args = append(args, reflect.ValueOf(input))
-	} else {
-		decoded, err := getHostEnvironment().decodeArgs(fnType, input)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode the input for queryType: %v, with error: %v", h.queryType, err)
-		}
-		args = append(args, decoded...)
+	// The DataConverter decides how a single []byte argument is passed, the default one passes it through.
+	decoded, err := decodeArgs(h.dataConverter, fnType, input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the input for queryType: %v, with error: %v", h.queryType, err)
 	}
+	args = append(args, decoded...)
 
 	// invoke the query handler with arguments.
 	fnValue := reflect.ValueOf(h.fn)
@@ -1159,7 +1541,7 @@ func (h *queryHandler) execute(input []byte) (result []byte, err error) {
 	// we already verified (in validateHandlerFn()) that the query handler returns 2 values
 	retValue := retValues[0]
 	if retValue.Kind() != reflect.Ptr || !retValue.IsNil() {
-		result, err = getHostEnvironment().encodeArg(retValue.Interface())
+		result, err = encodeArg(h.dataConverter, retValue.Interface())
 		if err != nil {
 			return nil, err
 		}
@@ -25,6 +25,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/uber-go/tally"
@@ -35,14 +37,23 @@ import (
 	"go.uber.org/cadence/common/backoff"
 	"go.uber.org/cadence/common/metrics"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 )
 
 // Assert that structs do indeed implement the interfaces
 var _ Client = (*workflowClient)(nil)
 var _ DomainClient = (*domainClient)(nil)
+var _ WorkflowRun = (*workflowRunImpl)(nil)
+var _ HistoryEventIterator = (*historyEventIteratorImpl)(nil)
+var _ WorkflowExecutionInfoIterator = (*workflowExecutionInfoIteratorImpl)(nil)
 
 const (
 	defaultDecisionTaskTimeoutInSecs = 20
+
+	defaultBatchMaxConcurrency = 10
+
+	// historyPollInterval is the interval at which the history of an open execution is polled for new events.
+	historyPollInterval = time.Second
 )
 
 type (
@@ -53,6 +64,53 @@ type (
 		domain            string
 		metricsScope      tally.Scope
 		identity          string
+		retryPolicy       backoff.RetryPolicy
+		dataConverter     DataConverter
+	}
+
+	// workflowRunImpl is an implementation of WorkflowRun
+	workflowRunImpl struct {
+		workflowID   string
+		firstRunID   string
+		currentRunID string
+		client       *workflowClient
+	}
+
+	// historyEventIteratorImpl is the implementation of HistoryEventIterator
+	historyEventIteratorImpl struct {
+		ctx        context.Context
+		client     *workflowClient
+		workflowID string
+		runID      string
+		options    HistoryIteratorOptions
+
+		// events are the fetched events not yet returned by Next()
+		events        []*s.HistoryEvent
+		nextPageToken []byte
+		// lastPageToken is the token of the last page read, which is read again when polling for new events.
+		lastPageToken []byte
+		// lastEventID is the ID of the last event added to events, used to skip already seen events when polling.
+		lastEventID int64
+		started     bool
+		closed      bool
+		done        bool
+		err         error
+	}
+
+	// workflowExecutionInfoIteratorImpl is the implementation of WorkflowExecutionInfoIterator.
+	// It pages through the open executions first and then through the closed ones.
+	workflowExecutionInfoIteratorImpl struct {
+		ctx     context.Context
+		client  *workflowClient
+		options ListWorkflowOptions
+
+		// startTimeFilter is fixed when the iterator is created so that all pages use the same time range.
+		startTimeFilter *s.StartTimeFilter
+		executions      []*WorkflowExecutionInfo
+		nextPageToken   []byte
+		listOpenDone    bool
+		listClosedDone  bool
+		err             error
 	}
 
 	// domainClient is the client for managing domains.
@@ -60,6 +118,7 @@ type (
 		workflowService m.TChanWorkflowService
 		metricsScope    tally.Scope
 		identity        string
+		retryPolicy     backoff.RetryPolicy
 	}
 )
 
@@ -74,34 +133,25 @@ func (wc *workflowClient) StartWorkflow(
 	options StartWorkflowOptions,
 	workflowFunc interface{},
 	args ...interface{},
-) (*WorkflowExecution, error) {
+) (WorkflowRun, error) {
 	workflowID := options.ID
 	if workflowID == "" {
 		workflowID = uuid.NewRandom().String()
 	}
 
-	if options.TaskList == "" {
-		return nil, errors.New("missing TaskList")
-	}
-
-	executionTimeout := int32(options.ExecutionStartToCloseTimeout.Seconds())
-	if executionTimeout <= 0 {
-		return nil, errors.New("missing or invalid ExecutionStartToCloseTimeout")
-	}
-
-	decisionTaskTimeout := int32(options.DecisionTaskStartToCloseTimeout.Seconds())
-	if decisionTaskTimeout < 0 {
-		return nil, errors.New("negative DecisionTaskStartToCloseTimeout provided")
-	}
-	if decisionTaskTimeout == 0 {
-		decisionTaskTimeout = defaultDecisionTaskTimeoutInSecs
+	executionTimeout, decisionTaskTimeout, err := validateStartWorkflowOptions(options)
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate type and its arguments.
-	workflowType, input, err := getValidatedWorkerFunction(workflowFunc, args)
+	workflowType, input, err := getValidatedWorkerFunction(workflowFunc, args, getHostEnvironment(), wc.dataConverter)
 	if err != nil {
 		return nil, err
 	}
+	if input, err = wrapCronWorkflowInput(options.CronSchedule, executionTimeout, input); err != nil {
+		return nil, err
+	}
 
 	startRequest := &s.StartWorkflowExecutionRequest{
 		Domain:       common.StringPtr(wc.domain),
@@ -116,7 +166,8 @@ func (wc *workflowClient) StartWorkflow(
 
 	var response *s.StartWorkflowExecutionResponse
 
-	// Start creating workflow request.
+	// Start creating workflow request. The request, including its RequestId, is built once outside of the
+	// retried operation so that the server deduplicates retries of the same start.
 	err = backoff.Retry(ctx,
 		func() error {
 			tchCtx, cancel := newTChannelContext(ctx)
@@ -125,7 +176,7 @@ func (wc *workflowClient) StartWorkflow(
 			var err1 error
 			response, err1 = wc.workflowService.StartWorkflowExecution(tchCtx, startRequest)
 			return err1
-		}, serviceOperationRetryPolicy, isServiceTransientError)
+		}, wc.getOperationRetryPolicy("StartWorkflowExecution"), isServiceTransientError)
 
 	if err != nil {
 		return nil, err
@@ -135,10 +186,99 @@ func (wc *workflowClient) StartWorkflow(
 		wc.metricsScope.Counter(metrics.WorkflowStartCounter).Inc(1)
 	}
 
-	executionInfo := &WorkflowExecution{
-		ID:    options.ID,
-		RunID: response.GetRunId()}
-	return executionInfo, nil
+	return &workflowRunImpl{
+		workflowID:   workflowID,
+		firstRunID:   response.GetRunId(),
+		currentRunID: response.GetRunId(),
+		client:       wc,
+	}, nil
+}
+
+// SignalWithStartWorkflow sends a signal to a running workflow.
+// If the workflow is not running or not found, it starts the workflow and then sends the signal in transaction.
+func (wc *workflowClient) SignalWithStartWorkflow(
+	ctx context.Context,
+	workflowID string,
+	signalName string,
+	signalArg interface{},
+	options StartWorkflowOptions,
+	workflowFunc interface{},
+	workflowArgs ...interface{},
+) (WorkflowRun, error) {
+	if workflowID == "" {
+		workflowID = options.ID
+	}
+	if workflowID == "" {
+		return nil, errors.New("missing workflow ID")
+	}
+
+	executionTimeout, decisionTaskTimeout, err := validateStartWorkflowOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	var signalInput []byte
+	if signalArg != nil {
+		if signalInput, err = encodeArg(wc.dataConverter, signalArg); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate type and its arguments.
+	workflowType, input, err := getValidatedWorkerFunction(
+		workflowFunc, workflowArgs, getHostEnvironment(), wc.dataConverter)
+	if err != nil {
+		return nil, err
+	}
+	if input, err = wrapCronWorkflowInput(options.CronSchedule, executionTimeout, input); err != nil {
+		return nil, err
+	}
+
+	signalWithStartRequest := &s.SignalWithStartWorkflowExecutionRequest{
+		Domain:       common.StringPtr(wc.domain),
+		RequestId:    common.StringPtr(uuid.New()),
+		WorkflowId:   common.StringPtr(workflowID),
+		WorkflowType: workflowTypePtr(*workflowType),
+		TaskList:     common.TaskListPtr(s.TaskList{Name: common.StringPtr(options.TaskList)}),
+		Input:        input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(executionTimeout),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(decisionTaskTimeout),
+		SignalName:                          common.StringPtr(signalName),
+		SignalInput:                         signalInput,
+		Identity:                            common.StringPtr(wc.identity)}
+
+	var response *s.StartWorkflowExecutionResponse
+
+	err = backoff.Retry(ctx,
+		func() error {
+			tchCtx, cancel := newTChannelContext(ctx)
+			defer cancel()
+
+			var err1 error
+			response, err1 = wc.workflowService.SignalWithStartWorkflowExecution(tchCtx, signalWithStartRequest)
+			return err1
+		}, wc.getOperationRetryPolicy("SignalWithStartWorkflowExecution"), isServiceTransientError)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowRunImpl{
+		workflowID:   workflowID,
+		firstRunID:   response.GetRunId(),
+		currentRunID: response.GetRunId(),
+		client:       wc,
+	}, nil
+}
+
+// GetWorkflow gets a workflow execution and returns a WorkflowRun that will allow to get the execution result.
+func (wc *workflowClient) GetWorkflow(ctx context.Context, workflowID string, runID string) WorkflowRun {
+	return &workflowRunImpl{
+		workflowID:   workflowID,
+		firstRunID:   runID,
+		currentRunID: runID,
+		client:       wc,
+	}
 }
 
 // SignalWorkflow signals a workflow in execution.
@@ -146,7 +286,7 @@ func (wc *workflowClient) SignalWorkflow(ctx context.Context, workflowID string,
 	var input []byte
 	if arg != nil {
 		var err error
-		if input, err = getHostEnvironment().encodeArg(arg); err != nil {
+		if input, err = encodeArg(wc.dataConverter, arg); err != nil {
 			return err
 		}
 	}
@@ -167,7 +307,7 @@ func (wc *workflowClient) SignalWorkflow(ctx context.Context, workflowID string,
 			tchCtx, cancel := newTChannelContext(ctx)
 			defer cancel()
 			return wc.workflowService.SignalWorkflowExecution(tchCtx, request)
-		}, serviceOperationRetryPolicy, isServiceTransientError)
+		}, wc.getOperationRetryPolicy("SignalWorkflowExecution"), isServiceRejectedError)
 }
 
 // CancelWorkflow cancels a workflow in execution.
@@ -186,7 +326,7 @@ func (wc *workflowClient) CancelWorkflow(ctx context.Context, workflowID string,
 			tchCtx, cancel := newTChannelContext(ctx)
 			defer cancel()
 			return wc.workflowService.RequestCancelWorkflowExecution(tchCtx, request)
-		}, serviceOperationRetryPolicy, isServiceTransientError)
+		}, wc.getOperationRetryPolicy("RequestCancelWorkflowExecution"), isServiceTransientError)
 }
 
 // TerminateWorkflow terminates a workflow execution.
@@ -200,6 +340,7 @@ func (wc *workflowClient) TerminateWorkflow(ctx context.Context, workflowID stri
 			RunId:      getRunID(runID),
 		},
 		Reason:   common.StringPtr(reason),
+		Details:  details,
 		Identity: common.StringPtr(wc.identity),
 	}
 
@@ -208,7 +349,7 @@ func (wc *workflowClient) TerminateWorkflow(ctx context.Context, workflowID stri
 			tchCtx, cancel := newTChannelContext(ctx)
 			defer cancel()
 			return wc.workflowService.TerminateWorkflowExecution(tchCtx, request)
-		}, serviceOperationRetryPolicy, isServiceTransientError)
+		}, wc.getOperationRetryPolicy("TerminateWorkflowExecution"), isServiceRejectedError)
 
 	return err
 }
@@ -217,40 +358,61 @@ func (wc *workflowClient) TerminateWorkflow(ctx context.Context, workflowID stri
 func (wc *workflowClient) GetWorkflowHistory(ctx context.Context, workflowID string, runID string) (*s.History, error) {
 	history := s.NewHistory()
 	history.Events = make([]*s.HistoryEvent, 0)
-	var nextPageToken []byte
-
-GetHistoryLoop:
-	for {
-		request := &s.GetWorkflowExecutionHistoryRequest{
-			Domain: common.StringPtr(wc.domain),
-			Execution: &s.WorkflowExecution{
-				WorkflowId: common.StringPtr(workflowID),
-				RunId:      getRunID(runID),
-			},
-			NextPageToken: nextPageToken,
-		}
-
-		var response *s.GetWorkflowExecutionHistoryResponse
-		err := backoff.Retry(ctx,
-			func() error {
-				var err1 error
-				tchCtx, cancel := newTChannelContext(ctx)
-				defer cancel()
-				response, err1 = wc.workflowService.GetWorkflowExecutionHistory(tchCtx, request)
-				return err1
-			}, serviceOperationRetryPolicy, isServiceTransientError)
+	iter := wc.GetWorkflowHistoryIterator(ctx, workflowID, runID, HistoryIteratorOptions{})
+	for iter.HasNext() {
+		event, err := iter.Next()
 		if err != nil {
 			return nil, err
 		}
-		history.Events = append(history.Events, response.GetHistory().GetEvents()...)
-		if response.GetNextPageToken() == nil {
-			break GetHistoryLoop
-		}
-		nextPageToken = response.GetNextPageToken()
+		history.Events = append(history.Events, event)
 	}
 	return history, nil
 }
 
+// GetWorkflowHistoryIterator gets an iterator over the history events of a particular workflow.
+func (wc *workflowClient) GetWorkflowHistoryIterator(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+	options HistoryIteratorOptions,
+) HistoryEventIterator {
+	return &historyEventIteratorImpl{
+		ctx:        ctx,
+		client:     wc,
+		workflowID: workflowID,
+		runID:      runID,
+		options:    options,
+	}
+}
+
+func isWorkflowCloseEvent(eventType s.EventType) bool {
+	switch eventType {
+	case s.EventType_WorkflowExecutionCompleted,
+		s.EventType_WorkflowExecutionFailed,
+		s.EventType_WorkflowExecutionCanceled,
+		s.EventType_WorkflowExecutionTerminated,
+		s.EventType_WorkflowExecutionContinuedAsNew,
+		s.EventType_WorkflowExecutionTimedOut:
+		return true
+	default:
+		return false
+	}
+}
+
+// DescribeWorkflow gets a description of a workflow execution by scanning its history.
+func (wc *workflowClient) DescribeWorkflow(ctx context.Context, workflowID string, runID string) (*WorkflowDescription, error) {
+	iter := wc.GetWorkflowHistoryIterator(ctx, workflowID, runID, HistoryIteratorOptions{})
+	describer := newWorkflowDescriber(WorkflowExecution{ID: workflowID, RunID: runID})
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		describer.processEvent(event)
+	}
+	return describer.description(), nil
+}
+
 func (wc *workflowClient) GetWorkflowStackTrace(ctx context.Context, workflowID string, runID string, atDecisionTaskCompletedEventID int64) (string, error) {
 	getHistoryPage := newGetHistoryPageFunc(
 		ctx,
@@ -260,10 +422,11 @@ func (wc *workflowClient) GetWorkflowStackTrace(ctx context.Context, workflowID
 		atDecisionTaskCompletedEventID,
 		wc.metricsScope,
 	)
-	return getWorkflowStackTraceImpl(workflowID, runID, getHistoryPage)
+	return getWorkflowStackTraceImpl(workflowID, runID, getHistoryPage, wc.dataConverter)
 }
 
-func getWorkflowStackTraceImpl(workflowID string, runID string, getHistoryPage GetHistoryPage) (string, error) {
+func getWorkflowStackTraceImpl(workflowID string, runID string, getHistoryPage GetHistoryPage,
+	dataConverter DataConverter) (string, error) {
 	firstPage, taskToken, err := getHistoryPage([]byte{})
 	if err != nil {
 		return "", err
@@ -287,6 +450,7 @@ func getWorkflowStackTraceImpl(workflowID string, runID string, getHistoryPage G
 		Logger:                    logger,
 		EnableLoggingInReplay:     false,
 		UserContext:               context.Background(),
+		DataConverter:             dataConverter,
 	}
 	var maxInt64 int64 = math.MaxInt64
 	taskHandler := newWorkflowTaskHandler("unknown", workerParams, nil, getHostEnvironment())
@@ -324,22 +488,67 @@ func (wc *workflowClient) CompleteActivity(ctx context.Context, taskToken []byte
 	var data []byte
 	if result != nil {
 		var err0 error
-		data, err0 = getHostEnvironment().encodeArg(result)
+		data, err0 = encodeArg(wc.dataConverter, result)
 		if err0 != nil {
 			return err0
 		}
 	}
-	request := convertActivityResultToRespondRequest(wc.identity, taskToken, data, err)
-	return reportActivityComplete(ctx, wc.workflowService, request, wc.metricsScope)
+	request := convertActivityResultToRespondRequest(wc.identity, taskToken, data, err, wc.dataConverter)
+	return reportActivityComplete(ctx, wc.workflowService, request, wc.metricsScope, wc.retryPolicy)
 }
 
 // RecordActivityHeartbeat records heartbeat for an activity.
 func (wc *workflowClient) RecordActivityHeartbeat(ctx context.Context, taskToken []byte, details ...interface{}) error {
-	data, err := getHostEnvironment().encodeArgs(details)
+	data, err := encodeArgs(wc.dataConverter, details)
 	if err != nil {
 		return err
 	}
-	return recordActivityHeartbeat(ctx, wc.workflowService, wc.identity, taskToken, data, serviceOperationRetryPolicy)
+	return recordActivityHeartbeat(ctx, wc.workflowService, wc.identity, taskToken, data,
+		wc.getOperationRetryPolicy("RecordActivityTaskHeartbeat"))
+}
+
+// CompleteActivityByID reports activity completed. Similar to CompleteActivity, but instead of the task token it uses
+// the domain, workflow ID, run ID and activity ID to identify the activity. An empty domain falls back to the
+// client's domain, and an empty runID targets the current run of the workflow.
+func (wc *workflowClient) CompleteActivityByID(ctx context.Context, domain, workflowID, runID, activityID string,
+	result interface{}, err error) error {
+	if workflowID == "" || activityID == "" {
+		return errors.New("empty workflow ID or activity ID")
+	}
+	if domain == "" {
+		domain = wc.domain
+	}
+
+	var data []byte
+	if result != nil {
+		var err0 error
+		data, err0 = encodeArg(wc.dataConverter, result)
+		if err0 != nil {
+			return err0
+		}
+	}
+	request := convertActivityResultToRespondRequestByID(wc.identity, domain, workflowID, runID, activityID, data, err,
+		wc.dataConverter)
+	return reportActivityComplete(ctx, wc.workflowService, request, wc.metricsScope, wc.retryPolicy)
+}
+
+// RecordActivityHeartbeatByID records heartbeat for an activity identified by domain, workflow ID, run ID and
+// activity ID.
+func (wc *workflowClient) RecordActivityHeartbeatByID(ctx context.Context, domain, workflowID, runID, activityID string,
+	details ...interface{}) error {
+	if workflowID == "" || activityID == "" {
+		return errors.New("empty workflow ID or activity ID")
+	}
+	if domain == "" {
+		domain = wc.domain
+	}
+
+	data, err := encodeArgs(wc.dataConverter, details)
+	if err != nil {
+		return err
+	}
+	return recordActivityHeartbeatByID(ctx, wc.workflowService, wc.identity, domain, workflowID, runID, activityID,
+		data, wc.getOperationRetryPolicy("RecordActivityTaskHeartbeatByID"))
 }
 
 // ListClosedWorkflow gets closed workflow executions based on request filters
@@ -359,7 +568,7 @@ func (wc *workflowClient) ListClosedWorkflow(ctx context.Context, request *s.Lis
 			defer cancel()
 			response, err1 = wc.workflowService.ListClosedWorkflowExecutions(tchCtx, request)
 			return err1
-		}, serviceOperationRetryPolicy, isServiceTransientError)
+		}, wc.getOperationRetryPolicy("ListClosedWorkflowExecutions"), isServiceTransientError)
 	if err != nil {
 		return nil, err
 	}
@@ -383,13 +592,269 @@ func (wc *workflowClient) ListOpenWorkflow(ctx context.Context, request *s.ListO
 			defer cancel()
 			response, err1 = wc.workflowService.ListOpenWorkflowExecutions(tchCtx, request)
 			return err1
-		}, serviceOperationRetryPolicy, isServiceTransientError)
+		}, wc.getOperationRetryPolicy("ListOpenWorkflowExecutions"), isServiceTransientError)
 	if err != nil {
 		return nil, err
 	}
 	return response, nil
 }
 
+// ListWorkflows gets workflow executions matching the given options.
+func (wc *workflowClient) ListWorkflows(ctx context.Context, options ListWorkflowOptions) WorkflowExecutionInfoIterator {
+	latestTime := options.LatestStartTime
+	if latestTime.IsZero() {
+		latestTime = time.Now()
+	}
+	var earliestTime int64
+	if !options.EarliestStartTime.IsZero() {
+		earliestTime = options.EarliestStartTime.UnixNano()
+	}
+
+	status := options.Status
+	return &workflowExecutionInfoIteratorImpl{
+		ctx:     ctx,
+		client:  wc,
+		options: options,
+		startTimeFilter: &s.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(earliestTime),
+			LatestTime:   common.Int64Ptr(latestTime.UnixNano()),
+		},
+		listOpenDone:   status != WorkflowExecutionStatusAny && status != WorkflowExecutionStatusOpen,
+		listClosedDone: status == WorkflowExecutionStatusOpen,
+	}
+}
+
+// HasNext returns whether there are more executions, fetching the next page if needed.
+func (iter *workflowExecutionInfoIteratorImpl) HasNext() bool {
+	for len(iter.executions) == 0 && iter.err == nil && !(iter.listOpenDone && iter.listClosedDone) {
+		iter.fetchNextPage()
+	}
+	return len(iter.executions) > 0 || iter.err != nil
+}
+
+// Next returns the next workflow execution.
+func (iter *workflowExecutionInfoIteratorImpl) Next() (*WorkflowExecutionInfo, error) {
+	if !iter.HasNext() {
+		return nil, errors.New("no more workflow executions")
+	}
+	if iter.err != nil {
+		err := iter.err
+		iter.err = nil
+		iter.listOpenDone = true
+		iter.listClosedDone = true
+		return nil, err
+	}
+	execution := iter.executions[0]
+	iter.executions = iter.executions[1:]
+	return execution, nil
+}
+
+func (iter *workflowExecutionInfoIteratorImpl) fetchNextPage() {
+	var executionFilter *s.WorkflowExecutionFilter
+	var typeFilter *s.WorkflowTypeFilter
+	// The server accepts only one of the execution and type filters, the type is then checked on the client side.
+	if iter.options.WorkflowID != "" {
+		executionFilter = &s.WorkflowExecutionFilter{WorkflowId: common.StringPtr(iter.options.WorkflowID)}
+	} else if iter.options.WorkflowType != "" {
+		typeFilter = &s.WorkflowTypeFilter{Name: common.StringPtr(iter.options.WorkflowType)}
+	}
+	var pageSize *int32
+	if iter.options.MaximumPageSize > 0 {
+		pageSize = common.Int32Ptr(iter.options.MaximumPageSize)
+	}
+
+	var executions []*s.WorkflowExecutionInfo
+	var nextPageToken []byte
+	if !iter.listOpenDone {
+		response, err := iter.client.ListOpenWorkflow(iter.ctx, &s.ListOpenWorkflowExecutionsRequest{
+			MaximumPageSize: pageSize,
+			NextPageToken:   iter.nextPageToken,
+			StartTimeFilter: iter.startTimeFilter,
+			ExecutionFilter: executionFilter,
+			TypeFilter:      typeFilter,
+		})
+		if err != nil {
+			iter.err = err
+			return
+		}
+		executions, nextPageToken = response.GetExecutions(), response.GetNextPageToken()
+		iter.listOpenDone = len(nextPageToken) == 0
+	} else {
+		response, err := iter.client.ListClosedWorkflow(iter.ctx, &s.ListClosedWorkflowExecutionsRequest{
+			MaximumPageSize: pageSize,
+			NextPageToken:   iter.nextPageToken,
+			StartTimeFilter: iter.startTimeFilter,
+			ExecutionFilter: executionFilter,
+			TypeFilter:      typeFilter,
+			StatusFilter:    closeStatusFilter(iter.options.Status),
+		})
+		if err != nil {
+			iter.err = err
+			return
+		}
+		executions, nextPageToken = response.GetExecutions(), response.GetNextPageToken()
+		iter.listClosedDone = len(nextPageToken) == 0
+	}
+	iter.nextPageToken = nextPageToken
+
+	for _, execution := range executions {
+		if iter.options.WorkflowType != "" && execution.GetType().GetName() != iter.options.WorkflowType {
+			continue
+		}
+		iter.executions = append(iter.executions, convertWorkflowExecutionInfo(execution))
+	}
+}
+
+func closeStatusFilter(status WorkflowExecutionStatus) *s.WorkflowExecutionCloseStatus {
+	var closeStatus s.WorkflowExecutionCloseStatus
+	switch status {
+	case WorkflowExecutionStatusCompleted:
+		closeStatus = s.WorkflowExecutionCloseStatus_COMPLETED
+	case WorkflowExecutionStatusFailed:
+		closeStatus = s.WorkflowExecutionCloseStatus_FAILED
+	case WorkflowExecutionStatusCanceled:
+		closeStatus = s.WorkflowExecutionCloseStatus_CANCELED
+	case WorkflowExecutionStatusTerminated:
+		closeStatus = s.WorkflowExecutionCloseStatus_TERMINATED
+	case WorkflowExecutionStatusContinuedAsNew:
+		closeStatus = s.WorkflowExecutionCloseStatus_CONTINUED_AS_NEW
+	case WorkflowExecutionStatusTimedOut:
+		closeStatus = s.WorkflowExecutionCloseStatus_TIMED_OUT
+	default:
+		return nil
+	}
+	return &closeStatus
+}
+
+func convertWorkflowExecutionInfo(info *s.WorkflowExecutionInfo) *WorkflowExecutionInfo {
+	result := &WorkflowExecutionInfo{
+		Execution: WorkflowExecution{
+			ID:    info.GetExecution().GetWorkflowId(),
+			RunID: info.GetExecution().GetRunId(),
+		},
+		Type:          WorkflowType{Name: info.GetType().GetName()},
+		StartTime:     time.Unix(0, info.GetStartTime()),
+		Status:        WorkflowExecutionStatusOpen,
+		HistoryLength: info.GetHistoryLength(),
+	}
+	if !info.IsSetCloseStatus() {
+		return result
+	}
+
+	result.CloseTime = time.Unix(0, info.GetCloseTime())
+	switch info.GetCloseStatus() {
+	case s.WorkflowExecutionCloseStatus_COMPLETED:
+		result.Status = WorkflowExecutionStatusCompleted
+	case s.WorkflowExecutionCloseStatus_FAILED:
+		result.Status = WorkflowExecutionStatusFailed
+	case s.WorkflowExecutionCloseStatus_CANCELED:
+		result.Status = WorkflowExecutionStatusCanceled
+	case s.WorkflowExecutionCloseStatus_TERMINATED:
+		result.Status = WorkflowExecutionStatusTerminated
+	case s.WorkflowExecutionCloseStatus_CONTINUED_AS_NEW:
+		result.Status = WorkflowExecutionStatusContinuedAsNew
+	case s.WorkflowExecutionCloseStatus_TIMED_OUT:
+		result.Status = WorkflowExecutionStatusTimedOut
+	}
+	return result
+}
+
+// BatchOperation applies an operation to all open workflow executions matching the request filter.
+func (wc *workflowClient) BatchOperation(ctx context.Context, request BatchRequest) (*BatchResult, error) {
+	if request.WorkflowType == "" {
+		return nil, errors.New("missing WorkflowType")
+	}
+	var operation func(execution WorkflowExecution) error
+	switch request.Operation {
+	case BatchOperationSignal:
+		if request.SignalName == "" {
+			return nil, errors.New("missing SignalName")
+		}
+		operation = func(execution WorkflowExecution) error {
+			return wc.SignalWorkflow(ctx, execution.ID, execution.RunID, request.SignalName, request.SignalArg)
+		}
+	case BatchOperationCancel:
+		operation = func(execution WorkflowExecution) error {
+			return wc.CancelWorkflow(ctx, execution.ID, execution.RunID)
+		}
+	case BatchOperationTerminate:
+		operation = func(execution WorkflowExecution) error {
+			return wc.TerminateWorkflow(ctx, execution.ID, execution.RunID, request.Reason, request.Details)
+		}
+	default:
+		return nil, fmt.Errorf("unknown batch operation: %v", request.Operation)
+	}
+
+	result := &BatchResult{}
+	iter := wc.ListWorkflows(ctx, ListWorkflowOptions{
+		EarliestStartTime: request.EarliestStartTime,
+		LatestStartTime:   request.LatestStartTime,
+		WorkflowType:      request.WorkflowType,
+		Status:            WorkflowExecutionStatusOpen,
+	})
+	for iter.HasNext() {
+		info, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		result.Targets = append(result.Targets, info.Execution)
+	}
+	if request.DryRun {
+		return result, nil
+	}
+
+	concurrency := request.MaxConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchMaxConcurrency
+	}
+	limit := rate.Inf
+	if request.MaxOperationsPerSecond > 0 {
+		limit = rate.Limit(request.MaxOperationsPerSecond)
+	}
+	limiter := rate.NewLimiter(limit, 1)
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	tokens := make(chan struct{}, concurrency)
+	for _, execution := range result.Targets {
+		if limiter.Wait(ctx) != nil {
+			// Context is done, the remaining executions are not processed.
+			lock.Lock()
+			result.Skipped = append(result.Skipped, execution)
+			lock.Unlock()
+			continue
+		}
+		tokens <- struct{}{}
+		wg.Add(1)
+		go func(execution WorkflowExecution) {
+			defer func() {
+				<-tokens
+				wg.Done()
+			}()
+			err := operation(execution)
+			lock.Lock()
+			defer lock.Unlock()
+			switch err.(type) {
+			case nil:
+				result.Succeeded = append(result.Succeeded, execution)
+			case *s.EntityNotExistsError:
+				// The execution was closed after it was listed.
+				result.Skipped = append(result.Skipped, execution)
+			default:
+				result.Failed = append(result.Failed, BatchFailure{Execution: execution, Err: err})
+			}
+		}(execution)
+	}
+	wg.Wait()
+
+	if wc.metricsScope != nil {
+		wc.metricsScope.Counter(metrics.WorkflowBatchOperationSucceededCounter).Inc(int64(len(result.Succeeded)))
+		wc.metricsScope.Counter(metrics.WorkflowBatchOperationFailedCounter).Inc(int64(len(result.Failed)))
+		wc.metricsScope.Counter(metrics.WorkflowBatchOperationSkippedCounter).Inc(int64(len(result.Skipped)))
+	}
+	return result, nil
+}
+
 // QueryWorkflow queries a given workflow execution
 // workflowID and queryType are required, other parameters are optional.
 // - workflow ID of the workflow.
@@ -406,7 +871,7 @@ func (wc *workflowClient) QueryWorkflow(ctx context.Context, workflowID string,
 	var input []byte
 	if len(args) > 0 {
 		var err error
-		if input, err = getHostEnvironment().encodeArgs(args); err != nil {
+		if input, err = encodeArgs(wc.dataConverter, args); err != nil {
 			return nil, err
 		}
 	}
@@ -430,12 +895,328 @@ func (wc *workflowClient) QueryWorkflow(ctx context.Context, workflowID string,
 			var err error
 			resp, err = wc.workflowService.QueryWorkflow(tchCtx, request)
 			return err
-		}, serviceOperationRetryPolicy, isServiceTransientError)
+		}, wc.getOperationRetryPolicy("QueryWorkflow"), isServiceTransientError)
 	if err != nil {
 		return nil, err
 	}
 
-	return EncodedValue(resp.QueryResult_), nil
+	return newEncodedValue(resp.QueryResult_, wc.dataConverter), nil
+}
+
+// workflowDescriber builds a WorkflowDescription from the events of a workflow execution. Pending entities are
+// tracked with the same identifiers the decisionsHelper uses and in the order they were added to the history.
+type workflowDescriber struct {
+	desc *WorkflowDescription
+
+	activities         map[int64]*PendingActivityInfo // by scheduled event ID
+	activityOrder      []int64
+	scheduledEventIDs  map[string]int64 // activity ID to scheduled event ID
+	timers             map[string]*PendingTimerInfo
+	timerOrder         []string
+	childWorkflows     map[string]*PendingChildWorkflowInfo // by child workflow ID
+	childWorkflowOrder []string
+	cancelRequests     map[int64]WorkflowExecution // by initiated event ID
+	cancelRequestOrder []int64
+}
+
+func newWorkflowDescriber(execution WorkflowExecution) *workflowDescriber {
+	return &workflowDescriber{
+		desc: &WorkflowDescription{
+			Execution: execution,
+			Status:    WorkflowExecutionStatusOpen,
+		},
+		activities:        make(map[int64]*PendingActivityInfo),
+		scheduledEventIDs: make(map[string]int64),
+		timers:            make(map[string]*PendingTimerInfo),
+		childWorkflows:    make(map[string]*PendingChildWorkflowInfo),
+		cancelRequests:    make(map[int64]WorkflowExecution),
+	}
+}
+
+func (d *workflowDescriber) processEvent(event *s.HistoryEvent) {
+	eventTime := time.Unix(0, event.GetTimestamp())
+	d.desc.HistoryLength++
+
+	switch event.GetEventType() {
+	case s.EventType_WorkflowExecutionStarted:
+		attributes := event.WorkflowExecutionStartedEventAttributes
+		d.desc.Type = WorkflowType{Name: attributes.GetWorkflowType().GetName()}
+		d.desc.TaskList = attributes.GetTaskList().GetName()
+		d.desc.StartTime = eventTime
+
+	case s.EventType_WorkflowExecutionCancelRequested:
+		d.desc.CancelRequested = true
+
+	case s.EventType_WorkflowExecutionSignaled:
+		d.desc.SignalCount++
+
+	case s.EventType_ActivityTaskScheduled:
+		attributes := event.ActivityTaskScheduledEventAttributes
+		d.activities[event.GetEventId()] = &PendingActivityInfo{
+			ActivityID:    attributes.GetActivityId(),
+			ActivityType:  ActivityType{Name: attributes.GetActivityType().GetName()},
+			ScheduledTime: eventTime,
+		}
+		d.activityOrder = append(d.activityOrder, event.GetEventId())
+		d.scheduledEventIDs[attributes.GetActivityId()] = event.GetEventId()
+
+	case s.EventType_ActivityTaskStarted:
+		scheduledEventID := event.ActivityTaskStartedEventAttributes.GetScheduledEventId()
+		if activity, ok := d.activities[scheduledEventID]; ok {
+			activity.StartedTime = eventTime
+		}
+
+	case s.EventType_ActivityTaskCancelRequested:
+		activityID := event.ActivityTaskCancelRequestedEventAttributes.GetActivityId()
+		if activity, ok := d.activities[d.scheduledEventIDs[activityID]]; ok {
+			activity.CancelRequested = true
+		}
+
+	case s.EventType_ActivityTaskCompleted:
+		delete(d.activities, event.ActivityTaskCompletedEventAttributes.GetScheduledEventId())
+	case s.EventType_ActivityTaskFailed:
+		delete(d.activities, event.ActivityTaskFailedEventAttributes.GetScheduledEventId())
+	case s.EventType_ActivityTaskTimedOut:
+		delete(d.activities, event.ActivityTaskTimedOutEventAttributes.GetScheduledEventId())
+	case s.EventType_ActivityTaskCanceled:
+		delete(d.activities, event.ActivityTaskCanceledEventAttributes.GetScheduledEventId())
+
+	case s.EventType_TimerStarted:
+		attributes := event.TimerStartedEventAttributes
+		d.timers[attributes.GetTimerId()] = &PendingTimerInfo{
+			TimerID:     attributes.GetTimerId(),
+			StartedTime: eventTime,
+			FireTime:    eventTime.Add(time.Duration(attributes.GetStartToFireTimeoutSeconds()) * time.Second),
+		}
+		d.timerOrder = append(d.timerOrder, attributes.GetTimerId())
+	case s.EventType_TimerFired:
+		delete(d.timers, event.TimerFiredEventAttributes.GetTimerId())
+	case s.EventType_TimerCanceled:
+		delete(d.timers, event.TimerCanceledEventAttributes.GetTimerId())
+
+	case s.EventType_StartChildWorkflowExecutionInitiated:
+		attributes := event.StartChildWorkflowExecutionInitiatedEventAttributes
+		d.childWorkflows[attributes.GetWorkflowId()] = &PendingChildWorkflowInfo{
+			Domain:        attributes.GetDomain(),
+			Execution:     WorkflowExecution{ID: attributes.GetWorkflowId()},
+			Type:          WorkflowType{Name: attributes.GetWorkflowType().GetName()},
+			InitiatedTime: eventTime,
+		}
+		d.childWorkflowOrder = append(d.childWorkflowOrder, attributes.GetWorkflowId())
+	case s.EventType_StartChildWorkflowExecutionFailed:
+		delete(d.childWorkflows, event.StartChildWorkflowExecutionFailedEventAttributes.GetWorkflowId())
+	case s.EventType_ChildWorkflowExecutionStarted:
+		execution := event.ChildWorkflowExecutionStartedEventAttributes.GetWorkflowExecution()
+		if childWorkflow, ok := d.childWorkflows[execution.GetWorkflowId()]; ok {
+			childWorkflow.Execution.RunID = execution.GetRunId()
+		}
+	case s.EventType_ChildWorkflowExecutionCompleted:
+		delete(d.childWorkflows, event.ChildWorkflowExecutionCompletedEventAttributes.GetWorkflowExecution().GetWorkflowId())
+	case s.EventType_ChildWorkflowExecutionFailed:
+		delete(d.childWorkflows, event.ChildWorkflowExecutionFailedEventAttributes.GetWorkflowExecution().GetWorkflowId())
+	case s.EventType_ChildWorkflowExecutionCanceled:
+		delete(d.childWorkflows, event.ChildWorkflowExecutionCanceledEventAttributes.GetWorkflowExecution().GetWorkflowId())
+	case s.EventType_ChildWorkflowExecutionTimedOut:
+		delete(d.childWorkflows, event.ChildWorkflowExecutionTimedOutEventAttributes.GetWorkflowExecution().GetWorkflowId())
+	case s.EventType_ChildWorkflowExecutionTerminated:
+		delete(d.childWorkflows, event.ChildWorkflowExecutionTerminatedEventAttributes.GetWorkflowExecution().GetWorkflowId())
+
+	case s.EventType_RequestCancelExternalWorkflowExecutionInitiated:
+		execution := event.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.GetWorkflowExecution()
+		d.cancelRequests[event.GetEventId()] = WorkflowExecution{ID: execution.GetWorkflowId(), RunID: execution.GetRunId()}
+		d.cancelRequestOrder = append(d.cancelRequestOrder, event.GetEventId())
+	case s.EventType_RequestCancelExternalWorkflowExecutionFailed:
+		delete(d.cancelRequests, event.RequestCancelExternalWorkflowExecutionFailedEventAttributes.GetInitiatedEventId())
+	case s.EventType_ExternalWorkflowExecutionCancelRequested:
+		delete(d.cancelRequests, event.ExternalWorkflowExecutionCancelRequestedEventAttributes.GetInitiatedEventId())
+
+	case s.EventType_WorkflowExecutionCompleted:
+		d.close(eventTime, WorkflowExecutionStatusCompleted)
+	case s.EventType_WorkflowExecutionFailed:
+		d.close(eventTime, WorkflowExecutionStatusFailed)
+	case s.EventType_WorkflowExecutionCanceled:
+		d.close(eventTime, WorkflowExecutionStatusCanceled)
+	case s.EventType_WorkflowExecutionTerminated:
+		d.close(eventTime, WorkflowExecutionStatusTerminated)
+	case s.EventType_WorkflowExecutionContinuedAsNew:
+		d.close(eventTime, WorkflowExecutionStatusContinuedAsNew)
+	case s.EventType_WorkflowExecutionTimedOut:
+		d.close(eventTime, WorkflowExecutionStatusTimedOut)
+	}
+}
+
+func (d *workflowDescriber) close(closeTime time.Time, status WorkflowExecutionStatus) {
+	d.desc.CloseTime = closeTime
+	d.desc.Status = status
+}
+
+func (d *workflowDescriber) description() *WorkflowDescription {
+	for _, scheduledEventID := range d.activityOrder {
+		if activity, ok := d.activities[scheduledEventID]; ok {
+			d.desc.PendingActivities = append(d.desc.PendingActivities, *activity)
+		}
+	}
+	for _, timerID := range d.timerOrder {
+		if timer, ok := d.timers[timerID]; ok {
+			d.desc.PendingTimers = append(d.desc.PendingTimers, *timer)
+		}
+	}
+	for _, childWorkflowID := range d.childWorkflowOrder {
+		if childWorkflow, ok := d.childWorkflows[childWorkflowID]; ok {
+			d.desc.PendingChildWorkflows = append(d.desc.PendingChildWorkflows, *childWorkflow)
+			// A child workflow ID can be reused after the previous child is closed, report it only once.
+			delete(d.childWorkflows, childWorkflowID)
+		}
+	}
+	for _, initiatedEventID := range d.cancelRequestOrder {
+		if execution, ok := d.cancelRequests[initiatedEventID]; ok {
+			d.desc.PendingCancelRequests = append(d.desc.PendingCancelRequests, execution)
+		}
+	}
+	return d.desc
+}
+
+// GetID returns the workflow ID of the execution.
+func (workflowRun *workflowRunImpl) GetID() string {
+	return workflowRun.workflowID
+}
+
+// GetRunID returns the run ID of the first run of the execution.
+func (workflowRun *workflowRunImpl) GetRunID() string {
+	return workflowRun.firstRunID
+}
+
+// Get waits for the workflow execution to close and fills valuePtr with its result, or returns the error it closed with.
+func (workflowRun *workflowRunImpl) Get(ctx context.Context, valuePtr interface{}) error {
+	for {
+		closeEvent, err := workflowRun.waitForCloseEvent(ctx)
+		if err != nil {
+			return err
+		}
+
+		switch closeEvent.GetEventType() {
+		case s.EventType_WorkflowExecutionCompleted:
+			attributes := closeEvent.WorkflowExecutionCompletedEventAttributes
+			if valuePtr == nil || attributes.Result_ == nil {
+				return nil
+			}
+			return decodeArg(workflowRun.client.dataConverter, attributes.Result_, valuePtr)
+		case s.EventType_WorkflowExecutionFailed:
+			attributes := closeEvent.WorkflowExecutionFailedEventAttributes
+			return constructError(attributes.GetReason(), attributes.GetDetails(), workflowRun.client.dataConverter)
+		case s.EventType_WorkflowExecutionCanceled:
+			attributes := closeEvent.WorkflowExecutionCanceledEventAttributes
+			return newCanceledErrorWithDetails(attributes.GetDetails(), workflowRun.client.dataConverter)
+		case s.EventType_WorkflowExecutionTerminated:
+			return &TerminatedError{}
+		case s.EventType_WorkflowExecutionTimedOut:
+			attributes := closeEvent.WorkflowExecutionTimedOutEventAttributes
+			return NewTimeoutError(attributes.GetTimeoutType())
+		case s.EventType_WorkflowExecutionContinuedAsNew:
+			attributes := closeEvent.WorkflowExecutionContinuedAsNewEventAttributes
+			workflowRun.currentRunID = attributes.GetNewExecutionRunId_()
+		}
+	}
+}
+
+// waitForCloseEvent tails the history of the current run until its close event is recorded.
+func (workflowRun *workflowRunImpl) waitForCloseEvent(ctx context.Context) (*s.HistoryEvent, error) {
+	iter := workflowRun.client.GetWorkflowHistoryIterator(ctx, workflowRun.workflowID, workflowRun.currentRunID,
+		HistoryIteratorOptions{WaitForNewEvent: true})
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		if isWorkflowCloseEvent(event.GetEventType()) {
+			return event, nil
+		}
+	}
+	return nil, errors.New("history ended without a workflow close event")
+}
+
+// HasNext returns whether there are more events, fetching the next page of history if needed.
+func (iter *historyEventIteratorImpl) HasNext() bool {
+	for len(iter.events) == 0 && iter.err == nil && !iter.done {
+		iter.fetchNextPage()
+	}
+	return len(iter.events) > 0 || iter.err != nil
+}
+
+// Next returns the next history event.
+func (iter *historyEventIteratorImpl) Next() (*s.HistoryEvent, error) {
+	if !iter.HasNext() {
+		return nil, errors.New("no more history events")
+	}
+	if iter.err != nil {
+		err := iter.err
+		iter.err = nil
+		iter.done = true
+		return nil, err
+	}
+	event := iter.events[0]
+	iter.events = iter.events[1:]
+	return event, nil
+}
+
+func (iter *historyEventIteratorImpl) fetchNextPage() {
+	pageToken := iter.nextPageToken
+	if iter.started && iter.nextPageToken == nil {
+		// Reached the end of the recorded history.
+		if !iter.options.WaitForNewEvent || iter.closed {
+			iter.done = true
+			return
+		}
+		// The server has no long poll for history, so read the last page again after a while and skip the events
+		// already seen.
+		select {
+		case <-iter.ctx.Done():
+			iter.err = iter.ctx.Err()
+			return
+		case <-time.After(historyPollInterval):
+		}
+		pageToken = iter.lastPageToken
+	}
+
+	request := &s.GetWorkflowExecutionHistoryRequest{
+		Domain: common.StringPtr(iter.client.domain),
+		Execution: &s.WorkflowExecution{
+			WorkflowId: common.StringPtr(iter.workflowID),
+			RunId:      getRunID(iter.runID),
+		},
+		NextPageToken: pageToken,
+	}
+	if iter.options.MaximumPageSize > 0 {
+		request.MaximumPageSize = common.Int32Ptr(iter.options.MaximumPageSize)
+	}
+
+	var response *s.GetWorkflowExecutionHistoryResponse
+	err := backoff.Retry(iter.ctx,
+		func() error {
+			var err1 error
+			tchCtx, cancel := newTChannelContext(iter.ctx)
+			defer cancel()
+			response, err1 = iter.client.workflowService.GetWorkflowExecutionHistory(tchCtx, request)
+			return err1
+		}, iter.client.getOperationRetryPolicy("GetWorkflowExecutionHistory"), isServiceTransientError)
+	if err != nil {
+		iter.err = err
+		return
+	}
+
+	iter.started = true
+	iter.lastPageToken = pageToken
+	iter.nextPageToken = response.GetNextPageToken()
+	for _, event := range response.GetHistory().GetEvents() {
+		if event.GetEventId() <= iter.lastEventID && iter.lastEventID > 0 {
+			continue
+		}
+		iter.events = append(iter.events, event)
+		iter.lastEventID = event.GetEventId()
+		if isWorkflowCloseEvent(event.GetEventType()) {
+			iter.closed = true
+		}
+	}
 }
 
 // Register a domain with cadence server
@@ -449,7 +1230,7 @@ func (dc *domainClient) Register(ctx context.Context, request *s.RegisterDomainR
 			tchCtx, cancel := newTChannelContext(ctx)
 			defer cancel()
 			return dc.workflowService.RegisterDomain(tchCtx, request)
-		}, serviceOperationRetryPolicy, isServiceTransientError)
+		}, dc.getOperationRetryPolicy("RegisterDomain"), isServiceTransientError)
 }
 
 // Describe a domain. The domain has two part of information
@@ -472,7 +1253,7 @@ func (dc *domainClient) Describe(ctx context.Context, name string) (*s.DomainInf
 			var err error
 			response, err = dc.workflowService.DescribeDomain(tchCtx, request)
 			return err
-		}, serviceOperationRetryPolicy, isServiceTransientError)
+		}, dc.getOperationRetryPolicy("DescribeDomain"), isServiceTransientError)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -499,7 +1280,19 @@ func (dc *domainClient) Update(ctx context.Context, name string, domainInfo *s.U
 			defer cancel()
 			_, err := dc.workflowService.UpdateDomain(tchCtx, request)
 			return err
-		}, serviceOperationRetryPolicy, isServiceTransientError)
+		}, dc.getOperationRetryPolicy("UpdateDomain"), isServiceTransientError)
+}
+
+// getOperationRetryPolicy returns the retry policy of the client for a WorkflowService operation, reporting the
+// retries on the scope of the operation.
+func (wc *workflowClient) getOperationRetryPolicy(operation string) backoff.RetryPolicy {
+	return metrics.NewRetryPolicyWrapper(wc.retryPolicy, wc.metricsScope, operation)
+}
+
+// getOperationRetryPolicy returns the retry policy of the client for a WorkflowService operation, reporting the
+// retries on the scope of the operation.
+func (dc *domainClient) getOperationRetryPolicy(operation string) backoff.RetryPolicy {
+	return metrics.NewRetryPolicyWrapper(dc.retryPolicy, dc.metricsScope, operation)
 }
 
 func getRunID(runID string) *string {
@@ -509,3 +1302,29 @@ func getRunID(runID string) *string {
 	}
 	return common.StringPtr(runID)
 }
+
+func validateStartWorkflowOptions(options StartWorkflowOptions) (executionTimeout, decisionTaskTimeout int32, err error) {
+	if options.TaskList == "" {
+		return 0, 0, errors.New("missing TaskList")
+	}
+
+	executionTimeout = int32(options.ExecutionStartToCloseTimeout.Seconds())
+	if executionTimeout <= 0 {
+		return 0, 0, errors.New("missing or invalid ExecutionStartToCloseTimeout")
+	}
+
+	decisionTaskTimeout = int32(options.DecisionTaskStartToCloseTimeout.Seconds())
+	if decisionTaskTimeout < 0 {
+		return 0, 0, errors.New("negative DecisionTaskStartToCloseTimeout provided")
+	}
+	if decisionTaskTimeout == 0 {
+		decisionTaskTimeout = defaultDecisionTaskTimeoutInSecs
+	}
+
+	if options.CronSchedule != "" {
+		if _, err := parseCronSchedule(options.CronSchedule); err != nil {
+			return 0, 0, err
+		}
+	}
+	return executionTimeout, decisionTaskTimeout, nil
+}
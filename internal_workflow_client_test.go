@@ -0,0 +1,603 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	m "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/common"
+	"go.uber.org/cadence/common/backoff"
+	"go.uber.org/cadence/mocks"
+)
+
+const (
+	testWorkflowClientDomain = "test-domain"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+)
+
+type (
+	workflowRunSuite struct {
+		suite.Suite
+		service        *mocks.TChanWorkflowService
+		workflowClient Client
+	}
+)
+
+func TestWorkflowRunSuite(t *testing.T) {
+	suite.Run(t, new(workflowRunSuite))
+}
+
+func (s *workflowRunSuite) SetupTest() {
+	s.service = new(mocks.TChanWorkflowService)
+	s.workflowClient = NewClient(s.service, testWorkflowClientDomain, nil)
+}
+
+func (s *workflowRunSuite) TearDownTest() {
+	s.service.AssertExpectations(s.T())
+}
+
+func newTestHistoryResponse(events ...*m.HistoryEvent) *m.GetWorkflowExecutionHistoryResponse {
+	return &m.GetWorkflowExecutionHistoryResponse{
+		History: &m.History{Events: events},
+	}
+}
+
+func newTestHistoryEvent(eventType m.EventType) *m.HistoryEvent {
+	return &m.HistoryEvent{EventType: common.EventTypePtr(eventType)}
+}
+
+func (s *workflowRunSuite) matchRunID(runID string) interface{} {
+	return mock.MatchedBy(func(request *m.GetWorkflowExecutionHistoryRequest) bool {
+		return request.GetExecution().GetRunId() == runID
+	})
+}
+
+func (s *workflowRunSuite) TestStartWorkflow_Get() {
+	s.service.On("StartWorkflowExecution", mock.Anything, mock.Anything).
+		Return(&m.StartWorkflowExecutionResponse{RunId: common.StringPtr(testRunID)}, nil).Once()
+	result, err := getHostEnvironment().encodeArg("workflow result")
+	s.NoError(err)
+	closeEvent := newTestHistoryEvent(m.EventType_WorkflowExecutionCompleted)
+	closeEvent.WorkflowExecutionCompletedEventAttributes = &m.WorkflowExecutionCompletedEventAttributes{Result_: result}
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, s.matchRunID(testRunID)).
+		Return(newTestHistoryResponse(newTestHistoryEvent(m.EventType_WorkflowExecutionStarted), closeEvent), nil).Once()
+
+	options := StartWorkflowOptions{
+		ID:                           testWorkflowID,
+		TaskList:                     "test-task-list",
+		ExecutionStartToCloseTimeout: time.Minute,
+	}
+	workflowRun, err := s.workflowClient.StartWorkflow(context.Background(), options, "workflowType")
+	s.NoError(err)
+	s.Equal(testWorkflowID, workflowRun.GetID())
+	s.Equal(testRunID, workflowRun.GetRunID())
+
+	var decoded string
+	s.NoError(workflowRun.Get(context.Background(), &decoded))
+	s.Equal("workflow result", decoded)
+}
+
+func (s *workflowRunSuite) TestGet_ContinuedAsNew() {
+	newRunID := "new-run-id"
+	continuedEvent := newTestHistoryEvent(m.EventType_WorkflowExecutionContinuedAsNew)
+	continuedEvent.WorkflowExecutionContinuedAsNewEventAttributes = &m.WorkflowExecutionContinuedAsNewEventAttributes{
+		NewExecutionRunId_: common.StringPtr(newRunID),
+	}
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, s.matchRunID(testRunID)).
+		Return(newTestHistoryResponse(continuedEvent), nil).Once()
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, s.matchRunID(newRunID)).
+		Return(newTestHistoryResponse(newTestHistoryEvent(m.EventType_WorkflowExecutionTerminated)), nil).Once()
+
+	workflowRun := s.workflowClient.GetWorkflow(context.Background(), testWorkflowID, testRunID)
+	err := workflowRun.Get(context.Background(), nil)
+	s.IsType(&TerminatedError{}, err)
+	s.Equal(testRunID, workflowRun.GetRunID())
+}
+
+func (s *workflowRunSuite) TestGet_Failed() {
+	closeEvent := newTestHistoryEvent(m.EventType_WorkflowExecutionFailed)
+	closeEvent.WorkflowExecutionFailedEventAttributes = &m.WorkflowExecutionFailedEventAttributes{
+		Reason: common.StringPtr("failure reason"),
+	}
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, mock.Anything).
+		Return(newTestHistoryResponse(closeEvent), nil).Once()
+
+	err := s.workflowClient.GetWorkflow(context.Background(), testWorkflowID, testRunID).Get(context.Background(), nil)
+	customErr, ok := err.(*CustomError)
+	s.True(ok)
+	s.Equal("failure reason", customErr.Reason())
+}
+
+func (s *workflowRunSuite) TestGet_TimedOut() {
+	closeEvent := newTestHistoryEvent(m.EventType_WorkflowExecutionTimedOut)
+	closeEvent.WorkflowExecutionTimedOutEventAttributes = &m.WorkflowExecutionTimedOutEventAttributes{
+		TimeoutType: m.TimeoutTypePtr(m.TimeoutType_START_TO_CLOSE),
+	}
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, mock.Anything).
+		Return(newTestHistoryResponse(closeEvent), nil).Once()
+
+	err := s.workflowClient.GetWorkflow(context.Background(), testWorkflowID, testRunID).Get(context.Background(), nil)
+	timeoutErr, ok := err.(*TimeoutError)
+	s.True(ok)
+	s.Equal(m.TimeoutType_START_TO_CLOSE, timeoutErr.TimeoutType())
+}
+
+func (s *workflowRunSuite) TestGet_Canceled() {
+	details, err := getDefaultDataConverter().ToData("cancel details", 3)
+	s.NoError(err)
+	closeEvent := newTestHistoryEvent(m.EventType_WorkflowExecutionCanceled)
+	closeEvent.WorkflowExecutionCanceledEventAttributes = &m.WorkflowExecutionCanceledEventAttributes{
+		Details: details,
+	}
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, mock.Anything).
+		Return(newTestHistoryResponse(closeEvent), nil).Once()
+
+	err = s.workflowClient.GetWorkflow(context.Background(), testWorkflowID, testRunID).Get(context.Background(), nil)
+	canceledErr, ok := err.(*CanceledError)
+	s.True(ok)
+	s.True(canceledErr.HasDetails())
+	var message string
+	var count int
+	canceledErr.Details(&message, &count)
+	s.Equal("cancel details", message)
+	s.Equal(3, count)
+}
+
+func (s *workflowRunSuite) TestHistoryIterator_Paging() {
+	pageToken := []byte("next-page")
+	firstPage := newTestHistoryResponse(newTestHistoryEvent(m.EventType_WorkflowExecutionStarted))
+	firstPage.NextPageToken = pageToken
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, mock.MatchedBy(func(request *m.GetWorkflowExecutionHistoryRequest) bool {
+		return request.NextPageToken == nil && request.GetMaximumPageSize() == 1
+	})).Return(firstPage, nil).Once()
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, mock.MatchedBy(func(request *m.GetWorkflowExecutionHistoryRequest) bool {
+		return string(request.NextPageToken) == string(pageToken)
+	})).Return(newTestHistoryResponse(newTestHistoryEvent(m.EventType_DecisionTaskScheduled)), nil).Once()
+
+	iter := s.workflowClient.GetWorkflowHistoryIterator(context.Background(), testWorkflowID, testRunID,
+		HistoryIteratorOptions{MaximumPageSize: 1})
+	var eventTypes []m.EventType
+	for iter.HasNext() {
+		event, err := iter.Next()
+		s.NoError(err)
+		eventTypes = append(eventTypes, event.GetEventType())
+	}
+	s.Equal([]m.EventType{m.EventType_WorkflowExecutionStarted, m.EventType_DecisionTaskScheduled}, eventTypes)
+}
+
+func (s *workflowRunSuite) TestHistoryIterator_WaitForNewEvent() {
+	pageToken := []byte("last-page")
+	startedEvent := newTestHistoryEvent(m.EventType_WorkflowExecutionStarted)
+	startedEvent.EventId = common.Int64Ptr(1)
+	scheduledEvent := newTestHistoryEvent(m.EventType_DecisionTaskScheduled)
+	scheduledEvent.EventId = common.Int64Ptr(2)
+	closeEvent := newTestHistoryEvent(m.EventType_WorkflowExecutionTerminated)
+	closeEvent.EventId = common.Int64Ptr(3)
+	firstPage := newTestHistoryResponse(startedEvent)
+	firstPage.NextPageToken = pageToken
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, mock.MatchedBy(func(request *m.GetWorkflowExecutionHistoryRequest) bool {
+		return request.NextPageToken == nil
+	})).Return(firstPage, nil).Once()
+	// Polling for new events reads the last page again, not the whole history.
+	lastPageRequest := mock.MatchedBy(func(request *m.GetWorkflowExecutionHistoryRequest) bool {
+		return string(request.NextPageToken) == string(pageToken)
+	})
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, lastPageRequest).
+		Return(newTestHistoryResponse(scheduledEvent), nil).Once()
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, lastPageRequest).
+		Return(newTestHistoryResponse(scheduledEvent, closeEvent), nil).Once()
+
+	iter := s.workflowClient.GetWorkflowHistoryIterator(context.Background(), testWorkflowID, testRunID,
+		HistoryIteratorOptions{WaitForNewEvent: true})
+	var eventIDs []int64
+	for iter.HasNext() {
+		event, err := iter.Next()
+		s.NoError(err)
+		eventIDs = append(eventIDs, event.GetEventId())
+	}
+	s.Equal([]int64{1, 2, 3}, eventIDs)
+}
+
+func (s *workflowRunSuite) TestListWorkflows() {
+	startTime := time.Unix(100, 0)
+	closeTime := time.Unix(200, 0)
+	closeStatus := m.WorkflowExecutionCloseStatus_FAILED
+	openPage := &m.ListOpenWorkflowExecutionsResponse{
+		Executions: []*m.WorkflowExecutionInfo{{
+			Execution: &m.WorkflowExecution{WorkflowId: common.StringPtr("open-id"), RunId: common.StringPtr("run1")},
+			Type:      &m.WorkflowType{Name: common.StringPtr("workflowType")},
+			StartTime: common.Int64Ptr(startTime.UnixNano()),
+		}},
+		NextPageToken: []byte("open-page"),
+	}
+	s.service.On("ListOpenWorkflowExecutions", mock.Anything, mock.MatchedBy(func(request *m.ListOpenWorkflowExecutionsRequest) bool {
+		return request.NextPageToken == nil && request.GetTypeFilter().GetName() == "workflowType"
+	})).Return(openPage, nil).Once()
+	s.service.On("ListOpenWorkflowExecutions", mock.Anything, mock.MatchedBy(func(request *m.ListOpenWorkflowExecutionsRequest) bool {
+		return string(request.NextPageToken) == "open-page"
+	})).Return(&m.ListOpenWorkflowExecutionsResponse{}, nil).Once()
+	s.service.On("ListClosedWorkflowExecutions", mock.Anything, mock.MatchedBy(func(request *m.ListClosedWorkflowExecutionsRequest) bool {
+		return request.NextPageToken == nil && request.StatusFilter == nil
+	})).Return(&m.ListClosedWorkflowExecutionsResponse{
+		Executions: []*m.WorkflowExecutionInfo{{
+			Execution:   &m.WorkflowExecution{WorkflowId: common.StringPtr("closed-id"), RunId: common.StringPtr("run2")},
+			Type:        &m.WorkflowType{Name: common.StringPtr("workflowType")},
+			StartTime:   common.Int64Ptr(startTime.UnixNano()),
+			CloseTime:   common.Int64Ptr(closeTime.UnixNano()),
+			CloseStatus: &closeStatus,
+		}},
+	}, nil).Once()
+
+	iter := s.workflowClient.ListWorkflows(context.Background(), ListWorkflowOptions{WorkflowType: "workflowType"})
+	var executions []*WorkflowExecutionInfo
+	for iter.HasNext() {
+		execution, err := iter.Next()
+		s.NoError(err)
+		executions = append(executions, execution)
+	}
+	s.Equal(2, len(executions))
+	s.Equal(WorkflowExecution{ID: "open-id", RunID: "run1"}, executions[0].Execution)
+	s.Equal(WorkflowExecutionStatusOpen, executions[0].Status)
+	s.True(executions[0].CloseTime.IsZero())
+	s.Equal(WorkflowExecutionStatusFailed, executions[1].Status)
+	s.Equal(startTime, executions[1].StartTime)
+	s.Equal(closeTime, executions[1].CloseTime)
+}
+
+func (s *workflowRunSuite) TestListWorkflows_ClosedStatusOnly() {
+	s.service.On("ListClosedWorkflowExecutions", mock.Anything, mock.MatchedBy(func(request *m.ListClosedWorkflowExecutionsRequest) bool {
+		return request.GetStatusFilter() == m.WorkflowExecutionCloseStatus_TERMINATED &&
+			request.GetExecutionFilter().GetWorkflowId() == testWorkflowID
+	})).Return(&m.ListClosedWorkflowExecutionsResponse{}, nil).Once()
+
+	iter := s.workflowClient.ListWorkflows(context.Background(), ListWorkflowOptions{
+		WorkflowID: testWorkflowID,
+		Status:     WorkflowExecutionStatusTerminated,
+	})
+	s.False(iter.HasNext())
+}
+
+func (s *workflowRunSuite) TestDescribeWorkflow() {
+	startTime := time.Unix(1000, 0)
+	newEvent := func(eventID int64, eventType m.EventType) *m.HistoryEvent {
+		event := newTestHistoryEvent(eventType)
+		event.EventId = common.Int64Ptr(eventID)
+		event.Timestamp = common.Int64Ptr(startTime.Add(time.Duration(eventID) * time.Second).UnixNano())
+		return event
+	}
+
+	started := newEvent(1, m.EventType_WorkflowExecutionStarted)
+	started.WorkflowExecutionStartedEventAttributes = &m.WorkflowExecutionStartedEventAttributes{
+		WorkflowType: &m.WorkflowType{Name: common.StringPtr("workflowType")},
+		TaskList:     &m.TaskList{Name: common.StringPtr("taskList")},
+	}
+	activityScheduled := newEvent(2, m.EventType_ActivityTaskScheduled)
+	activityScheduled.ActivityTaskScheduledEventAttributes = &m.ActivityTaskScheduledEventAttributes{
+		ActivityId:   common.StringPtr("0"),
+		ActivityType: &m.ActivityType{Name: common.StringPtr("activityType")},
+	}
+	activityStarted := newEvent(3, m.EventType_ActivityTaskStarted)
+	activityStarted.ActivityTaskStartedEventAttributes = &m.ActivityTaskStartedEventAttributes{
+		ScheduledEventId: common.Int64Ptr(2),
+	}
+	firedTimerStarted := newEvent(4, m.EventType_TimerStarted)
+	firedTimerStarted.TimerStartedEventAttributes = &m.TimerStartedEventAttributes{
+		TimerId:                   common.StringPtr("1"),
+		StartToFireTimeoutSeconds: common.Int64Ptr(1),
+	}
+	timerFired := newEvent(5, m.EventType_TimerFired)
+	timerFired.TimerFiredEventAttributes = &m.TimerFiredEventAttributes{TimerId: common.StringPtr("1")}
+	timerStarted := newEvent(6, m.EventType_TimerStarted)
+	timerStarted.TimerStartedEventAttributes = &m.TimerStartedEventAttributes{
+		TimerId:                   common.StringPtr("2"),
+		StartToFireTimeoutSeconds: common.Int64Ptr(60),
+	}
+	childInitiated := newEvent(7, m.EventType_StartChildWorkflowExecutionInitiated)
+	childInitiated.StartChildWorkflowExecutionInitiatedEventAttributes = &m.StartChildWorkflowExecutionInitiatedEventAttributes{
+		Domain:       common.StringPtr("childDomain"),
+		WorkflowId:   common.StringPtr("childID"),
+		WorkflowType: &m.WorkflowType{Name: common.StringPtr("childType")},
+	}
+	childStarted := newEvent(8, m.EventType_ChildWorkflowExecutionStarted)
+	childStarted.ChildWorkflowExecutionStartedEventAttributes = &m.ChildWorkflowExecutionStartedEventAttributes{
+		WorkflowExecution: &m.WorkflowExecution{WorkflowId: common.StringPtr("childID"), RunId: common.StringPtr("childRunID")},
+	}
+	signaled := newEvent(9, m.EventType_WorkflowExecutionSignaled)
+	cancelRequested := newEvent(10, m.EventType_WorkflowExecutionCancelRequested)
+	externalCancel := newEvent(11, m.EventType_RequestCancelExternalWorkflowExecutionInitiated)
+	externalCancel.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes = &m.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		WorkflowExecution: &m.WorkflowExecution{WorkflowId: common.StringPtr("externalID")},
+	}
+
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, mock.Anything).Return(newTestHistoryResponse(
+		started, activityScheduled, activityStarted, firedTimerStarted, timerFired, timerStarted,
+		childInitiated, childStarted, signaled, cancelRequested, externalCancel), nil).Once()
+
+	desc, err := s.workflowClient.DescribeWorkflow(context.Background(), testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(WorkflowExecution{ID: testWorkflowID, RunID: testRunID}, desc.Execution)
+	s.Equal(WorkflowType{Name: "workflowType"}, desc.Type)
+	s.Equal("taskList", desc.TaskList)
+	s.Equal(WorkflowExecutionStatusOpen, desc.Status)
+	s.Equal(int64(11), desc.HistoryLength)
+	s.True(desc.CancelRequested)
+	s.Equal(1, desc.SignalCount)
+	s.Equal([]PendingActivityInfo{{
+		ActivityID:    "0",
+		ActivityType:  ActivityType{Name: "activityType"},
+		ScheduledTime: time.Unix(1002, 0),
+		StartedTime:   time.Unix(1003, 0),
+	}}, desc.PendingActivities)
+	s.Equal([]PendingTimerInfo{{
+		TimerID:     "2",
+		StartedTime: time.Unix(1006, 0),
+		FireTime:    time.Unix(1066, 0),
+	}}, desc.PendingTimers)
+	s.Equal([]PendingChildWorkflowInfo{{
+		Domain:        "childDomain",
+		Execution:     WorkflowExecution{ID: "childID", RunID: "childRunID"},
+		Type:          WorkflowType{Name: "childType"},
+		InitiatedTime: time.Unix(1007, 0),
+	}}, desc.PendingChildWorkflows)
+	s.Equal([]WorkflowExecution{{ID: "externalID"}}, desc.PendingCancelRequests)
+}
+
+func (s *workflowRunSuite) TestBatchOperation() {
+	newExecutionInfo := func(workflowID string) *m.WorkflowExecutionInfo {
+		return &m.WorkflowExecutionInfo{
+			Execution: &m.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(testRunID)},
+			Type:      &m.WorkflowType{Name: common.StringPtr("workflowType")},
+		}
+	}
+	s.service.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).Return(&m.ListOpenWorkflowExecutionsResponse{
+		Executions: []*m.WorkflowExecutionInfo{newExecutionInfo("id1"), newExecutionInfo("id2"), newExecutionInfo("id3")},
+	}, nil).Twice()
+	matchWorkflowID := func(workflowID string) interface{} {
+		return mock.MatchedBy(func(request *m.TerminateWorkflowExecutionRequest) bool {
+			return request.GetWorkflowExecution().GetWorkflowId() == workflowID && request.GetReason() == "stuck" &&
+				string(request.GetDetails()) == "stuck details"
+		})
+	}
+	s.service.On("TerminateWorkflowExecution", mock.Anything, matchWorkflowID("id1")).Return(nil).Once()
+	s.service.On("TerminateWorkflowExecution", mock.Anything, matchWorkflowID("id2")).Return(&m.EntityNotExistsError{}).Once()
+	s.service.On("TerminateWorkflowExecution", mock.Anything, matchWorkflowID("id3")).Return(&m.BadRequestError{}).Once()
+
+	request := BatchRequest{
+		WorkflowType:           "workflowType",
+		Operation:              BatchOperationTerminate,
+		Reason:                 "stuck",
+		Details:                []byte("stuck details"),
+		MaxConcurrency:         2,
+		MaxOperationsPerSecond: 100,
+		DryRun:                 true,
+	}
+	result, err := s.workflowClient.BatchOperation(context.Background(), request)
+	s.NoError(err)
+	s.Equal(3, len(result.Targets))
+	s.Empty(result.Succeeded)
+
+	request.DryRun = false
+	result, err = s.workflowClient.BatchOperation(context.Background(), request)
+	s.NoError(err)
+	s.Equal(3, len(result.Targets))
+	s.Equal([]WorkflowExecution{{ID: "id1", RunID: testRunID}}, result.Succeeded)
+	s.Equal([]WorkflowExecution{{ID: "id2", RunID: testRunID}}, result.Skipped)
+	s.Equal(1, len(result.Failed))
+	s.Equal("id3", result.Failed[0].Execution.ID)
+}
+
+func (s *workflowRunSuite) TestCompleteActivityByID() {
+	s.service.On("RespondActivityTaskCompletedByID", mock.Anything,
+		mock.MatchedBy(func(request *m.RespondActivityTaskCompletedByIDRequest) bool {
+			return request.GetDomain() == testWorkflowClientDomain &&
+				request.GetWorkflowID() == testWorkflowID &&
+				request.GetRunID() == testRunID &&
+				request.GetActivityID() == "activity-1" &&
+				len(request.GetResult_()) > 0
+		})).Return(nil).Once()
+	err := s.workflowClient.CompleteActivityByID(context.Background(), "", testWorkflowID, testRunID, "activity-1", "done", nil)
+	s.NoError(err)
+
+	s.service.On("RespondActivityTaskFailedByID", mock.Anything,
+		mock.MatchedBy(func(request *m.RespondActivityTaskFailedByIDRequest) bool {
+			return request.GetDomain() == "other-domain" && request.GetReason() == "failure-reason" &&
+				request.RunID == nil
+		})).Return(nil).Once()
+	err = s.workflowClient.CompleteActivityByID(context.Background(), "other-domain", testWorkflowID, "", "activity-1",
+		nil, NewCustomError("failure-reason"))
+	s.NoError(err)
+
+	s.service.On("RespondActivityTaskCanceledByID", mock.Anything, mock.Anything).Return(nil).Once()
+	err = s.workflowClient.CompleteActivityByID(context.Background(), "", testWorkflowID, "", "activity-1",
+		nil, NewCanceledError())
+	s.NoError(err)
+
+	err = s.workflowClient.CompleteActivityByID(context.Background(), "", testWorkflowID, "", "", nil, nil)
+	s.Error(err)
+}
+
+func (s *workflowRunSuite) TestRecordActivityHeartbeatByID() {
+	s.service.On("RecordActivityTaskHeartbeatByID", mock.Anything,
+		mock.MatchedBy(func(request *m.RecordActivityTaskHeartbeatByIDRequest) bool {
+			return request.GetWorkflowID() == testWorkflowID && request.GetActivityID() == "activity-1"
+		})).Return(&m.RecordActivityTaskHeartbeatResponse{}, nil).Once()
+	err := s.workflowClient.RecordActivityHeartbeatByID(context.Background(), "", testWorkflowID, testRunID, "activity-1", "progress")
+	s.NoError(err)
+
+	s.service.On("RecordActivityTaskHeartbeatByID", mock.Anything,
+		mock.MatchedBy(func(request *m.RecordActivityTaskHeartbeatByIDRequest) bool {
+			return request.RunID == nil
+		})).Return(&m.RecordActivityTaskHeartbeatResponse{CancelRequested: common.BoolPtr(true)}, nil).Once()
+	err = s.workflowClient.RecordActivityHeartbeatByID(context.Background(), "", testWorkflowID, "", "activity-1")
+	s.IsType(&CanceledError{}, err)
+}
+
+func (s *workflowRunSuite) TestSignalWithStartWorkflow() {
+	signalInput, err := getHostEnvironment().encodeArg("signal value")
+	s.NoError(err)
+	s.service.On("SignalWithStartWorkflowExecution", mock.Anything,
+		mock.MatchedBy(func(request *m.SignalWithStartWorkflowExecutionRequest) bool {
+			return request.GetWorkflowId() == testWorkflowID &&
+				request.GetSignalName() == "signal-name" &&
+				string(request.GetSignalInput()) == string(signalInput) &&
+				request.GetWorkflowType().GetName() == "workflowType" &&
+				request.GetTaskList().GetName() == "test-task-list"
+		})).Return(&m.StartWorkflowExecutionResponse{RunId: common.StringPtr(testRunID)}, nil).Once()
+
+	options := StartWorkflowOptions{
+		TaskList:                     "test-task-list",
+		ExecutionStartToCloseTimeout: time.Minute,
+	}
+	workflowRun, err := s.workflowClient.SignalWithStartWorkflow(context.Background(), testWorkflowID,
+		"signal-name", "signal value", options, "workflowType")
+	s.NoError(err)
+	s.Equal(testWorkflowID, workflowRun.GetID())
+	s.Equal(testRunID, workflowRun.GetRunID())
+
+	_, err = s.workflowClient.SignalWithStartWorkflow(context.Background(), "", "signal-name", nil, options, "workflowType")
+	s.Error(err)
+}
+
+func (s *workflowRunSuite) TestStartWorkflow_RetryWithStableRequestID() {
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(5)
+	client := NewClient(s.service, testWorkflowClientDomain, &ClientOptions{RetryPolicy: policy})
+
+	var requestIDs []string
+	recordRequestID := func(request *m.StartWorkflowExecutionRequest) bool {
+		requestIDs = append(requestIDs, request.GetRequestId())
+		return true
+	}
+	s.service.On("StartWorkflowExecution", mock.Anything, mock.MatchedBy(recordRequestID)).
+		Return(nil, &m.ServiceBusyError{}).Twice()
+	s.service.On("StartWorkflowExecution", mock.Anything, mock.MatchedBy(recordRequestID)).
+		Return(&m.StartWorkflowExecutionResponse{RunId: common.StringPtr(testRunID)}, nil).Once()
+
+	options := StartWorkflowOptions{
+		ID:                           testWorkflowID,
+		TaskList:                     "test-task-list",
+		ExecutionStartToCloseTimeout: time.Minute,
+	}
+	workflowRun, err := client.StartWorkflow(context.Background(), options, "workflowType")
+	s.NoError(err)
+	s.Equal(testRunID, workflowRun.GetRunID())
+	s.True(len(requestIDs) >= 3)
+	for _, requestID := range requestIDs {
+		s.Equal(requestIDs[0], requestID)
+	}
+}
+
+func (s *workflowRunSuite) TestSignalWorkflow_NoRetryOnNonTransientError() {
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(5)
+	client := NewClient(s.service, testWorkflowClientDomain, &ClientOptions{RetryPolicy: policy})
+
+	s.service.On("SignalWorkflowExecution", mock.Anything, mock.Anything).Return(&m.BadRequestError{}).Once()
+	err := client.SignalWorkflow(context.Background(), testWorkflowID, "", "signal-name", nil)
+	s.IsType(&m.BadRequestError{}, err)
+
+	s.service.On("SignalWorkflowExecution", mock.Anything, mock.Anything).Return(&m.EntityNotExistsError{}).Once()
+	err = client.SignalWorkflow(context.Background(), testWorkflowID, "", "signal-name", nil)
+	s.IsType(&m.EntityNotExistsError{}, err)
+}
+
+func (s *workflowRunSuite) TestSignalAndTerminateWorkflow_RetryOnlyRejectedRequests() {
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(5)
+	client := NewClient(s.service, testWorkflowClientDomain, &ClientOptions{RetryPolicy: policy})
+
+	// The server may have applied the request before failing, so it is not retried.
+	s.service.On("SignalWorkflowExecution", mock.Anything, mock.Anything).Return(&m.InternalServiceError{}).Once()
+	err := client.SignalWorkflow(context.Background(), testWorkflowID, "", "signal-name", nil)
+	s.IsType(&m.InternalServiceError{}, err)
+	s.service.On("TerminateWorkflowExecution", mock.Anything, mock.Anything).Return(context.DeadlineExceeded).Once()
+	err = client.TerminateWorkflow(context.Background(), testWorkflowID, "", "reason", nil)
+	s.Equal(context.DeadlineExceeded, err)
+
+	// A busy server rejects the request without applying it.
+	s.service.On("SignalWorkflowExecution", mock.Anything, mock.Anything).Return(&m.ServiceBusyError{}).Once()
+	s.service.On("SignalWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
+	s.NoError(client.SignalWorkflow(context.Background(), testWorkflowID, "", "signal-name", nil))
+	s.service.On("TerminateWorkflowExecution", mock.Anything, mock.Anything).Return(&m.ServiceBusyError{}).Once()
+	s.service.On("TerminateWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
+	s.NoError(client.TerminateWorkflow(context.Background(), testWorkflowID, "", "reason", nil))
+}
+
+func (s *workflowRunSuite) TestDataConverter() {
+	dc := &testPrefixDataConverter{}
+	client := NewClient(s.service, testWorkflowClientDomain, &ClientOptions{DataConverter: dc})
+	hasPrefix := func(data []byte) bool {
+		return bytes.HasPrefix(data, testDataConverterPrefix)
+	}
+
+	s.service.On("StartWorkflowExecution", mock.Anything,
+		mock.MatchedBy(func(request *m.StartWorkflowExecutionRequest) bool {
+			return hasPrefix(request.GetInput())
+		})).Return(&m.StartWorkflowExecutionResponse{RunId: common.StringPtr(testRunID)}, nil).Once()
+	result, err := dc.ToData("workflow result")
+	s.NoError(err)
+	closeEvent := newTestHistoryEvent(m.EventType_WorkflowExecutionCompleted)
+	closeEvent.WorkflowExecutionCompletedEventAttributes = &m.WorkflowExecutionCompletedEventAttributes{Result_: result}
+	s.service.On("GetWorkflowExecutionHistory", mock.Anything, s.matchRunID(testRunID)).
+		Return(newTestHistoryResponse(newTestHistoryEvent(m.EventType_WorkflowExecutionStarted), closeEvent), nil).Once()
+
+	options := StartWorkflowOptions{
+		ID:                           testWorkflowID,
+		TaskList:                     "test-task-list",
+		ExecutionStartToCloseTimeout: time.Minute,
+	}
+	workflowRun, err := client.StartWorkflow(context.Background(), options, "workflowType", "input")
+	s.NoError(err)
+	var decoded string
+	s.NoError(workflowRun.Get(context.Background(), &decoded))
+	s.Equal("workflow result", decoded)
+
+	s.service.On("SignalWorkflowExecution", mock.Anything,
+		mock.MatchedBy(func(request *m.SignalWorkflowExecutionRequest) bool {
+			return hasPrefix(request.GetInput())
+		})).Return(nil).Once()
+	s.NoError(client.SignalWorkflow(context.Background(), testWorkflowID, "", "signal-name", "signal value"))
+
+	queryResult, err := dc.ToData("query result")
+	s.NoError(err)
+	s.service.On("QueryWorkflow", mock.Anything,
+		mock.MatchedBy(func(request *m.QueryWorkflowRequest) bool {
+			return hasPrefix(request.GetQuery().GetQueryArgs_())
+		})).Return(&m.QueryWorkflowResponse{QueryResult_: queryResult}, nil).Once()
+	encodedValue, err := client.QueryWorkflow(context.Background(), testWorkflowID, "", "query-type", "query arg")
+	s.NoError(err)
+	s.NoError(encodedValue.Get(&decoded))
+	s.Equal("query result", decoded)
+
+	s.service.On("RespondActivityTaskFailed", mock.Anything,
+		mock.MatchedBy(func(request *m.RespondActivityTaskFailedRequest) bool {
+			return request.GetReason() == "reason" && hasPrefix(request.GetDetails())
+		})).Return(nil).Once()
+	s.NoError(client.CompleteActivity(context.Background(), []byte("task-token"), nil,
+		NewCustomError("reason", "details")))
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import "time"
+
+// workflowEnvironmentInterceptor is the last WorkflowInterceptor of the chain, it executes the calls on the
+// workflowEnvironment of the workflow context.
+type workflowEnvironmentInterceptor struct{}
+
+var _ WorkflowInterceptor = (*workflowEnvironmentInterceptor)(nil)
+
+func (w *workflowEnvironmentInterceptor) ExecuteActivity(ctx Context, activityType string, args ...interface{}) Future {
+	return executeActivity(ctx, activityType, args...)
+}
+
+func (w *workflowEnvironmentInterceptor) ExecuteChildWorkflow(ctx Context, childWorkflowType string, args ...interface{}) ChildWorkflowFuture {
+	return executeChildWorkflow(ctx, childWorkflowType, args...)
+}
+
+func (w *workflowEnvironmentInterceptor) NewTimer(ctx Context, d time.Duration) Future {
+	return newTimer(ctx, d)
+}
+
+func (w *workflowEnvironmentInterceptor) SideEffect(ctx Context, f func(ctx Context) interface{}) EncodedValue {
+	return sideEffect(ctx, f)
+}
+
+func (w *workflowEnvironmentInterceptor) GetSignalChannel(ctx Context, signalName string) Channel {
+	return getWorkflowEnvOptions(ctx).getSignalChannel(ctx, signalName)
+}
+
+func (w *workflowEnvironmentInterceptor) SetQueryHandler(ctx Context, queryType string, handler interface{}) error {
+	return setQueryHandler(ctx, queryType, handler)
+}
+
+// newWorkflowInterceptorChain creates the interceptors of a workflow execution, from the factories of the worker. The
+// first factory creates the outermost interceptor, so the interceptors are called in the order of the factories.
+func newWorkflowInterceptorChain(env workflowEnvironment) WorkflowInterceptor {
+	var interceptor WorkflowInterceptor = &workflowEnvironmentInterceptor{}
+	factories := env.GetWorkflowInterceptorFactories()
+	for i := len(factories) - 1; i >= 0; i-- {
+		interceptor = factories[i].NewInterceptor(env.WorkflowInfo(), interceptor)
+	}
+	return interceptor
+}
+
+// getWorkflowInterceptor returns the first interceptor of the chain of the workflow.
+func getWorkflowInterceptor(ctx Context) WorkflowInterceptor {
+	if interceptor, ok := ctx.Value(workflowInterceptorContextKey).(WorkflowInterceptor); ok {
+		return interceptor
+	}
+	return &workflowEnvironmentInterceptor{}
+}
@@ -46,6 +46,7 @@ func (s *WorkflowUnitTest) SetupSuite() {
 	RegisterWorkflow(testClockWorkflow)
 	RegisterWorkflow(greetingsWorkflow)
 	RegisterWorkflow(continueAsNewWorkflowTest)
+	RegisterWorkflow(continueAsNewWithOptionsWorkflowTest)
 	RegisterWorkflow(cancelWorkflowTest)
 	RegisterWorkflow(cancelWorkflowAfterActivityTest)
 	RegisterWorkflow(signalWorkflowTest)
@@ -384,6 +385,33 @@ func (s *WorkflowUnitTest) Test_ContinueAsNewWorkflow() {
 	s.EqualValues("default-test-tasklist", *resultErr.options.taskListName)
 }
 
+func continueAsNewWithOptionsWorkflowTest(ctx Context) error {
+	options := ContinueAsNewOptions{
+		TaskList:                     "new-tasklist",
+		ExecutionStartToCloseTimeout: time.Minute,
+		TaskStartToCloseTimeout:      5 * time.Second,
+		WorkflowType:                 "continueAsNewWorkflowTest",
+	}
+	err := NewContinueAsNewErrorWithOptions(ctx, options, continueAsNewWithOptionsWorkflowTest)
+	// The options of the current execution are left untouched.
+	if GetWorkflowInfo(ctx).TaskListName == "new-tasklist" || *getWorkflowEnvOptions(ctx).taskListName == "new-tasklist" {
+		return errors.New("current execution options were modified")
+	}
+	return err
+}
+
+func (s *WorkflowUnitTest) Test_ContinueAsNewWorkflowWithOptions() {
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(continueAsNewWithOptionsWorkflowTest)
+	s.True(env.IsWorkflowCompleted())
+	resultErr, ok := env.GetWorkflowError().(*ContinueAsNewError)
+	s.True(ok, "unexpected error: %v", env.GetWorkflowError())
+	s.EqualValues("continueAsNewWorkflowTest", resultErr.options.workflowType.Name)
+	s.EqualValues(60, *resultErr.options.executionStartToCloseTimeoutSeconds)
+	s.EqualValues(5, *resultErr.options.taskStartToCloseTimeoutSeconds)
+	s.EqualValues("new-tasklist", *resultErr.options.taskListName)
+}
+
 func cancelWorkflowTest(ctx Context) (string, error) {
 	if ctx.Done().Receive(ctx, nil); ctx.Err() == ErrCanceled {
 		return "Cancelled.", ctx.Err()
@@ -30,6 +30,7 @@ import (
 	"time"
 
 	"github.com/facebookgo/clock"
+	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/uber-go/tally"
 	"github.com/uber/tchannel-go/thrift"
@@ -75,6 +76,11 @@ type (
 		env               *testWorkflowEnvironmentImpl
 	}
 
+	testLocalActivityHandle struct {
+		callback resultHandler
+		cancel   context.CancelFunc
+	}
+
 	activityExecutorWrapper struct {
 		*activityExecutor
 		env *testWorkflowEnvironmentImpl
@@ -85,6 +91,18 @@ type (
 		env *testWorkflowEnvironmentImpl
 	}
 
+	// dynamicActivityExecutorWrapper and dynamicWorkflowExecutorWrapper run the dynamic activity and workflow. They
+	// cannot be mocked, as the types of their arguments are not known.
+	dynamicActivityExecutorWrapper struct {
+		*dynamicActivityExecutor
+		env *testWorkflowEnvironmentImpl
+	}
+
+	dynamicWorkflowExecutorWrapper struct {
+		*dynamicWorkflowExecutor
+		env *testWorkflowEnvironmentImpl
+	}
+
 	mockWrapper struct {
 		env        *testWorkflowEnvironmentImpl
 		name       string
@@ -107,6 +125,7 @@ type (
 		testSuite *WorkflowTestSuite
 
 		taskListSpecificActivities map[string]*taskListSpecificActivity
+		registry                   *hostEnvImpl // isolated registry, falls back to the global one
 
 		mock          *mock.Mock
 		service       m.TChanWorkflowService
@@ -119,10 +138,11 @@ type (
 		callbackChannel chan testCallbackHandle
 		testTimeout     time.Duration
 
-		counterID      int
-		activities     map[string]*testActivityHandle
-		timers         map[string]*testTimerHandle
-		childWorkflows map[string]*testChildWorkflowHandle
+		counterID       int
+		activities      map[string]*testActivityHandle
+		localActivities map[string]*testLocalActivityHandle
+		timers          map[string]*testTimerHandle
+		childWorkflows  map[string]*testChildWorkflowHandle
 
 		runningCount atomic.Int32
 
@@ -143,9 +163,10 @@ type (
 		*testWorkflowEnvironmentShared
 		parentEnv *testWorkflowEnvironmentImpl
 
-		workflowInfo   *WorkflowInfo
-		workflowDef    workflowDefinition
-		changeVersions map[string]Version
+		workflowInfo      *WorkflowInfo
+		workflowDef       workflowDefinition
+		changeVersions    map[string]Version
+		mutableSideEffect map[string][]byte
 
 		workflowCancelHandler func()
 		signalHandler         func(name string, input []byte)
@@ -163,6 +184,7 @@ func newTestWorkflowEnvironmentImpl(s *WorkflowTestSuite) *testWorkflowEnvironme
 		testWorkflowEnvironmentShared: &testWorkflowEnvironmentShared{
 			testSuite:                  s,
 			taskListSpecificActivities: make(map[string]*taskListSpecificActivity),
+			registry:                   newWorkerHostEnvironment(),
 
 			logger:          s.logger,
 			metricsScope:    s.scope,
@@ -170,6 +192,7 @@ func newTestWorkflowEnvironmentImpl(s *WorkflowTestSuite) *testWorkflowEnvironme
 			wallClock:       clock.New(),
 			timers:          make(map[string]*testTimerHandle),
 			activities:      make(map[string]*testActivityHandle),
+			localActivities: make(map[string]*testLocalActivityHandle),
 			childWorkflows:  make(map[string]*testChildWorkflowHandle),
 			callbackChannel: make(chan testCallbackHandle, 1000),
 			testTimeout:     time.Second * 3,
@@ -187,7 +210,8 @@ func newTestWorkflowEnvironmentImpl(s *WorkflowTestSuite) *testWorkflowEnvironme
 			TaskStartToCloseTimeoutSeconds:      1,
 		},
 
-		changeVersions: make(map[string]Version),
+		changeVersions:    make(map[string]Version),
+		mutableSideEffect: make(map[string][]byte),
 
 		doneChannel: make(chan struct{}),
 	}
@@ -215,7 +239,7 @@ func newTestWorkflowEnvironmentImpl(s *WorkflowTestSuite) *testWorkflowEnvironme
 		activityInfo := env.getActivityInfo(activityID, activityHandle.activityType)
 		env.postCallback(func() {
 			if env.onActivityHeartbeatListener != nil {
-				env.onActivityHeartbeatListener(activityInfo, EncodedValues(r.Details))
+				env.onActivityHeartbeatListener(activityInfo, newEncodedValues(r.Details, env.GetDataConverter()))
 			}
 		}, false)
 
@@ -234,6 +258,9 @@ func newTestWorkflowEnvironmentImpl(s *WorkflowTestSuite) *testWorkflowEnvironme
 	if env.workerOptions.MetricsScope == nil {
 		env.workerOptions.MetricsScope = env.metricsScope
 	}
+	if env.workerOptions.DataConverter == nil {
+		env.workerOptions.DataConverter = getDefaultDataConverter()
+	}
 
 	return env
 }
@@ -249,7 +276,7 @@ func (env *testWorkflowEnvironmentImpl) newTestWorkflowEnvironmentForChild(optio
 	}
 	// set workflow info data for child workflow
 	childEnv.workflowInfo.WorkflowExecution.ID = options.workflowID
-	childEnv.workflowInfo.WorkflowExecution.RunID = options.workflowID + "_RunID"
+	childEnv.workflowInfo.WorkflowExecution.RunID = uuid.New()
 	childEnv.workflowInfo.Domain = *options.domain
 	childEnv.workflowInfo.TaskListName = *options.taskListName
 	childEnv.workflowInfo.ExecutionStartToCloseTimeoutSeconds = *options.executionStartToCloseTimeoutSeconds
@@ -269,6 +296,39 @@ func (env *testWorkflowEnvironmentImpl) setWorkerOptions(options WorkerOptions)
 	if options.MetricsScope != nil {
 		env.workerOptions.MetricsScope = options.MetricsScope
 	}
+	if options.DataConverter != nil {
+		env.workerOptions.DataConverter = options.DataConverter
+	}
+	if len(options.WorkflowInterceptorChainFactories) > 0 {
+		env.workerOptions.WorkflowInterceptorChainFactories = options.WorkflowInterceptorChainFactories
+	}
+	if len(options.ActivityInterceptorChainFactories) > 0 {
+		env.workerOptions.ActivityInterceptorChainFactories = options.ActivityInterceptorChainFactories
+	}
+}
+
+func (env *testWorkflowEnvironmentImpl) setStartWorkflowOptions(options StartWorkflowOptions) {
+	if len(options.ID) > 0 {
+		env.workflowInfo.WorkflowExecution.ID = options.ID
+	}
+	if len(options.TaskList) > 0 {
+		env.workflowInfo.TaskListName = options.TaskList
+	}
+	if options.ExecutionStartToCloseTimeout > 0 {
+		env.workflowInfo.ExecutionStartToCloseTimeoutSeconds = int32(options.ExecutionStartToCloseTimeout.Seconds())
+	}
+	if options.DecisionTaskStartToCloseTimeout > 0 {
+		env.workflowInfo.TaskStartToCloseTimeoutSeconds = int32(options.DecisionTaskStartToCloseTimeout.Seconds())
+	}
+	env.workflowInfo.CronSchedule = options.CronSchedule
+}
+
+func (env *testWorkflowEnvironmentImpl) setLastCompletionResult(result interface{}) {
+	data, err := encodeArg(env.GetDataConverter(), result)
+	if err != nil {
+		panic(err)
+	}
+	env.workflowInfo.lastCompletionResult = data
 }
 
 func (env *testWorkflowEnvironmentImpl) setActivityTaskList(tasklist string, activityFns ...interface{}) {
@@ -291,21 +351,37 @@ func (env *testWorkflowEnvironmentImpl) executeWorkflow(workflowFn interface{},
 		workflowType = workflowFn.(string)
 	case reflect.Func:
 		workflowType = getFunctionName(workflowFn)
-		if alias, ok := getHostEnvironment().getWorkflowAlias(workflowType); ok {
+		if alias, ok := env.registry.getWorkflowAlias(workflowType); ok {
 			workflowType = alias
 		}
 	default:
 		panic("unsupported workflowFn")
 	}
 
-	input, err := getHostEnvironment().encodeArgs(args)
+	input, err := encodeArgs(env.GetDataConverter(), args)
 	if err != nil {
 		panic(err)
 	}
+	if env.workflowInfo.CronSchedule != "" {
+		input, err = encodeCronWorkflowInput(&cronWorkflowInput{
+			Schedule:             env.workflowInfo.CronSchedule,
+			RunTimeoutSeconds:    env.workflowInfo.ExecutionStartToCloseTimeoutSeconds,
+			LastCompletionResult: env.workflowInfo.lastCompletionResult,
+			Input:                input,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
 	env.executeWorkflowInternal(workflowType, input)
 }
 
 func (env *testWorkflowEnvironmentImpl) executeWorkflowInternal(workflowType string, input []byte) {
+	env.postWorkflowExecution(workflowType, input)
+	env.startMainLoop()
+}
+
+func (env *testWorkflowEnvironmentImpl) postWorkflowExecution(workflowType string, input []byte) {
 	env.workflowInfo.WorkflowType.Name = workflowType
 	workflowDefinition, err := env.getWorkflowDefinition(env.workflowInfo.WorkflowType)
 	if err != nil {
@@ -313,19 +389,24 @@ func (env *testWorkflowEnvironmentImpl) executeWorkflowInternal(workflowType str
 	}
 	env.workflowDef = workflowDefinition
 	// env.workflowDef.Execute() method will execute dispatcher. We want the dispatcher to only run in main loop.
-	// In case of child workflow, this executeWorkflowInternal() is run in separate goroutinue, so use postCallback
-	// to make sure workflowDef.Execute() is run in main loop.
+	// In case of child workflow, this is called from the parent's decision, so use postCallback to make sure
+	// workflowDef.Execute() is run in main loop.
 	env.postCallback(func() {
 		env.workflowDef.Execute(env, input)
 	}, false)
-	env.startMainLoop()
 }
 
 func (env *testWorkflowEnvironmentImpl) getWorkflowDefinition(wt WorkflowType) (workflowDefinition, error) {
-	hostEnv := getHostEnvironment()
-	wf, ok := hostEnv.getWorkflowFn(wt.Name)
+	wf, ok := env.registry.getWorkflowFn(wt.Name)
 	if !ok {
-		supported := strings.Join(hostEnv.getRegisteredWorkflowTypes(), ", ")
+		if dynamicWorkflow := env.registry.getDynamicWorkflow(); dynamicWorkflow != nil {
+			wd := &dynamicWorkflowExecutorWrapper{
+				dynamicWorkflowExecutor: &dynamicWorkflowExecutor{name: wt.Name, fn: dynamicWorkflow},
+				env:                     env,
+			}
+			return newWorkflowDefinition(wd), nil
+		}
+		supported := strings.Join(env.registry.getRegisteredWorkflowTypes(), ", ")
 		return nil, fmt.Errorf("Unable to find workflow type: %v. Supported types: [%v]", wt.Name, supported)
 	}
 	wd := &workflowExecutorWrapper{
@@ -341,7 +422,7 @@ func (env *testWorkflowEnvironmentImpl) executeActivity(
 ) (EncodedValue, error) {
 	fnName := getFunctionName(activityFn)
 
-	input, err := getHostEnvironment().encodeArgs(args)
+	input, err := encodeArgs(env.GetDataConverter(), args)
 	if err != nil {
 		panic(err)
 	}
@@ -368,11 +449,11 @@ func (env *testWorkflowEnvironmentImpl) executeActivity(
 	}
 	switch request := result.(type) {
 	case *shared.RespondActivityTaskCanceledRequest:
-		return nil, NewCanceledError(request.Details)
+		return nil, newCanceledErrorWithDetails(request.Details, env.GetDataConverter())
 	case *shared.RespondActivityTaskFailedRequest:
-		return nil, constructError(request.GetReason(), request.Details)
+		return nil, constructError(request.GetReason(), request.Details, env.GetDataConverter())
 	case *shared.RespondActivityTaskCompletedRequest:
-		return EncodedValue(request.Result_), nil
+		return newEncodedValue(request.Result_, env.GetDataConverter()), nil
 	default:
 		// will never happen
 		return nil, fmt.Errorf("unsupported respond type %T", result)
@@ -561,14 +642,15 @@ func (env *testWorkflowEnvironmentImpl) Complete(result []byte, err error) {
 	}
 
 	env.isTestCompleted = true
-	env.testResult = EncodedValue(result)
+	env.testResult = newEncodedValue(result, env.GetDataConverter())
 
 	if err != nil {
 		switch err := err.(type) {
 		case *CanceledError, *ContinueAsNewError, *TimeoutError:
 			env.testError = err
 		default:
-			env.testError = constructError(getErrorDetails(err))
+			reason, details := getErrorDetails(err, env.GetDataConverter())
+			env.testError = constructError(reason, details, env.GetDataConverter())
 		}
 	}
 
@@ -583,7 +665,7 @@ func (env *testWorkflowEnvironmentImpl) Complete(result []byte, err error) {
 			delete(env.childWorkflows, childWorkflowID)
 			env.parentEnv.postCallback(func() {
 				// deliver result
-				childWorkflowHandle.callback(env.testResult, env.testError)
+				childWorkflowHandle.callback(result, env.testError)
 				if env.onChildWorkflowCompletedListener != nil {
 					env.onChildWorkflowCompletedListener(env.workflowInfo, env.testResult, env.testError)
 				}
@@ -599,7 +681,7 @@ func (env *testWorkflowEnvironmentImpl) CompleteActivity(taskToken []byte, resul
 	var data []byte
 	if result != nil {
 		var encodeErr error
-		data, encodeErr = getHostEnvironment().encodeArg(result)
+		data, encodeErr = encodeArg(env.GetDataConverter(), result)
 		if encodeErr != nil {
 			return encodeErr
 		}
@@ -613,7 +695,7 @@ func (env *testWorkflowEnvironmentImpl) CompleteActivity(taskToken []byte, resul
 				zap.String(tagActivityID, activityID))
 			return
 		}
-		request := convertActivityResultToRespondRequest("test-identity", taskToken, data, err)
+		request := convertActivityResultToRespondRequest("test-identity", taskToken, data, err, env.GetDataConverter())
 		env.handleActivityResult(activityID, request, activityHandle.activityType)
 	}, false /* do not auto schedule decision task, because activity might be still pending */)
 
@@ -628,6 +710,18 @@ func (env *testWorkflowEnvironmentImpl) GetMetricsScope() tally.Scope {
 	return env.workerOptions.MetricsScope
 }
 
+func (env *testWorkflowEnvironmentImpl) GetDataConverter() DataConverter {
+	return env.workerOptions.DataConverter
+}
+
+func (env *testWorkflowEnvironmentImpl) GetWorkflowInterceptorFactories() []WorkflowInterceptorFactory {
+	return env.workerOptions.WorkflowInterceptorChainFactories
+}
+
+func (env *testWorkflowEnvironmentImpl) GetRegistry() *hostEnvImpl {
+	return env.registry
+}
+
 func (env *testWorkflowEnvironmentImpl) ExecuteActivity(parameters executeActivityParameters, callback resultHandler) *activityInfo {
 	var activityID string
 	if parameters.ActivityID == nil || *parameters.ActivityID == "" {
@@ -664,6 +758,62 @@ func (env *testWorkflowEnvironmentImpl) ExecuteActivity(parameters executeActivi
 	return activityInfo
 }
 
+func (env *testWorkflowEnvironmentImpl) ExecuteLocalActivity(parameters executeLocalActivityParameters, callback resultHandler) *activityInfo {
+	activityInfo := &activityInfo{activityID: getStringID(env.nextID())}
+
+	var ae *activityExecutor
+	if parameters.ActivityFn != nil {
+		ae = &activityExecutor{name: parameters.ActivityType.Name, fn: parameters.ActivityFn}
+	} else if activity, ok := env.registry.getActivity(parameters.ActivityType.Name); ok {
+		ae = &activityExecutor{name: activity.ActivityType().Name, fn: activity.GetFunction()}
+	} else {
+		panic(fmt.Sprintf("unable to find activityType=%v", parameters.ActivityType.Name))
+	}
+	wrapper := &activityExecutorWrapper{activityExecutor: ae, env: env}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = newLocalActivityContext(ctx, activityInfo.activityID, parameters.ActivityType,
+		env.workflowInfo.WorkflowExecution, env.logger, env.metricsScope, env.GetDataConverter())
+	env.localActivities[activityInfo.activityID] = &testLocalActivityHandle{callback: callback, cancel: cancel}
+	env.runningCount.Inc()
+	// local activity runs in separate goroutinue outside of workflow dispatcher
+	go func() {
+		result, err := executeLocalActivity(ctx, wrapper, parameters)
+		// post local activity result to workflow dispatcher
+		env.postCallback(func() {
+			env.handleLocalActivityResult(activityInfo.activityID, result, err)
+		}, true)
+		env.runningCount.Dec()
+	}()
+
+	return activityInfo
+}
+
+func (env *testWorkflowEnvironmentImpl) handleLocalActivityResult(activityID string, result []byte, err error) {
+	handle, ok := env.localActivities[activityID]
+	if !ok {
+		env.logger.Debug("handleLocalActivityResult: ActivityID not exists, could be already cancelled.",
+			zap.String(tagActivityID, activityID))
+		return
+	}
+	delete(env.localActivities, activityID)
+	handle.cancel()
+	handle.callback(result, err)
+}
+
+func (env *testWorkflowEnvironmentImpl) RequestCancelLocalActivity(activityID string) {
+	handle, ok := env.localActivities[activityID]
+	if !ok {
+		env.logger.Debug("RequestCancelLocalActivity failed, LocalActivity not exists or already completed.",
+			zap.String(tagActivityID, activityID))
+		return
+	}
+	env.logger.Debug("RequestCancelLocalActivity", zap.String(tagActivityID, activityID))
+	delete(env.localActivities, activityID)
+	handle.cancel()
+	handle.callback(nil, ErrCanceled)
+}
+
 func (env *testWorkflowEnvironmentImpl) handleActivityResult(activityID string, result interface{}, activityType string) {
 	env.logger.Debug(fmt.Sprintf("handleActivityResult: %T.", result),
 		zap.String(tagActivityID, activityID), zap.String(tagActivityType, activityType))
@@ -692,10 +842,10 @@ func (env *testWorkflowEnvironmentImpl) handleActivityResult(activityID string,
 
 	switch request := result.(type) {
 	case *shared.RespondActivityTaskCanceledRequest:
-		err = NewCanceledError(request.Details)
+		err = newCanceledErrorWithDetails(request.Details, env.GetDataConverter())
 		activityHandle.callback(nil, err)
 	case *shared.RespondActivityTaskFailedRequest:
-		err = constructError(*request.Reason, request.Details)
+		err = constructError(*request.Reason, request.Details, env.GetDataConverter())
 		activityHandle.callback(nil, err)
 	case *shared.RespondActivityTaskCompletedRequest:
 		blob = request.Result_
@@ -705,7 +855,7 @@ func (env *testWorkflowEnvironmentImpl) handleActivityResult(activityID string,
 	}
 
 	if env.onActivityCompletedListener != nil {
-		env.onActivityCompletedListener(activityInfo, EncodedValue(blob), err)
+		env.onActivityCompletedListener(activityInfo, newEncodedValue(blob, env.GetDataConverter()), err)
 	}
 
 	env.startDecisionTask()
@@ -749,7 +899,7 @@ func (a *activityExecutorWrapper) Execute(ctx context.Context, input []byte) ([]
 	activityInfo := GetActivityInfo(ctx)
 	if a.env.onActivityStartedListener != nil {
 		a.env.postCallback(func() {
-			a.env.onActivityStartedListener(&activityInfo, ctx, EncodedValues(input))
+			a.env.onActivityStartedListener(&activityInfo, ctx, newEncodedValues(input, a.env.GetDataConverter()))
 		}, false)
 	}
 
@@ -766,7 +916,7 @@ func (w *workflowExecutorWrapper) Execute(ctx Context, input []byte) (result []b
 	env := w.env
 	if env.isChildWorkflow() && env.onChildWorkflowStartedListener != nil {
 		env.postCallback(func() {
-			env.onChildWorkflowStartedListener(GetWorkflowInfo(ctx), ctx, EncodedValues(input))
+			env.onChildWorkflowStartedListener(GetWorkflowInfo(ctx), ctx, newEncodedValues(input, env.GetDataConverter()))
 		}, false)
 	}
 
@@ -809,6 +959,32 @@ func (w *workflowExecutorWrapper) Execute(ctx Context, input []byte) (result []b
 	return w.workflowExecutor.Execute(ctx, input)
 }
 
+// Execute executes the dynamic activity.
+func (a *dynamicActivityExecutorWrapper) Execute(ctx context.Context, input []byte) ([]byte, error) {
+	activityInfo := GetActivityInfo(ctx)
+	if a.env.onActivityStartedListener != nil {
+		a.env.postCallback(func() {
+			a.env.onActivityStartedListener(&activityInfo, ctx, newEncodedValues(input, a.env.GetDataConverter()))
+		}, false)
+	}
+	return a.dynamicActivityExecutor.Execute(ctx, input)
+}
+
+// Execute executes the dynamic workflow.
+func (w *dynamicWorkflowExecutorWrapper) Execute(ctx Context, input []byte) ([]byte, error) {
+	env := w.env
+	if env.isChildWorkflow() {
+		if env.onChildWorkflowStartedListener != nil {
+			env.postCallback(func() {
+				env.onChildWorkflowStartedListener(GetWorkflowInfo(ctx), ctx, newEncodedValues(input, env.GetDataConverter()))
+			}, false)
+		}
+		// The counter is increased in env.ExecuteChildWorkflow(), there is no mock call to wait for.
+		env.runningCount.Dec()
+	}
+	return w.dynamicWorkflowExecutor.Execute(ctx, input)
+}
+
 func (m *mockWrapper) getMockReturn(ctx interface{}, input []byte) (retArgs mock.Arguments) {
 	if m.env.mock == nil {
 		// no mock
@@ -817,7 +993,7 @@ func (m *mockWrapper) getMockReturn(ctx interface{}, input []byte) (retArgs mock
 
 	// check if we have mock setup
 	fnType := reflect.TypeOf(m.fn)
-	reflectArgs, err := getHostEnvironment().decodeArgs(fnType, input)
+	reflectArgs, err := decodeArgs(m.env.GetDataConverter(), fnType, input)
 	if err != nil {
 		panic(err)
 	}
@@ -922,7 +1098,7 @@ func (m *mockWrapper) executeMock(ctx interface{}, input []byte, mockRet mock.Ar
 				panic(fmt.Sprintf("mock of %v has incorrect return type, expected %v, but actual is %T (%v)",
 					fnName, expectedType, mockResult, mockResult))
 			}
-			result, encodeErr := getHostEnvironment().encodeArg(mockResult)
+			result, encodeErr := encodeArg(m.env.GetDataConverter(), mockResult)
 			if encodeErr != nil {
 				panic(fmt.Sprintf("encode result from mock of %v failed: %v", fnName, encodeErr))
 			}
@@ -937,15 +1113,17 @@ func (m *mockWrapper) executeMock(ctx interface{}, input []byte, mockRet mock.Ar
 func (env *testWorkflowEnvironmentImpl) newTestActivityTaskHandler(taskList string) ActivityTaskHandler {
 	wOptions := fillWorkerOptionsDefaults(env.workerOptions)
 	params := workerExecutionParameters{
-		TaskList:     taskList,
-		Identity:     wOptions.Identity,
-		MetricsScope: wOptions.MetricsScope,
-		Logger:       wOptions.Logger,
-		UserContext:  wOptions.BackgroundActivityContext,
+		TaskList:                          taskList,
+		Identity:                          wOptions.Identity,
+		MetricsScope:                      wOptions.MetricsScope,
+		Logger:                            wOptions.Logger,
+		UserContext:                       wOptions.BackgroundActivityContext,
+		DataConverter:                     wOptions.DataConverter,
+		ActivityInterceptorChainFactories: wOptions.ActivityInterceptorChainFactories,
 	}
 	ensureRequiredParams(&params)
 
-	if len(getHostEnvironment().getRegisteredActivities()) == 0 {
+	if len(env.registry.getRegisteredActivities()) == 0 && env.registry.getDynamicActivity() == nil {
 		panic(fmt.Sprintf("no activity is registered for tasklist '%v'", taskList))
 	}
 
@@ -959,15 +1137,19 @@ func (env *testWorkflowEnvironmentImpl) newTestActivityTaskHandler(taskList stri
 			}
 		}
 
-		activity, ok := getHostEnvironment().getActivity(name)
+		activity, ok := env.registry.getActivity(name)
 		if !ok {
+			if dynamicActivity := env.registry.getDynamicActivity(); dynamicActivity != nil {
+				ae := &dynamicActivityExecutor{name: name, fn: dynamicActivity}
+				return &dynamicActivityExecutorWrapper{dynamicActivityExecutor: ae, env: env}
+			}
 			return nil
 		}
 		ae := &activityExecutor{name: activity.ActivityType().Name, fn: activity.GetFunction()}
 		return &activityExecutorWrapper{activityExecutor: ae, env: env}
 	}
 
-	taskHandler := newActivityTaskHandlerWithCustomProvider(env.service, params, getHostEnvironment(), getActivity)
+	taskHandler := newActivityTaskHandlerWithCustomProvider(env.service, params, env.registry, getActivity)
 	return taskHandler
 }
 
@@ -1060,6 +1242,31 @@ func (env *testWorkflowEnvironmentImpl) RequestCancelWorkflow(domainName, workfl
 	return nil
 }
 
+func (env *testWorkflowEnvironmentImpl) SignalExternalWorkflow(
+	domainName, workflowID, runID, signalName string, input []byte, callback resultHandler) {
+	// The signal can be delivered to the workflows that run in this test, a parent or a child workflow.
+	var targetEnv *testWorkflowEnvironmentImpl
+	if env.parentEnv != nil && env.parentEnv.workflowInfo.WorkflowExecution.ID == workflowID {
+		targetEnv = env.parentEnv
+	} else if childHandle, ok := env.childWorkflows[workflowID]; ok {
+		targetEnv = childHandle.env
+	}
+	if targetEnv == nil || (runID != "" && targetEnv.workflowInfo.WorkflowExecution.RunID != runID) {
+		env.postCallback(func() {
+			callback(nil, fmt.Errorf("SignalExternalWorkflowFailed: %v",
+				shared.SignalExternalWorkflowExecutionFailedCause_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION))
+		}, true)
+		return
+	}
+
+	targetEnv.postCallback(func() {
+		targetEnv.signalHandler(signalName, input)
+	}, true)
+	env.postCallback(func() {
+		callback(nil, nil)
+	}, true)
+}
+
 func (env *testWorkflowEnvironmentImpl) ExecuteChildWorkflow(options workflowOptions, callback resultHandler, startedHandler func(r WorkflowExecution, e error)) error {
 	childEnv := env.newTestWorkflowEnvironmentForChild(&options, callback)
 	env.logger.Sugar().Infof("ExecuteChildWorkflow: %v", options.workflowType.Name)
@@ -1068,8 +1275,9 @@ func (env *testWorkflowEnvironmentImpl) ExecuteChildWorkflow(options workflowOpt
 	startedHandler(childEnv.workflowInfo.WorkflowExecution, nil)
 	env.runningCount.Inc()
 
-	// run child workflow in separate goroutinue
-	go childEnv.executeWorkflowInternal(options.workflowType.Name, options.input)
+	// The child workflow runs in the main loop. Its execution is posted before returning, so the signals sent to it
+	// once it is started are handled after it registered its signal handler.
+	childEnv.postWorkflowExecution(options.workflowType.Name, options.input)
 
 	return nil
 }
@@ -1078,6 +1286,21 @@ func (env *testWorkflowEnvironmentImpl) SideEffect(f func() ([]byte, error), cal
 	callback(f())
 }
 
+func (env *testWorkflowEnvironmentImpl) MutableSideEffect(id string, f func() interface{}, equals func(a, b interface{}) bool) EncodedValue {
+	if result, ok := env.mutableSideEffect[id]; ok {
+		if changed, data := isMutableSideEffectValueChanged(env.GetDataConverter(), result, f(), equals); changed {
+			env.mutableSideEffect[id] = data
+		}
+	} else {
+		data, err := encodeArg(env.GetDataConverter(), f())
+		if err != nil {
+			panic(err)
+		}
+		env.mutableSideEffect[id] = data
+	}
+	return newEncodedValue(env.mutableSideEffect[id], env.GetDataConverter())
+}
+
 func (env *testWorkflowEnvironmentImpl) GetVersion(changeID string, minSupported, maxSupported Version) Version {
 	if version, ok := env.changeVersions[changeID]; ok {
 		validateVersion(changeID, version, minSupported, maxSupported)
@@ -1116,7 +1339,7 @@ func (env *testWorkflowEnvironmentImpl) cancelWorkflow() {
 }
 
 func (env *testWorkflowEnvironmentImpl) signalWorkflow(name string, input interface{}) {
-	data, err := getHostEnvironment().encodeArg(input)
+	data, err := encodeArg(env.GetDataConverter(), input)
 	if err != nil {
 		panic(err)
 	}
@@ -1126,7 +1349,7 @@ func (env *testWorkflowEnvironmentImpl) signalWorkflow(name string, input interf
 }
 
 func (env *testWorkflowEnvironmentImpl) queryWorkflow(queryType string, args ...interface{}) (EncodedValue, error) {
-	data, err := getHostEnvironment().encodeArg(args)
+	data, err := encodeArg(env.GetDataConverter(), args)
 	if err != nil {
 		return nil, err
 	}
@@ -1134,7 +1357,7 @@ func (env *testWorkflowEnvironmentImpl) queryWorkflow(queryType string, args ...
 	if err != nil {
 		return nil, err
 	}
-	return EncodedValue(blob), nil
+	return newEncodedValue(blob, env.GetDataConverter()), nil
 }
 
 // make sure interface is implemented
@@ -21,9 +21,12 @@
 package cadence
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -455,6 +458,41 @@ func (s *WorkflowTestSuiteUnitTest) Test_SideEffect() {
 	s.Nil(env.GetWorkflowError())
 }
 
+func (s *WorkflowTestSuiteUnitTest) Test_MutableSideEffect() {
+	config := []int{1, 1, 2}
+	var calls int
+	workflowFn := func(ctx Context) ([]int, error) {
+		var values []int
+		for range config {
+			var v int
+			err := MutableSideEffect(ctx, "config", func(ctx Context) interface{} {
+				value := config[calls]
+				calls++
+				return value
+			}, func(a, b interface{}) bool {
+				return a.(int) == b.(int)
+			}).Get(&v)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		return values, nil
+	}
+
+	RegisterWorkflow(workflowFn)
+	env := s.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(workflowFn)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var values []int
+	s.NoError(env.GetWorkflowResult(&values))
+	s.Equal([]int{1, 1, 2}, values)
+	s.Equal(3, calls)
+}
+
 func (s *WorkflowTestSuiteUnitTest) Test_ChildWorkflow_Basic() {
 	workflowFn := func(ctx Context) (string, error) {
 		ctx = WithActivityOptions(ctx, s.activityOptions)
@@ -486,6 +524,56 @@ func (s *WorkflowTestSuiteUnitTest) Test_ChildWorkflow_Basic() {
 	s.Equal("hello_activity hello_world", actualResult)
 }
 
+func (s *WorkflowTestSuiteUnitTest) Test_SignalChildWorkflow() {
+	childWorkflowFn := func(ctx Context, parentID string) (string, error) {
+		var ping string
+		GetSignalChannel(ctx, "ping").Receive(ctx, &ping)
+		if err := SignalExternalWorkflow(ctx, "", parentID, "", "pong", ping+"_pong").Get(ctx, nil); err != nil {
+			return "", err
+		}
+		return "child_" + ping, nil
+	}
+	workflowFn := func(ctx Context) (string, error) {
+		cwo := ChildWorkflowOptions{ExecutionStartToCloseTimeout: time.Minute}
+		ctx = WithChildWorkflowOptions(ctx, cwo)
+		f := ExecuteChildWorkflow(ctx, childWorkflowFn, GetWorkflowInfo(ctx).WorkflowExecution.ID)
+		if err := f.SignalChildWorkflow(ctx, "ping", "hello").Get(ctx, nil); err != nil {
+			return "", err
+		}
+		var pong, childResult string
+		GetSignalChannel(ctx, "pong").Receive(ctx, &pong)
+		if err := f.Get(ctx, &childResult); err != nil {
+			return "", err
+		}
+		return pong + " " + childResult, nil
+	}
+
+	RegisterWorkflow(childWorkflowFn)
+	RegisterWorkflow(workflowFn)
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(workflowFn)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var actualResult string
+	s.NoError(env.GetWorkflowResult(&actualResult))
+	s.Equal("hello_pong child_hello", actualResult)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_SignalExternalWorkflowUnknown() {
+	workflowFn := func(ctx Context) error {
+		return SignalExternalWorkflow(ctx, "", "unknown-workflow-id", "", "signal", "payload").Get(ctx, nil)
+	}
+
+	RegisterWorkflow(workflowFn)
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(workflowFn)
+
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+	s.Contains(env.GetWorkflowError().Error(), "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION")
+}
+
 func (s *WorkflowTestSuiteUnitTest) Test_ChildWorkflowCancel() {
 	workflowFn := func(ctx Context) error {
 		cwo := ChildWorkflowOptions{
@@ -1094,3 +1182,834 @@ func (s *WorkflowTestSuiteUnitTest) Test_QueryWorkflow() {
 	env.AssertExpectations(s.T())
 	verifyStateWithQuery(stateDone)
 }
+
+// testPrefixDataConverter prefixes the payloads of the default DataConverter and refuses payloads without the prefix,
+// so any payload that bypasses it fails to decode.
+type testPrefixDataConverter struct{}
+
+var testDataConverterPrefix = []byte("test-dc:")
+
+func (dc *testPrefixDataConverter) ToData(values ...interface{}) ([]byte, error) {
+	data, err := getDefaultDataConverter().ToData(values...)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, testDataConverterPrefix...), data...), nil
+}
+
+func (dc *testPrefixDataConverter) FromData(data []byte, valuePtrs ...interface{}) error {
+	if !bytes.HasPrefix(data, testDataConverterPrefix) {
+		return fmt.Errorf("payload %q was not encoded by testPrefixDataConverter", data)
+	}
+	return getDefaultDataConverter().FromData(data[len(testDataConverterPrefix):], valuePtrs...)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_DataConverter() {
+	errorActivityFn := func(ctx context.Context) error {
+		return NewCustomError("dc-reason", "details")
+	}
+	RegisterActivity(errorActivityFn)
+
+	workflowFn := func(ctx Context, name string) (string, error) {
+		state := "started"
+		err := SetQueryHandler(ctx, "state", func() (string, error) {
+			return state, nil
+		})
+		if err != nil {
+			return "", err
+		}
+
+		var signal string
+		GetSignalChannel(ctx, "dc-signal").Receive(ctx, &signal)
+
+		var sideEffect string
+		if err := SideEffect(ctx, func(ctx Context) interface{} {
+			return "side-effect"
+		}).Get(&sideEffect); err != nil {
+			return "", err
+		}
+
+		ctx = WithActivityOptions(ctx, s.activityOptions)
+		var greeting string
+		if err := ExecuteActivity(ctx, testActivityHello, name).Get(ctx, &greeting); err != nil {
+			return "", err
+		}
+
+		err = ExecuteActivity(ctx, errorActivityFn).Get(ctx, nil)
+		customErr, ok := err.(*CustomError)
+		if !ok {
+			return "", fmt.Errorf("expected CustomError, but got %v", err)
+		}
+		var details string
+		customErr.Details(&details)
+
+		state = "done"
+		return strings.Join([]string{greeting, signal, sideEffect, details}, ","), nil
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	env.SetWorkerOptions(WorkerOptions{DataConverter: &testPrefixDataConverter{}})
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow("dc-signal", "signal")
+	}, time.Minute)
+	env.ExecuteWorkflow(workflowFn, "dc")
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result string
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal("hello_dc,signal,side-effect,details", result)
+
+	encodedState, err := env.QueryWorkflow("state")
+	s.NoError(err)
+	var state string
+	s.NoError(encodedState.Get(&state))
+	s.Equal("done", state)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_WorkflowWithTimeout() {
+	activityFn := func(ctx context.Context) (time.Time, error) {
+		deadline, _ := ctx.Deadline()
+		return deadline, nil
+	}
+	RegisterActivity(activityFn)
+	childWorkflowFn := func(ctx Context) error {
+		return nil
+	}
+	RegisterWorkflow(childWorkflowFn)
+
+	workflowFn := func(ctx Context) (time.Time, error) {
+		startTime := Now(ctx)
+
+		// Sleep is interrupted by the deadline.
+		ctx1, cancel1 := WithTimeout(ctx, time.Minute)
+		defer cancel1()
+		if err := Sleep(ctx1, time.Hour); err != ErrDeadlineExceeded {
+			return time.Time{}, fmt.Errorf("expected ErrDeadlineExceeded, but got %v", err)
+		}
+		if ctx1.Err() != ErrDeadlineExceeded || Now(ctx).Sub(startTime) != time.Minute {
+			return time.Time{}, fmt.Errorf("deadline expired at the wrong time: %v", Now(ctx).Sub(startTime))
+		}
+
+		// Canceling the context cancels the deadline timer.
+		ctx2, cancel2 := WithTimeout(ctx, time.Hour)
+		cancel2()
+		if ctx2.Err() != ErrCanceled {
+			return time.Time{}, fmt.Errorf("expected ErrCanceled, but got %v", ctx2.Err())
+		}
+
+		// A later deadline doesn't extend the parent one.
+		ctx3, cancel3 := WithTimeout(ctx, 30*time.Second)
+		defer cancel3()
+		ctx4, cancel4 := WithDeadline(ctx3, Now(ctx).Add(time.Hour))
+		defer cancel4()
+		deadline3, _ := ctx3.Deadline()
+		if deadline4, ok := ctx4.Deadline(); !ok || !deadline4.Equal(deadline3) {
+			return time.Time{}, fmt.Errorf("expected deadline %v, but got %v", deadline3, deadline4)
+		}
+
+		// The deadline propagates to activities and child workflows.
+		var activityDeadline time.Time
+		if err := ExecuteActivity(WithActivityOptions(ctx4, s.activityOptions), activityFn).Get(ctx, &activityDeadline); err != nil {
+			return time.Time{}, err
+		}
+		ctx4 = WithChildWorkflowOptions(ctx4, ChildWorkflowOptions{ExecutionStartToCloseTimeout: time.Hour})
+		if err := ExecuteChildWorkflow(ctx4, childWorkflowFn).Get(ctx, nil); err != nil {
+			return time.Time{}, err
+		}
+		return activityDeadline, nil
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	var timersCanceled int
+	env.SetOnTimerCancelledListener(func(timerID string) {
+		timersCanceled++
+	})
+	var childTimeout int32
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *WorkflowInfo, ctx Context, args EncodedValues) {
+		childTimeout = workflowInfo.ExecutionStartToCloseTimeoutSeconds
+	})
+	startTime := time.Now()
+	env.ExecuteWorkflow(workflowFn)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var activityDeadline time.Time
+	s.NoError(env.GetWorkflowResult(&activityDeadline))
+	s.True(activityDeadline.Before(startTime.Add(time.Minute)), "activity deadline %v", activityDeadline)
+	s.Equal(int32(30), childTimeout)
+	// The interrupted Sleep timer and the deadline timers of ctx2 and ctx3.
+	s.Equal(3, timersCanceled)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_AwaitWithTimeout() {
+	workflowFn := func(ctx Context) ([]string, error) {
+		startTime := Now(ctx)
+		var signals []string
+		Go(ctx, func(ctx Context) {
+			ch := GetSignalChannel(ctx, "await-signal")
+			for {
+				var signal string
+				ch.Receive(ctx, &signal)
+				signals = append(signals, signal)
+			}
+		})
+
+		var result []string
+		ok, err := AwaitWithTimeout(ctx, time.Hour, func() bool { return len(signals) == 2 })
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, fmt.Sprintf("%v-%v", ok, Now(ctx).Sub(startTime)))
+
+		ok, err = AwaitWithTimeout(ctx, time.Hour, func() bool { return len(signals) == 3 })
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, fmt.Sprintf("%v-%v", ok, Now(ctx).Sub(startTime)))
+		return append(result, signals...), nil
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow("await-signal", "s1")
+	}, time.Minute)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow("await-signal", "s2")
+	}, 2*time.Minute)
+	env.ExecuteWorkflow(workflowFn)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result []string
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal([]string{"true-2m0s", "false-1h2m0s", "s1", "s2"}, result)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_LocalActivity() {
+	var attempts int
+	localActivityFn := func(ctx context.Context, name string) (string, error) {
+		attempts++
+		if attempts < 3 {
+			return "", NewCustomError("retry-me")
+		}
+		return fmt.Sprintf("Hello %v, attempt %v", name, attempts), nil
+	}
+	workflowFn := func(ctx Context) ([]string, error) {
+		ctx = WithLocalActivityOptions(ctx, LocalActivityOptions{
+			ScheduleToCloseTimeout: time.Minute,
+			RetryPolicy: &RetryPolicy{
+				InitialInterval:    time.Millisecond,
+				BackoffCoefficient: 1,
+				MaximumAttempts:    5,
+			},
+		})
+		var result1, result2 string
+		if err := ExecuteLocalActivity(ctx, localActivityFn, "local").Get(ctx, &result1); err != nil {
+			return nil, err
+		}
+		if err := ExecuteLocalActivity(ctx, testActivityHello, "local").Get(ctx, &result2); err != nil {
+			return nil, err
+		}
+		return []string{result1, result2}, nil
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(testActivityHello, mock.Anything, "local").Return("mock_local", nil).Once()
+	env.ExecuteWorkflow(workflowFn)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result []string
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal([]string{"Hello local, attempt 3", "mock_local"}, result)
+	env.AssertExpectations(s.T())
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_LocalActivityNonRetriableError() {
+	var attempts int
+	localActivityFn := func(ctx context.Context) error {
+		attempts++
+		return NewCustomError("bad-request")
+	}
+	workflowFn := func(ctx Context) error {
+		ctx = WithLocalActivityOptions(ctx, LocalActivityOptions{
+			ScheduleToCloseTimeout: time.Minute,
+			RetryPolicy: &RetryPolicy{
+				InitialInterval:          time.Millisecond,
+				BackoffCoefficient:       1,
+				NonRetriableErrorReasons: []string{"bad-request"},
+			},
+		})
+		return ExecuteLocalActivity(ctx, localActivityFn).Get(ctx, nil)
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(workflowFn)
+
+	s.True(env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	s.IsType(&CustomError{}, err)
+	s.Equal("bad-request", err.(*CustomError).Reason())
+	s.Equal(1, attempts)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_LocalActivityRetriesTimeoutError() {
+	var attempts int
+	localActivityFn := func(ctx context.Context) (int, error) {
+		attempts++
+		if attempts == 1 {
+			return 0, NewTimeoutError(shared.TimeoutType_START_TO_CLOSE)
+		}
+		return attempts, nil
+	}
+	workflowFn := func(ctx Context) (int, error) {
+		ctx = WithLocalActivityOptions(ctx, LocalActivityOptions{
+			ScheduleToCloseTimeout: time.Minute,
+			RetryPolicy: &RetryPolicy{
+				InitialInterval:    time.Millisecond,
+				BackoffCoefficient: 1,
+			},
+		})
+		var result int
+		err := ExecuteLocalActivity(ctx, localActivityFn).Get(ctx, &result)
+		return result, err
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(workflowFn)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result int
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(2, result)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_ActivityIDWithAttemptSeparator() {
+	workflowFn := func(ctx Context) error {
+		ctx = WithActivityOptions(ctx, ActivityOptions{
+			ActivityID:             "id" + activityAttemptSeparator + "2",
+			ScheduleToStartTimeout: time.Minute,
+			StartToCloseTimeout:    time.Minute,
+		})
+		return ExecuteActivity(ctx, testActivityHello, "id").Get(ctx, nil)
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(workflowFn)
+
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+	s.Contains(env.GetWorkflowError().Error(), activityAttemptSeparator)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_ActivityRetryPolicy() {
+	var attempts []string
+	activityFn := func(ctx context.Context, failures int32) (int32, error) {
+		info := GetActivityInfo(ctx)
+		attempts = append(attempts, fmt.Sprintf("%v:%v", info.ActivityID, info.Attempt))
+		if info.Attempt <= failures {
+			return 0, NewCustomError("retry-me")
+		}
+		return info.Attempt, nil
+	}
+	RegisterActivity(activityFn)
+
+	workflowFn := func(ctx Context, failures int32) (int32, error) {
+		ctx = WithActivityOptions(ctx, ActivityOptions{
+			ActivityID:             "retried",
+			ScheduleToStartTimeout: time.Minute,
+			StartToCloseTimeout:    time.Minute,
+			RetryPolicy: &RetryPolicy{
+				InitialInterval:          time.Second,
+				BackoffCoefficient:       2,
+				MaximumAttempts:          3,
+				NonRetriableErrorReasons: []string{"bad-request"},
+			},
+		})
+		startTime := Now(ctx)
+		var attempt int32
+		err := ExecuteActivity(ctx, activityFn, failures).Get(ctx, &attempt)
+		if err != nil {
+			return 0, err
+		}
+		if elapsed := Now(ctx).Sub(startTime); elapsed < 3*time.Second {
+			return 0, fmt.Errorf("retried without backoff, elapsed %v", elapsed)
+		}
+		return attempt, nil
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(workflowFn, int32(2))
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result int32
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(int32(3), result)
+	s.Equal([]string{"retried:1", "retried#attempt-2:2", "retried#attempt-3:3"}, attempts)
+
+	// The policy gives up after MaximumAttempts, and the error of the last attempt is returned.
+	attempts = nil
+	env = s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(workflowFn, int32(3))
+	s.True(env.IsWorkflowCompleted())
+	s.IsType(&CustomError{}, env.GetWorkflowError())
+	s.Equal(3, len(attempts))
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_ActivityRetryPolicyNonRetriableError() {
+	var attempts int
+	activityFn := func(ctx context.Context) error {
+		attempts++
+		return NewCustomError("bad-request")
+	}
+	RegisterActivity(activityFn)
+
+	workflowFn := func(ctx Context) error {
+		ctx = WithActivityOptions(ctx, ActivityOptions{
+			ScheduleToStartTimeout: time.Minute,
+			StartToCloseTimeout:    time.Minute,
+		})
+		ctx = WithRetryPolicy(ctx, RetryPolicy{
+			InitialInterval:          time.Second,
+			NonRetriableErrorReasons: []string{"bad-request"},
+		})
+		return ExecuteActivity(ctx, activityFn).Get(ctx, nil)
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(workflowFn)
+	s.True(env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	s.IsType(&CustomError{}, err)
+	s.Equal("bad-request", err.(*CustomError).Reason())
+	s.Equal(1, attempts)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_ChildWorkflowRetryPolicy() {
+	var childRuns []WorkflowExecution
+	childWorkflowFn := func(ctx Context, failures int) (int, error) {
+		childRuns = append(childRuns, GetWorkflowInfo(ctx).WorkflowExecution)
+		if len(childRuns) <= failures {
+			return 0, NewCustomError("retry-me")
+		}
+		return len(childRuns), nil
+	}
+	RegisterWorkflow(childWorkflowFn)
+
+	workflowFn := func(ctx Context, failures int) (string, error) {
+		ctx = WithChildWorkflowOptions(ctx, ChildWorkflowOptions{
+			ExecutionStartToCloseTimeout: time.Minute,
+			RetryPolicy: &RetryPolicy{
+				InitialInterval: time.Second,
+				MaximumAttempts: 3,
+			},
+		})
+		startTime := Now(ctx)
+		childFuture := ExecuteChildWorkflow(ctx, childWorkflowFn, failures)
+		firstExecutionFuture := childFuture.GetChildWorkflowExecution()
+		var attempts int
+		err := childFuture.Get(ctx, &attempts)
+		var execution, firstExecution WorkflowExecution
+		if err := childFuture.GetChildWorkflowExecution().Get(ctx, &execution); err != nil {
+			return "", err
+		}
+		if err := firstExecutionFuture.Get(ctx, &firstExecution); err != nil {
+			return "", err
+		}
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%v %v %v %v", attempts, firstExecution.RunID, execution.RunID,
+			Now(ctx).Sub(startTime) >= 3*time.Second), nil
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(workflowFn, 2)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result string
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(3, len(childRuns))
+	s.Equal(fmt.Sprintf("3 %v %v true", childRuns[0].RunID, childRuns[2].RunID), result)
+	// Every attempt is a new run of the child workflow, with the same workflow ID.
+	s.Equal(childRuns[0].ID, childRuns[1].ID)
+	s.Equal(childRuns[0].ID, childRuns[2].ID)
+	s.NotEqual(childRuns[0].RunID, childRuns[1].RunID)
+	s.NotEqual(childRuns[1].RunID, childRuns[2].RunID)
+
+	// The policy gives up after MaximumAttempts, and the error of the last attempt is returned.
+	childRuns = nil
+	env = s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(workflowFn, 3)
+	s.True(env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	s.IsType(&CustomError{}, err)
+	s.Equal("retry-me", err.(*CustomError).Reason())
+	s.Equal(3, len(childRuns))
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_CronWorkflow() {
+	workflowFn := func(ctx Context, increment int) (int, error) {
+		var count int
+		if err := GetLastCompletionResult(ctx, &count); err != nil && err != ErrNoData {
+			return 0, err
+		}
+		return count + increment, nil
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	env.SetStartWorkflowOptions(StartWorkflowOptions{ExecutionStartToCloseTimeout: time.Hour, CronSchedule: "*/15 * * * *"})
+	env.SetLastCompletionResult(5)
+	startTime := env.Now()
+	env.ExecuteWorkflow(workflowFn, 2)
+	s.True(env.IsWorkflowCompleted())
+	// The run continues as new right away with its result, the next run waiting for the next fire time.
+	s.Equal(startTime, env.Now())
+	err, ok := env.GetWorkflowError().(*ContinueAsNewError)
+	s.True(ok, "unexpected error: %v", env.GetWorkflowError())
+	next, decodeErr := decodeCronWorkflowInput(err.options.input)
+	s.NoError(decodeErr)
+	s.Equal("*/15 * * * *", next.Schedule)
+	s.Nil(next.LastFailureReason)
+	fireTime := time.Unix(0, next.FireTime)
+	s.Equal(0, fireTime.UTC().Minute()%15)
+	s.True(fireTime.After(startTime))
+	// The wait for the fire time is added to the timeout of the next run.
+	wait := int32(math.Ceil(fireTime.Sub(startTime).Seconds()))
+	s.Equal(int32(3600), next.RunTimeoutSeconds)
+	s.Equal(3600+wait, *err.options.executionStartToCloseTimeoutSeconds)
+	var count int
+	s.NoError(newEncodedValue(next.LastCompletionResult, nil).Get(&count))
+	s.Equal(7, count)
+	var increment int
+	s.NoError(newEncodedValues(next.Input, nil).Get(&increment))
+	s.Equal(2, increment)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_CronWorkflowFailure() {
+	workflowFn := func(ctx Context) error {
+		return NewCustomError("cron-failure", "details")
+	}
+	RegisterWorkflow(workflowFn)
+
+	env := s.NewTestWorkflowEnvironment()
+	env.SetStartWorkflowOptions(StartWorkflowOptions{ExecutionStartToCloseTimeout: time.Hour, CronSchedule: "@hourly"})
+	env.ExecuteWorkflow(workflowFn)
+	s.True(env.IsWorkflowCompleted())
+	// A failed run does not stop the schedule, the next run gets its error.
+	err, ok := env.GetWorkflowError().(*ContinueAsNewError)
+	s.True(ok, "unexpected error: %v", env.GetWorkflowError())
+	next, decodeErr := decodeCronWorkflowInput(err.options.input)
+	s.NoError(decodeErr)
+	s.Equal("cron-failure", *next.LastFailureReason)
+	info := &WorkflowInfo{}
+	setCronWorkflowInfo(info, next, nil)
+	s.IsType(&CustomError{}, info.lastFailure)
+	s.Equal("cron-failure", info.lastFailure.(*CustomError).Reason())
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_IsolatedRegistry() {
+	activityFn := func(ctx context.Context, name string) (string, error) {
+		return "hello " + name, nil
+	}
+	workflowFn := func(ctx Context) (string, error) {
+		ctx = WithActivityOptions(ctx, s.activityOptions)
+		var result string
+		err := ExecuteActivity(ctx, activityFn, "isolated").Get(ctx, &result)
+		return result, err
+	}
+
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(workflowFn, RegisterWorkflowOptions{Name: "isolated-workflow"})
+	env.RegisterActivityWithOptions(activityFn, RegisterActivityOptions{Name: "isolated-activity"})
+	env.ExecuteWorkflow("isolated-workflow")
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result string
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal("hello isolated", result)
+
+	// The registrations do not leak into other environments.
+	_, ok := getHostEnvironment().getWorkflowFn("isolated-workflow")
+	s.False(ok)
+	env = s.NewTestWorkflowEnvironment()
+	s.Panics(func() {
+		env.ExecuteWorkflow("isolated-workflow")
+	})
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_ActivityStruct() {
+	activities := &testActivityStruct{greeting: "Hello"}
+	workflowFn := func(ctx Context) (string, error) {
+		ctx = WithActivityOptions(ctx, s.activityOptions)
+		var greeting string
+		if err := ExecuteActivity(ctx, activities.Greet, "cadence").Get(ctx, &greeting); err != nil {
+			return "", err
+		}
+		var length int
+		if err := ExecuteActivity(ctx, "testActivityStruct.Length", greeting).Get(ctx, &length); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%v:%v", greeting, length), nil
+	}
+
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflow(workflowFn)
+	env.RegisterActivity(activities)
+	env.ExecuteWorkflow(workflowFn)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result string
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal("Hello cadence:13", result)
+}
+
+type testInterceptorFactory struct {
+	name  string
+	calls *[]string
+}
+
+type testInterceptor struct {
+	WorkflowInterceptorBase
+	name  string
+	calls *[]string
+}
+
+func (f *testInterceptorFactory) NewInterceptor(info *WorkflowInfo, next WorkflowInterceptor) WorkflowInterceptor {
+	return &testInterceptor{WorkflowInterceptorBase: WorkflowInterceptorBase{Next: next}, name: f.name, calls: f.calls}
+}
+
+func (i *testInterceptor) record(call string) {
+	*i.calls = append(*i.calls, i.name+":"+call)
+}
+
+func (i *testInterceptor) ExecuteActivity(ctx Context, activityType string, args ...interface{}) Future {
+	i.record("ExecuteActivity:" + activityType)
+	if i.name != "tenant" {
+		return i.Next.ExecuteActivity(ctx, activityType, args...)
+	}
+	// The tenant interceptor rejects some activities, and rewrites the arguments of the others.
+	if activityType == "forbidden" {
+		future, settable := NewFuture(ctx)
+		settable.Set(nil, errors.New("activity is forbidden"))
+		return future
+	}
+	return i.Next.ExecuteActivity(ctx, activityType, strings.ToUpper(args[0].(string)))
+}
+
+func (i *testInterceptor) ExecuteChildWorkflow(ctx Context, childWorkflowType string, args ...interface{}) ChildWorkflowFuture {
+	i.record("ExecuteChildWorkflow:" + childWorkflowType)
+	return i.Next.ExecuteChildWorkflow(ctx, childWorkflowType, args...)
+}
+
+func (i *testInterceptor) NewTimer(ctx Context, d time.Duration) Future {
+	i.record("NewTimer:" + d.String())
+	return i.Next.NewTimer(ctx, d)
+}
+
+func (i *testInterceptor) SideEffect(ctx Context, f func(ctx Context) interface{}) EncodedValue {
+	i.record("SideEffect")
+	return i.Next.SideEffect(ctx, f)
+}
+
+func (i *testInterceptor) GetSignalChannel(ctx Context, signalName string) Channel {
+	i.record("GetSignalChannel:" + signalName)
+	return i.Next.GetSignalChannel(ctx, signalName)
+}
+
+func (i *testInterceptor) SetQueryHandler(ctx Context, queryType string, handler interface{}) error {
+	i.record("SetQueryHandler:" + queryType)
+	return i.Next.SetQueryHandler(ctx, queryType, handler)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_WorkflowInterceptor() {
+	activityFn := func(ctx context.Context, name string) (string, error) {
+		return "hello " + name, nil
+	}
+	childWorkflowFn := func(ctx Context) (int, error) {
+		var value int
+		err := SideEffect(ctx, func(ctx Context) interface{} { return 1 }).Get(&value)
+		return value, err
+	}
+	workflowFn := func(ctx Context) (string, error) {
+		ctx = WithActivityOptions(ctx, s.activityOptions)
+		var greeting string
+		if err := ExecuteActivity(ctx, activityFn, "cadence").Get(ctx, &greeting); err != nil {
+			return "", err
+		}
+		if err := ExecuteActivity(ctx, "forbidden").Get(ctx, nil); err == nil {
+			return "", errors.New("forbidden activity was executed")
+		}
+		if err := Sleep(ctx, time.Minute); err != nil {
+			return "", err
+		}
+		GetSignalChannel(ctx, "signal")
+		if err := SetQueryHandler(ctx, "query", func() (string, error) { return greeting, nil }); err != nil {
+			return "", err
+		}
+		ctx = WithChildWorkflowOptions(ctx, ChildWorkflowOptions{ExecutionStartToCloseTimeout: time.Minute})
+		var childResult int
+		if err := ExecuteChildWorkflow(ctx, childWorkflowFn).Get(ctx, &childResult); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%v %v", greeting, childResult), nil
+	}
+
+	var calls []string
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflow(workflowFn)
+	env.RegisterWorkflowWithOptions(childWorkflowFn, RegisterWorkflowOptions{Name: "interceptor-child"})
+	env.RegisterActivityWithOptions(activityFn, RegisterActivityOptions{Name: "greet"})
+	env.SetWorkerOptions(WorkerOptions{
+		WorkflowInterceptorChainFactories: []WorkflowInterceptorFactory{
+			&testInterceptorFactory{name: "audit", calls: &calls},
+			&testInterceptorFactory{name: "tenant", calls: &calls},
+		},
+	})
+	env.ExecuteWorkflow(workflowFn)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result string
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal("hello CADENCE 1", result)
+	s.Equal([]string{
+		"audit:ExecuteActivity:greet", "tenant:ExecuteActivity:greet",
+		"audit:ExecuteActivity:forbidden", "tenant:ExecuteActivity:forbidden",
+		"audit:NewTimer:1m0s", "tenant:NewTimer:1m0s",
+		"audit:GetSignalChannel:signal", "tenant:GetSignalChannel:signal",
+		"audit:SetQueryHandler:query", "tenant:SetQueryHandler:query",
+		"audit:ExecuteChildWorkflow:interceptor-child", "tenant:ExecuteChildWorkflow:interceptor-child",
+		// The child workflow has its own chain.
+		"audit:SideEffect", "tenant:SideEffect",
+	}, calls)
+}
+
+type testActivityInterceptorFactory struct {
+	calls *[]string
+}
+
+type testActivityInterceptor struct {
+	ActivityInterceptorBase
+	calls *[]string
+}
+
+func (f *testActivityInterceptorFactory) NewInterceptor(info *ActivityInfo, next ActivityInterceptor) ActivityInterceptor {
+	*f.calls = append(*f.calls, "NewInterceptor:"+info.ActivityType.Name)
+	return &testActivityInterceptor{ActivityInterceptorBase: ActivityInterceptorBase{Next: next}, calls: f.calls}
+}
+
+func (i *testActivityInterceptor) ExecuteActivity(ctx context.Context, activityType string, args ...interface{}) (interface{}, error) {
+	*i.calls = append(*i.calls, fmt.Sprintf("ExecuteActivity:%v:%v", activityType, len(args)))
+	result, err := i.Next.ExecuteActivity(ctx, activityType, args...)
+	*i.calls = append(*i.calls, fmt.Sprintf("Result:%T:%v", result, err))
+	return result, err
+}
+
+func (i *testActivityInterceptor) RecordActivityHeartbeat(ctx context.Context, details ...interface{}) {
+	*i.calls = append(*i.calls, fmt.Sprintf("RecordActivityHeartbeat:%v", details))
+	i.Next.RecordActivityHeartbeat(ctx, details...)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_ActivityInterceptor() {
+	activityFn := func(ctx context.Context, name string, count int) (string, error) {
+		RecordActivityHeartbeat(ctx, count)
+		return fmt.Sprintf("%v %v", name, count), nil
+	}
+	workflowFn := func(ctx Context) (string, error) {
+		ctx = WithActivityOptions(ctx, s.activityOptions)
+		var result string
+		if err := ExecuteActivity(ctx, activityFn, "cadence", 1).Get(ctx, &result); err != nil {
+			return "", err
+		}
+		var dynamicResult string
+		if err := ExecuteActivity(ctx, "proxy-activity", result).Get(ctx, &dynamicResult); err != nil {
+			return "", err
+		}
+		return dynamicResult, nil
+	}
+
+	var calls []string
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflow(workflowFn)
+	env.RegisterActivityWithOptions(activityFn, RegisterActivityOptions{Name: "intercepted-activity"})
+	env.RegisterDynamicActivity(func(ctx context.Context, activityType string, args EncodedValues) (interface{}, error) {
+		var input string
+		err := args.Get(&input)
+		return "proxied " + input, err
+	})
+	env.SetWorkerOptions(WorkerOptions{
+		ActivityInterceptorChainFactories: []ActivityInterceptorFactory{&testActivityInterceptorFactory{calls: &calls}},
+	})
+	env.ExecuteWorkflow(workflowFn)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result string
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal("proxied cadence 1", result)
+	s.Equal([]string{
+		"NewInterceptor:intercepted-activity",
+		"ExecuteActivity:intercepted-activity:2",
+		"RecordActivityHeartbeat:[1]",
+		"Result:string:<nil>",
+		// The arguments of the dynamic activity are encoded, and so is its result.
+		"NewInterceptor:proxy-activity",
+		"ExecuteActivity:proxy-activity:1",
+		"Result:*cadence.encodedValue:<nil>",
+	}, calls)
+}
+
+func (s *WorkflowTestSuiteUnitTest) Test_DynamicWorkflowAndActivity() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterDynamicWorkflow(func(ctx Context, workflowType string, args EncodedValues) (interface{}, error) {
+		var name string
+		if err := args.Get(&name); err != nil {
+			return nil, err
+		}
+		if workflowType == "child-workflow" {
+			return "child " + name, nil
+		}
+		ctx = WithActivityOptions(ctx, s.activityOptions)
+		var activityResult string
+		if err := ExecuteActivity(ctx, "proxy-activity", name).Get(ctx, &activityResult); err != nil {
+			return nil, err
+		}
+		ctx = WithChildWorkflowOptions(ctx, ChildWorkflowOptions{ExecutionStartToCloseTimeout: time.Minute})
+		var childResult string
+		if err := ExecuteChildWorkflow(ctx, "child-workflow", name).Get(ctx, &childResult); err != nil {
+			return nil, err
+		}
+		return workflowType + ": " + activityResult + ", " + childResult, nil
+	})
+	env.RegisterDynamicActivity(func(ctx context.Context, activityType string, args EncodedValues) (interface{}, error) {
+		var name string
+		if err := args.Get(&name); err != nil {
+			return nil, err
+		}
+		return activityType + " " + name, nil
+	})
+	var startedActivityType string
+	env.SetOnActivityStartedListener(func(activityInfo *ActivityInfo, ctx context.Context, args EncodedValues) {
+		startedActivityType = activityInfo.ActivityType.Name
+	})
+
+	env.ExecuteWorkflow("gateway-workflow", "cadence")
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result string
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal("gateway-workflow: proxy-activity cadence, child cadence", result)
+	s.Equal("proxy-activity", startedActivityType)
+}
@@ -205,6 +205,29 @@ func (_m *TChanWorkflowService) RecordActivityTaskHeartbeat(ctx thrift.Context,
 	return r0, r1
 }
 
+// RecordActivityTaskHeartbeatByID provides a mock function with given fields: ctx, heartbeatRequest
+func (_m *TChanWorkflowService) RecordActivityTaskHeartbeatByID(ctx thrift.Context, heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+	ret := _m.Called(ctx, heartbeatRequest)
+
+	var r0 *shared.RecordActivityTaskHeartbeatResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *shared.RecordActivityTaskHeartbeatByIDRequest) *shared.RecordActivityTaskHeartbeatResponse); ok {
+		r0 = rf(ctx, heartbeatRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.RecordActivityTaskHeartbeatResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *shared.RecordActivityTaskHeartbeatByIDRequest) error); ok {
+		r1 = rf(ctx, heartbeatRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RegisterDomain provides a mock function with given fields: ctx, registerRequest
 func (_m *TChanWorkflowService) RegisterDomain(ctx thrift.Context, registerRequest *shared.RegisterDomainRequest) error {
 	ret := _m.Called(ctx, registerRequest)
@@ -233,6 +256,20 @@ func (_m *TChanWorkflowService) RespondActivityTaskCanceled(ctx thrift.Context,
 	return r0
 }
 
+// RespondActivityTaskCanceledByID provides a mock function with given fields: ctx, canceledRequest
+func (_m *TChanWorkflowService) RespondActivityTaskCanceledByID(ctx thrift.Context, canceledRequest *shared.RespondActivityTaskCanceledByIDRequest) error {
+	ret := _m.Called(ctx, canceledRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(thrift.Context, *shared.RespondActivityTaskCanceledByIDRequest) error); ok {
+		r0 = rf(ctx, canceledRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RespondActivityTaskCompleted provides a mock function with given fields: ctx, completeRequest
 func (_m *TChanWorkflowService) RespondActivityTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondActivityTaskCompletedRequest) error {
 	ret := _m.Called(ctx, completeRequest)
@@ -247,6 +284,20 @@ func (_m *TChanWorkflowService) RespondActivityTaskCompleted(ctx thrift.Context,
 	return r0
 }
 
+// RespondActivityTaskCompletedByID provides a mock function with given fields: ctx, completeRequest
+func (_m *TChanWorkflowService) RespondActivityTaskCompletedByID(ctx thrift.Context, completeRequest *shared.RespondActivityTaskCompletedByIDRequest) error {
+	ret := _m.Called(ctx, completeRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(thrift.Context, *shared.RespondActivityTaskCompletedByIDRequest) error); ok {
+		r0 = rf(ctx, completeRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RespondActivityTaskFailed provides a mock function with given fields: ctx, failRequest
 func (_m *TChanWorkflowService) RespondActivityTaskFailed(ctx thrift.Context, failRequest *shared.RespondActivityTaskFailedRequest) error {
 	ret := _m.Called(ctx, failRequest)
@@ -261,6 +312,20 @@ func (_m *TChanWorkflowService) RespondActivityTaskFailed(ctx thrift.Context, fa
 	return r0
 }
 
+// RespondActivityTaskFailedByID provides a mock function with given fields: ctx, failRequest
+func (_m *TChanWorkflowService) RespondActivityTaskFailedByID(ctx thrift.Context, failRequest *shared.RespondActivityTaskFailedByIDRequest) error {
+	ret := _m.Called(ctx, failRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(thrift.Context, *shared.RespondActivityTaskFailedByIDRequest) error); ok {
+		r0 = rf(ctx, failRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RespondDecisionTaskCompleted provides a mock function with given fields: ctx, completeRequest
 func (_m *TChanWorkflowService) RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondDecisionTaskCompletedRequest) error {
 	ret := _m.Called(ctx, completeRequest)
@@ -340,6 +405,29 @@ func (_m *TChanWorkflowService) SignalWorkflowExecution(ctx thrift.Context, sign
 	return r0
 }
 
+// SignalWithStartWorkflowExecution provides a mock function with given fields: ctx, signalWithStartRequest
+func (_m *TChanWorkflowService) SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, signalWithStartRequest)
+
+	var r0 *shared.StartWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *shared.SignalWithStartWorkflowExecutionRequest) *shared.StartWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, signalWithStartRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.StartWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *shared.SignalWithStartWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, signalWithStartRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateDomain provides a mock function with given fields: ctx, updateRequest
 func (_m *TChanWorkflowService) UpdateDomain(ctx thrift.Context, updateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse, error) {
 	ret := _m.Called(ctx, updateRequest)
@@ -42,6 +42,34 @@ type (
 		Stop()
 	}
 
+	// WorkerRegistry registers workflows and activities on a single worker. The Worker returned by NewWorker
+	// implements it:
+	//  w := cadence.NewWorker(service, domain, taskList, options)
+	//  w.(cadence.WorkerRegistry).RegisterWorkflow(MyWorkflow)
+	WorkerRegistry interface {
+		// RegisterWorkflow registers the workflow function on this worker only, unlike the package level
+		// RegisterWorkflow which registers it on all the workers of the process. A worker serves the workflows
+		// registered on it and the ones registered globally; if both use the same name, the worker's one is used.
+		// Registration must happen before Start. This method calls panic if the function doesn't comply with the
+		// expected format or if its name is already registered on this worker.
+		RegisterWorkflow(workflowFunc interface{})
+		// RegisterWorkflowWithOptions is RegisterWorkflow with options, see the package level
+		// RegisterWorkflowWithOptions.
+		RegisterWorkflowWithOptions(workflowFunc interface{}, options RegisterWorkflowOptions)
+		// RegisterActivity registers the activity function, or the methods of a struct, on this worker only, see
+		// RegisterWorkflow and the package level RegisterActivity.
+		RegisterActivity(activityFunc interface{})
+		// RegisterActivityWithOptions is RegisterActivity with options, see the package level
+		// RegisterActivityWithOptions.
+		RegisterActivityWithOptions(activityFunc interface{}, options RegisterActivityOptions)
+		// RegisterDynamicWorkflow registers the workflow of the workflow types that are not registered on this
+		// worker, see the package level RegisterDynamicWorkflow.
+		RegisterDynamicWorkflow(workflowFunc DynamicWorkflowFunc)
+		// RegisterDynamicActivity registers the activity of the activity types that are not registered on this
+		// worker, see the package level RegisterDynamicActivity.
+		RegisterDynamicActivity(activityFunc DynamicActivityFunc)
+	}
+
 	// WorkerOptions is to configure a worker instance,
 	// for example (1) the logger or any specific metrics.
 	// 	       (2) Whether to heart beat for activities automatically.
@@ -96,6 +124,21 @@ type (
 		// Optional: sets context for activity. The context can be used to pass any configuration to activity
 		// like common logger for all activities.
 		BackgroundActivityContext context.Context
+
+		// Optional: Sets DataConverter to customize serialization/deserialization of arguments in Cadence.
+		// It must match the DataConverter of the Client that starts and signals the workflows.
+		// default: JSON encoding, or thrift encoding for thrift types.
+		DataConverter DataConverter
+
+		// Optional: Sets the factories of the WorkflowInterceptor chain of each workflow execution. The interceptors
+		// are called in the order of the factories, the first one is called first by the workflow code.
+		// default: no interceptors.
+		WorkflowInterceptorChainFactories []WorkflowInterceptorFactory
+
+		// Optional: Sets the factories of the ActivityInterceptor chain of each activity task. The interceptors are
+		// called in the order of the factories, the first one is called first by the activity worker.
+		// default: no interceptors.
+		ActivityInterceptorChainFactories []ActivityInterceptorFactory
 	}
 )
 
@@ -105,6 +148,7 @@ type (
 // taskList 	- is the task list name you use to identify your client worker, also
 // 		  identifies group of workflow and activity implementations that are hosted by a single worker process.
 // options 	-  configure any worker specific options like logger, metrics, identity.
+// The returned Worker also implements WorkerRegistry to register workflows and activities on this worker only.
 func NewWorker(
 	service m.TChanWorkflowService,
 	domain string,
@@ -122,5 +166,5 @@ func GetWorkflowStackTrace(h *s.History) (string, error) {
 	getHistoryPage := func(nextPageToken []byte) (*s.History, []byte, error) {
 		return h, nil, nil
 	}
-	return getWorkflowStackTraceImpl("unknown", "unknown", getHistoryPage)
+	return getWorkflowStackTraceImpl("unknown", "unknown", getHistoryPage, getDefaultDataConverter())
 }
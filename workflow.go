@@ -23,6 +23,7 @@ package cadence
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -32,8 +33,9 @@ import (
 )
 
 var (
-	errActivityParamsBadRequest = errors.New("missing activity parameters through context, check ActivityOptions")
-	errWorkflowOptionBadRequest = errors.New("missing workflow options through context, check WorkflowOptions")
+	errActivityParamsBadRequest      = errors.New("missing activity parameters through context, check ActivityOptions")
+	errLocalActivityParamsBadRequest = errors.New("missing local activity parameters through context, check LocalActivityOptions")
+	errWorkflowOptionBadRequest      = errors.New("missing workflow options through context, check WorkflowOptions")
 )
 
 type (
@@ -87,13 +89,63 @@ type (
 		Chain(future Future) // Value (or error) of the future become the same of the chained one.
 	}
 
+	// Mutex is a mutual exclusion lock that must be used instead of sync.Mutex to coordinate workflow goroutines.
+	// Use NewMutex to create an instance. Waiters acquire the lock in the order they asked for it.
+	Mutex interface {
+		// Lock blocks until the lock is acquired. Returns ctx.Err() if ctx is canceled first.
+		Lock(ctx Context) error
+		// LockFuture returns a Future that becomes ready when the lock is acquired, so the lock can be waited on by
+		// a Selector. The Future fails with ctx.Err() if ctx is canceled first. Once the Future is ready without an
+		// error the lock is owned by the caller and must be unlocked, even if the Future was never read.
+		LockFuture(ctx Context) Future
+		// TryLock acquires the lock only if it is free at the time of the call.
+		TryLock() bool
+		// Unlock releases the lock. It panics if the lock is not held.
+		Unlock()
+	}
+
+	// Semaphore is a counting semaphore that must be used to limit the number of workflow goroutines accessing
+	// a resource. Use NewSemaphore to create an instance. Waiters are served in the order they asked for permits.
+	Semaphore interface {
+		// Acquire blocks until n permits are acquired. Returns ctx.Err() if ctx is canceled first.
+		Acquire(ctx Context, n int) error
+		// AcquireFuture returns a Future that becomes ready when n permits are acquired, so the permits can be
+		// waited on by a Selector. The Future fails with ctx.Err() if ctx is canceled first. Once the Future is
+		// ready without an error the permits are owned by the caller and must be released.
+		AcquireFuture(ctx Context, n int) Future
+		// TryAcquire acquires n permits only if they are available at the time of the call.
+		TryAcquire(n int) bool
+		// Release returns n permits. It panics if more permits are released than were acquired.
+		Release(n int)
+	}
+
+	// WaitGroup waits for a collection of workflow goroutines to finish and must be used instead of sync.WaitGroup.
+	// Use NewWaitGroup to create an instance.
+	WaitGroup interface {
+		// Add adds delta, which may be negative, to the counter. It panics if the counter becomes negative.
+		Add(delta int)
+		// Done decrements the counter by one.
+		Done()
+		// Wait blocks until the counter is zero. Returns ctx.Err() if ctx is canceled first.
+		Wait(ctx Context) error
+		// WaitFuture returns a Future that becomes ready when the counter is zero, so it can be waited on by
+		// a Selector. The Future fails with ctx.Err() if ctx is canceled first.
+		WaitFuture(ctx Context) Future
+	}
+
 	// ChildWorkflowFuture represents the result of a child workflow execution
 	ChildWorkflowFuture interface {
 		Future
 		// GetChildWorkflowExecution returns a future that will be ready when child workflow execution started. You can
 		// get the WorkflowExecution of the child workflow from the future. Then you can use Workflow ID and RunID of
 		// child workflow to cancel or send signal to child workflow.
+		// If the child workflow is retried by its RetryPolicy, every attempt has its own future, and this method
+		// returns the one of the latest attempt.
 		GetChildWorkflowExecution() Future
+
+		// SignalChildWorkflow sends a signal to the child workflow once it is started, see SignalExternalWorkflow.
+		// The returned future fails if the child workflow could not be started.
+		SignalChildWorkflow(ctx Context, signalName string, arg interface{}) Future
 	}
 
 	// WorkflowType identifies a workflow type.
@@ -107,8 +159,16 @@ type (
 		RunID string
 	}
 
-	// EncodedValue is type alias used to encapsulate/extract encoded result from workflow/activity.
-	EncodedValue []byte
+	// EncodedValue is used to encapsulate/extract encoded result from workflow/activity.
+	// Breaking change: EncodedValue used to be a []byte decoded with the default encoding. It is now an interface so
+	// that Get decodes with the DataConverter the value was encoded with; code converting it to or from []byte must
+	// use Get instead.
+	EncodedValue interface {
+		// HasValue return whether there is value encoded.
+		HasValue() bool
+		// Get extract data from encoded data to desired value type. valuePtr is pointer to the actual value type.
+		Get(valuePtr interface{}) error
+	}
 
 	// Version represents a change version. See GetVersion call.
 	Version int
@@ -143,6 +203,33 @@ type (
 		// as: completed/failed/timedout/terminated/canceled)
 		// Optional: default false
 		WaitForCancellation bool
+
+		// RetryPolicy - Specifies how to retry the child workflow if it fails. Every retry is a new run of the child
+		// workflow with the same WorkflowID, started by the parent after a durable timer.
+		// Optional: Default nil, means the child workflow is not retried.
+		RetryPolicy *RetryPolicy
+
+		// CronSchedule - Runs the child workflow on a cron schedule, like "0 * * * *" for every hour; see
+		// StartWorkflowOptions.CronSchedule.
+		// Optional: Default empty, means the child workflow runs once.
+		CronSchedule string
+	}
+
+	// ContinueAsNewOptions stores the options of the new execution started by NewContinueAsNewErrorWithOptions.
+	// A zero value field keeps the value the current execution is using, including overrides set on the context.
+	ContinueAsNewOptions struct {
+		// TaskList - The task list the new execution's decision tasks are scheduled on.
+		TaskList string
+
+		// ExecutionStartToCloseTimeout - The end to end timeout of the new execution.
+		ExecutionStartToCloseTimeout time.Duration
+
+		// TaskStartToCloseTimeout - The decision task timeout of the new execution.
+		TaskStartToCloseTimeout time.Duration
+
+		// WorkflowType - The workflow type name of the new execution. Use it when the workflow function was
+		// registered with a custom name through RegisterWorkflowWithOptions.
+		WorkflowType string
 	}
 
 	// ChildWorkflowPolicy defines child workflow behavior when parent workflow is terminated.
@@ -165,6 +252,10 @@ type RegisterWorkflowOptions struct {
 	Name string
 }
 
+// DynamicWorkflowFunc is the signature of the workflow registered through RegisterDynamicWorkflow. It is called with
+// the type of the workflow execution and its encoded arguments, and returns the result of the workflow.
+type DynamicWorkflowFunc func(ctx Context, workflowType string, args EncodedValues) (interface{}, error)
+
 // RegisterWorkflow - registers a workflow function with the framework.
 // A workflow takes a cadence context and input and returns a (result, error) or just error.
 // Examples:
@@ -199,6 +290,17 @@ func RegisterWorkflowWithOptions(workflowFunc interface{}, opts RegisterWorkflow
 	}
 }
 
+// RegisterDynamicWorkflow registers the workflow that executes the workflows of the types that are not registered.
+// Like any workflow, it has to be deterministic, so it must only depend on the workflow type and the arguments.
+// This method calls panic if a dynamic workflow is already registered.
+func RegisterDynamicWorkflow(workflowFunc DynamicWorkflowFunc) {
+	thImpl := getHostEnvironment()
+	err := thImpl.RegisterDynamicWorkflow(workflowFunc)
+	if err != nil {
+		panic(err)
+	}
+}
+
 // NewChannel create new Channel instance
 func NewChannel(ctx Context) Channel {
 	state := getState(ctx)
@@ -209,18 +311,18 @@ func NewChannel(ctx Context) Channel {
 // NewNamedChannel create new Channel instance with a given human readable name.
 // Name appears in stack traces that are blocked on this channel.
 func NewNamedChannel(ctx Context, name string) Channel {
-	return &channelImpl{name: name}
+	return &channelImpl{name: name, dataConverter: getDataConverterFromWorkflowContext(ctx)}
 }
 
 // NewBufferedChannel create new buffered Channel instance
 func NewBufferedChannel(ctx Context, size int) Channel {
-	return &channelImpl{size: size}
+	return &channelImpl{size: size, dataConverter: getDataConverterFromWorkflowContext(ctx)}
 }
 
 // NewNamedBufferedChannel create new BufferedChannel instance with a given human readable name.
 // Name appears in stack traces that are blocked on this Channel.
 func NewNamedBufferedChannel(ctx Context, name string, size int) Channel {
-	return &channelImpl{name: name, size: size}
+	return &channelImpl{name: name, size: size, dataConverter: getDataConverterFromWorkflowContext(ctx)}
 }
 
 // NewSelector creates a new Selector instance.
@@ -236,6 +338,29 @@ func NewNamedSelector(ctx Context, name string) Selector {
 	return &selectorImpl{name: name}
 }
 
+// NewMutex creates a new Mutex instance. Its name, shown in the stack traces of the goroutines blocked on it,
+// is mutex-<sequence>.
+func NewMutex(ctx Context) Mutex {
+	return &mutexImpl{semaphore: newSemaphore(ctx, "mutex", 1)}
+}
+
+// NewSemaphore creates a new Semaphore instance with n permits. Its name, shown in the stack traces of the goroutines
+// blocked on it, is semaphore-<sequence>.
+func NewSemaphore(ctx Context, n int) Semaphore {
+	if n <= 0 {
+		panic("semaphore size must be positive")
+	}
+	return newSemaphore(ctx, "semaphore", n)
+}
+
+// NewWaitGroup creates a new WaitGroup instance. Its name, shown in the stack traces of the goroutines blocked on it,
+// is waitgroup-<sequence>.
+func NewWaitGroup(ctx Context) WaitGroup {
+	state := getState(ctx)
+	state.dispatcher.syncSequence++
+	return &waitGroupImpl{name: fmt.Sprintf("waitgroup-%v", state.dispatcher.syncSequence)}
+}
+
 // Go creates a new coroutine. It has similar semantic to goroutine in a context of the workflow.
 func Go(ctx Context, f func(ctx Context)) {
 	state := getState(ctx)
@@ -278,11 +403,27 @@ func NewFuture(ctx Context) (Future, Settable) {
 // and it can be one of CustomError, TimeoutError, CanceledError, PanicError, GenericError.
 //  - You can also cancel the pending activity using context(WithCancel(ctx)) and that will fail the activity with
 // error CanceledError.
+//  - If the context has a deadline (WithDeadline(ctx) or WithTimeout(ctx)) the activity timeouts are capped to it,
+// and the activity is canceled and fails with ErrDeadlineExceeded when the deadline expires.
+//  - If ActivityOptions has a RetryPolicy, the failed activity is scheduled again after the backoff interval of the
+// policy, until it succeeds or the policy gives up, and the future gets the error of the last attempt. The backoff
+// is a durable timer, rounded up to whole seconds. GetActivityInfo(ctx).Attempt tells the activity which attempt it is.
 // - returns Future with activity result or failure
 func ExecuteActivity(ctx Context, f interface{}, args ...interface{}) Future {
 	// Validate type and its arguments.
-	future, settable := newDecodeFuture(ctx, f)
-	activityType, input, err := getValidatedActivityFunction(f, args)
+	activityType, err := getValidatedActivityType(f, args, getRegistryFromWorkflowContext(ctx))
+	if err != nil {
+		future, settable := newDecodeFuture(ctx, f)
+		settable.Set(nil, err)
+		return future
+	}
+	return getWorkflowInterceptor(ctx).ExecuteActivity(ctx, activityType, args...)
+}
+
+func executeActivity(ctx Context, activityType string, args ...interface{}) Future {
+	future, settable := newDecodeFuture(ctx, activityType)
+	registry, dc := getRegistryFromWorkflowContext(ctx), getDataConverterFromWorkflowContext(ctx)
+	validatedActivityType, input, err := getValidatedActivityFunction(activityType, args, registry, dc)
 	if err != nil {
 		settable.Set(nil, err)
 		return future
@@ -294,23 +435,89 @@ func ExecuteActivity(ctx Context, f interface{}, args ...interface{}) Future {
 		settable.Set(nil, err)
 		return future
 	}
-	parameters.ActivityType = *activityType
+	parameters.ActivityType = *validatedActivityType
 	parameters.Input = input
 
-	a := getWorkflowEnvironment(ctx).ExecuteActivity(*parameters, func(r []byte, e error) {
-		settable.Set(r, e)
+	if parameters.RetryPolicy != nil {
+		executeActivityWithRetryPolicy(ctx, *parameters, func(r []byte, e error) {
+			settable.Set(r, deadlineExceededError(ctx, e))
+		})
+		return future
+	}
+
+	a := getWorkflowEnvironment(ctx).ExecuteActivity(limitActivityTimeoutsToDeadline(ctx, *parameters), func(r []byte, e error) {
+		settable.Set(r, deadlineExceededError(ctx, e))
 	})
 	Go(ctx, func(ctx Context) {
 		if ctx.Done() == nil {
 			return // not cancellable.
 		}
-		if ctx.Done().Receive(ctx, nil); ctx.Err() == ErrCanceled {
+		if ctx.Done().Receive(ctx, nil); ctx.Err() == ErrCanceled || ctx.Err() == ErrDeadlineExceeded {
 			getWorkflowEnvironment(ctx).RequestCancelActivity(a.activityID)
 		}
 	})
 	return future
 }
 
+// ExecuteLocalActivity requests a local activity execution in the context of a workflow.
+// A local activity is an activity function that is executed by the worker processing the decision task, as soon as
+// the workflow code is blocked, instead of being scheduled through the Cadence service. This saves the round trips to
+// the service, which makes it a good fit for short operations like a cache lookup or a validation call.
+//  - The result of the local activity is recorded in the workflow history as a marker. When the workflow is replayed
+// the result is returned from the marker, and the local activity is not executed again.
+//  - The decision task is held up while the local activity runs, so its ScheduleToCloseTimeout, including retries,
+// should be shorter than the decision task timeout. Local activities can't heartbeat.
+//  - Context is used to pass the settings for the local activity. Use LocalActivityOptions to pass down the options.
+//			lao := LocalActivityOptions{
+// 				ScheduleToCloseTimeout: 5 * time.Second,
+// 			}
+//			ctx1 := WithLocalActivityOptions(ctx, lao)
+//  - f - Either an activity function, which doesn't need to be registered, or the name of a registered activity.
+//  - args - The arguments that need to be passed to the function represented by 'f'.
+//  - If the local activity failed to complete then the future get error would indicate the failure
+// and it can be one of CustomError, TimeoutError, CanceledError, PanicError, GenericError.
+//  - You can also cancel the local activity using context(WithCancel(ctx)) as long as it hasn't been executed yet,
+// and that will fail the local activity with error CanceledError.
+// - returns Future with local activity result or failure
+func ExecuteLocalActivity(ctx Context, f interface{}, args ...interface{}) Future {
+	future, settable := newDecodeFuture(ctx, f)
+	registry, dc := getRegistryFromWorkflowContext(ctx), getDataConverterFromWorkflowContext(ctx)
+	activityType, input, err := getValidatedActivityFunction(f, args, registry, dc)
+	if err != nil {
+		settable.Set(nil, err)
+		return future
+	}
+	options, err := getValidatedLocalActivityOptions(ctx)
+	if err != nil {
+		settable.Set(nil, err)
+		return future
+	}
+	parameters := executeLocalActivityParameters{
+		ActivityType:           *activityType,
+		Input:                  input,
+		ScheduleToCloseTimeout: options.ScheduleToCloseTimeout,
+		RetryPolicy:            options.RetryPolicy,
+	}
+	if reflect.TypeOf(f).Kind() == reflect.Func {
+		parameters.ActivityFn = f
+	}
+	if deadline, ok := ctx.Deadline(); ok && deadline.Sub(Now(ctx)) < parameters.ScheduleToCloseTimeout {
+		parameters.ScheduleToCloseTimeout = deadline.Sub(Now(ctx))
+	}
+
+	a := getWorkflowEnvironment(ctx).ExecuteLocalActivity(parameters, func(r []byte, e error) {
+		settable.Set(r, deadlineExceededError(ctx, e))
+	})
+	Go(ctx, func(ctx Context) {
+		if ctx.Done() == nil {
+			return // not cancellable.
+		}
+		ctx.Done().Receive(ctx, nil)
+		getWorkflowEnvironment(ctx).RequestCancelLocalActivity(a.activityID)
+	})
+	return future
+}
+
 // ExecuteChildWorkflow requests child workflow execution in the context of a workflow.
 //  - Context can be used to pass the settings for the child workflow.
 // 	For example: task list that this child workflow should be routed, timeouts that need to be configured.
@@ -326,14 +533,32 @@ func ExecuteActivity(ctx Context, f interface{}, args ...interface{}) Future {
 // and it can be one of CustomError, TimeoutError, CanceledError, GenericError.
 //  - You can also cancel the pending child workflow using context(WithCancel(ctx)) and that will fail the workflow with
 // error CanceledError.
+//  - If the context has a deadline (WithDeadline(ctx) or WithTimeout(ctx)) the child workflow execution timeout is
+// capped to it, and the child workflow is canceled and fails with ErrDeadlineExceeded when the deadline expires.
 // - returns ChildWorkflowFuture
 func ExecuteChildWorkflow(ctx Context, f interface{}, args ...interface{}) ChildWorkflowFuture {
+	childWorkflowType, err := getValidatedWorkflowType(f, args, getRegistryFromWorkflowContext(ctx))
+	if err != nil {
+		result, mainSettable, _ := newChildWorkflowFuture(ctx, f)
+		mainSettable.Set(nil, err)
+		return result
+	}
+	return getWorkflowInterceptor(ctx).ExecuteChildWorkflow(ctx, childWorkflowType, args...)
+}
+
+func newChildWorkflowFuture(ctx Context, f interface{}) (childWorkflowFutureImpl, Settable, Settable) {
 	mainFuture, mainSettable := newDecodeFuture(ctx, f)
 	executionFuture, executionSettable := NewFuture(ctx)
 	result := childWorkflowFutureImpl{
 		decodeFutureImpl: mainFuture.(*decodeFutureImpl),
 		executionFuture:  executionFuture.(*futureImpl)}
-	wfType, input, err := getValidatedWorkerFunction(f, args)
+	return result, mainSettable, executionSettable
+}
+
+func executeChildWorkflow(ctx Context, childWorkflowType string, args ...interface{}) ChildWorkflowFuture {
+	result, mainSettable, executionSettable := newChildWorkflowFuture(ctx, childWorkflowType)
+	registry, dc := getRegistryFromWorkflowContext(ctx), getDataConverterFromWorkflowContext(ctx)
+	wfType, input, err := getValidatedWorkerFunction(childWorkflowType, args, registry, dc)
 	if err != nil {
 		mainSettable.Set(nil, err)
 		return result
@@ -344,11 +569,24 @@ func ExecuteChildWorkflow(ctx Context, f interface{}, args ...interface{}) Child
 		return result
 	}
 
-	options.input = input
+	options.input, err = wrapCronWorkflowInput(options.cronSchedule, *options.executionStartToCloseTimeoutSeconds, input)
+	if err != nil {
+		mainSettable.Set(nil, err)
+		return result
+	}
 	options.workflowType = wfType
+	result.domain = *options.domain
+
+	if options.retryPolicy != nil {
+		result.retryState = executeChildWorkflowWithRetryPolicy(ctx, *options, func(r []byte, e error) {
+			mainSettable.Set(r, deadlineExceededError(ctx, e))
+		})
+		return result
+	}
+
 	var childWorkflowExecution *WorkflowExecution
-	getWorkflowEnvironment(ctx).ExecuteChildWorkflow(*options, func(r []byte, e error) {
-		mainSettable.Set(r, e)
+	getWorkflowEnvironment(ctx).ExecuteChildWorkflow(limitWorkflowTimeoutToDeadline(ctx, *options), func(r []byte, e error) {
+		mainSettable.Set(r, deadlineExceededError(ctx, e))
 	}, func(r WorkflowExecution, e error) {
 		if e == nil {
 			childWorkflowExecution = &r
@@ -359,7 +597,7 @@ func ExecuteChildWorkflow(ctx Context, f interface{}, args ...interface{}) Child
 		if ctx.Done() == nil {
 			return // not cancellable.
 		}
-		if ctx.Done().Receive(ctx, nil); ctx.Err() == ErrCanceled {
+		if ctx.Done().Receive(ctx, nil); ctx.Err() == ErrCanceled || ctx.Err() == ErrDeadlineExceeded {
 			if childWorkflowExecution != nil {
 				getWorkflowEnvironment(ctx).RequestCancelWorkflow(
 					*options.domain, childWorkflowExecution.ID, childWorkflowExecution.RunID)
@@ -378,6 +616,11 @@ type WorkflowInfo struct {
 	ExecutionStartToCloseTimeoutSeconds int32
 	TaskStartToCloseTimeoutSeconds      int32
 	Domain                              string
+	ContinuedExecutionRunID             string // RunID of the execution this one was continued as new from, if any
+	CronSchedule                        string
+
+	lastCompletionResult []byte
+	lastFailure          error
 }
 
 // GetWorkflowInfo extracts info of a current workflow from a context.
@@ -385,6 +628,23 @@ func GetWorkflowInfo(ctx Context) *WorkflowInfo {
 	return getWorkflowEnvironment(ctx).WorkflowInfo()
 }
 
+// GetLastCompletionResult extracts the result of the previous run of a cron workflow into valuePtr.
+// It returns the error of the previous run if it failed, and ErrNoData if there is no result, as in the first run.
+//  var lastCount int
+//  if err := cadence.GetLastCompletionResult(ctx, &lastCount); err == nil {
+//      count = lastCount
+//  }
+func GetLastCompletionResult(ctx Context, valuePtr ...interface{}) error {
+	info := GetWorkflowInfo(ctx)
+	if info.lastFailure != nil {
+		return info.lastFailure
+	}
+	if len(info.lastCompletionResult) == 0 {
+		return ErrNoData
+	}
+	return newEncodedValues(info.lastCompletionResult, getDataConverterFromWorkflowContext(ctx)).Get(valuePtr...)
+}
+
 // GetLogger returns a logger to be used in workflow's context
 func GetLogger(ctx Context) *zap.Logger {
 	return getWorkflowEnvironment(ctx).GetLogger()
@@ -407,6 +667,10 @@ func Now(ctx Context) time.Time {
 //  - You can also cancel the pending timer using context(WithCancel(ctx)) and that will cancel the timer with
 // error TimerCanceledError.
 func NewTimer(ctx Context, d time.Duration) Future {
+	return getWorkflowInterceptor(ctx).NewTimer(ctx, d)
+}
+
+func newTimer(ctx Context, d time.Duration) Future {
 	future, settable := NewFuture(ctx)
 	if d <= 0 {
 		settable.Set(true, nil)
@@ -414,7 +678,7 @@ func NewTimer(ctx Context, d time.Duration) Future {
 	}
 
 	t := getWorkflowEnvironment(ctx).NewTimer(d, func(r []byte, e error) {
-		settable.Set(nil, e)
+		settable.Set(nil, deadlineExceededError(ctx, e))
 	})
 	if t != nil {
 		Go(ctx, func(ctx Context) {
@@ -442,6 +706,77 @@ func Sleep(ctx Context, d time.Duration) (err error) {
 	return
 }
 
+// Await blocks the calling goroutine until condition returns true or ctx is canceled.
+// The condition is evaluated again every time any workflow goroutine makes progress, for example after a
+// signal was received or a future became ready, so it can be used to wait on workflow state instead of channels.
+//  - The condition must not block and must not have side effects, as it can be evaluated any number of times.
+//  - Returns ctx.Err() (CanceledError or ErrDeadlineExceeded) if ctx is canceled before the condition is true.
+func Await(ctx Context, condition func() bool) error {
+	state := getState(ctx)
+	defer state.unblocked()
+
+	for !condition() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		state.yield("blocked on Await")
+	}
+	return nil
+}
+
+// AwaitWithTimeout blocks the calling goroutine until condition returns true, the timeout expires or ctx is canceled.
+// The timeout is backed by a durable timer, see NewTimer.
+//  - ok is true if the condition became true, and false if the timeout expired first.
+//  - Returns ctx.Err() (CanceledError or ErrDeadlineExceeded) if ctx is canceled before either of them.
+func AwaitWithTimeout(ctx Context, timeout time.Duration, condition func() bool) (ok bool, err error) {
+	timerCtx, cancelTimer := WithCancel(ctx)
+	defer cancelTimer()
+	timer := NewTimer(timerCtx, timeout)
+
+	err = Await(ctx, func() bool {
+		ok = condition()
+		return ok || timer.IsReady()
+	})
+	if err == nil && !ok {
+		// The timer is also ready when it was canceled together with ctx.
+		err = ctx.Err()
+	}
+	return ok, err
+}
+
+// SignalExternalWorkflow can be used to send a signal to an external workflow, which receives it on the channel
+// returned by GetSignalChannel(ctx, signalName).
+//  - domain - Optional - the domain of the workflow, the current workflow's domain is used if empty.
+//  - workflowID - name of the workflow ID.
+//  - runID - Optional - indicates the instance of a workflow, the current run is signaled if empty.
+//  - arg - the signal payload, encoded with the workflow's DataConverter.
+// The returned future is ready once the signal is delivered, or fails if the workflow doesn't exist or is closed.
+// A signal, once requested, cannot be canceled.
+func SignalExternalWorkflow(ctx Context, domain, workflowID, runID, signalName string, arg interface{}) Future {
+	future, settable := NewFuture(ctx)
+	if workflowID == "" {
+		settable.Set(nil, errors.New("need a valid workflow ID, provided empty"))
+		return future
+	}
+	if signalName == "" {
+		settable.Set(nil, errors.New("need a valid signal name, provided empty"))
+		return future
+	}
+	input, err := encodeArg(getDataConverterFromWorkflowContext(ctx), arg)
+	if err != nil {
+		settable.Set(nil, err)
+		return future
+	}
+	if domain == "" {
+		domain = GetWorkflowInfo(ctx).Domain
+	}
+
+	getWorkflowEnvironment(ctx).SignalExternalWorkflow(domain, workflowID, runID, signalName, input, func(r []byte, e error) {
+		settable.Set(nil, e)
+	})
+	return future
+}
+
 // RequestCancelWorkflow can be used to request cancellation of an external workflow.
 // - workflowID - name of the workflow ID.
 // - runID 	- Optional - indicates the instance of a workflow.
@@ -467,6 +802,8 @@ func WithChildWorkflowOptions(ctx Context, cwo ChildWorkflowOptions) Context {
 	wfOptions.taskStartToCloseTimeoutSeconds = common.Int32Ptr(int32(cwo.TaskStartToCloseTimeout.Seconds()))
 	wfOptions.childPolicy = cwo.ChildPolicy
 	wfOptions.waitForCancellation = cwo.WaitForCancellation
+	wfOptions.retryPolicy = cwo.RetryPolicy
+	wfOptions.cronSchedule = cwo.CronSchedule
 
 	return ctx1
 }
@@ -515,12 +852,7 @@ func WithWorkflowTaskStartToCloseTimeout(ctx Context, d time.Duration) Context {
 
 // GetSignalChannel returns channel corresponding to the signal name.
 func GetSignalChannel(ctx Context, signalName string) Channel {
-	return getWorkflowEnvOptions(ctx).getSignalChannel(ctx, signalName)
-}
-
-// Get extract data from encoded data to desired value type. valuePtr is pointer to the actual value type.
-func (b EncodedValue) Get(valuePtr interface{}) error {
-	return getHostEnvironment().decodeArg(b, valuePtr)
+	return getWorkflowInterceptor(ctx).GetSignalChannel(ctx, signalName)
 }
 
 // SideEffect executes provided function once, records its result into the workflow history and doesn't
@@ -557,13 +889,18 @@ func (b EncodedValue) Get(valuePtr interface{}) error {
 //        ....
 // }
 func SideEffect(ctx Context, f func(ctx Context) interface{}) EncodedValue {
+	return getWorkflowInterceptor(ctx).SideEffect(ctx, f)
+}
+
+func sideEffect(ctx Context, f func(ctx Context) interface{}) EncodedValue {
+	dc := getDataConverterFromWorkflowContext(ctx)
 	future, settable := NewFuture(ctx)
 	wrapperFunc := func() ([]byte, error) {
 		r := f(ctx)
-		return getHostEnvironment().encodeArg(r)
+		return encodeArg(dc, r)
 	}
 	resultCallback := func(result []byte, err error) {
-		settable.Set(EncodedValue(result), err)
+		settable.Set(newEncodedValue(result, dc), err)
 	}
 	getWorkflowEnvironment(ctx).SideEffect(wrapperFunc, resultCallback)
 	var encoded EncodedValue
@@ -573,6 +910,38 @@ func SideEffect(ctx Context, f func(ctx Context) interface{}) EncodedValue {
 	return encoded
 }
 
+// MutableSideEffect executes the provided function once, then it looks up the history for the value with the given id.
+// If there is no existing value, then it records the function result as a value with the given id on history;
+// otherwise, it compares whether the existing value from history has changed from the new function result by calling
+// the provided equals function. If they are equal, it returns the value without recording a new one in history;
+// otherwise, it records the new value with the same id on history.
+//
+// Unlike SideEffect, which records a marker on every call, MutableSideEffect only records one when the value changes.
+// This makes it a good fit for reading configuration that changes rarely, like a feature flag, from a loop.
+// When the workflow is replayed, the function is not executed and the latest recorded value for the id is returned.
+//  - equals compares the new value with the recorded one, decoded into a value of the same type. If it is nil the
+// values are compared by their encoded form.
+//  - The function must not have side effects the workflow logic depends on, see SideEffect.
+//
+// Here is an example of reading a feature flag in a loop:
+//
+// for {
+//       encodedFlag := MutableSideEffect(ctx, "new-pricing-enabled", func(ctx cadence.Context) interface{} {
+//             return config.IsEnabled("new-pricing")
+//       }, func(a, b interface{}) bool {
+//             return a.(bool) == b.(bool)
+//       })
+//       var enabled bool
+//       encodedFlag.Get(&enabled)
+//       ....
+// }
+func MutableSideEffect(ctx Context, id string, f func(ctx Context) interface{}, equals func(a, b interface{}) bool) EncodedValue {
+	wrapperFunc := func() interface{} {
+		return f(ctx)
+	}
+	return getWorkflowEnvironment(ctx).MutableSideEffect(id, wrapperFunc, equals)
+}
+
 // DefaultVersion is a version returned by GetVersion for code that wasn't versioned before
 const DefaultVersion Version = -1
 
@@ -669,5 +1038,5 @@ func SetQueryHandler(ctx Context, queryType string, handler interface{}) error {
 	if strings.HasPrefix(queryType, "__") {
 		return errors.New("queryType starts with '__' is reserved for internal use")
 	}
-	return setQueryHandler(ctx, queryType, handler)
+	return getWorkflowInterceptor(ctx).SetQueryHandler(ctx, queryType, handler)
 }
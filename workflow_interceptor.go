@@ -0,0 +1,90 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import "time"
+
+type (
+	// WorkflowInterceptorFactory creates the WorkflowInterceptor of each workflow execution, see
+	// WorkerOptions.WorkflowInterceptorChainFactories.
+	WorkflowInterceptorFactory interface {
+		// NewInterceptor creates the interceptor of the workflow execution described by info. The interceptor calls
+		// next to continue the chain, the last one in the chain executes the call.
+		NewInterceptor(info *WorkflowInfo, next WorkflowInterceptor) WorkflowInterceptor
+	}
+
+	// WorkflowInterceptor intercepts the calls of the workflow code to ExecuteActivity, ExecuteChildWorkflow,
+	// NewTimer (and Sleep), SideEffect, GetSignalChannel and SetQueryHandler. It can apply cross-cutting behavior
+	// like auditing, tracing or default options, modify the context or the arguments, or fail the call without
+	// calling the next interceptor.
+	// The interceptors run as part of the workflow code, so they must be deterministic too: when the workflow is
+	// replayed, they must make the same calls in the same order. Embed WorkflowInterceptorBase to only implement the
+	// methods to intercept.
+	WorkflowInterceptor interface {
+		// ExecuteActivity is called by ExecuteActivity, with the activity type name the activity function is
+		// registered under.
+		ExecuteActivity(ctx Context, activityType string, args ...interface{}) Future
+		// ExecuteChildWorkflow is called by ExecuteChildWorkflow, with the workflow type name the workflow function
+		// is registered under.
+		ExecuteChildWorkflow(ctx Context, childWorkflowType string, args ...interface{}) ChildWorkflowFuture
+		NewTimer(ctx Context, d time.Duration) Future
+		SideEffect(ctx Context, f func(ctx Context) interface{}) EncodedValue
+		GetSignalChannel(ctx Context, signalName string) Channel
+		SetQueryHandler(ctx Context, queryType string, handler interface{}) error
+	}
+
+	// WorkflowInterceptorBase is a WorkflowInterceptor that passes all the calls to Next.
+	WorkflowInterceptorBase struct {
+		Next WorkflowInterceptor
+	}
+)
+
+var _ WorkflowInterceptor = (*WorkflowInterceptorBase)(nil)
+
+// ExecuteActivity calls Next.ExecuteActivity.
+func (b *WorkflowInterceptorBase) ExecuteActivity(ctx Context, activityType string, args ...interface{}) Future {
+	return b.Next.ExecuteActivity(ctx, activityType, args...)
+}
+
+// ExecuteChildWorkflow calls Next.ExecuteChildWorkflow.
+func (b *WorkflowInterceptorBase) ExecuteChildWorkflow(ctx Context, childWorkflowType string, args ...interface{}) ChildWorkflowFuture {
+	return b.Next.ExecuteChildWorkflow(ctx, childWorkflowType, args...)
+}
+
+// NewTimer calls Next.NewTimer.
+func (b *WorkflowInterceptorBase) NewTimer(ctx Context, d time.Duration) Future {
+	return b.Next.NewTimer(ctx, d)
+}
+
+// SideEffect calls Next.SideEffect.
+func (b *WorkflowInterceptorBase) SideEffect(ctx Context, f func(ctx Context) interface{}) EncodedValue {
+	return b.Next.SideEffect(ctx, f)
+}
+
+// GetSignalChannel calls Next.GetSignalChannel.
+func (b *WorkflowInterceptorBase) GetSignalChannel(ctx Context, signalName string) Channel {
+	return b.Next.GetSignalChannel(ctx, signalName)
+}
+
+// SetQueryHandler calls Next.SetQueryHandler.
+func (b *WorkflowInterceptorBase) SetQueryHandler(ctx Context, queryType string, handler interface{}) error {
+	return b.Next.SetQueryHandler(ctx, queryType, handler)
+}
@@ -31,8 +31,14 @@ import (
 )
 
 type (
-	// EncodedValues is a type alias used to encapsulate/extract encoded arguments from workflow/activity.
-	EncodedValues []byte
+	// EncodedValues is used to encapsulate/extract encoded arguments from workflow/activity.
+	// Breaking change: EncodedValues used to be a []byte, see EncodedValue.
+	EncodedValues interface {
+		// HasValues return whether there are values encoded.
+		HasValues() bool
+		// Get extract data from encoded data to desired value type. valuePtr is pointer to the actual value type.
+		Get(valuePtr ...interface{}) error
+	}
 
 	// WorkflowTestSuite is the test suite to run unit tests for workflow/activity.
 	WorkflowTestSuite struct {
@@ -61,11 +67,6 @@ type (
 	}
 )
 
-// Get extract data from encoded data to desired value type. valuePtr is pointer to the actual value type.
-func (b EncodedValues) Get(valuePtr ...interface{}) error {
-	return getHostEnvironment().decode(b, valuePtr)
-}
-
 // NewTestWorkflowEnvironment creates a new instance of TestWorkflowEnvironment. You can use the returned TestWorkflowEnvironment
 // to run your workflow in the test environment.
 func (s *WorkflowTestSuite) NewTestWorkflowEnvironment() *TestWorkflowEnvironment {
@@ -102,12 +103,56 @@ func (t *TestActivityEnvironment) ExecuteActivity(activityFn interface{}, args .
 }
 
 // SetWorkerOptions sets the WorkerOptions that will be use by TestActivityEnvironment. TestActivityEnvironment will
-// use options of Identity, MetricsScope and BackgroundActivityContext on the WorkerOptions. Other options are ignored.
+// use options of Identity, MetricsScope, BackgroundActivityContext, DataConverter and ActivityInterceptorChainFactories
+// on the WorkerOptions. Other options are ignored.
 func (t *TestActivityEnvironment) SetWorkerOptions(options WorkerOptions) *TestActivityEnvironment {
 	t.impl.setWorkerOptions(options)
 	return t
 }
 
+// RegisterWorkflow registers a workflow function on this TestWorkflowEnvironment only, so it does not leak into other
+// tests. The workflows registered through the package level RegisterWorkflow are available too.
+func (t *TestWorkflowEnvironment) RegisterWorkflow(workflowFn interface{}) {
+	t.RegisterWorkflowWithOptions(workflowFn, RegisterWorkflowOptions{})
+}
+
+// RegisterWorkflowWithOptions registers a workflow function with options on this TestWorkflowEnvironment only.
+func (t *TestWorkflowEnvironment) RegisterWorkflowWithOptions(workflowFn interface{}, options RegisterWorkflowOptions) {
+	if err := t.impl.registry.RegisterWorkflowWithOptions(workflowFn, options); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterActivity registers an activity function on this TestWorkflowEnvironment only, so it does not leak into other
+// tests. The activities registered through the package level RegisterActivity are available too.
+func (t *TestWorkflowEnvironment) RegisterActivity(activityFn interface{}) {
+	t.RegisterActivityWithOptions(activityFn, RegisterActivityOptions{})
+}
+
+// RegisterActivityWithOptions registers an activity function with options on this TestWorkflowEnvironment only.
+func (t *TestWorkflowEnvironment) RegisterActivityWithOptions(activityFn interface{}, options RegisterActivityOptions) {
+	if err := t.impl.registry.RegisterActivityWithOptions(activityFn, options); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterDynamicWorkflow registers the workflow of the workflow types that are not registered, on this
+// TestWorkflowEnvironment only. The workflow types can then be passed by name to ExecuteWorkflow and
+// ExecuteChildWorkflow.
+func (t *TestWorkflowEnvironment) RegisterDynamicWorkflow(workflowFn DynamicWorkflowFunc) {
+	if err := t.impl.registry.RegisterDynamicWorkflow(workflowFn); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterDynamicActivity registers the activity of the activity types that are not registered, on this
+// TestWorkflowEnvironment only. The dynamic activity cannot be mocked, the activity types it serves are not known.
+func (t *TestWorkflowEnvironment) RegisterDynamicActivity(activityFn DynamicActivityFunc) {
+	if err := t.impl.registry.RegisterDynamicActivity(activityFn); err != nil {
+		panic(err)
+	}
+}
+
 // OnActivity setup a mock call for activity. Parameter activity must be activity function (func) or activity name (string).
 // You must call Return() with appropriate parameters on the returned *MockCallWrapper instance. The supplied parameters to
 // the Return() call should either be a function that has exact same signature as the mocked activity, or it should be
@@ -121,6 +166,7 @@ func (t *TestActivityEnvironment) SetWorkerOptions(options WorkerOptions) *TestA
 //   })
 // OR return mock values with same types as activity function's return types:
 //   t.OnActivity(MyActivity, mock.Anything, mock.Anything).Return("mock_result", nil)
+// The mock applies to the activity whether it is executed by ExecuteActivity or by ExecuteLocalActivity.
 func (t *TestWorkflowEnvironment) OnActivity(activity interface{}, args ...interface{}) *MockCallWrapper {
 	fType := reflect.TypeOf(activity)
 	var call *mock.Call
@@ -131,7 +177,7 @@ func (t *TestWorkflowEnvironment) OnActivity(activity interface{}, args ...inter
 			panic(err)
 		}
 		fnName := getFunctionName(activity)
-		if alias, ok := getHostEnvironment().getActivityAlias(fnName); ok {
+		if alias, ok := t.impl.registry.getActivityAlias(fnName); ok {
 			fnName = alias
 		}
 		call = t.Mock.On(fnName, args...)
@@ -168,7 +214,7 @@ func (t *TestWorkflowEnvironment) OnWorkflow(workflow interface{}, args ...inter
 			panic(err)
 		}
 		fnName := getFunctionName(workflow)
-		if alias, ok := getHostEnvironment().getWorkflowAlias(fnName); ok {
+		if alias, ok := t.impl.registry.getWorkflowAlias(fnName); ok {
 			fnName = alias
 		}
 		call = t.Mock.On(fnName, args...)
@@ -237,12 +283,28 @@ func (t *TestWorkflowEnvironment) Now() time.Time {
 }
 
 // SetWorkerOptions sets the WorkerOptions for TestWorkflowEnvironment. TestWorkflowEnvironment will use options set by
-// use options of Identity, MetricsScope and BackgroundActivityContext on the WorkerOptions. Other options are ignored.
+// use options of Identity, MetricsScope, BackgroundActivityContext, DataConverter, WorkflowInterceptorChainFactories
+// and ActivityInterceptorChainFactories on the WorkerOptions. Other options are ignored.
 func (t *TestWorkflowEnvironment) SetWorkerOptions(options WorkerOptions) *TestWorkflowEnvironment {
 	t.impl.setWorkerOptions(options)
 	return t
 }
 
+// SetStartWorkflowOptions sets the StartWorkflowOptions the workflow under test is started with. TestWorkflowEnvironment
+// uses the ID, TaskList, ExecutionStartToCloseTimeout, DecisionTaskStartToCloseTimeout and CronSchedule options. A
+// cron workflow runs once right away and completes with the ContinueAsNewError of the run of the next fire time.
+func (t *TestWorkflowEnvironment) SetStartWorkflowOptions(options StartWorkflowOptions) *TestWorkflowEnvironment {
+	t.impl.setStartWorkflowOptions(options)
+	return t
+}
+
+// SetLastCompletionResult sets the result of the previous run of a cron workflow, returned by GetLastCompletionResult
+// to the workflow under test.
+func (t *TestWorkflowEnvironment) SetLastCompletionResult(result interface{}) *TestWorkflowEnvironment {
+	t.impl.setLastCompletionResult(result)
+	return t
+}
+
 // SetTestTimeout sets the wall clock timeout for this workflow test run. When test timeout happen, it means workflow is
 // blocked and cannot make progress. This could happen if workflow is waiting for activity result for too long.
 // This is real wall clock time, not the workflow time (a.k.a cadence.Now() time).
@@ -329,7 +391,7 @@ func (t *TestWorkflowEnvironment) GetWorkflowResult(valuePtr interface{}) error
 	if !t.impl.isTestCompleted {
 		panic("workflow is not completed")
 	}
-	if t.impl.testResult == nil {
+	if t.impl.testResult == nil || !t.impl.testResult.HasValue() {
 		return nil
 	}
 	return t.impl.testResult.Get(valuePtr)